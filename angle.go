@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"fmt"
+	"math"
+)
+
+// AngleUnit represents a unit of angles, corresponding to the CSS angle units.
+type AngleUnit int
+
+const (
+	// AngleUnitRadian represents radians (rad).
+	AngleUnitRadian AngleUnit = iota
+
+	// AngleUnitDegree represents degrees (deg). A full turn is 360 degrees.
+	AngleUnitDegree
+
+	// AngleUnitGradian represents gradians (grad). A full turn is 400 gradians.
+	AngleUnitGradian
+
+	// AngleUnitTurn represents turns (turn).
+	AngleUnitTurn
+)
+
+// String returns the CSS name of the unit.
+func (u AngleUnit) String() string {
+	switch u {
+	case AngleUnitRadian:
+		return "rad"
+	case AngleUnitDegree:
+		return "deg"
+	case AngleUnitGradian:
+		return "grad"
+	case AngleUnitTurn:
+		return "turn"
+	default:
+		return fmt.Sprintf("AngleUnit(%d)", u)
+	}
+}
+
+// fullTurn returns the angle of a full turn in the unit.
+func (u AngleUnit) fullTurn() float64 {
+	switch u {
+	case AngleUnitRadian:
+		return 2 * math.Pi
+	case AngleUnitDegree:
+		return 360
+	case AngleUnitGradian:
+		return 400
+	case AngleUnitTurn:
+		return 1
+	default:
+		panic(fmt.Sprintf("iro: invalid AngleUnit: %d", u))
+	}
+}
+
+// ToRadians converts an angle in the unit to radians.
+func (u AngleUnit) ToRadians(v float64) float64 {
+	if u == AngleUnitRadian {
+		return v
+	}
+	return v / u.fullTurn() * 2 * math.Pi
+}
+
+// FromRadians converts an angle in radians to the unit.
+func (u AngleUnit) FromRadians(rad float64) float64 {
+	if u == AngleUnitRadian {
+		return rad
+	}
+	return rad / (2 * math.Pi) * u.fullTurn()
+}
+
+// Normalize returns the angle in the unit normalized to [0, a full turn).
+func (u AngleUnit) Normalize(v float64) float64 {
+	t := u.fullTurn()
+	v = math.Mod(v, t)
+	if v < 0 {
+		v += t
+	}
+	// v can be t when a small negative value is added to t.
+	if v == t {
+		v = 0
+	}
+	return v
+}
+
+// NormalizeHue returns the hue in radians normalized to [0, 2π).
+func NormalizeHue(h float64) float64 {
+	return AngleUnitRadian.Normalize(h)
+}
+
+// NormalizeHueDeg returns the hue in degrees normalized to [0, 360).
+func NormalizeHueDeg(h float64) float64 {
+	return AngleUnitDegree.Normalize(h)
+}
+
+// angleUnitFromCSS returns the AngleUnit of the CSS unit name.
+func angleUnitFromCSS(name string) (AngleUnit, bool) {
+	switch name {
+	case "rad":
+		return AngleUnitRadian, true
+	case "deg":
+		return AngleUnitDegree, true
+	case "grad":
+		return AngleUnitGradian, true
+	case "turn":
+		return AngleUnitTurn, true
+	}
+	return 0, false
+}
+
+// ParseAngle parses a CSS angle like "90deg", "100grad", "1.5708rad", or "0.25turn", and returns the angle in radians.
+// As the hue of CSS colors, a number without a unit is treated as degrees.
+//
+// The error is a *[ParseError] like [ParseCSS].
+func ParseAngle(s string) (float64, error) {
+	tokens, err := tokenizeCSS(s, false)
+	if err != nil {
+		return 0, withParseInput(err, s)
+	}
+	if len(tokens) != 2 {
+		t := tokens[min(1, len(tokens)-1)]
+		return 0, withParseInput(newParseError(ErrSyntax, t.pos, "unexpected %s in an angle", t), s)
+	}
+	rad, err := cssHue(tokens[0])
+	if err != nil {
+		return 0, withParseInput(err, s)
+	}
+	if tokens[0].kind == cssTokenIdent {
+		// none is not an angle.
+		return 0, withParseInput(newParseError(ErrSyntax, tokens[0].pos, "unexpected %s in an angle", tokens[0]), s)
+	}
+	return rad, nil
+}
@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"math"
+)
+
+// APCA-W3 0.0.98G-4g constants.
+const (
+	apcaMainTRC = 2.4
+
+	apcaNormBG  = 0.56
+	apcaNormTXT = 0.57
+	apcaRevTXT  = 0.62
+	apcaRevBG   = 0.65
+
+	apcaBlkThrs = 0.022
+	apcaBlkClmp = 1.414
+
+	apcaScale     = 1.14
+	apcaLoOffset  = 0.027
+	apcaDeltaYMin = 0.0005
+	apcaLoClip    = 0.1
+)
+
+// apcaY returns the estimated screen luminance of c for APCA, soft-clamped near black.
+func apcaY(c Color) float64 {
+	r, g, b, _ := c.SRGB()
+	r, g, b = min(max(r, 0), 1), min(max(g, 0), 1), min(max(b, 0), 1)
+	y := 0.2126729*math.Pow(r, apcaMainTRC) + 0.7151522*math.Pow(g, apcaMainTRC) + 0.0721750*math.Pow(b, apcaMainTRC)
+	if y < apcaBlkThrs {
+		y += math.Pow(apcaBlkThrs-y, apcaBlkClmp)
+	}
+	return y
+}
+
+// APCAContrast returns the APCA lightness contrast Lc of the text color on the background color,
+// by the APCA-W3 0.0.98G-4g algorithm proposed for WCAG 3.
+//
+// Lc is positive for dark text on a light background and negative for light text on a dark background.
+// Its magnitude is at most about 108. The colors are clamped to the sRGB gamut and the alpha values are ignored.
+//
+// See https://github.com/Myndex/apca-w3
+func APCAContrast(text, background Color) float64 {
+	yt, yb := apcaY(text), apcaY(background)
+	if math.Abs(yb-yt) < apcaDeltaYMin {
+		return 0
+	}
+
+	if yb > yt {
+		sapc := (math.Pow(yb, apcaNormBG) - math.Pow(yt, apcaNormTXT)) * apcaScale
+		if sapc < apcaLoClip {
+			return 0
+		}
+		return (sapc - apcaLoOffset) * 100
+	}
+
+	sapc := (math.Pow(yb, apcaRevBG) - math.Pow(yt, apcaRevTXT)) * apcaScale
+	if sapc > -apcaLoClip {
+		return 0
+	}
+	return (sapc + apcaLoOffset) * 100
+}
+
+const (
+	// apcaProhibited represents that no text is allowed.
+	apcaProhibited = 999
+
+	// apcaNonText represents that only non-text elements are allowed.
+	apcaNonText = 777
+)
+
+// apcaFontLookup is the minimum font sizes in CSS pixels for font weights from 100 to 900,
+// for each Lc from 0 in steps of 5.
+//
+// The table is fontLookupAPCA of apca-w3 0.1.9.
+var apcaFontLookup = [][9]float64{
+	{999, 999, 999, 999, 999, 999, 999, 999, 999}, // 0
+	{999, 999, 999, 999, 999, 999, 999, 999, 999}, // 5
+	{999, 999, 999, 999, 999, 999, 999, 999, 999}, // 10
+	{777, 777, 777, 777, 777, 777, 777, 777, 777}, // 15
+	{777, 777, 777, 777, 777, 777, 777, 777, 777}, // 20
+	{777, 777, 777, 120, 120, 108, 96, 96, 96},    // 25
+	{777, 777, 120, 108, 108, 96, 72, 72, 72},     // 30
+	{777, 120, 108, 96, 72, 60, 48, 48, 48},       // 35
+	{120, 108, 96, 60, 48, 42, 32, 32, 32},        // 40
+	{108, 96, 72, 42, 32, 28, 24, 24, 24},         // 45
+	{96, 72, 60, 32, 28, 24, 21, 21, 21},          // 50
+	{80, 60, 48, 28, 24, 21, 18, 18, 18},          // 55
+	{72, 48, 42, 24, 21, 18, 16, 16, 18},          // 60
+	{68, 46, 32, 21.75, 19, 17, 15, 16, 18},       // 65
+	{64, 44, 28, 19.5, 18, 16, 14.5, 16, 18},      // 70
+	{60, 42, 24, 18, 16, 15, 14, 16, 18},          // 75
+	{56, 38.25, 23, 17.25, 15.81, 14, 13, 16, 18}, // 80
+	{52, 34.5, 22, 16.5, 15, 13, 12, 16, 18},      // 85
+	{48, 32, 21, 16, 14, 12, 11, 16, 18},          // 90
+	{45, 28, 19.5, 15.5, 13, 11, 10, 16, 18},      // 95
+	{42, 26.5, 18.5, 15, 12, 10, 9, 16, 18},       // 100
+	{39, 25, 18, 14, 11, 9, 8, 16, 18},            // 105
+	{36, 24, 18, 14, 11, 8, 7, 16, 18},            // 110
+	{34, 22.5, 17.5, 14, 11, 8, 7, 16, 18},        // 115
+	{32, 21, 17, 14, 11, 8, 7, 16, 18},            // 120
+	{30, 21, 17, 14, 11, 8, 7, 16, 18},            // 125
+}
+
+// APCAMinFontSize returns the minimum font size in CSS pixels for text with the font weight
+// to be readable at the APCA contrast lc, per the APCA font lookup table of the draft WCAG 3.
+// The sign of lc is ignored, and lc is rounded down to a multiple of 5.
+// fontWeight is rounded to the nearest multiple of 100 in [100, 900].
+//
+// APCAMinFontSize returns false if no text is readable at lc.
+// Contrasts at or above 15 might still be enough for non-text elements like dividers.
+func APCAMinFontSize(lc float64, fontWeight int) (float64, bool) {
+	i := min(int(math.Abs(lc)/5), len(apcaFontLookup)-1)
+	w := min(max((fontWeight+50)/100, 1), 9) - 1
+	size := apcaFontLookup[i][w]
+	if size == apcaProhibited || size == apcaNonText {
+		return 0, false
+	}
+	return size, true
+}
+
+// APCAPasses reports whether text with the font size in CSS pixels and the font weight
+// is readable at the APCA contrast lc. See [APCAMinFontSize].
+func APCAPasses(lc float64, fontSize float64, fontWeight int) bool {
+	size, ok := APCAMinFontSize(lc, fontWeight)
+	return ok && fontSize >= size
+}
@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestAPCAContrast(t *testing.T) {
+	gray := iro.ColorFromSRGB(0x88/255.0, 0x88/255.0, 0x88/255.0, 1)
+	testCases := []struct {
+		text       iro.Color
+		background iro.Color
+		want       float64
+	}{
+		{text: iro.Black, background: iro.White, want: 106.04},
+		{text: iro.White, background: iro.Black, want: -107.88},
+		{text: gray, background: iro.White, want: 63.06},
+		{text: iro.White, background: gray, want: -68.54},
+		{text: gray, background: gray, want: 0},
+	}
+	for _, tc := range testCases {
+		if got := iro.APCAContrast(tc.text, tc.background); math.Abs(got-tc.want) > 0.01 {
+			t.Errorf("APCAContrast(%v, %v): got %f, want %f", tc.text, tc.background, got, tc.want)
+		}
+	}
+}
+
+func TestAPCAMinFontSize(t *testing.T) {
+	testCases := []struct {
+		lc     float64
+		weight int
+		want   float64
+		ok     bool
+	}{
+		{lc: 90, weight: 400, want: 16, ok: true},
+		{lc: -90, weight: 400, want: 16, ok: true},
+		{lc: 94.9, weight: 400, want: 16, ok: true},
+		{lc: 75, weight: 700, want: 14, ok: true},
+		{lc: 60, weight: 450, want: 21, ok: true},
+		{lc: 60, weight: 449, want: 24, ok: true},
+		{lc: 108, weight: 0, want: 39, ok: true},
+		{lc: 200, weight: 1000, want: 18, ok: true},
+		{lc: 30, weight: 200, ok: false},
+		{lc: 10, weight: 700, ok: false},
+	}
+	for _, tc := range testCases {
+		got, ok := iro.APCAMinFontSize(tc.lc, tc.weight)
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("APCAMinFontSize(%f, %d): got (%f, %t), want (%f, %t)", tc.lc, tc.weight, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestAPCAPasses(t *testing.T) {
+	lc := iro.APCAContrast(iro.ColorFromSRGB(0x88/255.0, 0x88/255.0, 0x88/255.0, 1), iro.White)
+	testCases := []struct {
+		size   float64
+		weight int
+		want   bool
+	}{
+		{size: 16, weight: 400, want: false},
+		{size: 24, weight: 400, want: true},
+		{size: 18, weight: 700, want: true},
+		{size: 72, weight: 100, want: true},
+		{size: 48, weight: 100, want: false},
+	}
+	for _, tc := range testCases {
+		if got := iro.APCAPasses(lc, tc.size, tc.weight); got != tc.want {
+			t.Errorf("APCAPasses(%f, %f, %d): got %t, want %t", lc, tc.size, tc.weight, got, tc.want)
+		}
+	}
+}
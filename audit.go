@@ -0,0 +1,180 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"math"
+)
+
+// ContrastAuditOptions represents options for [AuditContrast].
+type ContrastAuditOptions struct {
+	// MinWCAGContrast is the minimum WCAG 2 contrast ratio to pass.
+	// If MinWCAGContrast is 0, [WCAGContrastAA] is used.
+	MinWCAGContrast float64
+
+	// MinAPCAContrast is the minimum magnitude of the APCA contrast Lc to pass.
+	// If MinAPCAContrast is 0, 60 is used, which is the minimum for content text other than body text.
+	MinAPCAContrast float64
+}
+
+func (o *ContrastAuditOptions) minWCAGContrast() float64 {
+	if o == nil || o.MinWCAGContrast == 0 {
+		return WCAGContrastAA
+	}
+	return o.MinWCAGContrast
+}
+
+func (o *ContrastAuditOptions) minAPCAContrast() float64 {
+	if o == nil || o.MinAPCAContrast == 0 {
+		return 60
+	}
+	return o.MinAPCAContrast
+}
+
+// ContrastPair is the result of the contrast audit of a pair of a foreground color and a background color.
+type ContrastPair struct {
+	// Foreground and Background are the indices of the colors in the palette and the backgrounds.
+	Foreground int
+	Background int
+
+	// WCAG is the WCAG 2 contrast ratio. See [WCAGContrast].
+	WCAG float64
+
+	// APCA is the APCA contrast Lc of the foreground as text on the background. See [APCAContrast].
+	APCA float64
+
+	// WCAGPasses and APCAPasses report whether the contrasts are enough.
+	WCAGPasses bool
+	APCAPasses bool
+
+	// Suggestion is the color nearest to the foreground with the same OKLCh hue that passes both the contrasts,
+	// found by changing the OKLCh lightness. The chroma is reduced if needed to fit the sRGB gamut.
+	// Suggestion is valid only when HasSuggestion is true.
+	// If the pair already passes, Suggestion is the foreground itself.
+	Suggestion    Color
+	HasSuggestion bool
+}
+
+// Passes reports whether the pair passes both the WCAG 2 and the APCA contrasts.
+func (p *ContrastPair) Passes() bool {
+	return p.WCAGPasses && p.APCAPasses
+}
+
+// ContrastAudit is the result of [AuditContrast].
+type ContrastAudit struct {
+	// Pairs is the matrix of the results indexed by the foreground and the background.
+	Pairs [][]ContrastPair
+}
+
+// OK reports whether all the pairs pass.
+func (a *ContrastAudit) OK() bool {
+	for _, row := range a.Pairs {
+		for i := range row {
+			if !row[i].Passes() {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Failures returns the pairs that don't pass, in the order of the foregrounds and the backgrounds.
+func (a *ContrastAudit) Failures() []ContrastPair {
+	var ps []ContrastPair
+	for _, row := range a.Pairs {
+		for _, p := range row {
+			if !p.Passes() {
+				ps = append(ps, p)
+			}
+		}
+	}
+	return ps
+}
+
+// AuditContrast audits the contrasts of all the pairs of the colors of the palette on the backgrounds,
+// for example to check a design system in continuous integration.
+// If backgrounds is nil, the palette itself is used as the backgrounds, and the pairs of the same colors are skipped as passing.
+// If opts is nil, the default options are used.
+//
+// For the failing pairs, AuditContrast suggests adjusted foreground colors that pass.
+func AuditContrast(palette, backgrounds []Color, opts *ContrastAuditOptions) *ContrastAudit {
+	self := backgrounds == nil
+	if self {
+		backgrounds = palette
+	}
+	minWCAG := opts.minWCAGContrast()
+	minAPCA := opts.minAPCAContrast()
+	passes := func(fg, bg Color) (wcag, apca float64, wcagOK, apcaOK bool) {
+		wcag = WCAGContrast(fg, bg)
+		apca = APCAContrast(fg, bg)
+		return wcag, apca, wcag >= minWCAG, math.Abs(apca) >= minAPCA
+	}
+
+	a := &ContrastAudit{
+		Pairs: make([][]ContrastPair, len(palette)),
+	}
+	for i, fg := range palette {
+		a.Pairs[i] = make([]ContrastPair, len(backgrounds))
+		for j, bg := range backgrounds {
+			p := ContrastPair{
+				Foreground: i,
+				Background: j,
+			}
+			if self && i == j {
+				p.WCAG = 1
+				p.WCAGPasses, p.APCAPasses = true, true
+				p.Suggestion, p.HasSuggestion = fg, true
+				a.Pairs[i][j] = p
+				continue
+			}
+			p.WCAG, p.APCA, p.WCAGPasses, p.APCAPasses = passes(fg, bg)
+			if p.Passes() {
+				p.Suggestion, p.HasSuggestion = fg, true
+			} else {
+				p.Suggestion, p.HasSuggestion = suggestContrastColor(fg, func(c Color) bool {
+					_, _, wcagOK, apcaOK := passes(c, bg)
+					return wcagOK && apcaOK
+				})
+			}
+			a.Pairs[i][j] = p
+		}
+	}
+	return a
+}
+
+// suggestContrastColor returns the color nearest to fg in the OKLCh lightness with the same hue that satisfies ok.
+func suggestContrastColor(fg Color, ok func(c Color) bool) (Color, bool) {
+	l, ch, h, alpha := fg.OKLch()
+	l = min(max(l, 0), 1)
+	color := func(l float64) Color {
+		return colorFromOKLchInSRGB(l, ch, h).WithAlpha(alpha)
+	}
+
+	// Search the nearest lightness in both directions, and then refine it by bisection.
+	const step = 1.0 / 128
+	for d := step; d <= 1; d += step {
+		for _, sign := range []float64{-1, 1} {
+			l1 := l + sign*d
+			if l1 < 0 || l1 > 1 || !ok(color(l1)) {
+				continue
+			}
+			lo, hi := l+sign*(d-step), l1
+			for i := 0; i < 32; i++ {
+				mid := (lo + hi) / 2
+				if ok(color(mid)) {
+					hi = mid
+				} else {
+					lo = mid
+				}
+			}
+			return color(hi), true
+		}
+	}
+	for _, l1 := range []float64{0, 1} {
+		if c := color(l1); ok(c) {
+			return c, true
+		}
+	}
+	return Color{}, false
+}
@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestAuditContrast(t *testing.T) {
+	gray := iro.ColorFromSRGB(0x77/255.0, 0x77/255.0, 0x77/255.0, 1)
+	palette := []iro.Color{iro.Black, gray, iro.ColorFromSRGB(0.2, 0.4, 0.9, 1)}
+	backgrounds := []iro.Color{iro.White, iro.Black}
+	a := iro.AuditContrast(palette, backgrounds, nil)
+
+	if got, want := len(a.Pairs), len(palette); got != want {
+		t.Fatalf("len(Pairs): got %d, want %d", got, want)
+	}
+	for i, row := range a.Pairs {
+		if got, want := len(row), len(backgrounds); got != want {
+			t.Fatalf("len(Pairs[%d]): got %d, want %d", i, got, want)
+		}
+		for j, p := range row {
+			if p.Foreground != i || p.Background != j {
+				t.Errorf("Pairs[%d][%d]: got indices (%d, %d)", i, j, p.Foreground, p.Background)
+			}
+			if got, want := p.WCAG, iro.WCAGContrast(palette[i], backgrounds[j]); !checkTol(got, want) {
+				t.Errorf("Pairs[%d][%d].WCAG: got %f, want %f", i, j, got, want)
+			}
+			if got, want := p.APCA, iro.APCAContrast(palette[i], backgrounds[j]); !checkTol(got, want) {
+				t.Errorf("Pairs[%d][%d].APCA: got %f, want %f", i, j, got, want)
+			}
+			if !p.HasSuggestion {
+				t.Errorf("Pairs[%d][%d]: no suggestion", i, j)
+				continue
+			}
+			if p.Passes() {
+				if p.Suggestion != palette[i] {
+					t.Errorf("Pairs[%d][%d].Suggestion: got %v, want %v", i, j, p.Suggestion, palette[i])
+				}
+				continue
+			}
+			// The suggestion passes both the contrasts and keeps the hue.
+			s := p.Suggestion
+			if c := iro.WCAGContrast(s, backgrounds[j]); c < iro.WCAGContrastAA {
+				t.Errorf("Pairs[%d][%d].Suggestion: WCAG contrast: got %f, want >= %f", i, j, c, iro.WCAGContrastAA)
+			}
+			if c := iro.APCAContrast(s, backgrounds[j]); math.Abs(c) < 60 {
+				t.Errorf("Pairs[%d][%d].Suggestion: APCA contrast: got %f, want >= 60", i, j, c)
+			}
+			if _, ch, h, _ := palette[i].OKLch(); ch > 1e-3 {
+				if _, _, h1, _ := s.OKLch(); math.Abs(math.Remainder(h1-h, 2*math.Pi)) > 1e-3 {
+					t.Errorf("Pairs[%d][%d].Suggestion: hue: got %f, want %f", i, j, h1, h)
+				}
+			}
+		}
+	}
+
+	// Black on white passes, and black on black fails.
+	if !a.Pairs[0][0].Passes() {
+		t.Errorf("black on white: got failing, want passing")
+	}
+	if a.Pairs[0][1].Passes() {
+		t.Errorf("black on black: got passing, want failing")
+	}
+	// #777777 on white is slightly below 4.5:1, and the suggestion is slightly darker.
+	p := a.Pairs[1][0]
+	if p.WCAGPasses {
+		t.Errorf("#777777 on white: got passing WCAG, want failing")
+	}
+	if l0, l1 := gray.LStar(), p.Suggestion.LStar(); l1 >= l0 || l0-l1 > 10 {
+		t.Errorf("#777777 on white: suggestion L*: got %f, want slightly less than %f", l1, l0)
+	}
+
+	if a.OK() {
+		t.Errorf("OK: got true, want false")
+	}
+	for _, p := range a.Failures() {
+		if p.Passes() {
+			t.Errorf("Failures: got a passing pair (%d, %d)", p.Foreground, p.Background)
+		}
+	}
+}
+
+func TestAuditContrastSelf(t *testing.T) {
+	a := iro.AuditContrast([]iro.Color{iro.White, iro.Black}, nil, nil)
+	if !a.OK() {
+		t.Errorf("OK: got false, want true: %v", a.Failures())
+	}
+	if got, want := len(a.Pairs), 2; got != want {
+		t.Fatalf("len(Pairs): got %d, want %d", got, want)
+	}
+
+	// A strict threshold fails.
+	opts := &iro.ContrastAuditOptions{MinAPCAContrast: 200}
+	a = iro.AuditContrast([]iro.Color{iro.White, iro.Black}, nil, opts)
+	if got, want := len(a.Failures()), 2; got != want {
+		t.Errorf("len(Failures): got %d, want %d", got, want)
+	}
+	for _, p := range a.Failures() {
+		if p.HasSuggestion {
+			t.Errorf("(%d, %d): got a suggestion %v for an impossible contrast", p.Foreground, p.Background, p.Suggestion)
+		}
+	}
+}
@@ -0,0 +1,603 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+)
+
+// bigGuardBits is the number of extra bits of the intermediate values in ConvertBig.
+const bigGuardBits = 32
+
+// ConvertBig converts the components in the space from to the components in the space to
+// with [big.Float] arithmetic of prec bits of precision.
+// As [Color.Components], hues are in radians.
+// The results are newly allocated with the precision prec.
+//
+// The constants are the same as the float64 implementation, like the decimals of the OKLab matrices and 2.4 of the sRGB transfer function,
+// and the matrices between linear spaces are the exact ones of [ConversionMatrixRat].
+// Then the results are accurate to prec bits except for the rounding of the constants.
+// ConvertBig is much slower than the float64 implementation,
+// and is intended to generate golden test data and to validate the error bounds of the float64 implementation.
+//
+// ConvertBig panics if prec is 0 or a component is infinite.
+func ConvertBig(from, to Space, c0, c1, c2 *big.Float, prec uint) (*big.Float, *big.Float, *big.Float) {
+	if prec == 0 {
+		panic("iro: the precision must be positive")
+	}
+	p := prec + bigGuardBits
+	v := [3]*big.Float{
+		newBigFloat(p).Set(c0),
+		newBigFloat(p).Set(c1),
+		newBigFloat(p).Set(c2),
+	}
+	for _, x := range v {
+		if x.IsInf() {
+			panic("iro: the components must be finite")
+		}
+	}
+	v = bigFromXYZ(to, bigToXYZ(from, v, p), p)
+	for _, x := range v {
+		x.SetPrec(prec)
+	}
+	return v[0], v[1], v[2]
+}
+
+func newBigFloat(prec uint) *big.Float {
+	return new(big.Float).SetPrec(prec)
+}
+
+// bigFromFloat64 returns the shortest decimal representation of v as a big.Float.
+// For a constant written as a decimal, this is the decimal rather than the nearest binary value.
+func bigFromFloat64(v float64, prec uint) *big.Float {
+	f, ok := newBigFloat(prec).SetString(strconv.FormatFloat(v, 'g', -1, 64))
+	if !ok {
+		panic(fmt.Sprintf("iro: invalid number: %v", v))
+	}
+	return f
+}
+
+// bigFromString returns the decimal or the fraction s as a big.Float.
+func bigFromString(s string, prec uint) *big.Float {
+	return newBigFloat(prec).SetRat(rat(s))
+}
+
+// bigFloatMatrix is a 3×3 matrix of big.Float.
+type bigFloatMatrix [3][3]*big.Float
+
+func bigFloatMatrixFromRat(m *ratMatrix, prec uint) bigFloatMatrix {
+	var r bigFloatMatrix
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			r[i][j] = newBigFloat(prec).SetRat(m[i][j])
+		}
+	}
+	return r
+}
+
+func bigFloatMatrixFromMatrix3(m *Matrix3, prec uint) bigFloatMatrix {
+	var r bigFloatMatrix
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			r[i][j] = bigFromFloat64(m[i][j], prec)
+		}
+	}
+	return r
+}
+
+// apply returns m·v.
+func (m *bigFloatMatrix) apply(v [3]*big.Float) [3]*big.Float {
+	var r [3]*big.Float
+	for i := 0; i < 3; i++ {
+		prec := m[i][0].Prec()
+		r[i] = newBigFloat(prec)
+		t := newBigFloat(prec)
+		for k := 0; k < 3; k++ {
+			r[i].Add(r[i], t.Mul(m[i][k], v[k]))
+		}
+	}
+	return r
+}
+
+// bigToXYZ converts the components in the space s to XYZ D65.
+func bigToXYZ(s Space, v [3]*big.Float, prec uint) [3]*big.Float {
+	switch s {
+	case SpaceOKLch:
+		return bigToXYZ(SpaceOKLab, bigFromPolar(v, prec), prec)
+	case SpaceLch:
+		return bigToXYZ(SpaceLab, bigFromPolar(v, prec), prec)
+	case SpaceOKLab:
+		m := bigFloatMatrixFromMatrix3(&MatrixOKLabToOKLabLMS, prec)
+		lms := m.apply(v)
+		for _, x := range lms {
+			x.Mul(x, newBigFloat(prec).Mul(x, x))
+		}
+		m = bigFloatMatrixFromMatrix3(&MatrixOKLabLMSToXYZ, prec)
+		return m.apply(lms)
+	case SpaceLab:
+		return bigLabToXYZ(v, prec)
+	}
+	if s.isLinear() {
+		m := s.ratToXYZMatrix()
+		bm := bigFloatMatrixFromRat(&m, prec)
+		return bm.apply(v)
+	}
+	linear, ok := s.bigLinearSpace()
+	if !ok {
+		panic(fmt.Sprintf("iro: invalid Space: %d", s))
+	}
+	for i, x := range v {
+		v[i] = bigDegamma(s, x, prec)
+	}
+	return bigToXYZ(linear, v, prec)
+}
+
+// bigFromXYZ converts XYZ D65 to the components in the space s.
+func bigFromXYZ(s Space, v [3]*big.Float, prec uint) [3]*big.Float {
+	switch s {
+	case SpaceOKLch:
+		return bigToPolar(bigFromXYZ(SpaceOKLab, v, prec), prec)
+	case SpaceLch:
+		return bigToPolar(bigFromXYZ(SpaceLab, v, prec), prec)
+	case SpaceOKLab:
+		m := bigFloatMatrixFromMatrix3(&MatrixXYZToOKLabLMS, prec)
+		lms := m.apply(v)
+		for i, x := range lms {
+			lms[i] = bigCbrt(x, prec)
+		}
+		m = bigFloatMatrixFromMatrix3(&MatrixOKLabLMSToOKLab, prec)
+		return m.apply(lms)
+	case SpaceLab:
+		return bigXYZToLab(v, prec)
+	}
+	if s.isLinear() {
+		m := s.ratToXYZMatrix()
+		m = m.inv()
+		bm := bigFloatMatrixFromRat(&m, prec)
+		return bm.apply(v)
+	}
+	linear, ok := s.bigLinearSpace()
+	if !ok {
+		panic(fmt.Sprintf("iro: invalid Space: %d", s))
+	}
+	v = bigFromXYZ(linear, v, prec)
+	for i, x := range v {
+		v[i] = bigGamma(s, x, prec)
+	}
+	return v
+}
+
+// bigLinearSpace returns the linear space of the nonlinear RGB space s.
+func (s Space) bigLinearSpace() (Space, bool) {
+	switch s {
+	case SpaceSRGB, SpaceSRGBGamma22:
+		return SpaceLinearSRGB, true
+	case SpaceDisplayP3:
+		return SpaceLinearDisplayP3, true
+	case SpaceRec2020:
+		return SpaceLinearRec2020, true
+	case SpaceA98RGB:
+		return SpaceLinearA98RGB, true
+	case SpaceProPhotoRGB:
+		return SpaceLinearProPhotoRGB, true
+	}
+	return 0, false
+}
+
+// bigDegamma is the big.Float version of the transfer functions to linear values.
+func bigDegamma(s Space, x *big.Float, prec uint) *big.Float {
+	abs := newBigFloat(prec).Abs(x)
+	r := newBigFloat(prec)
+	switch s {
+	case SpaceSRGB, SpaceDisplayP3:
+		// See degamma.
+		if abs.Cmp(bigFromString("0.04045", prec)) <= 0 {
+			return r.Quo(x, bigFromString("12.92", prec))
+		}
+		r.Add(abs, bigFromString("0.055", prec))
+		r.Quo(r, bigFromString("1.055", prec))
+		r = bigPow(r, bigFromString("2.4", prec), prec)
+	case SpaceRec2020:
+		// See rec2020Degamma.
+		alpha := bigFromFloat64(rec2020Alpha, prec)
+		beta := bigFromFloat64(rec2020Beta, prec)
+		if abs.Cmp(newBigFloat(prec).Mul(beta, bigFromString("4.5", prec))) < 0 {
+			return r.Quo(x, bigFromString("4.5", prec))
+		}
+		r.Add(abs, alpha)
+		r.Sub(r, newBigFloat(prec).SetInt64(1))
+		r.Quo(r, alpha)
+		r = bigPow(r, newBigFloat(prec).Quo(newBigFloat(prec).SetInt64(1), bigFromString("0.45", prec)), prec)
+	case SpaceSRGBGamma22:
+		// See degamma22.
+		r = bigPow(abs, bigFromString("2.2", prec), prec)
+	case SpaceA98RGB:
+		// See a98Degamma.
+		r = bigPow(abs, bigFromString("563/256", prec), prec)
+	case SpaceProPhotoRGB:
+		// See prophotoDegamma.
+		if abs.Cmp(bigFromString("16/512", prec)) <= 0 {
+			return r.Quo(x, newBigFloat(prec).SetInt64(16))
+		}
+		r = bigPow(abs, bigFromString("1.8", prec), prec)
+	default:
+		panic(fmt.Sprintf("iro: invalid Space: %d", s))
+	}
+	if x.Signbit() {
+		r.Neg(r)
+	}
+	return r
+}
+
+// bigGamma is the big.Float version of the transfer functions to nonlinear values.
+func bigGamma(s Space, x *big.Float, prec uint) *big.Float {
+	abs := newBigFloat(prec).Abs(x)
+	r := newBigFloat(prec)
+	switch s {
+	case SpaceSRGB, SpaceDisplayP3:
+		// See gamma.
+		if abs.Cmp(bigFromString("0.0031308", prec)) <= 0 {
+			return r.Mul(x, bigFromString("12.92", prec))
+		}
+		r = bigPow(abs, newBigFloat(prec).Quo(newBigFloat(prec).SetInt64(1), bigFromString("2.4", prec)), prec)
+		r.Mul(r, bigFromString("1.055", prec))
+		r.Sub(r, bigFromString("0.055", prec))
+	case SpaceRec2020:
+		// See rec2020Gamma.
+		alpha := bigFromFloat64(rec2020Alpha, prec)
+		beta := bigFromFloat64(rec2020Beta, prec)
+		if abs.Cmp(beta) < 0 {
+			return r.Mul(x, bigFromString("4.5", prec))
+		}
+		r = bigPow(abs, bigFromString("0.45", prec), prec)
+		r.Mul(r, alpha)
+		r.Sub(r, alpha)
+		r.Add(r, newBigFloat(prec).SetInt64(1))
+	case SpaceSRGBGamma22:
+		// See gamma22.
+		r = bigPow(abs, newBigFloat(prec).Quo(newBigFloat(prec).SetInt64(1), bigFromString("2.2", prec)), prec)
+	case SpaceA98RGB:
+		// See a98Gamma.
+		r = bigPow(abs, bigFromString("256/563", prec), prec)
+	case SpaceProPhotoRGB:
+		// See prophotoGamma.
+		if abs.Cmp(bigFromString("1/512", prec)) < 0 {
+			return r.Mul(x, newBigFloat(prec).SetInt64(16))
+		}
+		r = bigPow(abs, newBigFloat(prec).Quo(newBigFloat(prec).SetInt64(1), bigFromString("1.8", prec)), prec)
+	default:
+		panic(fmt.Sprintf("iro: invalid Space: %d", s))
+	}
+	if x.Signbit() {
+		r.Neg(r)
+	}
+	return r
+}
+
+// bigD50 returns the XYZ of D50 with Y = 1.
+func bigD50(prec uint) [3]*big.Float {
+	w := ratXYZFromChromaticity(WhiteD50)
+	return [3]*big.Float{
+		newBigFloat(prec).SetRat(w[0]),
+		newBigFloat(prec).SetRat(w[1]),
+		newBigFloat(prec).SetRat(w[2]),
+	}
+}
+
+// bigXYZToLab is the big.Float version of [Color.Lab].
+func bigXYZToLab(v [3]*big.Float, prec uint) [3]*big.Float {
+	m := ratBradfordD65ToD50()
+	bm := bigFloatMatrixFromRat(&m, prec)
+	v = bm.apply(v)
+
+	epsilon := bigFromString("216/24389", prec)
+	kappa := bigFromString("24389/27", prec)
+	w := bigD50(prec)
+	var f [3]*big.Float
+	for i := range v {
+		t := newBigFloat(prec).Quo(v[i], w[i])
+		if t.Cmp(epsilon) > 0 {
+			f[i] = bigCbrt(t, prec)
+			continue
+		}
+		// (κt + 16) / 116
+		f[i] = t.Mul(t, kappa)
+		f[i].Add(f[i], newBigFloat(prec).SetInt64(16))
+		f[i].Quo(f[i], newBigFloat(prec).SetInt64(116))
+	}
+
+	l := newBigFloat(prec).Mul(f[1], newBigFloat(prec).SetInt64(116))
+	l.Sub(l, newBigFloat(prec).SetInt64(16))
+	a := newBigFloat(prec).Sub(f[0], f[1])
+	a.Mul(a, newBigFloat(prec).SetInt64(500))
+	b := newBigFloat(prec).Sub(f[1], f[2])
+	b.Mul(b, newBigFloat(prec).SetInt64(200))
+	return [3]*big.Float{l, a, b}
+}
+
+// bigLabToXYZ is the big.Float version of [ColorFromLab].
+func bigLabToXYZ(v [3]*big.Float, prec uint) [3]*big.Float {
+	epsilon := bigFromString("216/24389", prec)
+	kappa := bigFromString("24389/27", prec)
+
+	fy := newBigFloat(prec).Add(v[0], newBigFloat(prec).SetInt64(16))
+	fy.Quo(fy, newBigFloat(prec).SetInt64(116))
+	fx := newBigFloat(prec).Quo(v[1], newBigFloat(prec).SetInt64(500))
+	fx.Add(fx, fy)
+	fz := newBigFloat(prec).Quo(v[2], newBigFloat(prec).SetInt64(200))
+	fz.Sub(fy, fz)
+
+	fInv := func(f *big.Float) *big.Float {
+		f3 := newBigFloat(prec).Mul(f, f)
+		f3.Mul(f3, f)
+		if f3.Cmp(epsilon) > 0 {
+			return f3
+		}
+		// (116f - 16) / κ
+		r := newBigFloat(prec).Mul(f, newBigFloat(prec).SetInt64(116))
+		r.Sub(r, newBigFloat(prec).SetInt64(16))
+		return r.Quo(r, kappa)
+	}
+
+	w := bigD50(prec)
+	x := fInv(fx)
+	var y *big.Float
+	if v[0].Cmp(newBigFloat(prec).Mul(kappa, epsilon)) > 0 {
+		y = newBigFloat(prec).Mul(fy, fy)
+		y.Mul(y, fy)
+	} else {
+		y = newBigFloat(prec).Quo(v[0], kappa)
+	}
+	z := fInv(fz)
+	xyz := [3]*big.Float{x.Mul(x, w[0]), y.Mul(y, w[1]), z.Mul(z, w[2])}
+
+	m := ratBradfordD65ToD50()
+	m = m.inv()
+	bm := bigFloatMatrixFromRat(&m, prec)
+	return bm.apply(xyz)
+}
+
+// bigToPolar converts (L, a, b) to (L, C, h), where h is in radians.
+func bigToPolar(v [3]*big.Float, prec uint) [3]*big.Float {
+	c := newBigFloat(prec).Mul(v[1], v[1])
+	c.Add(c, newBigFloat(prec).Mul(v[2], v[2]))
+	c.Sqrt(c)
+	return [3]*big.Float{v[0], c, bigAtan2(v[2], v[1], prec)}
+}
+
+// bigFromPolar converts (L, C, h) to (L, a, b), where h is in radians.
+func bigFromPolar(v [3]*big.Float, prec uint) [3]*big.Float {
+	sin, cos := bigSinCos(v[2], prec)
+	return [3]*big.Float{v[0], cos.Mul(cos, v[1]), sin.Mul(sin, v[1])}
+}
+
+// bigEpsilon returns 2^-prec.
+func bigEpsilon(prec uint) *big.Float {
+	return newBigFloat(prec).SetMantExp(newBigFloat(prec).SetInt64(1), -int(prec))
+}
+
+// bigCbrt returns the cube root of x with Newton's method.
+func bigCbrt(x *big.Float, prec uint) *big.Float {
+	if x.Sign() == 0 {
+		return newBigFloat(prec)
+	}
+	abs := newBigFloat(prec).Abs(x)
+
+	// Start from the float64 cube root, and double the number of correct bits at each iteration.
+	m := newBigFloat(prec)
+	e := abs.MantExp(m)
+	mf, _ := m.Float64()
+	y := newBigFloat(prec).SetFloat64(math.Cbrt(mf * math.Pow(2, float64(e%3))))
+	y.SetMantExp(y, e/3)
+	for bits := uint(50); bits < 2*prec; bits *= 2 {
+		// y = y - (y³ - x) / (3y²) = (2y + x/y²) / 3
+		t := newBigFloat(prec).Mul(y, y)
+		t.Quo(abs, t)
+		y.Add(y, y)
+		y.Add(y, t)
+		y.Quo(y, newBigFloat(prec).SetInt64(3))
+	}
+	if x.Sign() < 0 {
+		y.Neg(y)
+	}
+	return y
+}
+
+// bigPow returns x^y for x ≥ 0.
+func bigPow(x, y *big.Float, prec uint) *big.Float {
+	if x.Sign() == 0 {
+		return newBigFloat(prec)
+	}
+	r := bigLog(x, prec)
+	r.Mul(r, y)
+	return bigExp(r, prec)
+}
+
+// bigLn2 returns ln 2 = 2 atanh(1/3).
+func bigLn2(prec uint) *big.Float {
+	r := bigAtanh(newBigFloat(prec).Quo(newBigFloat(prec).SetInt64(1), newBigFloat(prec).SetInt64(3)), prec)
+	return r.Add(r, r)
+}
+
+// bigAtanh returns atanh(x) with the Taylor series for a small |x|.
+func bigAtanh(x *big.Float, prec uint) *big.Float {
+	eps := bigEpsilon(prec)
+	x2 := newBigFloat(prec).Mul(x, x)
+	r := newBigFloat(prec).Set(x)
+	pow := newBigFloat(prec).Set(x)
+	t := newBigFloat(prec)
+	for k := int64(3); ; k += 2 {
+		pow.Mul(pow, x2)
+		t.Quo(pow, newBigFloat(prec).SetInt64(k))
+		if newBigFloat(prec).Abs(t).Cmp(eps) < 0 {
+			break
+		}
+		r.Add(r, t)
+	}
+	return r
+}
+
+// bigLog returns the natural logarithm of x > 0.
+func bigLog(x *big.Float, prec uint) *big.Float {
+	// x = m × 2^e, where m is in [0.5, 1).
+	// ln x = e ln 2 + ln m = e ln 2 + 2 atanh((m - 1) / (m + 1)).
+	m := newBigFloat(prec)
+	e := x.MantExp(m)
+	one := newBigFloat(prec).SetInt64(1)
+	z := newBigFloat(prec).Sub(m, one)
+	z.Quo(z, newBigFloat(prec).Add(m, one))
+	r := bigAtanh(z, prec)
+	r.Add(r, r)
+	ln2 := bigLn2(prec)
+	return r.Add(r, ln2.Mul(ln2, newBigFloat(prec).SetInt64(int64(e))))
+}
+
+// bigExp returns e^x.
+func bigExp(x *big.Float, prec uint) *big.Float {
+	// x = n ln 2 + r, where |r| ≤ ln 2 / 2.
+	// e^x = 2^n × e^r.
+	ln2 := bigLn2(prec)
+	nf, _ := newBigFloat(prec).Quo(x, ln2).Float64()
+	n := math.Round(nf)
+	r := newBigFloat(prec).Mul(ln2, newBigFloat(prec).SetFloat64(n))
+	r.Sub(x, r)
+
+	eps := bigEpsilon(prec)
+	sum := newBigFloat(prec).SetInt64(1)
+	t := newBigFloat(prec).SetInt64(1)
+	for k := int64(1); ; k++ {
+		t.Mul(t, r)
+		t.Quo(t, newBigFloat(prec).SetInt64(k))
+		if newBigFloat(prec).Abs(t).Cmp(eps) < 0 {
+			break
+		}
+		sum.Add(sum, t)
+	}
+	return sum.SetMantExp(sum, int(n))
+}
+
+// bigAtanSeries returns atan(x) with the Taylor series for a small |x|.
+func bigAtanSeries(x *big.Float, prec uint) *big.Float {
+	eps := bigEpsilon(prec)
+	x2 := newBigFloat(prec).Mul(x, x)
+	r := newBigFloat(prec).Set(x)
+	pow := newBigFloat(prec).Set(x)
+	t := newBigFloat(prec)
+	for k := int64(3); ; k += 2 {
+		pow.Mul(pow, x2)
+		pow.Neg(pow)
+		t.Quo(pow, newBigFloat(prec).SetInt64(k))
+		if newBigFloat(prec).Abs(t).Cmp(eps) < 0 {
+			break
+		}
+		r.Add(r, t)
+	}
+	return r
+}
+
+// bigPi returns π with Machin's formula π = 16 atan(1/5) - 4 atan(1/239).
+func bigPi(prec uint) *big.Float {
+	one := newBigFloat(prec).SetInt64(1)
+	a := bigAtanSeries(newBigFloat(prec).Quo(one, newBigFloat(prec).SetInt64(5)), prec)
+	b := bigAtanSeries(newBigFloat(prec).Quo(one, newBigFloat(prec).SetInt64(239)), prec)
+	a.Mul(a, newBigFloat(prec).SetInt64(16))
+	b.Mul(b, newBigFloat(prec).SetInt64(4))
+	return a.Sub(a, b)
+}
+
+// bigAtan returns atan(x).
+func bigAtan(x *big.Float, prec uint) *big.Float {
+	if x.Signbit() {
+		r := bigAtan(newBigFloat(prec).Neg(x), prec)
+		return r.Neg(r)
+	}
+	one := newBigFloat(prec).SetInt64(1)
+	if x.Cmp(one) > 0 {
+		// atan(x) = π/2 - atan(1/x)
+		r := bigPi(prec)
+		r.Quo(r, newBigFloat(prec).SetInt64(2))
+		return r.Sub(r, bigAtan(newBigFloat(prec).Quo(one, x), prec))
+	}
+	// Reduce x with atan(x) = 2 atan(x / (1 + sqrt(1 + x²))) for the faster convergence.
+	x = newBigFloat(prec).Set(x)
+	var n int
+	for x.Cmp(newBigFloat(prec).SetFloat64(1.0/8)) > 0 {
+		t := newBigFloat(prec).Mul(x, x)
+		t.Add(t, one)
+		t.Sqrt(t)
+		t.Add(t, one)
+		x.Quo(x, t)
+		n++
+	}
+	r := bigAtanSeries(x, prec)
+	return r.SetMantExp(r, n)
+}
+
+// bigAtan2 returns atan2(y, x) in (-π, π] as [math.Atan2].
+func bigAtan2(y, x *big.Float, prec uint) *big.Float {
+	if x.Sign() == 0 {
+		if y.Sign() == 0 {
+			if x.Signbit() {
+				r := bigPi(prec)
+				if y.Signbit() {
+					r.Neg(r)
+				}
+				return r
+			}
+			return newBigFloat(prec).Set(y)
+		}
+		r := bigPi(prec)
+		r.Quo(r, newBigFloat(prec).SetInt64(2))
+		if y.Sign() < 0 {
+			r.Neg(r)
+		}
+		return r
+	}
+	r := bigAtan(newBigFloat(prec).Quo(y, x), prec)
+	if x.Sign() < 0 {
+		if y.Signbit() {
+			r.Sub(r, bigPi(prec))
+		} else {
+			r.Add(r, bigPi(prec))
+		}
+	}
+	return r
+}
+
+// bigSinCos returns sin(x) and cos(x).
+func bigSinCos(x *big.Float, prec uint) (*big.Float, *big.Float) {
+	// Reduce x to [-π, π].
+	twoPi := bigPi(prec)
+	twoPi.Add(twoPi, twoPi)
+	kf, _ := newBigFloat(prec).Quo(x, twoPi).Float64()
+	r := newBigFloat(prec).Mul(twoPi, newBigFloat(prec).SetFloat64(math.Round(kf)))
+	r.Sub(x, r)
+
+	eps := bigEpsilon(prec)
+	sin := newBigFloat(prec)
+	cos := newBigFloat(prec)
+	// t = r^k / k!
+	t := newBigFloat(prec).SetInt64(1)
+	for k := int64(0); ; k++ {
+		switch k % 4 {
+		case 0:
+			cos.Add(cos, t)
+		case 1:
+			sin.Add(sin, t)
+		case 2:
+			cos.Sub(cos, t)
+		case 3:
+			sin.Sub(sin, t)
+		}
+		t.Mul(t, r)
+		t.Quo(t, newBigFloat(prec).SetInt64(k+1))
+		if k > 0 && newBigFloat(prec).Abs(t).Cmp(eps) < 0 {
+			break
+		}
+	}
+	return sin, cos
+}
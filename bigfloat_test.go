@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+var bigFloatSpaces = []iro.Space{
+	iro.SpaceSRGB,
+	iro.SpaceLinearSRGB,
+	iro.SpaceDisplayP3,
+	iro.SpaceLinearDisplayP3,
+	iro.SpaceOKLab,
+	iro.SpaceOKLch,
+	iro.SpaceXYZ,
+	iro.SpaceLab,
+	iro.SpaceLch,
+	iro.SpaceRec2020,
+	iro.SpaceLinearRec2020,
+	iro.SpaceA98RGB,
+	iro.SpaceLinearA98RGB,
+	iro.SpaceProPhotoRGB,
+	iro.SpaceLinearProPhotoRGB,
+	iro.SpaceXYZD50,
+	iro.SpaceSRGBGamma22,
+}
+
+func TestConvertBigFloat64(t *testing.T) {
+	colors := []iro.Color{
+		iro.ColorFromSRGB(0.2, 0.5, 0.8, 1),
+		iro.ColorFromSRGB(1, 0.01, 0.001, 1),
+		iro.ColorFromSRGB(0.9, 0.9, 0.1, 1),
+	}
+	for _, c := range colors {
+		for _, from := range bigFloatSpaces {
+			for _, to := range bigFloatSpaces {
+				v0, v1, v2, _ := c.Components(from)
+				w0, w1, w2, _ := c.Components(to)
+				g0, g1, g2 := iro.ConvertBig(from, to, big.NewFloat(v0), big.NewFloat(v1), big.NewFloat(v2), 128)
+				for i, g := range []*big.Float{g0, g1, g2} {
+					got, _ := g.Float64()
+					want := []float64{w0, w1, w2}[i]
+					// The tolerance is relative to be valid with the irofast tag and CIELAB.
+					if math.Abs(got-want) > 1e-9*max(1, math.Abs(want)) {
+						t.Errorf("%s to %s: [%d]: got %v, want %v", from, to, i, got, want)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestConvertBigRoundTrip(t *testing.T) {
+	const prec = 256
+	c0, c1, c2 := big.NewFloat(0.25), big.NewFloat(0.5), big.NewFloat(0.75)
+	for _, to := range bigFloatSpaces {
+		// The decimals of the OKLab matrices are not exactly inverse to each other.
+		tol := math.Ldexp(1, -200)
+		if to == iro.SpaceOKLab || to == iro.SpaceOKLch {
+			tol = 1e-14
+		}
+		v0, v1, v2 := iro.ConvertBig(iro.SpaceSRGB, to, c0, c1, c2, prec)
+		w0, w1, w2 := iro.ConvertBig(to, iro.SpaceSRGB, v0, v1, v2, prec)
+		for i, pair := range [][2]*big.Float{{w0, c0}, {w1, c1}, {w2, c2}} {
+			d := new(big.Float).Sub(pair[0], pair[1])
+			if d.Abs(d).Cmp(big.NewFloat(tol)) > 0 {
+				t.Errorf("sRGB to %s and back: [%d]: got %s, want %s", to, i, pair[0].Text('g', 70), pair[1].Text('g', 70))
+			}
+		}
+	}
+}
+
+func TestConvertBigPrecision(t *testing.T) {
+	for _, prec := range []uint{24, 53, 100, 300} {
+		// The hue is 3π/4.
+		_, _, h := iro.ConvertBig(iro.SpaceLab, iro.SpaceLch, big.NewFloat(50), big.NewFloat(-10), big.NewFloat(10), prec)
+		if got := h.Prec(); got != prec {
+			t.Errorf("prec=%d: precision: got %d, want %d", prec, got, prec)
+		}
+		want, _ := new(big.Float).SetPrec(prec + 16).SetString("3.14159265358979323846264338327950288419716939937510582097494459230781640628620899862803482534211706798214808651")
+		want.Mul(want, big.NewFloat(0.75))
+		d := new(big.Float).Sub(h, want)
+		if d.Abs(d).Cmp(new(big.Float).SetMantExp(big.NewFloat(1), 3-int(prec))) > 0 {
+			t.Errorf("prec=%d: hue: got %s, want %s", prec, h.Text('g', 100), want.Text('g', 100))
+		}
+	}
+}
+
+func TestConvertBigExactLinear(t *testing.T) {
+	// Linear conversions match the exact rational conversions.
+	const prec = 200
+	r0, r1, r2 := big.NewRat(1, 4), big.NewRat(1, 2), big.NewRat(3, 4)
+	e0, e1, e2 := iro.ConvertRat(iro.SpaceLinearSRGB, iro.SpaceXYZD50, r0, r1, r2)
+	f0 := new(big.Float).SetRat(r0)
+	f1 := new(big.Float).SetRat(r1)
+	f2 := new(big.Float).SetRat(r2)
+	g0, g1, g2 := iro.ConvertBig(iro.SpaceLinearSRGB, iro.SpaceXYZD50, f0, f1, f2, prec)
+	for i, pair := range [][2]*big.Float{
+		{g0, new(big.Float).SetPrec(prec).SetRat(e0)},
+		{g1, new(big.Float).SetPrec(prec).SetRat(e1)},
+		{g2, new(big.Float).SetPrec(prec).SetRat(e2)},
+	} {
+		d := new(big.Float).Sub(pair[0], pair[1])
+		if d.Abs(d).Cmp(big.NewFloat(math.Ldexp(1, -190))) > 0 {
+			t.Errorf("[%d]: got %s, want %s", i, pair[0].Text('g', 60), pair[1].Text('g', 60))
+		}
+	}
+}
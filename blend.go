@@ -0,0 +1,34 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"fmt"
+	"image"
+)
+
+// BlendImages returns a new image interpolated between a and b at t per pixel in the given space, like a crossfade.
+// t = 0 returns a and t = 1 returns b. The bounds of the result are the same as a's.
+//
+// Each pair of pixels is interpolated by [Mix], with the alpha premultiplied.
+// Interpolating in a linear space like [SpaceLinearSRGB] or in [SpaceOKLab] avoids the dark midpoints
+// of interpolating the encoded sRGB values.
+// The pixels are interpreted as sRGB in the same way as [MapImage], and compared by the positions relative to the minimum points of the bounds.
+//
+// BlendImages panics if the sizes of a and b are different.
+func BlendImages(a, b image.Image, t float64, space Space) *image.NRGBA64 {
+	ba, bb := a.Bounds(), b.Bounds()
+	if ba.Size() != bb.Size() {
+		panic(fmt.Sprintf("iro: the sizes of the images must be the same but %v and %v", ba.Size(), bb.Size()))
+	}
+	dst := image.NewNRGBA64(ba)
+	for y := 0; y < ba.Dy(); y++ {
+		for x := 0; x < ba.Dx(); x++ {
+			ca := colorAt(a, ba.Min.X+x, ba.Min.Y+y)
+			cb := colorAt(b, bb.Min.X+x, bb.Min.Y+y)
+			dst.SetNRGBA64(ba.Min.X+x, ba.Min.Y+y, Mix(ca, cb, t, space).SRGBNRGBA64())
+		}
+	}
+	return dst
+}
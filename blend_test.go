@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestBlendImages(t *testing.T) {
+	a := image.NewNRGBA(image.Rect(0, 0, 2, 1))
+	a.SetNRGBA(0, 0, color.NRGBA{0xff, 0, 0, 0xff})
+	a.SetNRGBA(1, 0, color.NRGBA{0xff, 0, 0, 0xff})
+	b := image.NewNRGBA(image.Rect(3, 3, 5, 4))
+	b.SetNRGBA(3, 3, color.NRGBA{0, 0xff, 0, 0xff})
+	// A transparent pixel must not darken the other.
+	b.SetNRGBA(4, 3, color.NRGBA{0, 0, 0, 0})
+
+	for _, space := range []iro.Space{iro.SpaceLinearSRGB, iro.SpaceOKLab, iro.SpaceSRGB} {
+		for _, tt := range []float64{0, 0.25, 0.5, 1} {
+			got := iro.BlendImages(a, b, tt, space)
+			if got.Bounds() != a.Bounds() {
+				t.Fatalf("bounds: got %v, want %v", got.Bounds(), a.Bounds())
+			}
+			for x := 0; x < 2; x++ {
+				want := iro.Mix(iro.ColorFromSRGBNRGBA(a.NRGBAAt(x, 0)), iro.ColorFromSRGBNRGBA(b.NRGBAAt(x+3, 3)), tt, space).SRGBNRGBA64()
+				if got := got.NRGBA64At(x, 0); got != want {
+					t.Errorf("%s, t=%f: (%d, 0): got %v, want %v", space, tt, x, got, want)
+				}
+			}
+			if c := got.NRGBA64At(1, 0); tt < 1 && (c.R != 0xffff || c.G != 0 || c.B != 0) {
+				t.Errorf("%s, t=%f: (1, 0): got %v, want red", space, tt, c)
+			}
+		}
+	}
+
+	// The midpoint in linear light is brighter than the one of the encoded values.
+	lin := iro.BlendImages(a, b, 0.5, iro.SpaceLinearSRGB).NRGBA64At(0, 0)
+	enc := iro.BlendImages(a, b, 0.5, iro.SpaceSRGB).NRGBA64At(0, 0)
+	if lin.R <= enc.R || lin.G <= enc.G {
+		t.Errorf("linear: got %v, must be brighter than %v", lin, enc)
+	}
+}
+
+func TestBlendImagesPanic(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("BlendImages must panic for images of different sizes")
+		}
+	}()
+	iro.BlendImages(image.NewNRGBA(image.Rect(0, 0, 2, 2)), image.NewNRGBA(image.Rect(0, 0, 3, 2)), 0.5, iro.SpaceOKLab)
+}
@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"math"
+)
+
+// Luma returns the luma Y' of c in [0, 1], the weighted sum of the nonlinear sRGB values with the given matrix.
+// Luma is a quick estimate of the perceived brightness.
+// Values outside the sRGB gamut are not clamped. The alpha value is ignored.
+func (c Color) Luma(matrix YCbCrMatrix) float64 {
+	kr, kb := matrix.coefficients()
+	r, g, b, _ := c.SRGB()
+	return kr*r + (1-kr-kb)*g + kb*b
+}
+
+// HSPBrightness returns the perceived brightness of c in [0, 1] by the HSP color model,
+// the root of the weighted sum of the squared nonlinear sRGB values with the BT.601 coefficients.
+// Values outside the sRGB gamut are not clamped. The alpha value is ignored.
+//
+// See https://alienryderflex.com/hsp.html
+func (c Color) HSPBrightness() float64 {
+	r, g, b, _ := c.SRGB()
+	return math.Sqrt(0.299*r*r + 0.587*g*g + 0.114*b*b)
+}
+
+// LStar returns the CIE lightness L* of c in [0, 100], derived from the luminance Y relative to the D65 white.
+// The alpha value is ignored.
+func (c Color) LStar() float64 {
+	return 116*labF(c.y) - 16
+}
+
+// IsDark reports whether c is dark, i.e. its [Color.LStar] is less than 50.
+// For example, IsDark can be used to choose a light icon variant on the background c.
+// The alpha value is ignored.
+func (c Color) IsDark() bool {
+	return c.LStar() < 50
+}
+
+// IsLight reports whether c is light, i.e. c is not dark. See [Color.IsDark].
+func (c Color) IsLight() bool {
+	return !c.IsDark()
+}
+
+// hkHueFactor returns the dependency of the Helmholtz–Kohlrausch effect on the CIELAB hue h in radians.
+func hkHueFactor(h float64) float64 {
+	return 0.116*math.Abs(math.Sin((h-math.Pi/2)/2)) + 0.085
+}
+
+// HKLightness returns the lightness L** of c in the scale of CIE L*, accounting for the Helmholtz–Kohlrausch effect,
+// i.e. saturated colors look brighter than grays of the same luminance.
+// The model is the one by Fairchild and Pirrotta (1991) with CIELAB relative to the D65 white.
+// For grays, HKLightness is the same as [Color.LStar]. The alpha value is ignored.
+//
+// See https://doi.org/10.1002/col.5080160406
+func (c Color) HKLightness() float64 {
+	l, a, b, _ := c.LabWithWhite(WhiteD65)
+	return l + (2.5-0.025*l)*hkHueFactor(math.Atan2(b, a))*math.Hypot(a, b)
+}
+
+// WithHKLightness returns the color with the CIELAB chroma and hue of c whose [Color.HKLightness] is l.
+// Saturated colors become darker than grays of the same lightness l.
+// The result might be outside the sRGB gamut.
+func (c Color) WithHKLightness(l float64) Color {
+	_, a, b, alpha := c.LabWithWhite(WhiteD65)
+	// L** = L* + (2.5 - 0.025 L*) k is linear in L*, so solve it for L*.
+	k := hkHueFactor(math.Atan2(b, a)) * math.Hypot(a, b)
+	lstar := (l - 2.5*k) / (1 - 0.025*k)
+	return ColorFromLabWithWhite(lstar, a, b, alpha, WhiteD65)
+}
@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestLuma(t *testing.T) {
+	testCases := []struct {
+		color  iro.Color
+		matrix iro.YCbCrMatrix
+		want   float64
+	}{
+		{color: iro.White, matrix: iro.YCbCrMatrixBT601, want: 1},
+		{color: iro.Black, matrix: iro.YCbCrMatrixBT709, want: 0},
+		{color: iro.Red, matrix: iro.YCbCrMatrixBT601, want: 0.299},
+		{color: iro.Red, matrix: iro.YCbCrMatrixBT709, want: 0.2126},
+		{color: iro.Green, matrix: iro.YCbCrMatrixBT601, want: 0.587},
+		{color: iro.Green, matrix: iro.YCbCrMatrixBT709, want: 0.7152},
+		{color: iro.ColorFromSRGB(0.5, 0.5, 0.5, 1), matrix: iro.YCbCrMatrixBT709, want: 0.5},
+	}
+	for _, tc := range testCases {
+		if got := tc.color.Luma(tc.matrix); !checkTol(got, tc.want) {
+			t.Errorf("Luma(%v, %d): got %f, want %f", tc.color, tc.matrix, got, tc.want)
+		}
+	}
+}
+
+func TestHSPBrightness(t *testing.T) {
+	testCases := []struct {
+		color iro.Color
+		want  float64
+	}{
+		{color: iro.White, want: 1},
+		{color: iro.Black, want: 0},
+		{color: iro.Red, want: math.Sqrt(0.299)},
+		{color: iro.Blue, want: math.Sqrt(0.114)},
+		{color: iro.ColorFromSRGB(0.5, 0.5, 0.5, 1), want: 0.5},
+	}
+	for _, tc := range testCases {
+		if got := tc.color.HSPBrightness(); !checkTol(got, tc.want) {
+			t.Errorf("HSPBrightness(%v): got %f, want %f", tc.color, got, tc.want)
+		}
+	}
+}
+
+func TestLStar(t *testing.T) {
+	testCases := []struct {
+		color iro.Color
+		want  float64
+	}{
+		{color: iro.White, want: 100},
+		{color: iro.Black, want: 0},
+		{color: iro.ColorFromXYZ(0.18418651851244416, 0.18418651851244416, 0.18418651851244416, 1), want: 50},
+	}
+	for _, tc := range testCases {
+		if got := tc.color.LStar(); !checkTol(got, tc.want) {
+			t.Errorf("LStar(%v): got %f, want %f", tc.color, got, tc.want)
+		}
+	}
+}
+
+func TestIsDark(t *testing.T) {
+	testCases := []struct {
+		color iro.Color
+		dark  bool
+	}{
+		{color: iro.White, dark: false},
+		{color: iro.Black, dark: true},
+		{color: iro.Gray, dark: false},
+		{color: iro.ColorFromSRGB(0x76/255.0, 0x76/255.0, 0x76/255.0, 1), dark: true},
+		{color: iro.Blue, dark: true},
+		{color: iro.Yellow, dark: false},
+	}
+	for _, tc := range testCases {
+		if got := tc.color.IsDark(); got != tc.dark {
+			t.Errorf("IsDark(%v): got %t, want %t", tc.color, got, tc.dark)
+		}
+		if got := tc.color.IsLight(); got != !tc.dark {
+			t.Errorf("IsLight(%v): got %t, want %t", tc.color, got, !tc.dark)
+		}
+	}
+}
+
+func TestHKLightness(t *testing.T) {
+	// For grays, the H–K lightness is the same as L*.
+	for _, c := range []iro.Color{iro.White, iro.Black, iro.ColorFromSRGB(0.5, 0.5, 0.5, 1)} {
+		if got, want := c.HKLightness(), c.LStar(); !checkTol(got, want) {
+			t.Errorf("HKLightness(%v): got %f, want %f", c, got, want)
+		}
+	}
+
+	// Saturated colors look brighter than their L*.
+	red := iro.ColorFromSRGB(1, 0, 0, 1)
+	if got, want := red.HKLightness(), 69.62; math.Abs(got-want) > 0.01 {
+		t.Errorf("HKLightness(red): got %f, want %f", got, want)
+	}
+	for _, c := range []iro.Color{red, iro.ColorFromSRGB(0, 0, 1, 1), iro.ColorFromSRGB(0, 1, 0, 1)} {
+		if got, l := c.HKLightness(), c.LStar(); got <= l {
+			t.Errorf("HKLightness(%v): got %f, want > %f", c, got, l)
+		}
+	}
+}
+
+func TestWithHKLightness(t *testing.T) {
+	for _, c := range []iro.Color{iro.ColorFromSRGB(1, 0, 0, 1), iro.ColorFromSRGB(0.2, 0.4, 0.9, 0.5), iro.ColorFromSRGB(0.5, 0.5, 0.5, 1)} {
+		got := c.WithHKLightness(60)
+		if l := got.HKLightness(); !checkTol(l, 60) {
+			t.Errorf("WithHKLightness(%v, 60).HKLightness(): got %f, want 60", c, l)
+		}
+		// The CIELAB a* and b* are kept.
+		_, a0, b0, alpha0 := c.LabWithWhite(iro.WhiteD65)
+		_, a1, b1, alpha1 := got.LabWithWhite(iro.WhiteD65)
+		if !checkTol(a1, a0) || !checkTol(b1, b0) || !checkTol(alpha1, alpha0) {
+			t.Errorf("WithHKLightness(%v, 60): got (a, b, alpha) = (%f, %f, %f), want (%f, %f, %f)", c, a1, b1, alpha1, a0, b0, alpha0)
+		}
+	}
+}
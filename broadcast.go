@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"image"
+	"image/color"
+	"math"
+)
+
+// legalizeEpsilon is the tolerance of the limits of BroadcastLimits, to absorb rounding errors.
+const legalizeEpsilon = 1e-9
+
+// BroadcastLimits is the limits of video signals to be broadcast-safe.
+//
+// The signal values are the nonlinear sRGB values as R'G'B', where 0 and 1 are the nominal black and white levels.
+type BroadcastLimits struct {
+	// RGBMin and RGBMax are the range of each R', G', and B' component.
+	RGBMin float64
+	RGBMax float64
+
+	// LumaMin and LumaMax are the range of the BT.709 luma Y'.
+	LumaMin float64
+	LumaMax float64
+
+	// CompositeMin and CompositeMax are the range of the composite signal,
+	// the BT.601 luma plus and minus the amplitude of the PAL chroma subcarrier (U and V).
+	// If both are 0, the composite signal is not limited.
+	CompositeMin float64
+	CompositeMax float64
+}
+
+// EBUR103 is the preferred limits of EBU R 103: R'G'B' in [-5%, 105%] and the luma in [-1%, 103%].
+// EBU R 103 itself doesn't limit composite signals, but the composite signal is also limited to [-33⅓%, 133⅓%],
+// the peaks of the 100% color bars in PAL, for analog transmission.
+var EBUR103 = &BroadcastLimits{
+	RGBMin:       -0.05,
+	RGBMax:       1.05,
+	LumaMin:      -0.01,
+	LumaMax:      1.03,
+	CompositeMin: -1.0 / 3,
+	CompositeMax: 4.0 / 3,
+}
+
+// IsLegal reports whether c is within the limits. The alpha value is ignored.
+func (l *BroadcastLimits) IsLegal(c Color) bool {
+	_, altered := l.Legalize(c)
+	return !altered
+}
+
+// Legalize returns the color of c clamped into the limits, and reports whether c was altered.
+//
+// First, the luma is clamped by adding the same value to the components.
+// Then, the components are desaturated toward the luma until the R'G'B' components and the composite signal are within the limits.
+// Thus, the hue is kept, unlike clamping each component. The alpha value is kept.
+func (l *BroadcastLimits) Legalize(c Color) (Color, bool) {
+	r, g, b, alpha := c.SRGB()
+	kr, kb := YCbCrMatrixBT709.coefficients()
+	y := kr*r + (1-kr-kb)*g + kb*b
+
+	var altered bool
+	if ny := min(max(y, l.LumaMin), l.LumaMax); math.Abs(ny-y) > legalizeEpsilon {
+		r += ny - y
+		g += ny - y
+		b += ny - y
+		y = ny
+		altered = true
+	}
+
+	// The components are y + k*d for the chroma differences d, where k is in [0, 1].
+	dr, dg, db := r-y, g-y, b-y
+	k := 1.0
+	// limit reduces k so that y + k*v is within [lo, hi].
+	limit := func(v, lo, hi float64) {
+		if y+v > hi+legalizeEpsilon {
+			k = min(k, max((hi-y)/v, 0))
+		}
+		if y+v < lo-legalizeEpsilon {
+			k = min(k, max((lo-y)/v, 0))
+		}
+	}
+	for _, d := range [...]float64{dr, dg, db} {
+		limit(d, l.RGBMin, l.RGBMax)
+	}
+	if l.CompositeMin != 0 || l.CompositeMax != 0 {
+		// The BT.601 luma and the chroma subcarrier are linear to the components, and the chroma of the gray is 0.
+		kr, kb := YCbCrMatrixBT601.coefficients()
+		dy := kr*dr + (1-kr-kb)*dg + kb*db
+		u := 0.492111 * (db - dy)
+		v := 0.877283 * (dr - dy)
+		a := math.Hypot(u, v)
+		limit(dy+a, l.CompositeMin, l.CompositeMax)
+		limit(dy-a, l.CompositeMin, l.CompositeMax)
+	}
+	if k < 1 {
+		r, g, b = y+k*dr, y+k*dg, y+k*db
+		altered = true
+	}
+	if !altered {
+		return c, false
+	}
+	return ColorFromSRGB(r, g, b, alpha), true
+}
+
+// LegalizeImage returns a new image by legalizing each pixel of img, and a mask of the altered pixels,
+// where the altered pixels are 0xff and the others are 0.
+// See [MapImage] for the interpretation of the pixels.
+func (l *BroadcastLimits) LegalizeImage(img image.Image) (*image.NRGBA64, *image.Gray) {
+	b := img.Bounds()
+	mask := image.NewGray(b)
+	dst := image.NewNRGBA64(b)
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			c, altered := l.Legalize(colorAt(img, x, y))
+			if altered {
+				mask.SetGray(x, y, color.Gray{Y: 0xff})
+			}
+			dst.SetNRGBA64(x, y, c.SRGBNRGBA64())
+		}
+	}
+	return dst, mask
+}
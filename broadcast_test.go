@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func compositeRange(c iro.Color) (lo, hi float64) {
+	r, g, b, _ := c.SRGB()
+	y := 0.299*r + 0.587*g + 0.114*b
+	a := math.Hypot(0.492111*(b-y), 0.877283*(r-y))
+	return y - a, y + a
+}
+
+func TestBroadcastLimitsLegalize(t *testing.T) {
+	testCases := []struct {
+		name    string
+		in      iro.Color
+		altered bool
+	}{
+		{name: "gray", in: iro.ColorFromSRGB(0.5, 0.5, 0.5, 1), altered: false},
+		{name: "white", in: iro.ColorFromSRGB(1, 1, 1, 1), altered: false},
+		{name: "black", in: iro.ColorFromSRGB(0, 0, 0, 1), altered: false},
+		{name: "75% red", in: iro.ColorFromSRGB(0.75, 0, 0, 1), altered: false},
+		{name: "super white", in: iro.ColorFromSRGB(1.1, 1.1, 1.1, 1), altered: true},
+		{name: "sub black", in: iro.ColorFromSRGB(-0.1, -0.1, -0.1, 1), altered: true},
+		{name: "over red", in: iro.ColorFromSRGB(1.2, 0.2, 0.2, 1), altered: true},
+		{name: "100% yellow", in: iro.ColorFromSRGB(1, 1, 0, 1), altered: false},
+		{name: "100% blue", in: iro.ColorFromSRGB(0, 0, 1, 1), altered: false},
+		{name: "over yellow", in: iro.ColorFromSRGB(1.05, 1.05, -0.05, 1), altered: true},
+		{name: "over blue", in: iro.ColorFromSRGB(0, 0, 1.05, 0.5), altered: true},
+	}
+	l := iro.EBUR103
+	for _, tc := range testCases {
+		got, altered := l.Legalize(tc.in)
+		if altered != tc.altered {
+			t.Errorf("%s: altered: got %t, want %t", tc.name, altered, tc.altered)
+		}
+		if altered == l.IsLegal(tc.in) {
+			t.Errorf("%s: IsLegal: got %t, want %t", tc.name, !altered, altered)
+		}
+		if !altered {
+			if got != tc.in {
+				t.Errorf("%s: got %v, want %v", tc.name, got, tc.in)
+			}
+			continue
+		}
+		if !l.IsLegal(got) {
+			t.Errorf("%s: the result %v is not legal", tc.name, got)
+		}
+		if !checkTol(got.Alpha(), tc.in.Alpha()) {
+			t.Errorf("%s: alpha: got %f, want %f", tc.name, got.Alpha(), tc.in.Alpha())
+		}
+		r, g, b, _ := got.SRGB()
+		if min(r, g, b) < -0.05-1e-9 || max(r, g, b) > 1.05+1e-9 {
+			t.Errorf("%s: got (%f, %f, %f), out of the RGB limits", tc.name, r, g, b)
+		}
+		if y := got.Luma(iro.YCbCrMatrixBT709); y < -0.01-1e-9 || y > 1.03+1e-9 {
+			t.Errorf("%s: got luma %f, out of the luma limits", tc.name, y)
+		}
+		if lo, hi := compositeRange(got); lo < -1.0/3-1e-9 || hi > 4.0/3+1e-9 {
+			t.Errorf("%s: got composite [%f, %f], out of the composite limits", tc.name, lo, hi)
+		}
+	}
+}
+
+func TestBroadcastLimitsKeepHue(t *testing.T) {
+	in := iro.ColorFromSRGB(1.05, 1.05, -0.05, 1)
+	got, _ := iro.EBUR103.Legalize(in)
+	// Desaturation toward the luma keeps the ratios of the chroma differences.
+	r0, g0, b0, _ := in.SRGB()
+	r1, g1, b1, _ := got.SRGB()
+	y0 := in.Luma(iro.YCbCrMatrixBT709)
+	y1 := got.Luma(iro.YCbCrMatrixBT709)
+	if !checkTol(y0, y1) {
+		t.Errorf("luma: got %f, want %f", y1, y0)
+	}
+	k := (b1 - y1) / (b0 - y0)
+	if !checkTol(r1-y1, (r0-y0)*k) || !checkTol(g1-y1, (g0-y0)*k) || k <= 0 || k >= 1 {
+		t.Errorf("got (%f, %f, %f), not desaturated from (%f, %f, %f)", r1, g1, b1, r0, g0, b0)
+	}
+}
+
+func TestBroadcastLimitsNoComposite(t *testing.T) {
+	l := *iro.EBUR103
+	l.CompositeMin = 0
+	l.CompositeMax = 0
+	if c := iro.ColorFromSRGB(1.05, 1.05, -0.05, 1); !l.IsLegal(c) {
+		t.Errorf("%v must be legal without the composite limits", c)
+	}
+}
+
+func TestBroadcastLimitsLegalizeImage(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 2, 1))
+	img.SetNRGBA(0, 0, color.NRGBA{0x80, 0x80, 0x80, 0xff})
+	img.SetNRGBA(1, 0, color.NRGBA{0xff, 0x00, 0x00, 0xff})
+	l := &iro.BroadcastLimits{RGBMin: 0, RGBMax: 0.9, LumaMin: 0, LumaMax: 0.9}
+	dst, mask := l.LegalizeImage(img)
+	if got, want := mask.GrayAt(0, 0).Y, uint8(0); got != want {
+		t.Errorf("mask (0, 0): got %d, want %d", got, want)
+	}
+	if got, want := mask.GrayAt(1, 0).Y, uint8(0xff); got != want {
+		t.Errorf("mask (1, 0): got %d, want %d", got, want)
+	}
+	if got, want := dst.NRGBA64At(0, 0), (color.NRGBA64{0x8080, 0x8080, 0x8080, 0xffff}); got != want {
+		t.Errorf("(0, 0): got %v, want %v", got, want)
+	}
+	if r, _, _, _ := iro.ColorFromSRGBNRGBA64(dst.NRGBA64At(1, 0)).SRGB(); r > 0.9+1e-4 {
+		t.Errorf("(1, 0): got red %f, want at most 0.9", r)
+	}
+}
@@ -0,0 +1,277 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"fmt"
+	"math"
+)
+
+// CAM16Surround represents the surround of CAM16 viewing conditions.
+type CAM16Surround int
+
+const (
+	// CAM16SurroundAverage represents an average surround, like viewing a surface color.
+	CAM16SurroundAverage CAM16Surround = iota
+
+	// CAM16SurroundDim represents a dim surround, like watching television.
+	CAM16SurroundDim
+
+	// CAM16SurroundDark represents a dark surround, like a projector in a dark room.
+	CAM16SurroundDark
+)
+
+// params returns the parameters F, c, and Nc of the surround.
+func (s CAM16Surround) params() (f, c, nc float64) {
+	switch s {
+	case CAM16SurroundAverage:
+		return 1.0, 0.69, 1.0
+	case CAM16SurroundDim:
+		return 0.9, 0.59, 0.9
+	case CAM16SurroundDark:
+		return 0.8, 0.525, 0.8
+	default:
+		panic(fmt.Sprintf("iro: invalid CAM16Surround: %d", s))
+	}
+}
+
+// CAM16ViewingConditions represents viewing conditions of CAM16. The white point is D65.
+//
+// The defaults are the same as Material Design's HCT: a gray world with L* = 50 under 200 lux.
+type CAM16ViewingConditions struct {
+	// AdaptingLuminance is the luminance of the adapting field L_A in cd/m².
+	// The default is about 11.73.
+	AdaptingLuminance float64
+
+	// BackgroundLuminance is the relative luminance of the background Y_b in [0, 100].
+	// The default is about 18.42, which is L* = 50.
+	BackgroundLuminance float64
+
+	// Surround is the surround.
+	Surround CAM16Surround
+
+	// DiscountIlluminant reports whether the illuminant is discounted, i.e. the adaptation is complete.
+	DiscountIlluminant bool
+}
+
+// cam16Env is the values derived from CAM16ViewingConditions.
+type cam16Env struct {
+	d    [3]float64
+	fl   float64
+	n    float64
+	z    float64
+	nbb  float64
+	c    float64
+	nc   float64
+	aw   float64
+	fl4  float64
+	nExp float64
+}
+
+var (
+	cam16M16 = [3][3]float64{
+		{0.401288, 0.650173, -0.051461},
+		{-0.250268, 1.204414, 0.045854},
+		{-0.002079, 0.048952, 0.953127},
+	}
+	cam16M16Inv = [3][3]float64{
+		{1.8620678, -1.0112547, 0.14918678},
+		{0.38752654, 0.62144744, -0.00897398},
+		{-0.01584150, -0.03412294, 1.0499644},
+	}
+)
+
+func mul3(m [3][3]float64, x, y, z float64) (float64, float64, float64) {
+	return m[0][0]*x + m[0][1]*y + m[0][2]*z,
+		m[1][0]*x + m[1][1]*y + m[1][2]*z,
+		m[2][0]*x + m[2][1]*y + m[2][2]*z
+}
+
+// defaultCAM16Env is the CAM16 environment of the default viewing conditions, which HCT also uses.
+var defaultCAM16Env = newCAM16Env(nil)
+
+// env returns the CAM16 environment of the viewing conditions. If v is nil, defaultCAM16Env is returned.
+func (v *CAM16ViewingConditions) env() *cam16Env {
+	if v == nil {
+		return defaultCAM16Env
+	}
+	return newCAM16Env(v)
+}
+
+// newCAM16Env computes the CAM16 environment of the viewing conditions. v can be nil.
+func newCAM16Env(v *CAM16ViewingConditions) *cam16Env {
+	l := (200 / math.Pi) * 0.18418651851244416
+	yb := 18.418651851244416
+	var surround CAM16Surround
+	var discount bool
+	if v != nil {
+		if v.AdaptingLuminance != 0 {
+			l = v.AdaptingLuminance
+		}
+		if v.BackgroundLuminance != 0 {
+			yb = v.BackgroundLuminance
+		}
+		surround = v.Surround
+		discount = v.DiscountIlluminant
+	}
+	f, c, nc := surround.params()
+
+	e := &cam16Env{
+		c:  c,
+		nc: nc,
+	}
+
+	d := f * (1 - 1/3.6*math.Exp((-l-42)/92))
+	if discount {
+		d = 1
+	}
+	d = min(max(d, 0), 1)
+
+	k := 1 / (5*l + 1)
+	k4 := k * k * k * k
+	e.fl = k4*5*l*0.2 + 0.1*(1-k4)*(1-k4)*math.Cbrt(5*l)
+	e.fl4 = math.Pow(e.fl, 0.25)
+
+	wx, wy, wz, _ := White.XYZ()
+	rw, gw, bw := mul3(cam16M16, wx*100, wy*100, wz*100)
+	yw := wy * 100
+	e.d = [3]float64{
+		d*yw/rw + 1 - d,
+		d*yw/gw + 1 - d,
+		d*yw/bw + 1 - d,
+	}
+
+	e.n = yb / yw
+	e.z = 1.48 + math.Sqrt(e.n)
+	e.nbb = 0.725 / math.Pow(e.n, 0.2)
+	e.nExp = math.Pow(1.64-math.Pow(0.29, e.n), 0.73)
+
+	ra, ga, ba := e.adapt(rw*e.d[0]), e.adapt(gw*e.d[1]), e.adapt(bw*e.d[2])
+	e.aw = (2*ra + ga + 0.05*ba) * e.nbb
+	return e
+}
+
+// adapt applies the nonlinear response compression to the adapted cone response.
+func (e *cam16Env) adapt(v float64) float64 {
+	p := math.Pow(e.fl*math.Abs(v)/100, 0.42)
+	return math.Copysign(400*p/(p+27.13), v)
+}
+
+// unadapt is the inverse of adapt.
+func (e *cam16Env) unadapt(v float64) float64 {
+	a := math.Abs(v)
+	base := max(0, 27.13*a/(400-a))
+	return math.Copysign(100/e.fl*math.Pow(base, 1/0.42), v)
+}
+
+// CAM16 represents the appearance correlates of CAM16.
+type CAM16 struct {
+	// J is the lightness in [0, 100].
+	J float64
+
+	// C is the chroma.
+	C float64
+
+	// H is the hue angle in radians in [0, 2π).
+	H float64
+
+	// M is the colorfulness.
+	M float64
+
+	// S is the saturation.
+	S float64
+
+	// Q is the brightness.
+	Q float64
+}
+
+// CAM16 returns the CAM16 appearance correlates of c under the viewing conditions.
+// If vc is nil, the default viewing conditions are used. The alpha value is ignored.
+func (c Color) CAM16(vc *CAM16ViewingConditions) CAM16 {
+	e := vc.env()
+
+	r, g, b := mul3(cam16M16, c.x*100, c.y*100, c.z*100)
+	ra, ga, ba := e.adapt(r*e.d[0]), e.adapt(g*e.d[1]), e.adapt(b*e.d[2])
+
+	a := ra - 12*ga/11 + ba/11
+	bb := (ra + ga - 2*ba) / 9
+	u := (20*ra + 20*ga + 21*ba) / 20
+	p2 := (40*ra + 20*ga + ba) / 20
+
+	h := NormalizeHue(math.Atan2(bb, a))
+	ac := p2 * e.nbb
+	j := 100 * math.Pow(max(ac, 0)/e.aw, e.c*e.z)
+	q := 4 / e.c * math.Sqrt(j/100) * (e.aw + 4) * e.fl4
+
+	et := 0.25 * (math.Cos(h+2) + 3.8)
+	t := 50000.0 / 13 * e.nc * e.nbb * et * math.Hypot(a, bb) / (u + 0.305)
+	alpha := math.Pow(t, 0.9) * e.nExp
+
+	ch := alpha * math.Sqrt(j/100)
+	m := ch * e.fl4
+	s := 50 * math.Sqrt(alpha*e.c/(e.aw+4))
+
+	return CAM16{
+		J: j,
+		C: ch,
+		H: h,
+		M: m,
+		S: s,
+		Q: q,
+	}
+}
+
+// ColorFromCAM16 builds a Color from CAM16 lightness J, chroma, hue angle in radians, and alpha,
+// under the viewing conditions. If vc is nil, the default viewing conditions are used.
+func ColorFromCAM16(j, ch, h, alpha float64, vc *CAM16ViewingConditions) Color {
+	return vc.env().color(j, ch, h, alpha)
+}
+
+// color builds a Color from CAM16 lightness J, chroma, hue angle in radians, and alpha.
+func (e *cam16Env) color(j, ch, h, alpha float64) Color {
+	var a0 float64
+	if j != 0 {
+		a0 = ch / math.Sqrt(j/100)
+	}
+	t := math.Pow(a0/e.nExp, 1/0.9)
+
+	et := 0.25 * (math.Cos(h+2) + 3.8)
+	ac := e.aw * math.Pow(j/100, 1/(e.c*e.z))
+	p1 := et * 50000 / 13 * e.nc * e.nbb
+	p2 := ac / e.nbb
+
+	sin, cos := math.Sincos(h)
+	gamma := 23 * (p2 + 0.305) * t / (23*p1 + 11*t*cos + 108*t*sin)
+	a := gamma * cos
+	b := gamma * sin
+
+	ra := (460*p2 + 451*a + 288*b) / 1403
+	ga := (460*p2 - 891*a - 261*b) / 1403
+	ba := (460*p2 - 220*a - 6300*b) / 1403
+
+	r := e.unadapt(ra) / e.d[0]
+	g := e.unadapt(ga) / e.d[1]
+	bl := e.unadapt(ba) / e.d[2]
+
+	x, y, z := mul3(cam16M16Inv, r, g, bl)
+	return ColorFromXYZ(x/100, y/100, z/100, alpha)
+}
+
+// CAM16Colorfulness returns the CAM16 colorfulness M of c under the viewing conditions.
+// If vc is nil, the default viewing conditions are used.
+func (c Color) CAM16Colorfulness(vc *CAM16ViewingConditions) float64 {
+	return c.CAM16(vc).M
+}
+
+// CAM16Saturation returns the CAM16 saturation s of c under the viewing conditions.
+// If vc is nil, the default viewing conditions are used.
+func (c Color) CAM16Saturation(vc *CAM16ViewingConditions) float64 {
+	return c.CAM16(vc).S
+}
+
+// CAM16Brightness returns the CAM16 brightness Q of c under the viewing conditions.
+// If vc is nil, the default viewing conditions are used.
+func (c Color) CAM16Brightness(vc *CAM16ViewingConditions) float64 {
+	return c.CAM16(vc).Q
+}
@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestCAM16(t *testing.T) {
+	// The expected values are from Material Color Utilities under the default viewing conditions.
+	testCases := []struct {
+		name  string
+		color iro.Color
+		want  iro.CAM16
+	}{
+		{name: "red", color: iro.Red, want: iro.CAM16{J: 46.445, C: 113.357, H: 27.408, M: 89.494, S: 91.889, Q: 105.988}},
+		{name: "green", color: iro.Green, want: iro.CAM16{J: 79.331, C: 108.410, H: 142.139, M: 85.587, S: 78.604, Q: 138.520}},
+		{name: "blue", color: iro.Blue, want: iro.CAM16{J: 25.465, C: 87.230, H: 282.788, M: 68.867, S: 93.674, Q: 78.481}},
+		{name: "white", color: iro.White, want: iro.CAM16{J: 100, C: 2.869, H: 209.492, M: 2.265, S: 12.068, Q: 155.521}},
+	}
+	for _, tc := range testCases {
+		got := tc.color.CAM16(nil)
+		got.H *= 180 / math.Pi
+		for _, v := range [][3]float64{
+			{got.J, tc.want.J},
+			{got.C, tc.want.C},
+			{got.H, tc.want.H},
+			{got.M, tc.want.M},
+			{got.S, tc.want.S},
+			{got.Q, tc.want.Q},
+		} {
+			if math.Abs(v[0]-v[1]) > 0.1 {
+				t.Errorf("CAM16(%s): got %+v, want %+v", tc.name, got, tc.want)
+				break
+			}
+		}
+	}
+}
+
+func TestCAM16RoundTrip(t *testing.T) {
+	vcs := []*iro.CAM16ViewingConditions{
+		nil,
+		{AdaptingLuminance: 64, BackgroundLuminance: 20, Surround: iro.CAM16SurroundDim},
+		{AdaptingLuminance: 300, Surround: iro.CAM16SurroundDark, DiscountIlluminant: true},
+	}
+	colors := []iro.Color{
+		iro.ColorFromSRGB(0.2, 0.4, 0.6, 1),
+		iro.ColorFromSRGB(0.9, 0.5, 0.1, 0.5),
+		iro.Red,
+		iro.Gray,
+	}
+	for i, vc := range vcs {
+		for _, c := range colors {
+			cam := c.CAM16(vc)
+			got := iro.ColorFromCAM16(cam.J, cam.C, cam.H, c.Alpha(), vc)
+			r0, g0, b0, a0 := c.SRGB()
+			r1, g1, b1, a1 := got.SRGB()
+			if !checkTol(r0, r1) || !checkTol(g0, g1) || !checkTol(b0, b1) || !checkTol(a0, a1) {
+				t.Errorf("vc #%d: got (%f, %f, %f, %f), want (%f, %f, %f, %f)", i, r1, g1, b1, a1, r0, g0, b0, a0)
+			}
+		}
+	}
+}
+
+func TestCAM16DefaultViewingConditions(t *testing.T) {
+	// nil and the zero viewing conditions are the same default conditions.
+	c := iro.ColorFromSRGB(0.2, 0.4, 0.6, 1)
+	if got, want := c.CAM16(nil), c.CAM16(&iro.CAM16ViewingConditions{}); got != want {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestCAM16Accessors(t *testing.T) {
+	vc := &iro.CAM16ViewingConditions{AdaptingLuminance: 100}
+	c := iro.ColorFromSRGB(0.2, 0.6, 0.4, 1)
+	cam := c.CAM16(vc)
+	if got := c.CAM16Colorfulness(vc); got != cam.M {
+		t.Errorf("CAM16Colorfulness: got %f, want %f", got, cam.M)
+	}
+	if got := c.CAM16Saturation(vc); got != cam.S {
+		t.Errorf("CAM16Saturation: got %f, want %f", got, cam.S)
+	}
+	if got := c.CAM16Brightness(vc); got != cam.Q {
+		t.Errorf("CAM16Brightness: got %f, want %f", got, cam.Q)
+	}
+
+	// Brighter adapting fields make the same color brighter and more colorful.
+	bright := &iro.CAM16ViewingConditions{AdaptingLuminance: 1000}
+	if c.CAM16Brightness(bright) <= cam.Q {
+		t.Errorf("CAM16Brightness under a brighter field: got %f, want > %f", c.CAM16Brightness(bright), cam.Q)
+	}
+	if c.CAM16Colorfulness(bright) <= cam.M {
+		t.Errorf("CAM16Colorfulness under a brighter field: got %f, want > %f", c.CAM16Colorfulness(bright), cam.M)
+	}
+}
@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+const (
+	// canonicalDecimals is the number of decimal places of the lightness, the chroma, and alpha in the canonical form.
+	canonicalDecimals = 6
+
+	// canonicalHueDecimals is the number of decimal places of the hue in degrees in the canonical form.
+	canonicalHueDecimals = 4
+)
+
+// String returns the canonical form of c, like "oklch(0.627955 0.257683 29.2339deg / 1)".
+//
+// The canonical form is the OKLCh components and alpha in CSS:
+// the lightness, the chroma, and alpha are rounded to 6 decimal places, and the hue is in degrees in [0, 360) rounded to 4 decimal places.
+// Trailing zeros are removed. If the rounded chroma is 0, the hue is 0.
+// Alpha is always present.
+//
+// The canonical form is stable: it will not change in future versions,
+// and [ParseCSS] and [Color.UnmarshalText] will always accept it.
+// Parsing the canonical form of a color and formatting it again results in the same string.
+func (c Color) String() string {
+	l, ch, h, alpha := c.OKLch()
+	cs := formatCanonicalNumber(ch, canonicalDecimals)
+	hs := "0"
+	if cs != "0" {
+		hs = formatCanonicalNumber(NormalizeHueDeg(AngleUnitDegree.FromRadians(h)), canonicalHueDecimals)
+		if hs == "360" {
+			hs = "0"
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString("oklch(")
+	b.WriteString(formatCanonicalNumber(l, canonicalDecimals))
+	b.WriteByte(' ')
+	b.WriteString(cs)
+	b.WriteByte(' ')
+	b.WriteString(hs)
+	b.WriteString("deg / ")
+	b.WriteString(formatCanonicalNumber(alpha, canonicalDecimals))
+	b.WriteByte(')')
+	return b.String()
+}
+
+// formatCanonicalNumber formats v with the decimal places without trailing zeros.
+func formatCanonicalNumber(v float64, decimals int) string {
+	if math.IsNaN(v) {
+		return "none"
+	}
+	s := strconv.FormatFloat(v, 'f', decimals, 64)
+	if strings.ContainsRune(s, '.') {
+		s = strings.TrimRight(s, "0")
+		s = strings.TrimSuffix(s, ".")
+	}
+	// Avoid "-0".
+	if s == "-0" {
+		s = "0"
+	}
+	return s
+}
+
+// Key returns the canonical form of c to use c as a key of maps, for example to deduplicate colors.
+// Colors with the same key are indistinguishable at the precision of the canonical form, even if they are not equal with ==.
+// See [Color.String] for the canonical form.
+func (c Color) Key() string {
+	return c.String()
+}
+
+// MarshalText implements [encoding.TextMarshaler]. The result is the canonical form of c. See [Color.String].
+func (c Color) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+// Any CSS color accepted by [ParseCSS] is accepted, including the canonical form.
+func (c *Color) UnmarshalText(text []byte) error {
+	clr, err := ParseCSS(string(text))
+	if err != nil {
+		return err
+	}
+	*c = clr
+	return nil
+}
@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestColorString(t *testing.T) {
+	testCases := []struct {
+		c    iro.Color
+		want string
+	}{
+		{iro.ColorFromSRGB(1, 0, 0, 1), "oklch(0.627955 0.257683 29.2339deg / 1)"},
+		{iro.ColorFromSRGB(0, 0, 1, 0.5), "oklch(0.452014 0.313214 264.052deg / 0.5)"},
+		{iro.ColorFromSRGB(1, 1, 1, 1), "oklch(1 0 0deg / 1)"},
+		{iro.ColorFromSRGB(0.5, 0.5, 0.5, 0), "oklch(0.598181 0 0deg / 0)"},
+		{iro.ColorFromSRGB(0, 0, 0, 1), "oklch(0 0 0deg / 1)"},
+		{iro.ColorFromOKLch(0.5, 0.1, -1e-9, 1), "oklch(0.5 0.1 0deg / 1)"},
+	}
+	for _, tc := range testCases {
+		if got := tc.c.String(); got != tc.want {
+			t.Errorf("got %q, want %q", got, tc.want)
+		}
+		if got := tc.c.Key(); got != tc.want {
+			t.Errorf("Key: got %q, want %q", got, tc.want)
+		}
+	}
+}
+
+func TestColorStringRoundTrip(t *testing.T) {
+	for _, c := range []iro.Color{
+		iro.ColorFromSRGB(1, 0, 0, 1),
+		iro.ColorFromSRGB(0.2, 0.4, 0.6, 0.8),
+		iro.ColorFromDisplayP3(0, 1, 0, 1),
+		iro.ColorFromOKLch(0.7, 0.15, 3, 0.25),
+		iro.ColorFromSRGB(0.5, 0.5, 0.5, 1),
+	} {
+		s := c.String()
+		parsed, err := iro.ParseCSS(s)
+		if err != nil {
+			t.Fatalf("ParseCSS(%q): %v", s, err)
+		}
+		if got := parsed.String(); got != s {
+			t.Errorf("round trip: got %q, want %q", got, s)
+		}
+		if d := iro.DeltaEOK(parsed, c); d > 1e-5 {
+			t.Errorf("%s: ΔEOK: got %f, want at most 1e-5", s, d)
+		}
+	}
+}
+
+func TestColorKey(t *testing.T) {
+	a := iro.ColorFromSRGB(0.2, 0.4, 0.6, 1)
+	b := iro.ColorFromOKLab(a.OKLab())
+	m := map[string]iro.Color{a.Key(): a}
+	if _, ok := m[b.Key()]; !ok {
+		t.Errorf("Key: %q and %q must be the same", a.Key(), b.Key())
+	}
+	if c := iro.ColorFromSRGB(0.2, 0.4, 0.61, 1); c.Key() == a.Key() {
+		t.Errorf("Key: %q must be different from %q", c.Key(), a.Key())
+	}
+}
+
+func TestColorMarshalText(t *testing.T) {
+	type doc struct {
+		Color iro.Color `json:"color"`
+	}
+	in := doc{Color: iro.ColorFromSRGB(1, 0, 0, 1)}
+	b, err := json.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(b), `{"color":"oklch(0.627955 0.257683 29.2339deg / 1)"}`; got != want {
+		t.Errorf("Marshal: got %s, want %s", got, want)
+	}
+
+	var out doc
+	if err := json.Unmarshal(b, &out); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := out.Color.String(), in.Color.String(); got != want {
+		t.Errorf("Unmarshal: got %s, want %s", got, want)
+	}
+
+	// Other CSS colors are also accepted.
+	if err := json.Unmarshal([]byte(`{"color":"#ff0000"}`), &out); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := out.Color.Hex(), "#ff0000"; got != want {
+		t.Errorf("Unmarshal hex: got %s, want %s", got, want)
+	}
+	if err := json.Unmarshal([]byte(`{"color":"nocolor"}`), &out); err == nil {
+		t.Errorf("Unmarshal: got no error for an invalid color")
+	}
+}
@@ -0,0 +1,274 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+// Package cdl provides the ASC Color Decision List (ASC CDL).
+package cdl
+
+import (
+	"encoding/xml"
+	"fmt"
+	"image"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/hajimehoshi/iro"
+)
+
+// Correction is an ASC CDL color correction with slope, offset, power, and saturation.
+type Correction struct {
+	// ID is the identifier of the correction. ID is optional.
+	ID string
+
+	// Description is the description of the correction. Description is optional.
+	Description string
+
+	// Slope, Offset, and Power are the parameters for the red, green, and blue channels.
+	Slope  [3]float64
+	Offset [3]float64
+	Power  [3]float64
+
+	// Saturation is the saturation. 1 doesn't change the saturation.
+	Saturation float64
+}
+
+// NewCorrection creates a Correction that doesn't change colors.
+func NewCorrection() *Correction {
+	return &Correction{
+		Slope:      [3]float64{1, 1, 1},
+		Power:      [3]float64{1, 1, 1},
+		Saturation: 1,
+	}
+}
+
+// Apply applies the correction to the channels.
+//
+// As ASC CDL v1.2 specifies, the results of the slope and the offset are clamped to [0, 1] before the power,
+// and the results of the saturation are clamped to [0, 1].
+// The saturation uses the Rec. 709 luma coefficients.
+func (c *Correction) Apply(r, g, b float64) (float64, float64, float64) {
+	in := [3]float64{r, g, b}
+	var out [3]float64
+	for i := range in {
+		v := in[i]*c.Slope[i] + c.Offset[i]
+		v = min(max(v, 0), 1)
+		out[i] = math.Pow(v, c.Power[i])
+	}
+	luma := 0.2126*out[0] + 0.7152*out[1] + 0.0722*out[2]
+	for i := range out {
+		out[i] = min(max(luma+c.Saturation*(out[i]-luma), 0), 1)
+	}
+	return out[0], out[1], out[2]
+}
+
+// ApplyToColor applies the correction to the nonlinear sRGB channels of clr.
+// The alpha value is kept.
+func (c *Correction) ApplyToColor(clr iro.Color) iro.Color {
+	r, g, b, a := clr.SRGB()
+	r, g, b = c.Apply(r, g, b)
+	return iro.ColorFromSRGB(r, g, b, a)
+}
+
+// ApplyToImage returns a new image by applying the correction to the nonlinear sRGB channels of each pixel of img.
+func (c *Correction) ApplyToImage(img image.Image) *image.NRGBA64 {
+	return iro.MapImage(img, c.ApplyToColor)
+}
+
+type xmlSOPNode struct {
+	Description string `xml:"Description,omitempty"`
+	Slope       string `xml:"Slope"`
+	Offset      string `xml:"Offset"`
+	Power       string `xml:"Power"`
+}
+
+type xmlSatNode struct {
+	Saturation string `xml:"Saturation"`
+}
+
+type xmlColorCorrection struct {
+	XMLName xml.Name    `xml:"ColorCorrection"`
+	ID      string      `xml:"id,attr,omitempty"`
+	SOPNode *xmlSOPNode `xml:"SOPNode"`
+	SatNode *xmlSatNode `xml:"SatNode"`
+
+	// SATNode is an alternative spelling found in some files.
+	SATNode *xmlSatNode `xml:"SATNode"`
+}
+
+type xmlColorDecision struct {
+	ColorCorrection xmlColorCorrection `xml:"ColorCorrection"`
+}
+
+type xmlColorDecisionList struct {
+	XMLName        xml.Name           `xml:"ColorDecisionList"`
+	Xmlns          string             `xml:"xmlns,attr,omitempty"`
+	ColorDecisions []xmlColorDecision `xml:"ColorDecision"`
+}
+
+type xmlColorCorrectionCollection struct {
+	XMLName          xml.Name             `xml:"ColorCorrectionCollection"`
+	Xmlns            string               `xml:"xmlns,attr,omitempty"`
+	ColorCorrections []xmlColorCorrection `xml:"ColorCorrection"`
+}
+
+const namespace = "urn:ASC:CDL:v1.2"
+
+// Parse parses an ASC CDL XML document.
+// The root element can be ColorDecisionList (.cdl), ColorCorrectionCollection (.ccc), or ColorCorrection (.cc).
+func Parse(r io.Reader) ([]*Correction, error) {
+	d := xml.NewDecoder(r)
+	for {
+		t, err := d.Token()
+		if err != nil {
+			if err == io.EOF {
+				return nil, fmt.Errorf("cdl: no root element")
+			}
+			return nil, err
+		}
+		start, ok := t.(xml.StartElement)
+		if !ok {
+			continue
+		}
+
+		var ccs []xmlColorCorrection
+		switch start.Name.Local {
+		case "ColorDecisionList":
+			var v xmlColorDecisionList
+			if err := d.DecodeElement(&v, &start); err != nil {
+				return nil, err
+			}
+			for _, cd := range v.ColorDecisions {
+				ccs = append(ccs, cd.ColorCorrection)
+			}
+		case "ColorCorrectionCollection":
+			var v xmlColorCorrectionCollection
+			if err := d.DecodeElement(&v, &start); err != nil {
+				return nil, err
+			}
+			ccs = v.ColorCorrections
+		case "ColorCorrection":
+			var v xmlColorCorrection
+			if err := d.DecodeElement(&v, &start); err != nil {
+				return nil, err
+			}
+			ccs = []xmlColorCorrection{v}
+		default:
+			return nil, fmt.Errorf("cdl: unknown root element: %s", start.Name.Local)
+		}
+
+		corrections := make([]*Correction, 0, len(ccs))
+		for _, cc := range ccs {
+			c, err := cc.correction()
+			if err != nil {
+				return nil, err
+			}
+			corrections = append(corrections, c)
+		}
+		return corrections, nil
+	}
+}
+
+func (x *xmlColorCorrection) correction() (*Correction, error) {
+	c := NewCorrection()
+	c.ID = x.ID
+	if sop := x.SOPNode; sop != nil {
+		c.Description = strings.TrimSpace(sop.Description)
+		for _, v := range []struct {
+			name string
+			src  string
+			dst  *[3]float64
+		}{
+			{"Slope", sop.Slope, &c.Slope},
+			{"Offset", sop.Offset, &c.Offset},
+			{"Power", sop.Power, &c.Power},
+		} {
+			if strings.TrimSpace(v.src) == "" {
+				continue
+			}
+			fs := strings.Fields(v.src)
+			if len(fs) != 3 {
+				return nil, fmt.Errorf("cdl: %s of %q must have 3 values: %q", v.name, x.ID, v.src)
+			}
+			for i, f := range fs {
+				n, err := strconv.ParseFloat(f, 64)
+				if err != nil {
+					return nil, fmt.Errorf("cdl: invalid %s of %q: %w", v.name, x.ID, err)
+				}
+				v.dst[i] = n
+			}
+		}
+	}
+	sat := x.SatNode
+	if sat == nil {
+		sat = x.SATNode
+	}
+	if sat != nil && strings.TrimSpace(sat.Saturation) != "" {
+		n, err := strconv.ParseFloat(strings.TrimSpace(sat.Saturation), 64)
+		if err != nil {
+			return nil, fmt.Errorf("cdl: invalid Saturation of %q: %w", x.ID, err)
+		}
+		c.Saturation = n
+	}
+	return c, nil
+}
+
+func formatTriplet(v [3]float64) string {
+	return strings.Join([]string{
+		strconv.FormatFloat(v[0], 'f', -1, 64),
+		strconv.FormatFloat(v[1], 'f', -1, 64),
+		strconv.FormatFloat(v[2], 'f', -1, 64),
+	}, " ")
+}
+
+func newXMLColorCorrection(c *Correction) xmlColorCorrection {
+	return xmlColorCorrection{
+		ID: c.ID,
+		SOPNode: &xmlSOPNode{
+			Description: c.Description,
+			Slope:       formatTriplet(c.Slope),
+			Offset:      formatTriplet(c.Offset),
+			Power:       formatTriplet(c.Power),
+		},
+		SatNode: &xmlSatNode{
+			Saturation: strconv.FormatFloat(c.Saturation, 'f', -1, 64),
+		},
+	}
+}
+
+func encode(w io.Writer, v any) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	e := xml.NewEncoder(w)
+	e.Indent("", "  ")
+	if err := e.Encode(v); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// EncodeCDL writes the corrections as a ColorDecisionList (.cdl) document.
+func EncodeCDL(w io.Writer, corrections []*Correction) error {
+	v := xmlColorDecisionList{
+		Xmlns: namespace,
+	}
+	for _, c := range corrections {
+		v.ColorDecisions = append(v.ColorDecisions, xmlColorDecision{
+			ColorCorrection: newXMLColorCorrection(c),
+		})
+	}
+	return encode(w, v)
+}
+
+// EncodeCCC writes the corrections as a ColorCorrectionCollection (.ccc) document.
+func EncodeCCC(w io.Writer, corrections []*Correction) error {
+	v := xmlColorCorrectionCollection{
+		Xmlns: namespace,
+	}
+	for _, c := range corrections {
+		v.ColorCorrections = append(v.ColorCorrections, newXMLColorCorrection(c))
+	}
+	return encode(w, v)
+}
@@ -0,0 +1,146 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package cdl_test
+
+import (
+	"bytes"
+	"io"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+	"github.com/hajimehoshi/iro/cdl"
+)
+
+const tol = 1e-6
+
+const cccSource = `<?xml version="1.0" encoding="UTF-8"?>
+<ColorCorrectionCollection xmlns="urn:ASC:CDL:v1.01">
+  <ColorCorrection id="shot_010">
+    <SOPNode>
+      <Description>warm</Description>
+      <Slope>1.1 1.0 0.9</Slope>
+      <Offset>0.01 0 -0.01</Offset>
+      <Power>1.0 1.2 1.0</Power>
+    </SOPNode>
+    <SATNode>
+      <Saturation>0.8</Saturation>
+    </SATNode>
+  </ColorCorrection>
+  <ColorCorrection id="shot_020">
+    <SOPNode>
+      <Slope>1 1 1</Slope>
+      <Offset>0 0 0</Offset>
+      <Power>1 1 1</Power>
+    </SOPNode>
+  </ColorCorrection>
+</ColorCorrectionCollection>
+`
+
+func TestParseCCC(t *testing.T) {
+	cs, err := cdl.Parse(strings.NewReader(cccSource))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*cdl.Correction{
+		{
+			ID:          "shot_010",
+			Description: "warm",
+			Slope:       [3]float64{1.1, 1.0, 0.9},
+			Offset:      [3]float64{0.01, 0, -0.01},
+			Power:       [3]float64{1.0, 1.2, 1.0},
+			Saturation:  0.8,
+		},
+		{
+			ID:         "shot_020",
+			Slope:      [3]float64{1, 1, 1},
+			Power:      [3]float64{1, 1, 1},
+			Saturation: 1,
+		},
+	}
+	if !reflect.DeepEqual(cs, want) {
+		t.Errorf("got %+v, want %+v", cs, want)
+	}
+}
+
+func TestParseCC(t *testing.T) {
+	const src = `<ColorCorrection id="a"><SOPNode><Slope>2 2 2</Slope><Offset>0 0 0</Offset><Power>1 1 1</Power></SOPNode><SatNode><Saturation>0</Saturation></SatNode></ColorCorrection>`
+	cs, err := cdl.Parse(strings.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cs) != 1 {
+		t.Fatalf("len(cs): got %d, want 1", len(cs))
+	}
+	if got, want := cs[0].Saturation, 0.0; got != want {
+		t.Errorf("Saturation: got %f, want %f", got, want)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, src := range []string{
+		``,
+		`<Foo/>`,
+		`<ColorCorrection><SOPNode><Slope>1 1</Slope></SOPNode></ColorCorrection>`,
+		`<ColorCorrection><SatNode><Saturation>x</Saturation></SatNode></ColorCorrection>`,
+	} {
+		if _, err := cdl.Parse(strings.NewReader(src)); err == nil {
+			t.Errorf("Parse(%q) must return an error", src)
+		}
+	}
+}
+
+func TestEncodeRoundTrip(t *testing.T) {
+	cs, err := cdl.Parse(strings.NewReader(cccSource))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, encode := range []func(io.Writer, []*cdl.Correction) error{cdl.EncodeCDL, cdl.EncodeCCC} {
+		var buf bytes.Buffer
+		if err := encode(&buf, cs); err != nil {
+			t.Fatal(err)
+		}
+		got, err := cdl.Parse(&buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, cs) {
+			t.Errorf("got %+v, want %+v", got, cs)
+		}
+	}
+}
+
+func TestCorrectionApply(t *testing.T) {
+	c := &cdl.Correction{
+		Slope:      [3]float64{2, 1, 1},
+		Offset:     [3]float64{0, 0.1, 0},
+		Power:      [3]float64{1, 1, 2},
+		Saturation: 1,
+	}
+	r, g, b := c.Apply(0.25, 0.5, 0.5)
+	if math.Abs(r-0.5) > tol || math.Abs(g-0.6) > tol || math.Abs(b-0.25) > tol {
+		t.Errorf("got (%f, %f, %f), want (0.5, 0.6, 0.25)", r, g, b)
+	}
+
+	// Zero saturation makes colors gray with the Rec. 709 luma.
+	c = cdl.NewCorrection()
+	c.Saturation = 0
+	r, g, b = c.Apply(1, 0, 0)
+	for _, v := range []float64{r, g, b} {
+		if math.Abs(v-0.2126) > tol {
+			t.Errorf("got (%f, %f, %f), want 0.2126 for all the channels", r, g, b)
+			break
+		}
+	}
+}
+
+func TestCorrectionApplyToColor(t *testing.T) {
+	clr := iro.ColorFromSRGB(0.2, 0.4, 0.6, 0.5)
+	r, g, b, a := cdl.NewCorrection().ApplyToColor(clr).SRGB()
+	if math.Abs(r-0.2) > tol || math.Abs(g-0.4) > tol || math.Abs(b-0.6) > tol || math.Abs(a-0.5) > tol {
+		t.Errorf("got (%f, %f, %f, %f), want (0.2, 0.4, 0.6, 0.5)", r, g, b, a)
+	}
+}
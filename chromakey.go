@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"image"
+	"image/color"
+	"math"
+)
+
+// ChromaKey is a keyer that makes the colors near a key color transparent, like a green screen.
+//
+// The nearness is measured perceptually by the distance in OKLab (see [DeltaEOK]),
+// which separates the key from the foreground more evenly than a distance in RGB.
+type ChromaKey struct {
+	// Key is the key color to remove.
+	Key Color
+
+	// Tolerance is the OKLab distance from the key within which colors are fully transparent.
+	Tolerance float64
+
+	// Softness is the width of the OKLab distance beyond Tolerance where the matte rises smoothly from transparent to opaque.
+	Softness float64
+
+	// SpillSuppression is the strength in [0, 1] to remove the hue of the key reflected on the foreground.
+	// 0 means no suppression and 1 removes the component toward the key hue from the chroma entirely.
+	SpillSuppression float64
+}
+
+// Matte returns the value of the alpha matte for c in [0, 1], where 0 means c is the key and 1 means c is the foreground.
+// The alpha value of c is ignored.
+func (k *ChromaKey) Matte(c Color) float64 {
+	d := DeltaEOK(c, k.Key) - k.Tolerance
+	if d <= 0 {
+		return 0
+	}
+	if d >= k.Softness {
+		return 1
+	}
+	// Use smoothstep for the ramp.
+	t := d / k.Softness
+	return t * t * (3 - 2*t)
+}
+
+// Apply returns a new Color by multiplying the alpha value of c by the matte and suppressing the spill.
+func (k *ChromaKey) Apply(c Color) Color {
+	m := k.Matte(c)
+	if k.SpillSuppression > 0 {
+		c = k.suppressSpill(c)
+	}
+	return c.WithAlpha(c.alpha * m)
+}
+
+// suppressSpill reduces the component of the chroma of c toward the hue of the key.
+func (k *ChromaKey) suppressSpill(c Color) Color {
+	_, ka, kb, _ := k.Key.OKLab()
+	kc := math.Hypot(ka, kb)
+	if kc == 0 {
+		return c
+	}
+	ka /= kc
+	kb /= kc
+	l, a, b, alpha := c.OKLab()
+	spill := a*ka + b*kb
+	if spill <= 0 {
+		return c
+	}
+	s := spill * min(k.SpillSuppression, 1)
+	return ColorFromOKLab(l, a-s*ka, b-s*kb, alpha)
+}
+
+// MatteImage returns the alpha matte of img as a grayscale image, where black is the key and white is the foreground.
+// The alpha values of the pixels are ignored.
+func (k *ChromaKey) MatteImage(img image.Image) *image.Gray16 {
+	b := img.Bounds()
+	dst := image.NewGray16(b)
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			dst.SetGray16(x, y, color.Gray16{Y: uint16(math.Round(k.Matte(colorAt(img, x, y)) * 0xffff))})
+		}
+	}
+	return dst
+}
+
+// ApplyToImage returns a new image by keying each pixel of img.
+// See [MapImage] for the interpretation of the pixels.
+func (k *ChromaKey) ApplyToImage(img image.Image) *image.NRGBA64 {
+	return MapImage(img, k.Apply)
+}
@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestChromaKeyMatte(t *testing.T) {
+	key := iro.ColorFromOKLab(0.8, -0.2, 0.15, 1)
+	k := &iro.ChromaKey{Key: key, Tolerance: 0.1, Softness: 0.2}
+	testCases := []struct {
+		d    float64
+		want float64
+	}{
+		{d: 0, want: 0},
+		{d: 0.05, want: 0},
+		{d: 0.1, want: 0},
+		{d: 0.2, want: 0.5},
+		{d: 0.3, want: 1},
+		{d: 0.6, want: 1},
+	}
+	for _, tc := range testCases {
+		c := iro.ColorFromOKLab(0.8-tc.d, -0.2, 0.15, 1)
+		if got := k.Matte(c); !checkTol(got, tc.want) {
+			t.Errorf("distance %f: got %f, want %f", tc.d, got, tc.want)
+		}
+	}
+}
+
+func TestChromaKeyApply(t *testing.T) {
+	key := iro.ColorFromOKLab(0.8, -0.2, 0.15, 1)
+	k := &iro.ChromaKey{Key: key, Tolerance: 0.1, Softness: 0.1}
+
+	if got := k.Apply(key).Alpha(); got != 0 {
+		t.Errorf("key: got alpha %f, want 0", got)
+	}
+
+	fg := iro.ColorFromOKLab(0.5, 0.1, 0.05, 0.5)
+	if got := k.Apply(fg); got != fg {
+		t.Errorf("foreground: got %v, want %v", got, fg)
+	}
+
+	// A greenish foreground loses its green spill but keeps the rest.
+	k.SpillSuppression = 1
+	spilled := iro.ColorFromOKLab(0.6, 0.06-0.04, 0.08+0.03, 1)
+	l, a, b, alpha := k.Apply(spilled).OKLab()
+	// The direction of the key is (-0.8, 0.6) and (0.06, 0.08) is perpendicular to it, so the spill is (-0.04, 0.03).
+	if !checkTol(l, 0.6) || !checkTol(a, 0.06) || !checkTol(b, 0.08) || !checkTol(alpha, 1) {
+		t.Errorf("spill: got (%f, %f, %f, %f), want (0.6, 0.06, 0.08, 1)", l, a, b, alpha)
+	}
+
+	// A color opposite to the key is not suppressed.
+	opposite := iro.ColorFromOKLab(0.6, 0.16, -0.12, 1)
+	if got := k.Apply(opposite); iro.DeltaEOK(got, opposite) > 1e-9 {
+		t.Errorf("opposite: got %v, want %v", got, opposite)
+	}
+}
+
+func TestChromaKeyImage(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 2, 1))
+	img.SetNRGBA(0, 0, color.NRGBA{0x00, 0xff, 0x00, 0xff})
+	img.SetNRGBA(1, 0, color.NRGBA{0xff, 0x00, 0x00, 0xff})
+	k := &iro.ChromaKey{Key: iro.ColorFromSRGB(0, 1, 0, 1), Tolerance: 0.05, Softness: 0.1}
+
+	m := k.MatteImage(img)
+	if got := m.Gray16At(0, 0).Y; got != 0 {
+		t.Errorf("MatteImage(0, 0): got %d, want 0", got)
+	}
+	if got := m.Gray16At(1, 0).Y; got != 0xffff {
+		t.Errorf("MatteImage(1, 0): got %d, want 0xffff", got)
+	}
+
+	dst := k.ApplyToImage(img)
+	if got := dst.NRGBA64At(0, 0).A; got != 0 {
+		t.Errorf("ApplyToImage(0, 0): got alpha %d, want 0", got)
+	}
+	if got, want := dst.NRGBA64At(1, 0), (color.NRGBA64{0xffff, 0, 0, 0xffff}); got != want {
+		t.Errorf("ApplyToImage(1, 0): got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+// Chromaticity represents CIE 1931 xy chromaticity coordinates.
+type Chromaticity struct {
+	X float64
+	Y float64
+}
+
+// The chromaticities of the standard illuminants as white points, for the CIE 1931 2° standard observer.
+// These are the values used by CSS Color 4 and this package.
+var (
+	// WhiteD65 is the chromaticity of the CIE standard illuminant D65.
+	WhiteD65 = Chromaticity{X: 0.3127, Y: 0.3290}
+
+	// WhiteD50 is the chromaticity of the CIE standard illuminant D50.
+	WhiteD50 = Chromaticity{X: 0.3457, Y: 0.3585}
+
+	// WhiteA is the chromaticity of the CIE standard illuminant A, representing incandescent light.
+	WhiteA = Chromaticity{X: 0.44757, Y: 0.40745}
+
+	// WhiteC is the chromaticity of the CIE illuminant C, representing average daylight in older instruments and standards.
+	WhiteC = Chromaticity{X: 0.31006, Y: 0.31616}
+
+	// WhiteE is the chromaticity of the CIE equal-energy illuminant E.
+	WhiteE = Chromaticity{X: 1.0 / 3, Y: 1.0 / 3}
+)
+
+// ChromaticityFromUV returns the Chromaticity of CIE 1976 u'v' chromaticity coordinates.
+func ChromaticityFromUV(u, v float64) Chromaticity {
+	d := 6*u - 16*v + 12
+	return Chromaticity{
+		X: 9 * u / d,
+		Y: 4 * v / d,
+	}
+}
+
+// UV returns the CIE 1976 u'v' chromaticity coordinates.
+func (c Chromaticity) UV() (u, v float64) {
+	d := -2*c.X + 12*c.Y + 3
+	return 4 * c.X / d, 9 * c.Y / d
+}
+
+// XYZ returns the XYZ coordinates of the chromaticity with the luminance yy.
+//
+// If c.Y is 0, XYZ returns (0, 0, 0).
+func (c Chromaticity) XYZ(yy float64) (x, y, z float64) {
+	if c.Y == 0 {
+		return 0, 0, 0
+	}
+	return c.X * yy / c.Y, yy, (1 - c.X - c.Y) * yy / c.Y
+}
+
+// Color returns the Color of the chromaticity with the luminance yy and alpha.
+// This is the same as [ColorFromXyY].
+func (c Chromaticity) Color(yy, alpha float64) Color {
+	return ColorFromXyY(c.X, c.Y, yy, alpha)
+}
+
+// Chromaticity returns the chromaticity of c.
+//
+// For black, whose chromaticity is undefined, Chromaticity returns [WhiteD65].
+func (c Color) Chromaticity() Chromaticity {
+	x, y, _, _ := c.XyY()
+	return Chromaticity{X: x, Y: y}
+}
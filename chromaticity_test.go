@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestChromaticityUV(t *testing.T) {
+	testCases := []struct {
+		c    iro.Chromaticity
+		u, v float64
+	}{
+		// u' = 4x / (-2x + 12y + 3), v' = 9y / (-2x + 12y + 3)
+		{c: iro.WhiteD65, u: 0.197830, v: 0.468319},
+		{c: iro.WhiteD50, u: 0.209179, v: 0.488080},
+		{c: iro.Chromaticity{X: 1.0 / 3, Y: 1.0 / 3}, u: 4.0 / 19, v: 9.0 / 19},
+	}
+	for _, tc := range testCases {
+		u, v := tc.c.UV()
+		if !checkTol(u, tc.u) || !checkTol(v, tc.v) {
+			t.Errorf("%v.UV(): got (%f, %f), want (%f, %f)", tc.c, u, v, tc.u, tc.v)
+		}
+		got := iro.ChromaticityFromUV(u, v)
+		if !checkTol(got.X, tc.c.X) || !checkTol(got.Y, tc.c.Y) {
+			t.Errorf("ChromaticityFromUV(%f, %f): got %v, want %v", u, v, got, tc.c)
+		}
+	}
+}
+
+func TestChromaticityXYZ(t *testing.T) {
+	x, y, z := iro.WhiteD65.XYZ(1)
+	if !checkTol(x, 0.3127/0.3290) || !checkTol(y, 1) || !checkTol(z, (1-0.3127-0.3290)/0.3290) {
+		t.Errorf("WhiteD65.XYZ(1): got (%f, %f, %f)", x, y, z)
+	}
+
+	x, y, z = iro.Chromaticity{X: 0.3, Y: 0}.XYZ(1)
+	if x != 0 || y != 0 || z != 0 {
+		t.Errorf("XYZ with y = 0: got (%f, %f, %f), want (0, 0, 0)", x, y, z)
+	}
+}
+
+func TestColorChromaticity(t *testing.T) {
+	// The white point of sRGB is D65.
+	got := iro.White.Chromaticity()
+	if !checkTol(got.X, iro.WhiteD65.X) || !checkTol(got.Y, iro.WhiteD65.Y) {
+		t.Errorf("White.Chromaticity(): got %v, want %v", got, iro.WhiteD65)
+	}
+
+	c := iro.ColorFromSRGB(0.2, 0.4, 0.6, 1)
+	_, yy, _, _ := c.XYZ()
+	x0, y0, z0, _ := c.XYZ()
+	x1, y1, z1, _ := c.Chromaticity().Color(yy, 1).XYZ()
+	if !checkTol(x1, x0) || !checkTol(y1, y0) || !checkTol(z1, z0) {
+		t.Errorf("round trip: got (%f, %f, %f), want (%f, %f, %f)", x1, y1, z1, x0, y0, z0)
+	}
+}
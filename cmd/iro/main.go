@@ -0,0 +1,244 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+// Command iro converts colors to various color spaces and formats, and generates palettes and gradients.
+//
+// Usage:
+//
+//	iro [command] [flags] [arg ...]
+//
+// The commands are:
+//
+//	convert   print colors in formats (the default command)
+//	ramp      print the colors sampled from a gradient through colors
+//	harmony   print the colors of a color harmony
+//	extract   print the dominant colors of an image
+//	swatch    render colors as a swatch PNG
+//	gradient  render a gradient through colors as a PNG
+//
+// The colors are CSS colors that [iro.ParseCSS] accepts, like "#ff8000", "cornflowerblue", or "oklch(0.7 0.15 60)".
+// If no colors are given, the colors are read from the standard input line by line.
+// Run "iro [command] -h" for the flags of each command.
+//
+// The commands printing colors print one color per line, with the representations in the formats specified by -to separated by tabs.
+// The common flags of them are:
+//
+//	-to formats
+//		The comma-separated list of the output formats (default "hex,oklch").
+//		The formats are hex, srgb, srgb-linear, display-p3 (or p3), a98-rgb, prophoto-rgb, rec2020,
+//		xyz (or xyz-d65), xyz-d50, lab, lch, oklab, and oklch.
+//	-gamut-map
+//		Map colors outside the gamut of an RGB output format into the gamut,
+//		by reducing the OKLCh chroma with the lightness and the hue kept.
+//		The hex format always uses the sRGB gamut.
+//	-precision n
+//		The number of significant digits of the numbers.
+//		The default 0 means the shortest representation that parses back to the same value.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/hajimehoshi/iro"
+)
+
+// format is an output format.
+type format struct {
+	space iro.Space
+	hex   bool
+	rgb   bool
+}
+
+var formats = map[string]format{
+	"hex":          {space: iro.SpaceSRGB, hex: true, rgb: true},
+	"srgb":         {space: iro.SpaceSRGB, rgb: true},
+	"srgb-linear":  {space: iro.SpaceLinearSRGB, rgb: true},
+	"display-p3":   {space: iro.SpaceDisplayP3, rgb: true},
+	"p3":           {space: iro.SpaceDisplayP3, rgb: true},
+	"a98-rgb":      {space: iro.SpaceA98RGB, rgb: true},
+	"prophoto-rgb": {space: iro.SpaceProPhotoRGB, rgb: true},
+	"rec2020":      {space: iro.SpaceRec2020, rgb: true},
+	"xyz":          {space: iro.SpaceXYZ},
+	"xyz-d65":      {space: iro.SpaceXYZ},
+	"xyz-d50":      {space: iro.SpaceXYZD50},
+	"lab":          {space: iro.SpaceLab},
+	"lch":          {space: iro.SpaceLch},
+	"oklab":        {space: iro.SpaceOKLab},
+	"oklch":        {space: iro.SpaceOKLch},
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// commands is the subcommands.
+var commands = map[string]func(args []string, stdin io.Reader, stdout, stderr io.Writer) int{
+	"convert":  runConvert,
+	"ramp":     runRamp,
+	"harmony":  runHarmony,
+	"extract":  runExtract,
+	"swatch":   runSwatch,
+	"gradient": runGradient,
+}
+
+// run runs the command with the arguments and returns the exit code.
+// If the first argument is not a command name, the convert command is run.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) > 0 {
+		if cmd, ok := commands[args[0]]; ok {
+			return cmd(args[1:], stdin, stdout, stderr)
+		}
+	}
+	return runConvert(args, stdin, stdout, stderr)
+}
+
+// newFlagSet returns a flag set of the command printing the usage to stderr.
+func newFlagSet(name, args string, stderr io.Writer) *flag.FlagSet {
+	fs := flag.NewFlagSet("iro "+name, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		fmt.Fprintf(stderr, "usage: iro %s [flags] %s\n", name, args)
+		fs.PrintDefaults()
+	}
+	return fs
+}
+
+// outputFlags is the flags of the output formats of the commands printing colors.
+type outputFlags struct {
+	to        *string
+	gamutMap  *bool
+	precision *int
+}
+
+func addOutputFlags(fs *flag.FlagSet) *outputFlags {
+	return &outputFlags{
+		to:        fs.String("to", "hex,oklch", "comma-separated output formats"),
+		gamutMap:  fs.Bool("gamut-map", false, "map colors into the gamuts of RGB output formats"),
+		precision: fs.Int("precision", 0, "number of significant digits (0 for the shortest representation)"),
+	}
+}
+
+// printer returns the printer of colors with the flags.
+func (o *outputFlags) printer() (*printer, error) {
+	p := &printer{
+		gamutMap: *o.gamutMap,
+	}
+	for _, name := range strings.Split(*o.to, ",") {
+		f, ok := formats[strings.ToLower(strings.TrimSpace(name))]
+		if !ok {
+			return nil, fmt.Errorf("iro: unknown format: %q", name)
+		}
+		p.formats = append(p.formats, f)
+	}
+	if *o.precision < 0 {
+		return nil, fmt.Errorf("iro: precision must not be negative: %d", *o.precision)
+	}
+	p.opts = &iro.FormatOptions{Precision: *o.precision}
+	return p, nil
+}
+
+// printer prints colors in formats.
+type printer struct {
+	formats  []format
+	gamutMap bool
+	opts     *iro.FormatOptions
+}
+
+// print prints c in the formats separated by tabs in a line.
+func (p *printer) print(w io.Writer, c iro.Color) {
+	outs := make([]string, len(p.formats))
+	for i, f := range p.formats {
+		outs[i] = f.format(c, p.gamutMap, p.opts)
+	}
+	fmt.Fprintln(w, strings.Join(outs, "\t"))
+}
+
+// readInputs returns args, or the non-empty lines of stdin if args is empty.
+func readInputs(args []string, stdin io.Reader) ([]string, error) {
+	if len(args) > 0 {
+		return args, nil
+	}
+	var inputs []string
+	s := bufio.NewScanner(stdin)
+	for s.Scan() {
+		if line := strings.TrimSpace(s.Text()); line != "" {
+			inputs = append(inputs, line)
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, fmt.Errorf("iro: %w", err)
+	}
+	return inputs, nil
+}
+
+// parseColors parses the colors of args, or of the lines of stdin if args is empty.
+func parseColors(args []string, stdin io.Reader) ([]iro.Color, error) {
+	inputs, err := readInputs(args, stdin)
+	if err != nil {
+		return nil, err
+	}
+	cs := make([]iro.Color, len(inputs))
+	for i, in := range inputs {
+		c, err := iro.ParseCSS(in)
+		if err != nil {
+			return nil, err
+		}
+		cs[i] = c
+	}
+	return cs, nil
+}
+
+// runConvert runs the convert command, which prints colors in formats.
+// Invalid colors are reported and skipped.
+func runConvert(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := newFlagSet("convert", "[color ...]", stderr)
+	out := addOutputFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	p, err := out.printer()
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 2
+	}
+
+	inputs, err := readInputs(fs.Args(), stdin)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+	code := 0
+	for _, in := range inputs {
+		c, err := iro.ParseCSS(in)
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			code = 1
+			continue
+		}
+		p.print(stdout, c)
+	}
+	return code
+}
+
+// format returns the representation of c in the format f.
+func (f format) format(c iro.Color, gamutMap bool, opts *iro.FormatOptions) string {
+	if gamutMap && f.rgb {
+		c = mapToGamut(c, f.space)
+	}
+	if f.hex {
+		return c.Hex()
+	}
+	return c.CSS(f.space, opts)
+}
+
+// mapToGamut returns the color inside the gamut of the RGB space with the OKLCh lightness and hue of c,
+// whose chroma is reduced if needed.
+func mapToGamut(c iro.Color, space iro.Space) iro.Color {
+	c0, c1, c2, alpha := c.Convert(space, iro.WithGamutMapping(iro.GamutMappingChroma))
+	return iro.ColorFromComponents(space, c0, c1, c2, alpha)
+}
@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestRun(t *testing.T) {
+	testCases := []struct {
+		name  string
+		args  []string
+		stdin string
+		want  string
+		code  int
+	}{
+		{
+			name: "Default",
+			args: []string{"-precision", "6", "#ff0000"},
+			want: "#ff0000\toklch(0.627955 0.257683 29.2339)\n",
+		},
+		{
+			name: "Formats",
+			args: []string{"-to", "srgb, Lab", "-precision", "3", "rgb(255 0 0)", "#000"},
+			want: "color(srgb 1 0 0)\tlab(54.3 80.8 69.9)\ncolor(srgb 0 0 0)\tlab(0 0 0)\n",
+		},
+		{
+			name:  "Stdin",
+			args:  []string{"-to", "hex"},
+			stdin: "oklch(1 0 0)\n\n  #123456  \n",
+			want:  "#ffffff\n#123456\n",
+		},
+		{
+			name: "GamutMap",
+			args: []string{"-to", "srgb", "-gamut-map", "-precision", "4", "color(display-p3 0 1 0)"},
+			want: "color(srgb 0 0.9683 0.3083)\n",
+		},
+		{
+			name: "NoGamutMap",
+			args: []string{"-to", "srgb", "-precision", "4", "color(display-p3 0 1 0)"},
+			want: "color(srgb -0.5116 1.018 -0.3107)\n",
+		},
+		{
+			name: "InvalidColor",
+			args: []string{"-to", "hex", "#ff0000", "bogus", "#00ff00"},
+			want: "#ff0000\n#00ff00\n",
+			code: 1,
+		},
+		{
+			name: "Convert",
+			args: []string{"convert", "-to", "hex", "oklch(0 0 0)"},
+			want: "#000000\n",
+		},
+		{
+			name: "NamedColor",
+			args: []string{"-to", "hex", "cornflowerblue", "red"},
+			want: "#6495ed\n#ff0000\n",
+		},
+		{
+			name: "InvalidFormat",
+			args: []string{"-to", "cmyk", "#ff0000"},
+			code: 2,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			code := run(tc.args, strings.NewReader(tc.stdin), &stdout, &stderr)
+			if code != tc.code {
+				t.Errorf("exit code: got %d, want %d (stderr: %q)", code, tc.code, stderr.String())
+			}
+			if got := stdout.String(); got != tc.want {
+				t.Errorf("got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMapToGamut(t *testing.T) {
+	for _, space := range []iro.Space{iro.SpaceSRGB, iro.SpaceDisplayP3, iro.SpaceRec2020} {
+		for _, s := range []string{"oklch(0.7 0.4 150)", "color(rec2020 1 0 0)", "oklch(1.2 0.1 20)", "#808080"} {
+			c, err := iro.ParseCSS(s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := mapToGamut(c, space)
+			r, g, b, _ := got.Components(space)
+			for _, v := range []float64{r, g, b} {
+				if v < -1e-9 || v > 1+1e-9 {
+					t.Errorf("mapToGamut(%s, %s): got (%f, %f, %f), want inside the gamut", s, space, r, g, b)
+					break
+				}
+			}
+		}
+	}
+}
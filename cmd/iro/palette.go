@@ -0,0 +1,162 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package main
+
+import (
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/hajimehoshi/iro"
+	"github.com/hajimehoshi/iro/palette"
+)
+
+// parseSpace returns the space of the format name for interpolation, like "oklab".
+func parseSpace(name string) (iro.Space, error) {
+	f, ok := formats[strings.ToLower(name)]
+	if !ok || f.hex {
+		return 0, fmt.Errorf("iro: unknown space: %q", name)
+	}
+	return f.space, nil
+}
+
+// runRamp runs the ramp command, which prints the colors sampled evenly from a gradient through colors.
+func runRamp(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := newFlagSet("ramp", "[color ...]", stderr)
+	n := fs.Int("n", 5, "number of colors")
+	space := fs.String("space", "oklab", "space to interpolate the colors in")
+	out := addOutputFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	p, err := out.printer()
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 2
+	}
+	s, err := parseSpace(*space)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 2
+	}
+	if *n <= 0 {
+		fmt.Fprintf(stderr, "iro: n must be positive: %d\n", *n)
+		return 2
+	}
+
+	cs, err := parseColors(fs.Args(), stdin)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+	if len(cs) == 0 {
+		fmt.Fprintln(stderr, "iro: no colors")
+		return 1
+	}
+	g := iro.NewGradient(cs...)
+	g.Space = s
+	for _, c := range g.Samples(*n) {
+		p.print(stdout, c)
+	}
+	return 0
+}
+
+// runHarmony runs the harmony command, which prints the colors of a color harmony based on a color.
+func runHarmony(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := newFlagSet("harmony", "[color]", stderr)
+	typ := fs.String("type", "complementary", "harmony: complementary, analogous, triadic, split-complementary, tetradic, or square")
+	out := addOutputFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	p, err := out.printer()
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 2
+	}
+	h, err := iro.ParseHarmony(strings.ToLower(*typ))
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 2
+	}
+
+	cs, err := parseColors(fs.Args(), stdin)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+	if len(cs) != 1 {
+		fmt.Fprintf(stderr, "iro: harmony requires exactly one color but %d\n", len(cs))
+		return 1
+	}
+	for _, c := range cs[0].Harmony(h) {
+		p.print(stdout, c)
+	}
+	return 0
+}
+
+// runExtract runs the extract command, which prints the dominant colors of an image file.
+func runExtract(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := newFlagSet("extract", "image", stderr)
+	n := fs.Int("n", 5, "maximum number of colors")
+	strip := fs.Bool("strip", false, "read the colors of a palette strip image in order instead of the dominant colors")
+	out := addOutputFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	p, err := out.printer()
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 2
+	}
+	if *n <= 0 {
+		fmt.Fprintf(stderr, "iro: n must be positive: %d\n", *n)
+		return 2
+	}
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return 2
+	}
+
+	img, err := decodeImage(fs.Arg(0), stdin)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+	var cs []iro.Color
+	if *strip {
+		for _, s := range palette.SwatchesFromImage(img) {
+			cs = append(cs, s.Color)
+		}
+	} else {
+		cs = iro.DominantColors(img, *n)
+	}
+	for _, c := range cs {
+		p.print(stdout, c)
+	}
+	return 0
+}
+
+// decodeImage decodes the image file of the name, or the standard input if the name is "-".
+func decodeImage(name string, stdin io.Reader) (image.Image, error) {
+	r := stdin
+	if name != "-" {
+		f, err := os.Open(name)
+		if err != nil {
+			return nil, fmt.Errorf("iro: %w", err)
+		}
+		defer f.Close()
+		r = f
+	}
+	img, _, err := image.Decode(r)
+	if err != nil {
+		return nil, fmt.Errorf("iro: %s: %w", name, err)
+	}
+	return img, nil
+}
@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package main
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRunPalette(t *testing.T) {
+	// A palette strip image of three colors, with a large area of red.
+	img := image.NewNRGBA(image.Rect(0, 0, 6, 1))
+	for x, c := range []color.NRGBA{
+		{R: 0xff, A: 0xff},
+		{R: 0xff, A: 0xff},
+		{R: 0xff, A: 0xff},
+		{G: 0xff, A: 0xff},
+		{G: 0xff, A: 0xff},
+		{B: 0xff, A: 0xff},
+	} {
+		img.SetNRGBA(x, 0, c)
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "strip.png")
+	if err := os.WriteFile(path, buf.Bytes(), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name  string
+		args  []string
+		stdin string
+		want  string
+		code  int
+	}{
+		{
+			name: "Ramp",
+			args: []string{"ramp", "-n", "3", "-to", "hex", "#000000", "#ffffff"},
+			want: "#000000\n#636363\n#ffffff\n",
+		},
+		{
+			name: "RampSRGB",
+			args: []string{"ramp", "-n", "4", "-space", "srgb", "-to", "hex", "#000000", "#ffffff"},
+			want: "#000000\n#555555\n#aaaaaa\n#ffffff\n",
+		},
+		{
+			name:  "RampStdin",
+			args:  []string{"ramp", "-n", "2", "-to", "hex"},
+			stdin: "#ff0000\n#0000ff\n",
+			want:  "#ff0000\n#0000ff\n",
+		},
+		{
+			name: "RampNoColors",
+			args: []string{"ramp"},
+			code: 1,
+		},
+		{
+			name: "RampInvalidSpace",
+			args: []string{"ramp", "-space", "hex", "#000000"},
+			code: 2,
+		},
+		{
+			name: "Harmony",
+			args: []string{"harmony", "-type", "complementary", "-to", "oklch", "-precision", "3", "oklch(0.7 0.1 30)"},
+			want: "oklch(0.7 0.1 30)\noklch(0.7 0.1 210)\n",
+		},
+		{
+			name: "HarmonySquare",
+			args: []string{"harmony", "-type", "square", "-to", "oklch", "-precision", "3", "oklch(0.7 0.1 30)"},
+			want: "oklch(0.7 0.1 30)\noklch(0.7 0.1 120)\noklch(0.7 0.1 210)\noklch(0.7 0.1 300)\n",
+		},
+		{
+			name: "HarmonyInvalidType",
+			args: []string{"harmony", "-type", "monochrome", "#ff0000"},
+			code: 2,
+		},
+		{
+			name: "HarmonyTwoColors",
+			args: []string{"harmony", "#ff0000", "#00ff00"},
+			code: 1,
+		},
+		{
+			name: "Extract",
+			// Blue is merged into the cluster of red, the most populous color.
+			args: []string{"extract", "-n", "2", "-to", "hex", path},
+			want: "#c6496d\n#00ff00\n",
+		},
+		{
+			name:  "ExtractStdin",
+			args:  []string{"extract", "-to", "hex", "-"},
+			stdin: buf.String(),
+			want:  "#ff0000\n#00ff00\n#0000ff\n",
+		},
+		{
+			name: "ExtractStrip",
+			args: []string{"extract", "-strip", "-to", "hex", path},
+			want: "#ff0000\n#00ff00\n#0000ff\n",
+		},
+		{
+			name: "ExtractNoFile",
+			args: []string{"extract", filepath.Join(t.TempDir(), "missing.png")},
+			code: 1,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			code := run(tc.args, strings.NewReader(tc.stdin), &stdout, &stderr)
+			if code != tc.code {
+				t.Errorf("exit code: got %d, want %d (stderr: %q)", code, tc.code, stderr.String())
+			}
+			if got := stdout.String(); got != tc.want {
+				t.Errorf("got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"image"
+	"image/png"
+	"io"
+	"os"
+
+	"github.com/hajimehoshi/iro"
+	"github.com/hajimehoshi/iro/diagram"
+)
+
+// renderFlags is the flags of the commands rendering PNG images.
+type renderFlags struct {
+	output *string
+	width  *int
+	height *int
+	label  *string
+}
+
+func addRenderFlags(fs *flag.FlagSet, widthUsage string) *renderFlags {
+	return &renderFlags{
+		output: fs.String("o", "-", "output PNG file, or - for the standard output"),
+		width:  fs.Int("width", 0, widthUsage),
+		height: fs.Int("height", 0, "height of the image in pixels (0 for the default)"),
+		label:  fs.String("label", "none", "labels: none, hex, or oklch"),
+	}
+}
+
+// options returns the swatch options with the flags.
+func (r *renderFlags) options() (*diagram.SwatchOptions, error) {
+	if *r.width < 0 || *r.height < 0 {
+		return nil, fmt.Errorf("iro: the size must not be negative: %dx%d", *r.width, *r.height)
+	}
+	opts := &diagram.SwatchOptions{
+		Width:  *r.width,
+		Height: *r.height,
+	}
+	switch *r.label {
+	case "none":
+		opts.Label = diagram.LabelNone
+	case "hex":
+		opts.Label = diagram.LabelHex
+	case "oklch":
+		opts.Label = diagram.LabelOKLch
+	default:
+		return nil, fmt.Errorf("iro: unknown label: %q", *r.label)
+	}
+	return opts, nil
+}
+
+// write encodes the image as PNG to the output file.
+func (r *renderFlags) write(img image.Image, stdout io.Writer) (err error) {
+	w := stdout
+	if *r.output != "-" {
+		f, err := os.Create(*r.output)
+		if err != nil {
+			return fmt.Errorf("iro: %w", err)
+		}
+		defer func() {
+			if cerr := f.Close(); cerr != nil && err == nil {
+				err = fmt.Errorf("iro: %w", cerr)
+			}
+		}()
+		w = f
+	}
+	bw := bufio.NewWriter(w)
+	if err := png.Encode(bw, img); err != nil {
+		return fmt.Errorf("iro: %w", err)
+	}
+	if err := bw.Flush(); err != nil {
+		return fmt.Errorf("iro: %w", err)
+	}
+	return nil
+}
+
+// runSwatch runs the swatch command, which renders colors as a horizontal strip of swatches.
+func runSwatch(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := newFlagSet("swatch", "[color ...]", stderr)
+	r := addRenderFlags(fs, "width of each swatch in pixels (0 for the default)")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	opts, err := r.options()
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 2
+	}
+
+	cs, err := parseColors(fs.Args(), stdin)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+	if len(cs) == 0 {
+		fmt.Fprintln(stderr, "iro: no colors")
+		return 1
+	}
+	if err := r.write(diagram.Swatches(cs, opts), stdout); err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+	return 0
+}
+
+// runGradient runs the gradient command, which renders a gradient through colors as a horizontal strip.
+func runGradient(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := newFlagSet("gradient", "[color ...]", stderr)
+	space := fs.String("space", "oklab", "space to interpolate the colors in")
+	r := addRenderFlags(fs, "width of the image in pixels (0 for the default)")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	opts, err := r.options()
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 2
+	}
+	s, err := parseSpace(*space)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 2
+	}
+
+	cs, err := parseColors(fs.Args(), stdin)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+	if len(cs) == 0 {
+		fmt.Fprintln(stderr, "iro: no colors")
+		return 1
+	}
+	g := iro.NewGradient(cs...)
+	g.Space = s
+	if err := r.write(diagram.GradientStrip(g, opts), stdout); err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package main
+
+import (
+	"bytes"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRunRender(t *testing.T) {
+	out := filepath.Join(t.TempDir(), "gradient.png")
+	testCases := []struct {
+		name   string
+		args   []string
+		output string
+		width  int
+		height int
+		at     map[int]color.NRGBA
+	}{
+		{
+			name:   "Swatch",
+			args:   []string{"swatch", "-width", "10", "-height", "4", "#ff0000", "#0000ff"},
+			width:  20,
+			height: 4,
+			at: map[int]color.NRGBA{
+				0:  {R: 0xff, A: 0xff},
+				19: {B: 0xff, A: 0xff},
+			},
+		},
+		{
+			name:   "Gradient",
+			args:   []string{"gradient", "-o", out, "-width", "100", "-height", "2", "-space", "srgb", "#000000", "#ffffff"},
+			output: out,
+			width:  100,
+			height: 2,
+			at: map[int]color.NRGBA{
+				0:  {A: 0xff},
+				99: {R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			if code := run(tc.args, strings.NewReader(""), &stdout, &stderr); code != 0 {
+				t.Fatalf("exit code: got %d, want 0 (stderr: %q)", code, stderr.String())
+			}
+			data := stdout.Bytes()
+			if tc.output != "" {
+				if stdout.Len() != 0 {
+					t.Errorf("got %d bytes in the standard output, want none", stdout.Len())
+				}
+				var err error
+				if data, err = os.ReadFile(tc.output); err != nil {
+					t.Fatal(err)
+				}
+			}
+			img, err := png.Decode(bytes.NewReader(data))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := img.Bounds().Size(); got.X != tc.width || got.Y != tc.height {
+				t.Errorf("size: got %v, want %dx%d", got, tc.width, tc.height)
+			}
+			for x, want := range tc.at {
+				// Allow the rounding errors of sampling at the centers of the pixels.
+				got := color.NRGBAModel.Convert(img.At(x, 0)).(color.NRGBA)
+				if diff(got.R, want.R) > 3 || diff(got.G, want.G) > 3 || diff(got.B, want.B) > 3 || got.A != want.A {
+					t.Errorf("(%d, 0): got %v, want %v", x, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestRunRenderErrors(t *testing.T) {
+	testCases := []struct {
+		name string
+		args []string
+		code int
+	}{
+		{name: "NoColors", args: []string{"swatch"}, code: 1},
+		{name: "InvalidLabel", args: []string{"swatch", "-label", "rgb", "#ff0000"}, code: 2},
+		{name: "NegativeSize", args: []string{"gradient", "-width", "-1", "#ff0000"}, code: 2},
+		{name: "InvalidSpace", args: []string{"gradient", "-space", "cmyk", "#ff0000"}, code: 2},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			if code := run(tc.args, strings.NewReader(""), &stdout, &stderr); code != tc.code {
+				t.Errorf("exit code: got %d, want %d", code, tc.code)
+			}
+		})
+	}
+}
+
+func diff(a, b uint8) int {
+	if a > b {
+		return int(a - b)
+	}
+	return int(b - a)
+}
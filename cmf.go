@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"fmt"
+)
+
+// Observer represents a CIE standard colorimetric observer.
+type Observer int
+
+const (
+	// Observer2Degree represents the CIE 1931 2° standard observer, used for fields of view up to about 4°.
+	Observer2Degree Observer = iota
+
+	// Observer10Degree represents the CIE 1964 10° supplementary standard observer, used for larger fields of view.
+	Observer10Degree
+)
+
+// cmf returns the color matching functions of the observer at the wavelength in nanometers.
+func (o Observer) cmf(lambda float64) (x, y, z float64) {
+	switch o {
+	case Observer2Degree:
+		return cie1931CMF(lambda)
+	case Observer10Degree:
+		return cie1964CMF(lambda)
+	default:
+		panic(fmt.Sprintf("iro: invalid Observer: %d", o))
+	}
+}
+
+// cie1931CMF returns the CIE 1931 2° standard observer color matching functions at the wavelength in nanometers.
+// See [cmfAt] for the interpolation.
+func cie1931CMF(lambda float64) (x, y, z float64) {
+	return cmfAt(cie1931Table[:], lambda)
+}
+
+// cie1964CMF returns the CIE 1964 10° standard observer color matching functions at the wavelength in nanometers.
+// See [cmfAt] for the interpolation.
+func cie1964CMF(lambda float64) (x, y, z float64) {
+	return cmfAt(cie1964Table[:], lambda)
+}
+
+// cmfAt returns the color matching functions of the table at the wavelength in nanometers.
+// Between the rows, the values are interpolated linearly. Outside the table, the values are 0.
+func cmfAt(table [][3]float64, lambda float64) (x, y, z float64) {
+	t := (lambda - cmfStart) / cmfInterval
+	if t < 0 || t > float64(len(table)-1) {
+		return 0, 0, 0
+	}
+	i := int(t)
+	if i == len(table)-1 {
+		r := table[i]
+		return r[0], r[1], r[2]
+	}
+	f := t - float64(i)
+	r0, r1 := table[i], table[i+1]
+	return r0[0]*(1-f) + r1[0]*f, r0[1]*(1-f) + r1[1]*f, r0[2]*(1-f) + r1[2]*f
+}
+
+const (
+	// spectralLocusMin and spectralLocusMax are the range of wavelengths in nanometers for the spectral locus.
+	// Above 700 nm, the chromaticity of the locus is almost constant.
+	spectralLocusMin = 380
+	spectralLocusMax = 700
+)
+
+// SpectralLocus returns the chromaticities of the spectral locus
+// of the CIE 1931 2° standard observer from 380 nm to 700 nm, at 5 nm intervals.
+//
+// The locus is computed from the tabulated color matching functions of CIE 15.
+// The line between the first and the last chromaticities is the purple line.
+func SpectralLocus() []Chromaticity {
+	locus := make([]Chromaticity, 0, (spectralLocusMax-spectralLocusMin)/cmfInterval+1)
+	for l := spectralLocusMin; l <= spectralLocusMax; l += cmfInterval {
+		x, y, z := cie1931CMF(float64(l))
+		sum := x + y + z
+		locus = append(locus, Chromaticity{X: x / sum, Y: y / sum})
+	}
+	return locus
+}
@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestSpectralLocus(t *testing.T) {
+	locus := iro.SpectralLocus()
+	if got, want := len(locus), 65; got != want {
+		t.Fatalf("len(locus): got %d, want %d", got, want)
+	}
+
+	// Compare with the chromaticities of the CIE 1931 2° standard observer, including both ends of the purple line.
+	testCases := []struct {
+		lambda int
+		x, y   float64
+	}{
+		{lambda: 380, x: 0.17411, y: 0.00496},
+		{lambda: 450, x: 0.15664, y: 0.01771},
+		{lambda: 520, x: 0.07430, y: 0.83380},
+		{lambda: 580, x: 0.51249, y: 0.48659},
+		{lambda: 640, x: 0.71903, y: 0.28093},
+		{lambda: 700, x: 0.73469, y: 0.26531},
+	}
+	for _, tc := range testCases {
+		p := locus[(tc.lambda-380)/5]
+		if math.Abs(p.X-tc.x) > 1e-5 || math.Abs(p.Y-tc.y) > 1e-5 {
+			t.Errorf("%d nm: got (%f, %f), want (%f, %f)", tc.lambda, p.X, p.Y, tc.x, tc.y)
+		}
+	}
+}
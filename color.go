@@ -2,9 +2,17 @@
 // SPDX-FileCopyrightText: 2025 Hajime Hoshi
 
 // Package iro provides color conversion utilities between various color spaces.
+//
+// With the build tag irofast, some functions like the sRGB transfer functions are approximated for performance.
+// The maximum relative error of the approximations is 1e-7.
+//
+// The color rendering of light sources is evaluated by the CIE 13.3 color rendering indices like [GeneralColorRenderingIndex].
+// The fidelity index R_f and the gamut index R_g of IES TM-30 are not implemented,
+// as they require the spectral reflectances of its 99 color evaluation samples, which this package doesn't have.
 package iro
 
 import (
+	"fmt"
 	"image/color"
 	"math"
 )
@@ -36,6 +44,34 @@ func (c Color) WithAlpha(alpha float64) Color {
 	}
 }
 
+// ScaleLuminance returns a new Color with the XYZ coordinates multiplied by factor.
+// The chromaticity and the alpha value are kept.
+//
+// This package uses relative XYZ, where Y of the D65 white is 1.
+// For example, XYZ in cd/m² can be scaled with 1/Yn for the luminance Yn of the white.
+func (c Color) ScaleLuminance(factor float64) Color {
+	return Color{
+		x:     c.x * factor,
+		y:     c.y * factor,
+		z:     c.z * factor,
+		alpha: c.alpha,
+	}
+}
+
+// NormalizeToWhite returns a new Color scaled so that the luminance Y of white is 1,
+// e.g. to bring measured XYZ with an arbitrary scale into the relative convention of this package.
+// white is the measured white in the same scale as c. The alpha value of c is kept.
+//
+// NormalizeToWhite doesn't adapt the chromaticity of white. Use [ChromaticAdaptationMatrix] for that.
+//
+// NormalizeToWhite panics if the luminance of white is not positive.
+func (c Color) NormalizeToWhite(white Color) Color {
+	if !(white.y > 0) {
+		panic(fmt.Sprintf("iro: the luminance of the white must be positive but %f", white.y))
+	}
+	return c.ScaleLuminance(1 / white.y)
+}
+
 // ColorFromXYZ builds a Color from XYZ D65 coordinates and alpha in [0,1].
 func ColorFromXYZ(x, y, z, alpha float64) Color {
 	return Color{
@@ -61,23 +97,9 @@ func ColorFromSRGB(r, g, b, alpha float64) Color {
 func ColorFromSRGBColor(c color.Color) Color {
 	switch v := c.(type) {
 	case color.NRGBA:
-		// Use non-premultiplied alpha directly.
-		// This is not only for performance but also for semantics:
-		// RGB values are no longer precise after premultiplying alpha.
-		return ColorFromSRGB(
-			float64(v.R)/0xff,
-			float64(v.G)/0xff,
-			float64(v.B)/0xff,
-			float64(v.A)/0xff,
-		)
+		return ColorFromSRGBNRGBA(v)
 	case color.NRGBA64:
-		// Use non-premultiplied alpha directly in the same way as color.NRGBA.
-		return ColorFromSRGB(
-			float64(v.R)/0xffff,
-			float64(v.G)/0xffff,
-			float64(v.B)/0xffff,
-			float64(v.A)/0xffff,
-		)
+		return ColorFromSRGBNRGBA64(v)
 	case color.Alpha:
 		// This is just a performance optimization.
 		a := float64(v.A) / 0xff
@@ -130,12 +152,39 @@ func ColorFromSRGBColor(c color.Color) Color {
 	}
 }
 
+// ColorFromSRGBNRGBA converts an sRGB [color.NRGBA] to Color.
+// Unlike [ColorFromSRGBColor], ColorFromSRGBNRGBA doesn't allocate.
+func ColorFromSRGBNRGBA(c color.NRGBA) Color {
+	// Use non-premultiplied alpha directly.
+	// This is not only for performance but also for semantics:
+	// RGB values are no longer precise after premultiplying alpha.
+	return ColorFromSRGB(
+		float64(c.R)/0xff,
+		float64(c.G)/0xff,
+		float64(c.B)/0xff,
+		float64(c.A)/0xff,
+	)
+}
+
+// ColorFromSRGBNRGBA64 converts an sRGB [color.NRGBA64] to Color.
+// Unlike [ColorFromSRGBColor], ColorFromSRGBNRGBA64 doesn't allocate.
+func ColorFromSRGBNRGBA64(c color.NRGBA64) Color {
+	// Use non-premultiplied alpha directly in the same way as color.NRGBA.
+	return ColorFromSRGB(
+		float64(c.R)/0xffff,
+		float64(c.G)/0xffff,
+		float64(c.B)/0xffff,
+		float64(c.A)/0xffff,
+	)
+}
+
 // ColorFromLinearSRGB builds a Color from linear sRGB channels in [0,1] and alpha.
 func ColorFromLinearSRGB(r, g, b, alpha float64) Color {
+	x, y, z := MatrixLinearSRGBToXYZ.Apply(r, g, b)
 	return Color{
-		x:     r*506752/1228815 + g*87881/245763 + b*12673/70218,
-		y:     r*87098/409605 + g*175762/245763 + b*12673/175545,
-		z:     r*7918/409605 + g*87881/737289 + b*1001167/1053270,
+		x:     x,
+		y:     y,
+		z:     z,
 		alpha: alpha,
 	}
 }
@@ -171,12 +220,7 @@ func ColorFromLinearSRGBColor(c color.Color) Color {
 			float64(v.A)/0xff,
 		)
 	case color.NRGBA64:
-		return ColorFromLinearSRGB(
-			float64(v.R)/0xffff,
-			float64(v.G)/0xffff,
-			float64(v.B)/0xffff,
-			float64(v.A)/0xffff,
-		)
+		return ColorFromLinearSRGBNRGBA64(v)
 	case color.Alpha:
 		a := float64(v.A) / 0xff
 		return ColorFromLinearSRGB(
@@ -223,6 +267,17 @@ func ColorFromLinearSRGBColor(c color.Color) Color {
 	}
 }
 
+// ColorFromLinearSRGBNRGBA64 converts a linear sRGB [color.NRGBA64] to Color.
+// Unlike [ColorFromLinearSRGBColor], ColorFromLinearSRGBNRGBA64 doesn't allocate.
+func ColorFromLinearSRGBNRGBA64(c color.NRGBA64) Color {
+	return ColorFromLinearSRGB(
+		float64(c.R)/0xffff,
+		float64(c.G)/0xffff,
+		float64(c.B)/0xffff,
+		float64(c.A)/0xffff,
+	)
+}
+
 // ColorFromDisplayP3 builds a Color from nonlinear Display P3 channels in [0,1] and alpha.
 func ColorFromDisplayP3(r, g, b, alpha float64) Color {
 	r = degamma(r)
@@ -234,39 +289,48 @@ func ColorFromDisplayP3(r, g, b, alpha float64) Color {
 
 // ColorFromLinearDisplayP3 builds a Color from linear Display P3 channels in [0,1] and alpha.
 func ColorFromLinearDisplayP3(r, g, b, alpha float64) Color {
+	x, y, z := MatrixLinearDisplayP3ToXYZ.Apply(r, g, b)
 	return Color{
-		x:     r*608311/1250200 + g*189793/714400 + b*198249/1000160,
-		y:     r*35783/156275 + g*247089/357200 + b*198249/2500400,
-		z:     g*32229/714400 + b*5220557/5000800,
+		x:     x,
+		y:     y,
+		z:     z,
 		alpha: alpha,
 	}
 }
 
 // ColorFromOKLab builds a Color from OKLab components and alpha.
 func ColorFromOKLab(l, a, b, alpha float64) Color {
-	l_ := l + 0.3963377773761749*a + 0.2158037573099136*b
-	m_ := l - 0.1055613458156586*a + -0.0638541728258133*b
-	s_ := l - 0.0894841775298119*a - 1.2914855480194092*b
+	l_, m_, s_ := MatrixOKLabToOKLabLMS.Apply(l, a, b)
 
 	l_ = l_ * l_ * l_
 	m_ = m_ * m_ * m_
 	s_ = s_ * s_ * s_
 
+	x, y, z := MatrixOKLabLMSToXYZ.Apply(l_, m_, s_)
 	return Color{
-		x:     1.2268798758459243*l_ - 0.5578149944602171*m_ + 0.2813910456659647*s_,
-		y:     -0.0405757452148008*l_ + 1.1122868032803170*m_ - 0.0717110580655164*s_,
-		z:     -0.0763729366746601*l_ - 0.4214933324022432*m_ + 1.5869240198367816*s_,
+		x:     x,
+		y:     y,
+		z:     z,
 		alpha: alpha,
 	}
 }
 
 // ColorFromOKLch builds a Color from OKLCh components (h in radians) and alpha.
+// A NaN hue is treated as a missing hue, i.e. 0, as CSS does.
 func ColorFromOKLch(l, c, h, alpha float64) Color {
+	if math.IsNaN(h) {
+		h = 0
+	}
 	a := math.Cos(h) * c
 	b := math.Sin(h) * c
 	return ColorFromOKLab(l, a, b, alpha)
 }
 
+// ColorFromOKLchDeg builds a Color from OKLCh components (h in degrees) and alpha.
+func ColorFromOKLchDeg(l, c, h, alpha float64) Color {
+	return ColorFromOKLch(l, c, AngleUnitDegree.ToRadians(h), alpha)
+}
+
 // XYZ returns the XYZ D65 coordinates and alpha.
 func (c Color) XYZ() (x, y, z, a float64) {
 	return c.x, c.y, c.z, c.alpha
@@ -282,7 +346,24 @@ func (c Color) SRGB() (r, g, b, a float64) {
 }
 
 // SRGBColor converts Color to a nonlinear sRGB [color.Color].
+// The result is a [color.NRGBA64]. To avoid an allocation, use [Color.SRGBNRGBA64].
 func (c Color) SRGBColor() color.Color {
+	return c.SRGBNRGBA64()
+}
+
+// SRGBNRGBA converts Color to a nonlinear sRGB [color.NRGBA]. The values are clamped.
+func (c Color) SRGBNRGBA() color.NRGBA {
+	r, g, b, a := c.SRGB()
+	return color.NRGBA{
+		R: toUint8(r),
+		G: toUint8(g),
+		B: toUint8(b),
+		A: toUint8(a),
+	}
+}
+
+// SRGBNRGBA64 converts Color to a nonlinear sRGB [color.NRGBA64]. The values are clamped.
+func (c Color) SRGBNRGBA64() color.NRGBA64 {
 	r, g, b, a := c.SRGB()
 	return color.NRGBA64{
 		R: toUint16(r),
@@ -294,15 +375,19 @@ func (c Color) SRGBColor() color.Color {
 
 // LinearSRGB converts Color to linear sRGB channels and alpha.
 func (c Color) LinearSRGB() (r, g, b, a float64) {
-	r = c.x*12831/3959 + c.y*-329/214 + c.z*-1974/3959
-	g = c.x*-851781/878810 + c.y*1648619/878810 + c.z*36519/878810
-	b = c.x*705/12673 + c.y*-2585/12673 + c.z*705/667
+	r, g, b = MatrixXYZToLinearSRGB.Apply(c.x, c.y, c.z)
 	a = c.alpha
 	return
 }
 
 // LinearSRGBColor converts Color to a linear sRGB [color.Color].
+// The result is a [color.NRGBA64]. To avoid an allocation, use [Color.LinearSRGBNRGBA64].
 func (c Color) LinearSRGBColor() color.Color {
+	return c.LinearSRGBNRGBA64()
+}
+
+// LinearSRGBNRGBA64 converts Color to a linear sRGB [color.NRGBA64]. The values are clamped.
+func (c Color) LinearSRGBNRGBA64() color.NRGBA64 {
 	r, g, b, a := c.LinearSRGB()
 	return color.NRGBA64{
 		R: toUint16(r),
@@ -323,31 +408,26 @@ func (c Color) DisplayP3() (r, g, b, a float64) {
 
 // LinearDisplayP3 converts Color to linear Display P3 channels and alpha.
 func (c Color) LinearDisplayP3() (r, g, b, a float64) {
-	r = c.x*446124/178915 + c.y*-333277/357830 + c.z*-72051/178915
-	g = c.x*-14852/17905 + c.y*63121/35810 + c.z*423/17905
-	b = c.x*11844/330415 + c.y*-50337/660830 + c.z*316169/330415
+	r, g, b = MatrixXYZToLinearDisplayP3.Apply(c.x, c.y, c.z)
 	a = c.alpha
 	return
 }
 
 // OKLab converts Color to OKLab components and alpha.
 func (c Color) OKLab() (l, a, b, alpha float64) {
-	l_ := 0.8190224379967030*c.x + 0.3619062600528904*c.y + -0.1288737815209879*c.z
-	m_ := 0.0329836539323885*c.x + 0.9292868615863434*c.y + 0.0361446663506424*c.z
-	s_ := 0.0481771893596242*c.x + 0.2642395317527308*c.y + 0.6335478284694309*c.z
+	l_, m_, s_ := MatrixXYZToOKLabLMS.Apply(c.x, c.y, c.z)
 
-	l_ = math.Cbrt(l_)
-	m_ = math.Cbrt(m_)
-	s_ = math.Cbrt(s_)
+	l_ = cbrt(l_)
+	m_ = cbrt(m_)
+	s_ = cbrt(s_)
 
-	l = 0.2104542683093140*l_ + 0.7936177747023054*m_ + -0.0040720430116193*s_
-	a = 1.9779985324311684*l_ + -2.4285922420485799*m_ + 0.4505937096174110*s_
-	b = 0.0259040424655478*l_ + 0.7827717124575296*m_ + -0.8086757549230774*s_
+	l, a, b = MatrixOKLabLMSToOKLab.Apply(l_, m_, s_)
 	alpha = c.alpha
 	return
 }
 
 // OKLch converts Color to OKLCh components (h in radians) and alpha.
+// The hue of an achromatic color is meaningless. See [Color.HuePowerless].
 func (c Color) OKLch() (l, ch, h, alpha float64) {
 	l, a, b, alpha := c.OKLab()
 	ch = math.Hypot(a, b)
@@ -355,7 +435,17 @@ func (c Color) OKLch() (l, ch, h, alpha float64) {
 	return
 }
 
+// OKLchDeg converts Color to OKLCh components (h in degrees in [0, 360)) and alpha.
+func (c Color) OKLchDeg() (l, ch, h, alpha float64) {
+	l, ch, h, alpha = c.OKLch()
+	h = NormalizeHueDeg(AngleUnitDegree.FromRadians(h))
+	return
+}
+
 func degamma(x float64) float64 {
+	if fastMath {
+		return fastDegamma(x)
+	}
 	// https://www.w3.org/TR/css-color-4/#color-conversion-code
 	sign := math.Copysign(1, x)
 	abs := math.Abs(x)
@@ -366,13 +456,16 @@ func degamma(x float64) float64 {
 }
 
 func gamma(x float64) float64 {
+	if fastMath {
+		return fastGamma(x)
+	}
 	// https://www.w3.org/TR/css-color-4/#color-conversion-code
 	sign := math.Copysign(1, x)
 	abs := math.Abs(x)
 	if abs <= 0.0031308 {
 		return 12.92 * x
 	}
-	return sign*1.055*math.Pow(abs, 1/2.4) - 0.055
+	return sign * (1.055*math.Pow(abs, 1/2.4) - 0.055)
 }
 
 func toUint16(v float64) uint16 {
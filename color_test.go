@@ -18,6 +18,11 @@ func check(got, want float64) (float64, bool) {
 	return diff, diff <= tol
 }
 
+func checkTol(got, want float64) bool {
+	_, ok := check(got, want)
+	return ok
+}
+
 func TestXYZRoundTrip(t *testing.T) {
 	x0, y0, z0, a0 := 0.3, 0.4, 0.5, 0.6
 	c := iro.ColorFromXYZ(x0, y0, z0, a0)
@@ -56,6 +61,30 @@ func TestSRGBRoundTrip(t *testing.T) {
 	}
 }
 
+// TestSRGBNegative tests that the transfer functions are odd functions, so that negative out-of-gamut components round-trip.
+func TestSRGBNegative(t *testing.T) {
+	for _, x := range []float64{-0.5, -0.002, -1.5} {
+		c := iro.ColorFromSRGB(x, 0, 0, 1)
+		if got, _, _, _ := c.SRGB(); !checkTol(got, x) {
+			t.Errorf("SRGB(%f): got %f, want %f", x, got, x)
+		}
+		if got, _, _, _ := c.Convert(iro.SpaceSRGB, iro.WithFastMath()); !checkTol(got, x) {
+			t.Errorf("SRGB(%f) with fast math: got %f, want %f", x, got, x)
+		}
+		if lr, _, _, _ := c.LinearSRGB(); lr >= 0 {
+			t.Errorf("LinearSRGB(%f): got %f, want a negative value", x, lr)
+		}
+
+		p := iro.ColorFromDisplayP3(x, 0, 0, 1)
+		if got, _, _, _ := p.DisplayP3(); !checkTol(got, x) {
+			t.Errorf("DisplayP3(%f): got %f, want %f", x, got, x)
+		}
+		if got, _, _, _ := p.Convert(iro.SpaceDisplayP3, iro.WithFastMath()); !checkTol(got, x) {
+			t.Errorf("DisplayP3(%f) with fast math: got %f, want %f", x, got, x)
+		}
+	}
+}
+
 func TestDisplayP3RoundTrip(t *testing.T) {
 	r0, g0, b0, a0 := 0.1, 0.7, 0.3, 0.5
 	c := iro.ColorFromDisplayP3(r0, g0, b0, a0)
@@ -227,3 +256,106 @@ func TestSRGBAndOKLchRoundTrip(t *testing.T) {
 		})
 	}
 }
+
+func TestOKLchDeg(t *testing.T) {
+	// Blue has a negative hue in radians.
+	c := iro.ColorFromSRGB(0, 0, 1, 1)
+	l0, ch0, h0, _ := c.OKLch()
+	l1, ch1, h1, a1 := c.OKLchDeg()
+	if want := h0*180/math.Pi + 360; !checkTol(h1, want) {
+		t.Errorf("h: got %f, want %f", h1, want)
+	}
+	if !checkTol(l1, l0) || !checkTol(ch1, ch0) || !checkTol(a1, 1) {
+		t.Errorf("got (%f, %f, %f, %f), want (%f, %f, %f, 1)", l1, ch1, h1, a1, l0, ch0, h1)
+	}
+
+	r, g, b, _ := iro.ColorFromOKLchDeg(l1, ch1, h1, a1).SRGB()
+	if !checkTol(r, 0) || !checkTol(g, 0) || !checkTol(b, 1) {
+		t.Errorf("round trip: got (%f, %f, %f), want (0, 0, 1)", r, g, b)
+	}
+}
+
+func TestNRGBAConversions(t *testing.T) {
+	c := iro.ColorFromSRGB(0.2, 0.4, 0.6, 0.8)
+
+	if got, want := c.SRGBNRGBA(), (color.NRGBA{R: 51, G: 102, B: 153, A: 204}); got != want {
+		t.Errorf("SRGBNRGBA: got %v, want %v", got, want)
+	}
+	if got, want := c.SRGBNRGBA64(), c.SRGBColor(); got != want {
+		t.Errorf("SRGBNRGBA64: got %v, want %v", got, want)
+	}
+	if got, want := c.LinearSRGBNRGBA64(), c.LinearSRGBColor(); got != want {
+		t.Errorf("LinearSRGBNRGBA64: got %v, want %v", got, want)
+	}
+
+	// The values are clamped.
+	if got, want := iro.ColorFromSRGB(-0.5, 1.5, 0.6, 2).SRGBNRGBA(), (color.NRGBA{R: 0, G: 255, B: 153, A: 255}); got != want {
+		t.Errorf("SRGBNRGBA: got %v, want %v", got, want)
+	}
+
+	n := color.NRGBA{R: 1, G: 2, B: 3, A: 4}
+	if got, want := iro.ColorFromSRGBNRGBA(n), iro.ColorFromSRGBColor(n); got != want {
+		t.Errorf("ColorFromSRGBNRGBA: got %v, want %v", got, want)
+	}
+	n64 := color.NRGBA64{R: 0x1000, G: 0x2000, B: 0x3000, A: 0x4000}
+	if got, want := iro.ColorFromSRGBNRGBA64(n64), iro.ColorFromSRGBColor(n64); got != want {
+		t.Errorf("ColorFromSRGBNRGBA64: got %v, want %v", got, want)
+	}
+	if got, want := iro.ColorFromLinearSRGBNRGBA64(n64), iro.ColorFromLinearSRGBColor(n64); got != want {
+		t.Errorf("ColorFromLinearSRGBNRGBA64: got %v, want %v", got, want)
+	}
+	if got, want := iro.ColorFromSRGBNRGBA64(n64).SRGBNRGBA64(), n64; got != want {
+		t.Errorf("round trip: got %v, want %v", got, want)
+	}
+}
+
+func TestNRGBAConversionsAllocs(t *testing.T) {
+	c := iro.ColorFromSRGB(0.2, 0.4, 0.6, 0.8)
+	n := color.NRGBA{R: 1, G: 2, B: 3, A: 4}
+	n64 := color.NRGBA64{R: 0x1000, G: 0x2000, B: 0x3000, A: 0x4000}
+
+	testCases := []struct {
+		name string
+		f    func()
+	}{
+		{name: "SRGBNRGBA", f: func() { n = c.SRGBNRGBA() }},
+		{name: "SRGBNRGBA64", f: func() { n64 = c.SRGBNRGBA64() }},
+		{name: "LinearSRGBNRGBA64", f: func() { n64 = c.LinearSRGBNRGBA64() }},
+		{name: "ColorFromSRGBNRGBA", f: func() { c = iro.ColorFromSRGBNRGBA(n) }},
+		{name: "ColorFromSRGBNRGBA64", f: func() { c = iro.ColorFromSRGBNRGBA64(n64) }},
+		{name: "ColorFromLinearSRGBNRGBA64", f: func() { c = iro.ColorFromLinearSRGBNRGBA64(n64) }},
+	}
+	for _, tc := range testCases {
+		if got := testing.AllocsPerRun(100, tc.f); got != 0 {
+			t.Errorf("%s: allocations: got %f, want 0", tc.name, got)
+		}
+	}
+}
+
+func TestScaleLuminance(t *testing.T) {
+	c := iro.ColorFromXYZ(0.2, 0.3, 0.4, 0.5)
+	x, y, z, a := c.ScaleLuminance(2).XYZ()
+	if !checkTol(x, 0.4) || !checkTol(y, 0.6) || !checkTol(z, 0.8) || !checkTol(a, 0.5) {
+		t.Errorf("got (%f, %f, %f, %f), want (0.4, 0.6, 0.8, 0.5)", x, y, z, a)
+	}
+}
+
+func TestNormalizeToWhite(t *testing.T) {
+	// Measured in cd/m², where the white is 250 cd/m².
+	white := iro.ColorFromXYZ(237.6, 250, 272.2, 1)
+	c := iro.ColorFromXYZ(50, 40, 30, 1)
+	x, y, z, a := c.NormalizeToWhite(white).XYZ()
+	if !checkTol(x, 0.2) || !checkTol(y, 0.16) || !checkTol(z, 0.12) || !checkTol(a, 1) {
+		t.Errorf("got (%f, %f, %f, %f), want (0.2, 0.16, 0.12, 1)", x, y, z, a)
+	}
+	if got := white.NormalizeToWhite(white).Chromaticity(); got != white.Chromaticity() {
+		t.Errorf("chromaticity: got %v, want %v", got, white.Chromaticity())
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("NormalizeToWhite with a black white must panic")
+		}
+	}()
+	c.NormalizeToWhite(iro.ColorFromXYZ(0, 0, 0, 1))
+}
@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+// Package colorchecker provides the reference data of the 24-patch ColorChecker Classic chart
+// and the calibration of cameras with the chart.
+//
+// The reference values are the CIELAB values for the D50 illuminant and the CIE 1931 2° standard observer
+// published by X-Rite for the charts manufactured after November 2014.
+// Individual charts vary, so use the measurements of the chart for color-critical work.
+package colorchecker
+
+import (
+	"fmt"
+
+	"github.com/hajimehoshi/iro"
+)
+
+// Patch is a patch of the ColorChecker Classic chart.
+type Patch struct {
+	// Number is the number of the patch from 1 to 24, in the row-major order from the top-left patch "dark skin".
+	Number int
+
+	// Name is the name like "dark skin".
+	Name string
+
+	// L, A, and B are the reference CIELAB values for D50, where L is in [0, 100].
+	L, A, B float64
+}
+
+// String returns the number and the name like "1 (dark skin)".
+func (p Patch) String() string {
+	return fmt.Sprintf("%d (%s)", p.Number, p.Name)
+}
+
+// Color returns the reference color of the patch.
+// As CIELAB of this package is for D50, the color is the one adapted to D65 with the Bradford transform.
+func (p Patch) Color() iro.Color {
+	return iro.ColorFromLab(p.L, p.A, p.B, 1)
+}
+
+// XYY returns the reference chromaticity and the luminance Y in [0, 1] of the patch for D50.
+func (p Patch) XYY() (x, y, yy float64) {
+	xx, yy, z, _ := p.Color().XYZD50()
+	sum := xx + yy + z
+	return xx / sum, yy / sum, yy
+}
+
+// patches is the patches of the chart.
+var patches = []Patch{
+	{Number: 1, Name: "dark skin", L: 37.54, A: 14.37, B: 14.92},
+	{Number: 2, Name: "light skin", L: 64.66, A: 19.27, B: 17.50},
+	{Number: 3, Name: "blue sky", L: 49.32, A: -3.82, B: -22.54},
+	{Number: 4, Name: "foliage", L: 43.46, A: -12.74, B: 22.72},
+	{Number: 5, Name: "blue flower", L: 54.94, A: 9.61, B: -24.79},
+	{Number: 6, Name: "bluish green", L: 70.48, A: -32.26, B: -0.37},
+	{Number: 7, Name: "orange", L: 62.73, A: 35.83, B: 56.50},
+	{Number: 8, Name: "purplish blue", L: 39.43, A: 10.75, B: -45.17},
+	{Number: 9, Name: "moderate red", L: 50.57, A: 48.64, B: 16.67},
+	{Number: 10, Name: "purple", L: 30.10, A: 22.54, B: -20.87},
+	{Number: 11, Name: "yellow green", L: 71.77, A: -24.13, B: 58.19},
+	{Number: 12, Name: "orange yellow", L: 71.51, A: 18.24, B: 67.37},
+	{Number: 13, Name: "blue", L: 28.37, A: 15.42, B: -49.80},
+	{Number: 14, Name: "green", L: 54.38, A: -39.72, B: 32.27},
+	{Number: 15, Name: "red", L: 42.43, A: 51.05, B: 28.62},
+	{Number: 16, Name: "yellow", L: 81.80, A: 2.67, B: 80.41},
+	{Number: 17, Name: "magenta", L: 50.63, A: 51.28, B: -14.12},
+	{Number: 18, Name: "cyan", L: 49.57, A: -29.71, B: -28.32},
+	{Number: 19, Name: "white 9.5", L: 95.19, A: -1.03, B: 2.93},
+	{Number: 20, Name: "neutral 8", L: 81.29, A: -0.57, B: 0.44},
+	{Number: 21, Name: "neutral 6.5", L: 66.89, A: -0.75, B: -0.06},
+	{Number: 22, Name: "neutral 5", L: 50.76, A: -0.13, B: 0.14},
+	{Number: 23, Name: "neutral 3.5", L: 35.63, A: -0.46, B: -0.48},
+	{Number: 24, Name: "black 2", L: 20.64, A: 0.07, B: -0.46},
+}
+
+// Patches returns the 24 patches of the chart in the order of the numbers.
+func Patches() []Patch {
+	return append([]Patch(nil), patches...)
+}
+
+// References returns the reference colors of the 24 patches in the order of the numbers. See [Patch.Color].
+func References() []iro.Color {
+	cs := make([]iro.Color, len(patches))
+	for i, p := range patches {
+		cs[i] = p.Color()
+	}
+	return cs
+}
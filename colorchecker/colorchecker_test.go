@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package colorchecker_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/iro/colorchecker"
+)
+
+func TestPatches(t *testing.T) {
+	ps := colorchecker.Patches()
+	if got, want := len(ps), 24; got != want {
+		t.Fatalf("len: got %d, want %d", got, want)
+	}
+	for i, p := range ps {
+		if p.Number != i+1 {
+			t.Errorf("patch %d: got number %d", i, p.Number)
+		}
+		l, a, b, _ := p.Color().Lab()
+		if math.Abs(l-p.L) > 1e-6 || math.Abs(a-p.A) > 1e-6 || math.Abs(b-p.B) > 1e-6 {
+			t.Errorf("%s: Lab: got (%f, %f, %f), want (%f, %f, %f)", p, l, a, b, p.L, p.A, p.B)
+		}
+	}
+	if got, want := ps[18].String(), "19 (white 9.5)"; got != want {
+		t.Errorf("String: got %q, want %q", got, want)
+	}
+
+	// The returned slice is a copy.
+	ps[0].Name = ""
+	if colorchecker.Patches()[0].Name == "" {
+		t.Errorf("Patches must return a copy")
+	}
+}
+
+func TestPatchXYY(t *testing.T) {
+	// The neutral patches have the chromaticity near D50.
+	for _, p := range colorchecker.Patches()[19:] {
+		x, y, _ := p.XYY()
+		if math.Abs(x-0.3457) > 0.005 || math.Abs(y-0.3585) > 0.005 {
+			t.Errorf("%s: got (%f, %f), want near D50", p, x, y)
+		}
+	}
+	// The Y of the neutral 5 is about 19%.
+	if _, _, yy := colorchecker.Patches()[21].XYY(); math.Abs(yy-0.19) > 0.005 {
+		t.Errorf("neutral 5: got Y %f, want about 0.19", yy)
+	}
+}
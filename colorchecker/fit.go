@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package colorchecker
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/hajimehoshi/iro"
+)
+
+// Calibration is a correction matrix of a camera fitted to reference colors.
+type Calibration struct {
+	// Matrix is the matrix from the linear RGB of the camera to XYZ D65.
+	Matrix iro.Matrix3
+
+	// DeltaE is the residual CIEDE2000 of each patch between the corrected color and the reference color.
+	DeltaE []float64
+
+	// MeanDeltaE is the mean of DeltaE.
+	MeanDeltaE float64
+
+	// MaxDeltaE is the maximum of DeltaE.
+	MaxDeltaE float64
+}
+
+// Apply returns the corrected color of the linear RGB of the camera.
+func (c *Calibration) Apply(r, g, b float64) iro.Color {
+	x, y, z := c.Matrix.Apply(r, g, b)
+	return iro.ColorFromXYZ(x, y, z, 1)
+}
+
+// Fit fits a 3×3 correction matrix from the measured linear RGB of the camera to the reference colors,
+// minimizing the squared errors in XYZ by the least squares.
+// measured[i] is the measured color of the patch of reference[i], e.g. the averaged pixels of the patch in a raw image.
+// For the chart, use [References] as reference.
+//
+// Fit returns an error if the lengths don't match, fewer than 3 patches are given, or the measured colors are degenerate.
+func Fit(measured [][3]float64, reference []iro.Color) (*Calibration, error) {
+	if len(measured) != len(reference) {
+		return nil, fmt.Errorf("colorchecker: the numbers of the measured and the reference colors don't match: %d and %d", len(measured), len(reference))
+	}
+	if len(measured) < 3 {
+		return nil, fmt.Errorf("colorchecker: at least 3 patches are required but %d", len(measured))
+	}
+
+	// Solve the normal equations (AᵀA)·mᵢ = Aᵀ·bᵢ for each row mᵢ of the matrix,
+	// where A is the measured colors and bᵢ is the i-th components of the reference XYZ.
+	var ata iro.Matrix3
+	var atb [3][3]float64
+	for i, m := range measured {
+		x, y, z, _ := reference[i].XYZ()
+		ref := [...]float64{x, y, z}
+		for j := 0; j < 3; j++ {
+			for k := 0; k < 3; k++ {
+				ata[j][k] += m[j] * m[k]
+				atb[k][j] += m[j] * ref[k]
+			}
+		}
+	}
+	// Compare the determinant with the scale of the matrix, as the scale of the measured values is arbitrary.
+	var scale float64
+	for _, row := range ata {
+		for _, v := range row {
+			scale = max(scale, math.Abs(v))
+		}
+	}
+	if d := det(&ata); !(math.Abs(d) > 1e-12*scale*scale*scale) {
+		return nil, fmt.Errorf("colorchecker: the measured colors are degenerate")
+	}
+	inv := ata.Inverse()
+
+	c := &Calibration{}
+	for i := 0; i < 3; i++ {
+		c.Matrix[i][0], c.Matrix[i][1], c.Matrix[i][2] = inv.Apply(atb[i][0], atb[i][1], atb[i][2])
+	}
+	c.DeltaE = make([]float64, len(measured))
+	for i, m := range measured {
+		d := iro.DeltaE2000(c.Apply(m[0], m[1], m[2]), reference[i])
+		c.DeltaE[i] = d
+		c.MeanDeltaE += d
+		c.MaxDeltaE = max(c.MaxDeltaE, d)
+	}
+	c.MeanDeltaE /= float64(len(measured))
+	return c, nil
+}
+
+func det(m *iro.Matrix3) float64 {
+	return m[0][0]*(m[1][1]*m[2][2]-m[1][2]*m[2][1]) -
+		m[0][1]*(m[1][0]*m[2][2]-m[1][2]*m[2][0]) +
+		m[0][2]*(m[1][0]*m[2][1]-m[1][1]*m[2][0])
+}
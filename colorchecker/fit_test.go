@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package colorchecker_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+	"github.com/hajimehoshi/iro/colorchecker"
+)
+
+func TestFit(t *testing.T) {
+	// A camera whose RGB is a linear transform of XYZ is corrected exactly.
+	camera := iro.Matrix3{
+		{0.9, 0.3, -0.1},
+		{-0.4, 1.3, 0.1},
+		{0.05, -0.2, 1.1},
+	}
+	refs := colorchecker.References()
+	measured := make([][3]float64, len(refs))
+	for i, c := range refs {
+		x, y, z, _ := c.XYZ()
+		r, g, b := camera.Apply(x, y, z)
+		measured[i] = [3]float64{r, g, b}
+	}
+	cal, err := colorchecker.Fit(measured, refs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := camera.Inverse()
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			if math.Abs(cal.Matrix[i][j]-want[i][j]) > 1e-9 {
+				t.Errorf("Matrix[%d][%d]: got %f, want %f", i, j, cal.Matrix[i][j], want[i][j])
+			}
+		}
+	}
+	if cal.MaxDeltaE > 1e-6 || cal.MeanDeltaE > 1e-6 || len(cal.DeltaE) != len(refs) {
+		t.Errorf("residual: got mean %f, max %f", cal.MeanDeltaE, cal.MaxDeltaE)
+	}
+
+	// Noise makes residuals.
+	measured[0][0] *= 1.2
+	cal, err = colorchecker.Fit(measured, refs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cal.MaxDeltaE <= 0 || cal.MaxDeltaE != cal.DeltaE[0] {
+		t.Errorf("residual: got max %f, want the one of the first patch %f", cal.MaxDeltaE, cal.DeltaE[0])
+	}
+}
+
+func TestFitErrors(t *testing.T) {
+	refs := colorchecker.References()
+	if _, err := colorchecker.Fit(make([][3]float64, 3), refs); err == nil {
+		t.Errorf("mismatched lengths: got nil error")
+	}
+	if _, err := colorchecker.Fit([][3]float64{{1, 0, 0}, {0, 1, 0}}, refs[:2]); err == nil {
+		t.Errorf("too few patches: got nil error")
+	}
+	gray := make([][3]float64, len(refs))
+	for i := range gray {
+		gray[i] = [3]float64{0.5, 0.5, 0.5}
+	}
+	if _, err := colorchecker.Fit(gray, refs); err == nil {
+		t.Errorf("degenerate: got nil error")
+	}
+}
@@ -0,0 +1,246 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package colorchecker
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/hajimehoshi/iro"
+)
+
+// Model is a model of a color correction from the linear RGB of a camera to XYZ.
+type Model int
+
+const (
+	// ModelLinear represents the 3×3 matrix of r, g, and b, same as [Fit].
+	ModelLinear Model = iota
+
+	// ModelPolynomial2 represents the second-order polynomial of 9 terms: r, g, b, rg, gb, rb, r², g², and b².
+	ModelPolynomial2
+
+	// ModelPolynomial3 represents the third-order polynomial of 19 terms,
+	// the terms of ModelPolynomial2 and the third-order terms of r, g, and b.
+	ModelPolynomial3
+
+	// ModelRootPolynomial2 represents the second-order root-polynomial of 6 terms: r, g, b, √(rg), √(gb), and √(rb).
+	ModelRootPolynomial2
+
+	// ModelRootPolynomial3 represents the third-order root-polynomial of 13 terms,
+	// the terms of ModelRootPolynomial2 and the cube roots of the third-order terms except for r³, g³, and b³.
+	ModelRootPolynomial3
+)
+
+// String returns the name of the model.
+func (m Model) String() string {
+	switch m {
+	case ModelLinear:
+		return "linear"
+	case ModelPolynomial2:
+		return "polynomial 2"
+	case ModelPolynomial3:
+		return "polynomial 3"
+	case ModelRootPolynomial2:
+		return "root-polynomial 2"
+	case ModelRootPolynomial3:
+		return "root-polynomial 3"
+	default:
+		return fmt.Sprintf("Model(%d)", m)
+	}
+}
+
+// Terms returns the number of the terms of the model.
+func (m Model) Terms() int {
+	return len(m.appendTerms(nil, 0, 0, 0))
+}
+
+// appendTerms appends the terms of the model for the linear RGB to dst.
+//
+// The root-polynomial terms scale linearly with the intensity of the RGB, so the correction is independent of the exposure.
+// See Finlayson, Mackiewicz, and Hurlbert, "Color Correction Using Root-Polynomial Regression" (2015).
+func (m Model) appendTerms(dst []float64, r, g, b float64) []float64 {
+	dst = append(dst, r, g, b)
+	switch m {
+	case ModelLinear:
+	case ModelPolynomial2, ModelPolynomial3:
+		dst = append(dst, r*g, g*b, r*b, r*r, g*g, b*b)
+		if m == ModelPolynomial3 {
+			dst = append(dst,
+				r*r*r, g*g*g, b*b*b,
+				r*g*g, r*b*b, g*r*r, g*b*b, b*r*r, b*g*g,
+				r*g*b)
+		}
+	case ModelRootPolynomial2, ModelRootPolynomial3:
+		dst = append(dst, math.Sqrt(max(r*g, 0)), math.Sqrt(max(g*b, 0)), math.Sqrt(max(r*b, 0)))
+		if m == ModelRootPolynomial3 {
+			dst = append(dst,
+				math.Cbrt(r*g*g), math.Cbrt(r*b*b), math.Cbrt(g*r*r), math.Cbrt(g*b*b), math.Cbrt(b*r*r), math.Cbrt(b*g*g),
+				math.Cbrt(r*g*b))
+		}
+	default:
+		panic(fmt.Sprintf("colorchecker: invalid Model: %d", m))
+	}
+	return dst
+}
+
+// PolynomialCalibration is a color correction of a camera fitted to reference colors with a model.
+type PolynomialCalibration struct {
+	// Model is the model of the correction.
+	Model Model
+
+	// Coefficients is the coefficients of the terms of the model for X, Y, and Z of D65.
+	Coefficients [3][]float64
+
+	// DeltaE is the residual CIEDE2000 of each patch between the corrected color and the reference color.
+	DeltaE []float64
+
+	// MeanDeltaE is the mean of DeltaE.
+	MeanDeltaE float64
+
+	// MaxDeltaE is the maximum of DeltaE.
+	MaxDeltaE float64
+
+	// CrossValidatedDeltaE is the CIEDE2000 of each patch corrected by the fit without the patch (leave-one-out cross-validation).
+	// Unlike DeltaE, CrossValidatedDeltaE is not reduced by overfitting, so it estimates the errors for colors other than the patches.
+	CrossValidatedDeltaE []float64
+
+	// CrossValidatedMeanDeltaE is the mean of CrossValidatedDeltaE.
+	CrossValidatedMeanDeltaE float64
+
+	// CrossValidatedMaxDeltaE is the maximum of CrossValidatedDeltaE.
+	CrossValidatedMaxDeltaE float64
+}
+
+// Apply returns the corrected color of the linear RGB of the camera.
+func (c *PolynomialCalibration) Apply(r, g, b float64) iro.Color {
+	x, y, z := applyCoefficients(&c.Coefficients, c.Model.appendTerms(nil, r, g, b))
+	return iro.ColorFromXYZ(x, y, z, 1)
+}
+
+func applyCoefficients(coeffs *[3][]float64, terms []float64) (x, y, z float64) {
+	var v [3]float64
+	for i, cs := range coeffs {
+		for j, t := range terms {
+			v[i] += cs[j] * t
+		}
+	}
+	return v[0], v[1], v[2]
+}
+
+// FitPolynomial fits a color correction with the model from the measured linear RGB of the camera to the reference colors,
+// minimizing the squared errors in XYZ by the least squares. See [Fit] for the arguments.
+//
+// FitPolynomial also reports the errors by the leave-one-out cross-validation,
+// so more patches than the terms of the model (see [Model.Terms]) are required.
+// Compare the cross-validated errors among models to choose one, as higher-order models tend to overfit.
+//
+// FitPolynomial returns an error if the lengths don't match, the patches are too few, or the measured colors are degenerate.
+func FitPolynomial(model Model, measured [][3]float64, reference []iro.Color) (*PolynomialCalibration, error) {
+	if len(measured) != len(reference) {
+		return nil, fmt.Errorf("colorchecker: the numbers of the measured and the reference colors don't match: %d and %d", len(measured), len(reference))
+	}
+	n := model.Terms()
+	if len(measured) <= n {
+		return nil, fmt.Errorf("colorchecker: more than %d patches are required for the model %s but %d", n, model, len(measured))
+	}
+
+	terms := make([][]float64, len(measured))
+	refs := make([][3]float64, len(reference))
+	for i, m := range measured {
+		terms[i] = model.appendTerms(nil, m[0], m[1], m[2])
+		x, y, z, _ := reference[i].XYZ()
+		refs[i] = [3]float64{x, y, z}
+	}
+
+	coeffs, err := leastSquares(terms, refs, -1)
+	if err != nil {
+		return nil, err
+	}
+	c := &PolynomialCalibration{
+		Model:                model,
+		Coefficients:         coeffs,
+		DeltaE:               make([]float64, len(measured)),
+		CrossValidatedDeltaE: make([]float64, len(measured)),
+	}
+	for i := range measured {
+		x, y, z := applyCoefficients(&coeffs, terms[i])
+		d := iro.DeltaE2000(iro.ColorFromXYZ(x, y, z, 1), reference[i])
+		c.DeltaE[i] = d
+		c.MeanDeltaE += d
+		c.MaxDeltaE = max(c.MaxDeltaE, d)
+
+		cs, err := leastSquares(terms, refs, i)
+		if err != nil {
+			return nil, fmt.Errorf("colorchecker: cross-validation without the patch %d: %w", i, err)
+		}
+		x, y, z = applyCoefficients(&cs, terms[i])
+		d = iro.DeltaE2000(iro.ColorFromXYZ(x, y, z, 1), reference[i])
+		c.CrossValidatedDeltaE[i] = d
+		c.CrossValidatedMeanDeltaE += d
+		c.CrossValidatedMaxDeltaE = max(c.CrossValidatedMaxDeltaE, d)
+	}
+	c.MeanDeltaE /= float64(len(measured))
+	c.CrossValidatedMeanDeltaE /= float64(len(measured))
+	return c, nil
+}
+
+// leastSquares solves the linear least squares of the terms to each of the three components of refs,
+// skipping the sample at the index skip. If skip is negative, all the samples are used.
+func leastSquares(terms [][]float64, refs [][3]float64, skip int) ([3][]float64, error) {
+	n := len(terms[0])
+	// a is the augmented matrix of the normal equations, AᵀA | Aᵀb for the three components.
+	a := make([][]float64, n)
+	for i := range a {
+		a[i] = make([]float64, n+3)
+	}
+	for s, ts := range terms {
+		if s == skip {
+			continue
+		}
+		for i, ti := range ts {
+			for j, tj := range ts {
+				a[i][j] += ti * tj
+			}
+			for k := 0; k < 3; k++ {
+				a[i][n+k] += ti * refs[s][k]
+			}
+		}
+	}
+
+	// Solve by the Gaussian elimination with partial pivoting.
+	var scale float64
+	for i := 0; i < n; i++ {
+		scale = max(scale, math.Abs(a[i][i]))
+	}
+	for col := 0; col < n; col++ {
+		p := col
+		for i := col + 1; i < n; i++ {
+			if math.Abs(a[i][col]) > math.Abs(a[p][col]) {
+				p = i
+			}
+		}
+		if !(math.Abs(a[p][col]) > 1e-12*scale) {
+			return [3][]float64{}, fmt.Errorf("colorchecker: the measured colors are degenerate")
+		}
+		a[col], a[p] = a[p], a[col]
+		for i := 0; i < n; i++ {
+			if i == col {
+				continue
+			}
+			f := a[i][col] / a[col][col]
+			for j := col; j < n+3; j++ {
+				a[i][j] -= f * a[col][j]
+			}
+		}
+	}
+
+	var coeffs [3][]float64
+	for k := range coeffs {
+		coeffs[k] = make([]float64, n)
+		for i := 0; i < n; i++ {
+			coeffs[k][i] = a[i][n+k] / a[i][i]
+		}
+	}
+	return coeffs, nil
+}
@@ -0,0 +1,120 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package colorchecker_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+	"github.com/hajimehoshi/iro/colorchecker"
+)
+
+func TestModelTerms(t *testing.T) {
+	testCases := []struct {
+		model colorchecker.Model
+		want  int
+	}{
+		{model: colorchecker.ModelLinear, want: 3},
+		{model: colorchecker.ModelPolynomial2, want: 9},
+		{model: colorchecker.ModelPolynomial3, want: 19},
+		{model: colorchecker.ModelRootPolynomial2, want: 6},
+		{model: colorchecker.ModelRootPolynomial3, want: 13},
+	}
+	for _, tc := range testCases {
+		if got := tc.model.Terms(); got != tc.want {
+			t.Errorf("%s: got %d, want %d", tc.model, got, tc.want)
+		}
+	}
+}
+
+// nonlinearCamera returns the measured colors of the references by a camera with a nonlinear response.
+func nonlinearCamera(refs []iro.Color) [][3]float64 {
+	m := iro.Matrix3{
+		{0.9, 0.3, -0.1},
+		{-0.4, 1.3, 0.1},
+		{0.05, -0.2, 1.1},
+	}
+	measured := make([][3]float64, len(refs))
+	for i, c := range refs {
+		x, y, z, _ := c.XYZ()
+		r, g, b := m.Apply(x, y, z)
+		// Mix the channels nonlinearly.
+		measured[i] = [3]float64{r + 0.1*math.Sqrt(max(r*g, 0)), g, b + 0.1*math.Sqrt(max(g*b, 0))}
+	}
+	return measured
+}
+
+func TestFitPolynomial(t *testing.T) {
+	refs := colorchecker.References()
+	measured := nonlinearCamera(refs)
+
+	linear, err := colorchecker.FitPolynomial(colorchecker.ModelLinear, measured, refs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	root, err := colorchecker.FitPolynomial(colorchecker.ModelRootPolynomial2, measured, refs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The root-polynomial model captures the response better than the linear model.
+	if root.MeanDeltaE >= linear.MeanDeltaE {
+		t.Errorf("root-polynomial: got mean %f, want less than the linear %f", root.MeanDeltaE, linear.MeanDeltaE)
+	}
+	if root.CrossValidatedMeanDeltaE >= linear.CrossValidatedMeanDeltaE {
+		t.Errorf("root-polynomial: got cross-validated mean %f, want less than the linear %f", root.CrossValidatedMeanDeltaE, linear.CrossValidatedMeanDeltaE)
+	}
+
+	// The cross-validated errors are not less than the fitting errors.
+	for _, m := range []colorchecker.Model{colorchecker.ModelPolynomial2, colorchecker.ModelPolynomial3, colorchecker.ModelRootPolynomial3} {
+		c, err := colorchecker.FitPolynomial(m, measured, refs)
+		if err != nil {
+			t.Fatalf("%s: %v", m, err)
+		}
+		if c.CrossValidatedMeanDeltaE < c.MeanDeltaE {
+			t.Errorf("%s: got cross-validated mean %f, want at least %f", m, c.CrossValidatedMeanDeltaE, c.MeanDeltaE)
+		}
+		if c.CrossValidatedMaxDeltaE < c.CrossValidatedDeltaE[0] {
+			t.Errorf("%s: got cross-validated max %f, less than an element %f", m, c.CrossValidatedMaxDeltaE, c.CrossValidatedDeltaE[0])
+		}
+	}
+
+	// Apply is consistent with the residuals.
+	got := root.Apply(measured[5][0], measured[5][1], measured[5][2])
+	if d := iro.DeltaE2000(got, refs[5]); math.Abs(d-root.DeltaE[5]) > 1e-9 {
+		t.Errorf("Apply: got ΔE %f, want %f", d, root.DeltaE[5])
+	}
+}
+
+func TestFitPolynomialLinear(t *testing.T) {
+	// The linear model is the same as Fit.
+	refs := colorchecker.References()
+	measured := nonlinearCamera(refs)
+	c0, err := colorchecker.Fit(measured, refs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c1, err := colorchecker.FitPolynomial(colorchecker.ModelLinear, measured, refs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			if math.Abs(c0.Matrix[i][j]-c1.Coefficients[i][j]) > 1e-9 {
+				t.Errorf("[%d][%d]: got %f, want %f", i, j, c1.Coefficients[i][j], c0.Matrix[i][j])
+			}
+		}
+	}
+}
+
+func TestFitPolynomialErrors(t *testing.T) {
+	refs := colorchecker.References()
+	measured := nonlinearCamera(refs)
+	if _, err := colorchecker.FitPolynomial(colorchecker.ModelPolynomial3, measured[:19], refs[:19]); err == nil {
+		t.Errorf("too few patches: got nil error")
+	}
+	if _, err := colorchecker.FitPolynomial(colorchecker.ModelLinear, measured[:3], refs); err == nil {
+		t.Errorf("mismatched lengths: got nil error")
+	}
+}
@@ -0,0 +1,327 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+// Package colorname provides a search of color names over the named colors of CSS and X11,
+// the traditional Japanese colors of [github.com/hajimehoshi/iro/wairo],
+// and the colors of RAL Classic of [github.com/hajimehoshi/iro/ral], for example for autocompletion in color pickers.
+package colorname
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hajimehoshi/iro"
+	"github.com/hajimehoshi/iro/ral"
+	"github.com/hajimehoshi/iro/wairo"
+)
+
+// Source is a table of color names.
+type Source int
+
+const (
+	// SourceCSS is the named colors of CSS Color Module Level 4.
+	SourceCSS Source = iota
+
+	// SourceX11 is the colors of the traditional X11 rgb.txt without the numbered variants like "red1".
+	// Some colors like gray and green have different values from the CSS ones.
+	SourceX11
+
+	// SourceWairo is the traditional Japanese colors of [github.com/hajimehoshi/iro/wairo].
+	SourceWairo
+
+	// SourceRAL is the colors of RAL Classic of [github.com/hajimehoshi/iro/ral], which are approximations for screen display.
+	SourceRAL
+)
+
+// String returns the name of s.
+func (s Source) String() string {
+	switch s {
+	case SourceCSS:
+		return "CSS"
+	case SourceX11:
+		return "X11"
+	case SourceWairo:
+		return "wairo"
+	case SourceRAL:
+		return "RAL"
+	default:
+		return fmt.Sprintf("Source(%d)", int(s))
+	}
+}
+
+// Entry is a named color.
+type Entry struct {
+	// Name is the primary name, like "cornflowerblue", "瑠璃", or "Green beige".
+	Name string
+
+	// Aliases is the other names of the color, like "るり" and "ruri", or "RAL 6021".
+	Aliases []string
+
+	// Source is the table of the color.
+	Source Source
+
+	// Color is the color.
+	Color iro.Color
+}
+
+// tableEntry is an entry of the table of X11.
+type tableEntry struct {
+	name string
+	rgb  uint32
+}
+
+func colorFromRGB(rgb uint32) iro.Color {
+	return iro.ColorFromSRGB(
+		float64(rgb>>16)/0xff,
+		float64((rgb>>8)&0xff)/0xff,
+		float64(rgb&0xff)/0xff,
+		1)
+}
+
+// entries is all the entries in the order of the sources.
+var entries = func() []Entry {
+	var es []Entry
+	x11 := make([]Entry, 0, len(x11Extras))
+	for _, e := range x11Extras {
+		x11 = append(x11, Entry{Name: e.name, Source: SourceX11, Color: colorFromRGB(e.rgb)})
+	}
+
+	// The CSS named colors are shared with [iro.ParseCSS].
+	for _, name := range iro.CSSColorNames() {
+		c, _ := iro.CSSNamedColor(name)
+		es = append(es, Entry{Name: name, Source: SourceCSS, Color: c})
+
+		if cssOnlyNames[name] {
+			continue
+		}
+		if rgb, ok := x11Overrides[name]; ok {
+			c = colorFromRGB(rgb)
+		}
+		x11 = append(x11, Entry{Name: name, Source: SourceX11, Color: c})
+	}
+	sort.Slice(x11, func(i, j int) bool {
+		return x11[i].Name < x11[j].Name
+	})
+	es = append(es, x11...)
+
+	for _, c := range wairo.Colors() {
+		es = append(es, Entry{Name: c.Kanji, Aliases: []string{c.Kana, c.Romaji}, Source: SourceWairo, Color: c.Color})
+	}
+	for _, c := range ral.Colors() {
+		es = append(es, Entry{Name: c.Name, Aliases: []string{c.String()}, Source: SourceRAL, Color: c.Color})
+	}
+	return es
+}()
+
+// Entries returns all the named colors, ordered by the sources and then by the orders of the tables.
+func Entries() []Entry {
+	es := make([]Entry, len(entries))
+	for i, e := range entries {
+		e.Aliases = slices.Clone(e.Aliases)
+		es[i] = e
+	}
+	return es
+}
+
+// SearchOptions represents options for [Search].
+type SearchOptions struct {
+	// Sources is the sources to search. The default nil means all the sources.
+	Sources []Source
+
+	// Limit is the maximum number of the results.
+	// The default 0 means 10. If Limit is negative, all the matches are returned.
+	Limit int
+}
+
+func (o *SearchOptions) includes(s Source) bool {
+	if o == nil || o.Sources == nil {
+		return true
+	}
+	return slices.Contains(o.Sources, s)
+}
+
+func (o *SearchOptions) limit() int {
+	if o == nil || o.Limit == 0 {
+		return 10
+	}
+	return o.Limit
+}
+
+// Match is a result of [Search].
+type Match struct {
+	Entry
+
+	// Score is how well the query matches the names in (0, 1]. 1 means an exact match.
+	Score float64
+}
+
+// Search returns the named colors matching the query, ranked from the best.
+//
+// The names are compared case-insensitively, ignoring spaces, hyphens, and underscores,
+// so "Light Blue" matches "lightblue" exactly.
+// The matches are ranked from exact matches, prefix matches, substring matches, matches with a few typos,
+// to fuzzy matches, where the characters of the query appear in the name in order.
+// Among the matches with the same score, shorter names are ranked higher, and then the order of the sources.
+// If the same name with the same color is in multiple sources, only the first source is included.
+//
+// opts can be nil. Search returns nil if the query is empty.
+func Search(query string, opts *SearchOptions) []Match {
+	q := []rune(normalizeName(query))
+	if len(q) == 0 {
+		return nil
+	}
+
+	type seenKey struct {
+		name  string
+		color iro.Color
+	}
+	seen := map[seenKey]bool{}
+	var ms []Match
+	for _, e := range entries {
+		if !opts.includes(e.Source) {
+			continue
+		}
+		var score float64
+		for i, n := range append([]string{e.Name}, e.Aliases...) {
+			s := matchScore(q, []rune(normalizeName(n)))
+			// Prefer the primary name slightly.
+			if i > 0 {
+				s *= 0.99
+			}
+			score = max(score, s)
+		}
+		if score == 0 {
+			continue
+		}
+		k := seenKey{name: normalizeName(e.Name), color: e.Color}
+		if seen[k] {
+			continue
+		}
+		seen[k] = true
+		ms = append(ms, Match{Entry: e, Score: score})
+	}
+
+	sort.SliceStable(ms, func(i, j int) bool {
+		if ms[i].Score != ms[j].Score {
+			return ms[i].Score > ms[j].Score
+		}
+		return utf8.RuneCountInString(ms[i].Name) < utf8.RuneCountInString(ms[j].Name)
+	})
+	if l := opts.limit(); l >= 0 && len(ms) > l {
+		ms = ms[:l]
+	}
+	return ms
+}
+
+// normalizeName returns the lower case of the name without spaces, hyphens, and underscores.
+func normalizeName(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '-', '_', '\t':
+			return -1
+		}
+		return r
+	}, strings.ToLower(name))
+}
+
+// matchScore returns the score of the normalized query for the normalized name in [0, 1] as [Search] does.
+func matchScore(q, name []rune) float64 {
+	if len(name) == 0 {
+		return 0
+	}
+	ratio := float64(len(q)) / float64(len(name))
+	switch {
+	case slices.Equal(q, name):
+		return 1
+	case hasPrefix(name, q):
+		return 0.8 + 0.1*ratio
+	case index(name, q) >= 0:
+		return 0.6 + 0.1*ratio
+	}
+
+	// Allow a typo for short queries and two for long ones, comparing with the name and its prefix of the same length.
+	if maxTypos := len(q) / 4; maxTypos > 0 {
+		maxTypos = min(maxTypos, 2)
+		d := editDistance(q, name)
+		if len(name) > len(q) {
+			d = min(d, editDistance(q, name[:len(q)]))
+		}
+		if d <= maxTypos {
+			return 0.5 - 0.1*float64(d)
+		}
+	}
+
+	if len(q) >= 2 {
+		if span := subsequenceSpan(q, name); span > 0 {
+			return 0.1 + 0.2*float64(len(q))/float64(span)
+		}
+	}
+	return 0
+}
+
+func hasPrefix(s, prefix []rune) bool {
+	return len(s) >= len(prefix) && slices.Equal(s[:len(prefix)], prefix)
+}
+
+func index(s, sub []rune) int {
+	for i := 0; i+len(sub) <= len(s); i++ {
+		if slices.Equal(s[i:i+len(sub)], sub) {
+			return i
+		}
+	}
+	return -1
+}
+
+// subsequenceSpan returns the length of the shortest part of s that has the runes of q in order, or 0 if there is no such part.
+func subsequenceSpan(q, s []rune) int {
+	best := 0
+	for start := range s {
+		if s[start] != q[0] {
+			continue
+		}
+		j := 1
+		end := start + 1
+		for ; end < len(s) && j < len(q); end++ {
+			if s[end] == q[j] {
+				j++
+			}
+		}
+		if j < len(q) {
+			break
+		}
+		if span := end - start; best == 0 || span < best {
+			best = span
+		}
+	}
+	return best
+}
+
+// editDistance returns the optimal string alignment distance of a and b,
+// i.e. the Levenshtein distance counting a transposition of adjacent runes as one edit.
+func editDistance(a, b []rune) int {
+	rows := make([][]int, len(a)+1)
+	for i := range rows {
+		rows[i] = make([]int, len(b)+1)
+		rows[i][0] = i
+	}
+	for j := range rows[0] {
+		rows[0][j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			d := min(rows[i-1][j]+1, rows[i][j-1]+1, rows[i-1][j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				d = min(d, rows[i-2][j-2]+1)
+			}
+			rows[i][j] = d
+		}
+	}
+	return rows[len(a)][len(b)]
+}
@@ -0,0 +1,155 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package colorname_test
+
+import (
+	"testing"
+
+	"github.com/hajimehoshi/iro/colorname"
+	"github.com/hajimehoshi/iro/ral"
+	"github.com/hajimehoshi/iro/wairo"
+)
+
+func TestEntries(t *testing.T) {
+	es := colorname.Entries()
+	counts := map[colorname.Source]int{}
+	hex := map[colorname.Source]map[string]string{}
+	for _, e := range es {
+		counts[e.Source]++
+		if hex[e.Source] == nil {
+			hex[e.Source] = map[string]string{}
+		}
+		hex[e.Source][e.Name] = e.Color.Hex()
+	}
+	for _, tc := range []struct {
+		source colorname.Source
+		want   int
+	}{
+		{colorname.SourceCSS, 148},
+		{colorname.SourceX11, 143},
+		{colorname.SourceWairo, len(wairo.Colors())},
+		{colorname.SourceRAL, len(ral.Colors())},
+	} {
+		if got := counts[tc.source]; got != tc.want {
+			t.Errorf("%s: got %d colors, want %d", tc.source, got, tc.want)
+		}
+	}
+
+	for _, tc := range []struct {
+		source colorname.Source
+		name   string
+		want   string
+	}{
+		{colorname.SourceCSS, "rebeccapurple", "#663399"},
+		{colorname.SourceCSS, "gray", "#808080"},
+		{colorname.SourceCSS, "green", "#008000"},
+		{colorname.SourceX11, "gray", "#bebebe"},
+		{colorname.SourceX11, "green", "#00ff00"},
+		{colorname.SourceX11, "cornflowerblue", "#6495ed"},
+		{colorname.SourceX11, "rebeccapurple", ""},
+		{colorname.SourceWairo, "瑠璃", "#005caf"},
+	} {
+		if got := hex[tc.source][tc.name]; got != tc.want {
+			t.Errorf("%s %s: got %q, want %q", tc.source, tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestSearch(t *testing.T) {
+	testCases := []struct {
+		query      string
+		opts       *colorname.SearchOptions
+		wantName   string
+		wantSource colorname.Source
+		wantScore  float64
+	}{
+		{query: "cornflowerblue", wantName: "cornflowerblue", wantSource: colorname.SourceCSS, wantScore: 1},
+		{query: "Cornflower Blue", wantName: "cornflowerblue", wantSource: colorname.SourceCSS, wantScore: 1},
+		{query: "cornf", wantName: "cornflowerblue", wantSource: colorname.SourceCSS},
+		{query: "flower", wantName: "cornflowerblue", wantSource: colorname.SourceCSS},
+		{query: "tomatoe", wantName: "tomato", wantSource: colorname.SourceCSS},
+		{query: "cornflwerblue", wantName: "cornflowerblue", wantSource: colorname.SourceCSS},
+		{query: "rbccprpl", wantName: "rebeccapurple", wantSource: colorname.SourceCSS},
+		{query: "gray", opts: &colorname.SearchOptions{Sources: []colorname.Source{colorname.SourceX11}}, wantName: "gray", wantSource: colorname.SourceX11, wantScore: 1},
+		{query: "ruri", wantName: "瑠璃", wantSource: colorname.SourceWairo},
+		{query: "るり", wantName: "瑠璃", wantSource: colorname.SourceWairo},
+		{query: "瑠璃", wantName: "瑠璃", wantSource: colorname.SourceWairo, wantScore: 1},
+		{query: "RAL 6021", wantName: "Pale green", wantSource: colorname.SourceRAL},
+		{query: "pale green", opts: &colorname.SearchOptions{Sources: []colorname.Source{colorname.SourceRAL}}, wantName: "Pale green", wantSource: colorname.SourceRAL, wantScore: 1},
+	}
+	for _, tc := range testCases {
+		ms := colorname.Search(tc.query, tc.opts)
+		if len(ms) == 0 {
+			t.Errorf("Search(%q): no matches", tc.query)
+			continue
+		}
+		m := ms[0]
+		if m.Name != tc.wantName || m.Source != tc.wantSource {
+			t.Errorf("Search(%q): got %s %q, want %s %q", tc.query, m.Source, m.Name, tc.wantSource, tc.wantName)
+		}
+		if tc.wantScore != 0 && m.Score != tc.wantScore {
+			t.Errorf("Search(%q): score: got %f, want %f", tc.query, m.Score, tc.wantScore)
+		}
+		for i := 1; i < len(ms); i++ {
+			if ms[i].Score > ms[i-1].Score {
+				t.Errorf("Search(%q): not ranked: %f after %f", tc.query, ms[i].Score, ms[i-1].Score)
+			}
+			if ms[i].Score <= 0 || ms[i].Score > 1 {
+				t.Errorf("Search(%q): score: got %f, want in (0, 1]", tc.query, ms[i].Score)
+			}
+		}
+	}
+}
+
+func TestSearchDuplicates(t *testing.T) {
+	// The CSS gray and the X11 gray have different colors, so both are included.
+	ms := colorname.Search("gray", nil)
+	if len(ms) < 2 || ms[0].Source != colorname.SourceCSS || ms[0].Name != "gray" || ms[1].Source != colorname.SourceX11 || ms[1].Name != "gray" {
+		t.Errorf("gray: got %v", ms)
+	}
+
+	// The CSS navy and the X11 navy are the same, so only the CSS one is included.
+	for _, m := range colorname.Search("navy", &colorname.SearchOptions{Limit: -1}) {
+		if m.Name == "navy" && m.Source != colorname.SourceCSS {
+			t.Errorf("navy: got %s navy, want only CSS navy", m.Source)
+		}
+	}
+}
+
+func TestSearchLimit(t *testing.T) {
+	if got := len(colorname.Search("blue", nil)); got != 10 {
+		t.Errorf("default: got %d matches, want 10", got)
+	}
+	if got := len(colorname.Search("blue", &colorname.SearchOptions{Limit: 3})); got != 3 {
+		t.Errorf("Limit 3: got %d matches, want 3", got)
+	}
+	if got := len(colorname.Search("blue", &colorname.SearchOptions{Limit: -1})); got <= 10 {
+		t.Errorf("Limit -1: got %d matches, want more than 10", got)
+	}
+	for _, q := range []string{"", " - "} {
+		if got := colorname.Search(q, nil); got != nil {
+			t.Errorf("Search(%q): got %v, want nil", q, got)
+		}
+	}
+	if got := colorname.Search("zzzzzz", nil); len(got) != 0 {
+		t.Errorf("zzzzzz: got %v, want no matches", got)
+	}
+}
+
+func TestSourceString(t *testing.T) {
+	for _, tc := range []struct {
+		s    colorname.Source
+		want string
+	}{
+		{colorname.SourceCSS, "CSS"},
+		{colorname.SourceX11, "X11"},
+		{colorname.SourceWairo, "wairo"},
+		{colorname.SourceRAL, "RAL"},
+		{colorname.Source(100), "Source(100)"},
+	} {
+		if got := tc.s.String(); got != tc.want {
+			t.Errorf("got %q, want %q", got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package colorname
+
+// x11Overrides is the colors of X11 whose values differ from the CSS ones with the same names.
+var x11Overrides = map[string]uint32{
+	"gray":   0xbebebe,
+	"grey":   0xbebebe,
+	"green":  0x00ff00,
+	"maroon": 0xb03060,
+	"purple": 0xa020f0,
+}
+
+// cssOnlyNames is the names of CSS that the traditional X11 colors don't have.
+var cssOnlyNames = map[string]bool{
+	"aqua":          true,
+	"crimson":       true,
+	"fuchsia":       true,
+	"indigo":        true,
+	"lime":          true,
+	"olive":         true,
+	"rebeccapurple": true,
+	"silver":        true,
+	"teal":          true,
+}
+
+// x11Extras is the colors of X11 that CSS doesn't have.
+var x11Extras = []tableEntry{
+	{"lightgoldenrod", 0xeedd82},
+	{"lightslateblue", 0x8470ff},
+	{"navyblue", 0x000080},
+	{"violetred", 0xd02090},
+}
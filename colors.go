@@ -0,0 +1,38 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+// Common colors. The values are in nonlinear sRGB.
+var (
+	// Transparent is the fully transparent black.
+	Transparent = ColorFromSRGB(0, 0, 0, 0)
+
+	// Black is #000000.
+	Black = ColorFromSRGB(0, 0, 0, 1)
+
+	// White is #ffffff, which is also D65 white.
+	White = ColorFromSRGB(1, 1, 1, 1)
+
+	// Gray is #808080, the same as CSS gray.
+	Gray = ColorFromSRGB(0x80/255.0, 0x80/255.0, 0x80/255.0, 1)
+
+	// Red is #ff0000, the sRGB red primary.
+	Red = ColorFromSRGB(1, 0, 0, 1)
+
+	// Green is #00ff00, the sRGB green primary.
+	// Note that this is CSS lime, and CSS green is #008000.
+	Green = ColorFromSRGB(0, 1, 0, 1)
+
+	// Blue is #0000ff, the sRGB blue primary.
+	Blue = ColorFromSRGB(0, 0, 1, 1)
+
+	// Cyan is #00ffff.
+	Cyan = ColorFromSRGB(0, 1, 1, 1)
+
+	// Magenta is #ff00ff.
+	Magenta = ColorFromSRGB(1, 0, 1, 1)
+
+	// Yellow is #ffff00.
+	Yellow = ColorFromSRGB(1, 1, 0, 1)
+)
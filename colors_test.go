@@ -0,0 +1,38 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestColors(t *testing.T) {
+	testCases := []struct {
+		name  string
+		color iro.Color
+		want  color.NRGBA
+	}{
+		{name: "Transparent", color: iro.Transparent, want: color.NRGBA{0, 0, 0, 0}},
+		{name: "Black", color: iro.Black, want: color.NRGBA{0, 0, 0, 0xff}},
+		{name: "White", color: iro.White, want: color.NRGBA{0xff, 0xff, 0xff, 0xff}},
+		{name: "Gray", color: iro.Gray, want: color.NRGBA{0x80, 0x80, 0x80, 0xff}},
+		{name: "Red", color: iro.Red, want: color.NRGBA{0xff, 0, 0, 0xff}},
+		{name: "Green", color: iro.Green, want: color.NRGBA{0, 0xff, 0, 0xff}},
+		{name: "Blue", color: iro.Blue, want: color.NRGBA{0, 0, 0xff, 0xff}},
+		{name: "Cyan", color: iro.Cyan, want: color.NRGBA{0, 0xff, 0xff, 0xff}},
+		{name: "Magenta", color: iro.Magenta, want: color.NRGBA{0xff, 0, 0xff, 0xff}},
+		{name: "Yellow", color: iro.Yellow, want: color.NRGBA{0xff, 0xff, 0, 0xff}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := color.NRGBAModel.Convert(tc.color.SRGBColor()).(color.NRGBA)
+			if got != tc.want {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"image"
+	"math"
+)
+
+// ColorStatistics is the statistics of the colors of an image in OKLab.
+type ColorStatistics struct {
+	// Mean is the means of the OKLab L, a, and b.
+	Mean [3]float64
+
+	// StdDev is the standard deviations of the OKLab L, a, and b.
+	StdDev [3]float64
+}
+
+// ImageColorStatistics returns the statistics of the colors of img in OKLab.
+// Each pixel is weighted by its alpha value, so fully transparent pixels are ignored.
+// If all the pixels are transparent, ImageColorStatistics returns the zero value.
+func ImageColorStatistics(img image.Image) ColorStatistics {
+	var sum, sum2 [3]float64
+	var weight float64
+	b := img.Bounds()
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			l, a, bb, alpha := colorAt(img, x, y).OKLab()
+			if alpha <= 0 {
+				continue
+			}
+			for i, v := range [...]float64{l, a, bb} {
+				sum[i] += v * alpha
+				sum2[i] += v * v * alpha
+			}
+			weight += alpha
+		}
+	}
+	var s ColorStatistics
+	if weight == 0 {
+		return s
+	}
+	for i := range s.Mean {
+		m := sum[i] / weight
+		s.Mean[i] = m
+		s.StdDev[i] = math.Sqrt(max(sum2[i]/weight-m*m, 0))
+	}
+	return s
+}
+
+// ColorTransfer transfers the colors of an image to another image statistically, like applying the grade of a reference image.
+//
+// ColorTransfer is Reinhard et al.'s method done in OKLab instead of lαβ:
+// the mean and the standard deviation of each component are matched to the reference.
+//
+// See Reinhard, Ashikhmin, Gooch, and Shirley, "Color Transfer between Images" (2001).
+type ColorTransfer struct {
+	// Source is the statistics of the colors to transfer from.
+	Source ColorStatistics
+
+	// Reference is the statistics of the colors to transfer to.
+	Reference ColorStatistics
+}
+
+// NewColorTransfer creates a ColorTransfer that makes the colors of src similar to those of reference.
+func NewColorTransfer(src, reference image.Image) *ColorTransfer {
+	return &ColorTransfer{
+		Source:    ImageColorStatistics(src),
+		Reference: ImageColorStatistics(reference),
+	}
+}
+
+// Apply returns a new Color by transferring c.
+// The result might be outside the gamut of c.
+// The alpha value is kept.
+func (t *ColorTransfer) Apply(c Color) Color {
+	l, a, b, alpha := c.OKLab()
+	v := [...]float64{l, a, b}
+	for i := range v {
+		s := 1.0
+		if t.Source.StdDev[i] > 0 {
+			s = t.Reference.StdDev[i] / t.Source.StdDev[i]
+		}
+		v[i] = (v[i]-t.Source.Mean[i])*s + t.Reference.Mean[i]
+	}
+	return ColorFromOKLab(v[0], v[1], v[2], alpha)
+}
+
+// ApplyToImage returns a new image by transferring each pixel of img.
+// See [MapImage] for the interpretation of the pixels.
+func (t *ColorTransfer) ApplyToImage(img image.Image) *image.NRGBA64 {
+	return MapImage(img, t.Apply)
+}
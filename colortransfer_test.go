@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestImageColorStatistics(t *testing.T) {
+	img := image.NewNRGBA64(image.Rect(0, 0, 3, 1))
+	img.SetNRGBA64(0, 0, iro.ColorFromOKLab(0.4, 0.1, -0.05, 1).SRGBNRGBA64())
+	img.SetNRGBA64(1, 0, iro.ColorFromOKLab(0.6, -0.1, 0.05, 1).SRGBNRGBA64())
+	// Transparent pixels are ignored.
+	img.SetNRGBA64(2, 0, color.NRGBA64{0xffff, 0xffff, 0xffff, 0})
+
+	s := iro.ImageColorStatistics(img)
+	want := iro.ColorStatistics{
+		Mean:   [3]float64{0.5, 0, 0},
+		StdDev: [3]float64{0.1, 0.1, 0.05},
+	}
+	for i := 0; i < 3; i++ {
+		// The pixels are quantized to 16 bits.
+		if d := s.Mean[i] - want.Mean[i]; d > 1e-4 || d < -1e-4 {
+			t.Errorf("Mean[%d]: got %f, want %f", i, s.Mean[i], want.Mean[i])
+		}
+		if d := s.StdDev[i] - want.StdDev[i]; d > 1e-4 || d < -1e-4 {
+			t.Errorf("StdDev[%d]: got %f, want %f", i, s.StdDev[i], want.StdDev[i])
+		}
+	}
+
+	if got := iro.ImageColorStatistics(image.NewNRGBA(image.Rect(0, 0, 2, 2))); got != (iro.ColorStatistics{}) {
+		t.Errorf("transparent image: got %v, want the zero value", got)
+	}
+}
+
+func TestColorTransfer(t *testing.T) {
+	tr := &iro.ColorTransfer{
+		Source: iro.ColorStatistics{
+			Mean:   [3]float64{0.5, 0, 0},
+			StdDev: [3]float64{0.1, 0.02, 0},
+		},
+		Reference: iro.ColorStatistics{
+			Mean:   [3]float64{0.6, 0.01, 0.02},
+			StdDev: [3]float64{0.05, 0.04, 0.03},
+		},
+	}
+	testCases := []struct {
+		in   [3]float64
+		want [3]float64
+	}{
+		{in: [3]float64{0.5, 0, 0}, want: [3]float64{0.6, 0.01, 0.02}},
+		{in: [3]float64{0.7, 0.02, 0.01}, want: [3]float64{0.7, 0.05, 0.03}},
+		{in: [3]float64{0.3, -0.01, -0.01}, want: [3]float64{0.5, -0.01, 0.01}},
+	}
+	for _, tc := range testCases {
+		l, a, b, alpha := tr.Apply(iro.ColorFromOKLab(tc.in[0], tc.in[1], tc.in[2], 0.5)).OKLab()
+		if !checkTol(l, tc.want[0]) || !checkTol(a, tc.want[1]) || !checkTol(b, tc.want[2]) || !checkTol(alpha, 0.5) {
+			t.Errorf("%v: got (%f, %f, %f, %f), want (%v, 0.5)", tc.in, l, a, b, alpha, tc.want)
+		}
+	}
+}
+
+func TestNewColorTransfer(t *testing.T) {
+	src := image.NewNRGBA64(image.Rect(0, 0, 2, 1))
+	src.SetNRGBA64(0, 0, iro.ColorFromOKLab(0.3, 0.05, 0, 1).SRGBNRGBA64())
+	src.SetNRGBA64(1, 0, iro.ColorFromOKLab(0.5, -0.05, 0.02, 1).SRGBNRGBA64())
+	ref := image.NewNRGBA64(image.Rect(0, 0, 2, 1))
+	ref.SetNRGBA64(0, 0, iro.ColorFromOKLab(0.6, 0.02, 0.05, 1).SRGBNRGBA64())
+	ref.SetNRGBA64(1, 0, iro.ColorFromOKLab(0.8, 0.04, 0.09, 1).SRGBNRGBA64())
+
+	// The transferred image has the statistics of the reference.
+	got := iro.ImageColorStatistics(iro.NewColorTransfer(src, ref).ApplyToImage(src))
+	want := iro.ImageColorStatistics(ref)
+	for i := 0; i < 3; i++ {
+		if d := got.Mean[i] - want.Mean[i]; d > 1e-4 || d < -1e-4 {
+			t.Errorf("Mean[%d]: got %f, want %f", i, got.Mean[i], want.Mean[i])
+		}
+		if d := got.StdDev[i] - want.StdDev[i]; d > 1e-4 || d < -1e-4 {
+			t.Errorf("StdDev[%d]: got %f, want %f", i, got.StdDev[i], want.StdDev[i])
+		}
+	}
+}
@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+)
+
+// CompiledGradient is a precomputed lookup table of a Gradient.
+// A CompiledGradient evaluates colors in constant time, at the cost of the precision of positions.
+type CompiledGradient struct {
+	colors  []Color
+	nrgba64 []color.NRGBA64
+}
+
+// Compile precomputes the gradient at n evenly spaced positions from 0 to 1, like [Gradient.Samples].
+// Later changes to g don't affect the result.
+//
+// Compile panics if n is less than 2.
+func (g *Gradient) Compile(n int) *CompiledGradient {
+	if n < 2 {
+		panic(fmt.Sprintf("iro: the size of a compiled gradient must be at least 2 but %d", n))
+	}
+	colors := g.Samples(n)
+	nrgba64 := make([]color.NRGBA64, n)
+	for i, c := range colors {
+		nrgba64[i] = c.SRGBNRGBA64()
+	}
+	return &CompiledGradient{
+		colors:  colors,
+		nrgba64: nrgba64,
+	}
+}
+
+// index returns the index of the nearest sample at t. t is clamped to [0, 1].
+func (c *CompiledGradient) index(t float64) int {
+	f := t * float64(len(c.colors)-1)
+	// !(f > 0) is true for NaN.
+	if !(f > 0) {
+		return 0
+	}
+	return int(math.Round(min(f, float64(len(c.colors)-1))))
+}
+
+// At returns the color of the nearest sample at t. t is clamped to [0, 1].
+func (c *CompiledGradient) At(t float64) Color {
+	return c.colors[c.index(t)]
+}
+
+// NRGBA64 returns the color of the nearest sample at t, encoded as nonlinear sRGB.
+// t is clamped to [0, 1].
+//
+// NRGBA64 is faster than converting the result of [CompiledGradient.At], as the encoded colors are also precomputed.
+func (c *CompiledGradient) NRGBA64(t float64) color.NRGBA64 {
+	return c.nrgba64[c.index(t)]
+}
+
+// CompiledScale is a Scale with a precomputed lookup table of its gradient.
+type CompiledScale struct {
+	scale      Scale
+	gradient   *CompiledGradient
+	nanNRGBA64 color.NRGBA64
+}
+
+// Compile precomputes the gradient of the scale with n samples. See [Gradient.Compile].
+// Later changes to s don't affect the result.
+//
+// Compile panics if n is less than 2.
+func (s *Scale) Compile(n int) *CompiledScale {
+	return &CompiledScale{
+		scale:      *s,
+		gradient:   s.Gradient.Compile(n),
+		nanNRGBA64: s.NaNColor.SRGBNRGBA64(),
+	}
+}
+
+// At returns the color for the value v.
+func (s *CompiledScale) At(v float64) Color {
+	if math.IsNaN(v) {
+		return s.scale.NaNColor
+	}
+	return s.gradient.At(s.scale.position(v))
+}
+
+// NRGBA64 returns the color for the value v, encoded as nonlinear sRGB.
+func (s *CompiledScale) NRGBA64(v float64) color.NRGBA64 {
+	if math.IsNaN(v) {
+		return s.nanNRGBA64
+	}
+	return s.gradient.NRGBA64(s.scale.position(v))
+}
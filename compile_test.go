@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"image/color"
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestCompiledGradient(t *testing.T) {
+	g := iro.NewGradient(iro.Red, iro.White, iro.Blue)
+	cg := g.Compile(257)
+
+	for i := 0; i <= 256; i++ {
+		pos := float64(i) / 256
+		if got, want := cg.At(pos), g.At(pos); got != want {
+			t.Errorf("At(%f): got %v, want %v", pos, got, want)
+		}
+		r, gr, b, a := g.At(pos).SRGB()
+		u16 := func(v float64) uint16 {
+			return uint16(math.Round(min(max(v, 0), 1) * 0xffff))
+		}
+		want := color.NRGBA64{R: u16(r), G: u16(gr), B: u16(b), A: u16(a)}
+		if got := cg.NRGBA64(pos); got != want {
+			t.Errorf("NRGBA64(%f): got %v, want %v", pos, got, want)
+		}
+	}
+
+	testCases := []struct {
+		t    float64
+		want iro.Color
+	}{
+		{t: -1, want: iro.Red},
+		{t: math.NaN(), want: iro.Red},
+		{t: 2, want: iro.Blue},
+		{t: math.Inf(1), want: iro.Blue},
+		// The nearest sample is used.
+		{t: 0.5 + 0.4/256, want: g.At(0.5)},
+		{t: 0.5 + 0.6/256, want: g.At(0.5 + 1.0/256)},
+	}
+	for _, tc := range testCases {
+		if got := cg.At(tc.t); got != tc.want {
+			t.Errorf("At(%f): got %v, want %v", tc.t, got, tc.want)
+		}
+	}
+}
+
+func TestCompiledScale(t *testing.T) {
+	s := iro.NewScale(iro.NewGradient(iro.Black, iro.White), 0, 100)
+	s.Transform = iro.ScaleTransformPow
+	s.Exponent = 0.5
+	s.NaNColor = iro.Red
+	cs := s.Compile(11)
+
+	testCases := []struct {
+		v    float64
+		want float64
+	}{
+		{v: 0, want: 0},
+		{v: 25, want: 0.5},
+		{v: 49, want: 0.7},
+		{v: 100, want: 1},
+		{v: 200, want: 1},
+	}
+	for _, tc := range testCases {
+		l, _, _, _ := cs.At(tc.v).OKLab()
+		if !checkTol(l, tc.want) {
+			t.Errorf("At(%f): L: got %f, want %f", tc.v, l, tc.want)
+		}
+	}
+	if got, want := cs.At(math.NaN()), iro.Red; got != want {
+		t.Errorf("At(NaN): got %v, want %v", got, want)
+	}
+	if got, want := cs.NRGBA64(math.NaN()), (color.NRGBA64{R: 0xffff, A: 0xffff}); got != want {
+		t.Errorf("NRGBA64(NaN): got %v, want %v", got, want)
+	}
+
+	// Later changes to the scale don't affect the compiled scale.
+	s.Max = 25
+	if l, _, _, _ := cs.At(25).OKLab(); !checkTol(l, 0.5) {
+		t.Errorf("At(25) after changing the scale: L: got %f, want 0.5", l)
+	}
+}
+
+func TestCompilePanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Compile(1) must panic")
+		}
+	}()
+	iro.NewGradient(iro.Black, iro.White).Compile(1)
+}
@@ -0,0 +1,38 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+// The minimum contrast ratios of WCAG 2 success criteria.
+const (
+	// WCAGContrastAALarge is the minimum contrast ratio of large text for the level AA, and of non-text elements.
+	WCAGContrastAALarge = 3
+
+	// WCAGContrastAA is the minimum contrast ratio of normal text for the level AA.
+	WCAGContrastAA = 4.5
+
+	// WCAGContrastAAA is the minimum contrast ratio of normal text for the level AAA.
+	WCAGContrastAAA = 7
+)
+
+// RelativeLuminance returns the relative luminance of c in [0, 1] as WCAG 2 defines.
+// The color is clamped to the sRGB gamut and the alpha value is ignored.
+func (c Color) RelativeLuminance() float64 {
+	r, g, b, _ := c.SRGB()
+	r, g, b = min(max(r, 0), 1), min(max(g, 0), 1), min(max(b, 0), 1)
+	c = ColorFromSRGB(r, g, b, 1)
+	return c.y
+}
+
+// WCAGContrast returns the contrast ratio of the two colors in [1, 21] as WCAG 2 defines.
+// The order of the colors does not matter.
+// The colors are clamped to the sRGB gamut and the alpha values are ignored.
+//
+// See https://www.w3.org/TR/WCAG22/#dfn-contrast-ratio
+func WCAGContrast(a, b Color) float64 {
+	la, lb := a.RelativeLuminance(), b.RelativeLuminance()
+	if la < lb {
+		la, lb = lb, la
+	}
+	return (la + 0.05) / (lb + 0.05)
+}
@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestWCAGContrast(t *testing.T) {
+	testCases := []struct {
+		a, b iro.Color
+		want float64
+	}{
+		{a: iro.White, b: iro.Black, want: 21},
+		{a: iro.Black, b: iro.White, want: 21},
+		{a: iro.White, b: iro.White, want: 1},
+		// #777777 on white is a well-known borderline case.
+		{a: iro.ColorFromSRGB(0x77/255.0, 0x77/255.0, 0x77/255.0, 1), b: iro.White, want: 4.48},
+		// Pure red on white.
+		{a: iro.ColorFromSRGB(1, 0, 0, 1), b: iro.White, want: 4.00},
+		// Colors outside the gamut are clamped.
+		{a: iro.ColorFromSRGB(1.5, 1.2, 1.1, 1), b: iro.Black, want: 21},
+	}
+	for _, tc := range testCases {
+		if got := iro.WCAGContrast(tc.a, tc.b); math.Abs(got-tc.want) > 0.005 {
+			t.Errorf("WCAGContrast(%v, %v): got %f, want %f", tc.a, tc.b, got, tc.want)
+		}
+	}
+}
+
+func TestRelativeLuminance(t *testing.T) {
+	if got := iro.ColorFromSRGB(0, 1, 0, 1).RelativeLuminance(); math.Abs(got-0.7152) > 1e-4 {
+		t.Errorf("RelativeLuminance(green): got %f, want 0.7152", got)
+	}
+}
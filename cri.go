@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"fmt"
+	"math"
+)
+
+// criReference returns the reference illuminant of CIE 13.3 for the light source:
+// a Planckian radiator below 5000 K, and a CIE daylight illuminant otherwise, at the correlated color temperature of the source.
+func criReference(source *SPD) *SPD {
+	t := source.CorrelatedColorTemperature(Observer2Degree)
+	if t < 5000 {
+		return BlackbodySPD(t)
+	}
+	return DaylightSPD(t)
+}
+
+// criCD returns the parameters c and d of the von Kries transform in CIE 13.3 for the CIE 1960 uv coordinates.
+func criCD(u, v float64) (c, d float64) {
+	return (4 - u - 10*v) / v, (1.708*v + 0.404 - 1.481*u) / v
+}
+
+// criUVW returns the CIE 1964 U*V*W* coordinates of the XYZ coordinates relative to the white with the CIE 1960 uv coordinates.
+// Y is in [0, 100].
+func criUVW(u, v, y, uw, vw float64) (float64, float64, float64) {
+	w := 25*math.Cbrt(y) - 17
+	return 13 * w * (u - uw), 13 * w * (v - vw), w
+}
+
+// xyzToUV1960 returns the CIE 1960 uv coordinates of the XYZ coordinates.
+func xyzToUV1960(x, y, z float64) (u, v float64) {
+	d := x + 15*y + 3*z
+	return 4 * x / d, 6 * y / d
+}
+
+// SpecialColorRenderingIndex returns the CIE special color rendering index R_i of the light source
+// for the test color sample with the spectral reflectance, as defined in CIE 13.3.
+//
+// The sample is rendered by the source and by the reference illuminant at the correlated color temperature of the source,
+// and the difference in CIE 1964 U*V*W*, after the von Kries chromatic adaptation, is mapped to R_i = 100 - 4.6 ΔE.
+// R_i is 100 when the sample looks the same, and can be negative.
+//
+// See [SPD.Chromaticity] for the color matching functions.
+func SpecialColorRenderingIndex(source, sample *SPD) float64 {
+	return specialColorRenderingIndex(source, criReference(source), sample)
+}
+
+func specialColorRenderingIndex(source, reference, sample *SPD) float64 {
+	uk, vk := xyzToUV1960(perfectReflector.ReflectanceXYZ(source))
+	ur, vr := xyzToUV1960(perfectReflector.ReflectanceXYZ(reference))
+	ck, dk := criCD(uk, vk)
+	cr, dr := criCD(ur, vr)
+
+	// The sample under the reference illuminant.
+	x, y, z := sample.ReflectanceXYZ(reference)
+	u, v := xyzToUV1960(x, y, z)
+	u0, v0, w0 := criUVW(u, v, y*100, ur, vr)
+
+	// The sample under the source, adapted to the reference illuminant.
+	x, y, z = sample.ReflectanceXYZ(source)
+	u, v = xyzToUV1960(x, y, z)
+	c, d := criCD(u, v)
+	c *= cr / ck
+	d *= dr / dk
+	den := 16.518 + 1.481*c - d
+	u = (10.872 + 0.404*c - 4*d) / den
+	v = 5.520 / den
+	u1, v1, w1 := criUVW(u, v, y*100, ur, vr)
+
+	de := math.Sqrt((u0-u1)*(u0-u1) + (v0-v1)*(v0-v1) + (w0-w1)*(w0-w1))
+	return 100 - 4.6*de
+}
+
+// ColorRenderingIndex returns the mean of the special color rendering indices of the light source for the test color samples.
+// See [SpecialColorRenderingIndex] for the details.
+//
+// The general color rendering index R_a is the result for the test color samples 1 to 8 of CIE 13.3.
+// See [GeneralColorRenderingIndex].
+//
+// ColorRenderingIndex panics if samples is empty.
+func ColorRenderingIndex(source *SPD, samples []*SPD) float64 {
+	if len(samples) == 0 {
+		panic("iro: the color rendering index requires at least one sample")
+	}
+	reference := criReference(source)
+	var sum float64
+	for _, s := range samples {
+		sum += specialColorRenderingIndex(source, reference, s)
+	}
+	return sum / float64(len(samples))
+}
+
+// GeneralColorRenderingIndex returns the CIE general color rendering index R_a of the light source,
+// i.e. the mean of the special color rendering indices for the test color samples 1 to 8 of CIE 13.3.
+//
+// R_a is 100 for the reference illuminants, and for example about 64 for the CIE illuminant F2 and about 90 for F7.
+//
+// IES TM-30 R_f and R_g are not implemented. See the package documentation.
+func GeneralColorRenderingIndex(source *SPD) float64 {
+	samples := make([]*SPD, 8)
+	for i := range samples {
+		samples[i] = TestColorSample(i + 1)
+	}
+	return ColorRenderingIndex(source, samples)
+}
+
+// TestColorSample returns the spectral reflectance of the test color sample i of CIE 13.3,
+// from 380 nm to 780 nm at 5 nm intervals.
+//
+// The samples 1 to 8 are moderately saturated colors used for [GeneralColorRenderingIndex].
+// The samples 9 to 14 are a strong red, yellow, green, and blue, the complexion of a European, and a leaf green.
+// For example, R_9 for saturated reds is SpecialColorRenderingIndex(source, TestColorSample(9)).
+//
+// TestColorSample panics if i is not in [1, 14].
+func TestColorSample(i int) *SPD {
+	if i < 1 || i > len(testColorSamples[0]) {
+		panic(fmt.Sprintf("iro: the test color sample must be in [1, 14] but %d", i))
+	}
+	s := &SPD{Start: testColorSamplesStart, Interval: testColorSamplesInterval, Values: make([]float64, len(testColorSamples))}
+	for j, r := range testColorSamples {
+		s.Values[j] = r[i-1]
+	}
+	return s
+}
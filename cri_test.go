@@ -0,0 +1,140 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+// criSamples returns smooth spectral reflectances with various hues.
+func criSamples() []*iro.SPD {
+	var samples []*iro.SPD
+	for _, mean := range []float64{420, 470, 510, 550, 580, 610, 650, 700} {
+		samples = append(samples, sampleSPD(func(lambda float64) float64 {
+			d := (lambda - mean) / 40
+			return 0.2 + 0.5*math.Exp(-d*d/2)
+		}))
+	}
+	return samples
+}
+
+func TestSpecialColorRenderingIndex(t *testing.T) {
+	// A Planckian radiator is its own reference illuminant.
+	source := iro.BlackbodySPD(3000)
+	for i, s := range criSamples() {
+		if got := iro.SpecialColorRenderingIndex(source, s); math.Abs(got-100) > 0.05 {
+			t.Errorf("SpecialColorRenderingIndex(3000 K, samples[%d]): got %f, want 100", i, got)
+		}
+	}
+}
+
+func TestColorRenderingIndex(t *testing.T) {
+	// LEDs with narrow peaks render colors poorly.
+	led := sampleSPD(func(lambda float64) float64 {
+		var v float64
+		for _, p := range [][2]float64{{450, 1}, {540, 1.2}, {610, 1.1}} {
+			d := (lambda - p[0]) / 8
+			v += p[1] * math.Exp(-d*d/2)
+		}
+		return v
+	})
+
+	testCases := []struct {
+		name     string
+		source   *iro.SPD
+		min, max float64
+	}{
+		{name: "D65", source: iro.IlluminantD65, min: 99.5, max: 100},
+		{name: "A", source: iro.IlluminantA, min: 99.5, max: 100},
+		{name: "Blackbody(4000)", source: iro.BlackbodySPD(4000), min: 99.95, max: 100},
+		{name: "LED", source: led, min: -100, max: 80},
+	}
+	samples := criSamples()
+	for _, tc := range testCases {
+		if got := iro.ColorRenderingIndex(tc.source, samples); got < tc.min || got > tc.max {
+			t.Errorf("ColorRenderingIndex(%s): got %f, want in [%f, %f]", tc.name, got, tc.min, tc.max)
+		}
+	}
+}
+
+// The CIE illuminants F2 and F7 from 380 nm to 780 nm at 5 nm intervals, referenced from CIE 15:2004.
+var (
+	illuminantF2 = &iro.SPD{Start: 380, Interval: 5, Values: []float64{
+		1.18, 1.48, 1.84, 2.15, 3.44, 15.69, 3.85, 3.74, 4.19,
+		4.62, 5.06, 34.98, 11.81, 6.27, 6.63, 6.93, 7.19, 7.40,
+		7.54, 7.62, 7.65, 7.62, 7.62, 7.45, 7.28, 7.15, 7.05,
+		7.04, 7.16, 7.47, 8.04, 8.88, 10.01, 24.88, 16.64, 14.59,
+		16.16, 17.56, 18.62, 21.47, 22.79, 19.29, 18.66, 17.73, 16.54,
+		15.21, 13.80, 12.36, 10.95, 9.65, 8.40, 7.32, 6.31, 5.43,
+		4.68, 4.02, 3.45, 2.96, 2.55, 2.19, 1.89, 1.64, 1.53,
+		1.27, 1.10, 0.99, 0.88, 0.76, 0.68, 0.61, 0.56, 0.54,
+		0.51, 0.47, 0.47, 0.43, 0.46, 0.47, 0.40, 0.33, 0.27,
+	}}
+	illuminantF7 = &iro.SPD{Start: 380, Interval: 5, Values: []float64{
+		2.56, 3.18, 3.84, 4.53, 6.15, 19.37, 7.37, 7.05, 7.71,
+		8.41, 9.15, 44.14, 17.52, 11.35, 12.00, 12.58, 13.08, 13.45,
+		13.71, 13.88, 13.95, 13.93, 13.82, 13.64, 13.43, 13.25, 13.08,
+		12.93, 12.78, 12.60, 12.44, 12.33, 12.26, 29.52, 17.05, 12.44,
+		12.58, 12.72, 12.83, 15.46, 16.75, 12.83, 12.67, 12.45, 12.19,
+		11.89, 11.60, 11.35, 11.12, 10.95, 10.76, 10.42, 10.11, 10.04,
+		10.02, 10.11, 9.87, 8.65, 7.27, 6.44, 5.83, 5.41, 5.04,
+		4.57, 4.12, 3.77, 3.46, 3.08, 2.73, 2.47, 2.25, 2.06,
+		1.90, 1.75, 1.62, 1.54, 1.45, 1.32, 1.17, 0.99, 0.81,
+	}}
+)
+
+func TestGeneralColorRenderingIndex(t *testing.T) {
+	// R_a is referenced from CIE 15:2004, and the special color rendering indices are the commonly published values rounded to integers.
+	testCases := []struct {
+		name   string
+		source *iro.SPD
+		ra     float64
+		ri     [8]float64
+	}{
+		{name: "F2", source: illuminantF2, ra: 64, ri: [8]float64{56, 77, 90, 57, 59, 67, 74, 33}},
+		{name: "F7", source: illuminantF7, ra: 90, ri: [8]float64{89, 92, 91, 91, 90, 89, 93, 87}},
+	}
+	for _, tc := range testCases {
+		if got := iro.GeneralColorRenderingIndex(tc.source); math.Abs(got-tc.ra) > 0.5 {
+			t.Errorf("GeneralColorRenderingIndex(%s): got %f, want %f", tc.name, got, tc.ra)
+		}
+		for i, want := range tc.ri {
+			if got := iro.SpecialColorRenderingIndex(tc.source, iro.TestColorSample(i+1)); math.Abs(got-want) > 0.5 {
+				t.Errorf("SpecialColorRenderingIndex(%s, TestColorSample(%d)): got %f, want %f", tc.name, i+1, got, want)
+			}
+		}
+	}
+
+	// The reference illuminants render the samples perfectly.
+	for _, temp := range []float64{2856, 4000} {
+		if got := iro.GeneralColorRenderingIndex(iro.BlackbodySPD(temp)); math.Abs(got-100) > 0.05 {
+			t.Errorf("GeneralColorRenderingIndex(Blackbody(%f)): got %f, want 100", temp, got)
+		}
+	}
+	if got := iro.GeneralColorRenderingIndex(iro.IlluminantD65); math.Abs(got-100) > 0.5 {
+		t.Errorf("GeneralColorRenderingIndex(D65): got %f, want 100", got)
+	}
+}
+
+func TestTestColorSample(t *testing.T) {
+	// The Munsell values of the samples 1 to 8 are 6, so the luminance factors are about 0.3 under D65.
+	for i := 1; i <= 8; i++ {
+		if _, y, _ := iro.TestColorSample(i).ReflectanceXYZ(iro.IlluminantD65); math.Abs(y-0.3) > 0.02 {
+			t.Errorf("TestColorSample(%d): Y: got %f, want about 0.3", i, y)
+		}
+	}
+	for _, i := range []int{0, 15} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("TestColorSample(%d): want panic", i)
+				}
+			}()
+			iro.TestColorSample(i)
+		}()
+	}
+}
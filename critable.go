@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+const (
+	// testColorSamplesStart and testColorSamplesInterval are the wavelength of the first row and the interval of [testColorSamples] in nanometers.
+	testColorSamplesStart    = 380
+	testColorSamplesInterval = 5
+)
+
+// testColorSamples is the spectral reflectances of the test color samples TCS01 to TCS14 of CIE 13.3
+// from 380 nm to 780 nm at 5 nm intervals. Each row is the reflectances of the samples at a wavelength.
+//
+// The values are referenced from CIE 13.3-1995.
+var testColorSamples = [...][14]float64{
+	{0.219, 0.070, 0.065, 0.074, 0.295, 0.151, 0.378, 0.104, 0.066, 0.050, 0.111, 0.120, 0.104, 0.036}, // 380
+	{0.239, 0.079, 0.068, 0.083, 0.306, 0.203, 0.459, 0.129, 0.062, 0.054, 0.121, 0.103, 0.127, 0.036}, // 385
+	{0.252, 0.089, 0.070, 0.093, 0.310, 0.265, 0.524, 0.170, 0.058, 0.059, 0.127, 0.090, 0.161, 0.037}, // 390
+	{0.256, 0.101, 0.072, 0.105, 0.312, 0.339, 0.546, 0.240, 0.055, 0.063, 0.129, 0.082, 0.211, 0.038}, // 395
+	{0.256, 0.111, 0.073, 0.116, 0.313, 0.410, 0.551, 0.319, 0.052, 0.066, 0.129, 0.076, 0.264, 0.039}, // 400
+	{0.254, 0.116, 0.073, 0.121, 0.315, 0.464, 0.555, 0.416, 0.052, 0.067, 0.127, 0.068, 0.313, 0.039}, // 405
+	{0.252, 0.118, 0.074, 0.124, 0.319, 0.492, 0.559, 0.462, 0.051, 0.068, 0.126, 0.064, 0.341, 0.040}, // 410
+	{0.248, 0.120, 0.074, 0.126, 0.322, 0.508, 0.560, 0.482, 0.050, 0.069, 0.122, 0.065, 0.352, 0.041}, // 415
+	{0.244, 0.121, 0.074, 0.128, 0.326, 0.517, 0.561, 0.490, 0.050, 0.069, 0.119, 0.075, 0.359, 0.042}, // 420
+	{0.240, 0.122, 0.073, 0.131, 0.330, 0.524, 0.558, 0.488, 0.049, 0.070, 0.114, 0.093, 0.361, 0.042}, // 425
+	{0.237, 0.122, 0.073, 0.135, 0.334, 0.531, 0.556, 0.482, 0.048, 0.072, 0.109, 0.123, 0.364, 0.043}, // 430
+	{0.232, 0.122, 0.073, 0.139, 0.339, 0.538, 0.551, 0.473, 0.047, 0.073, 0.105, 0.160, 0.365, 0.044}, // 435
+	{0.230, 0.123, 0.073, 0.144, 0.346, 0.544, 0.544, 0.462, 0.046, 0.076, 0.102, 0.207, 0.367, 0.044}, // 440
+	{0.226, 0.124, 0.073, 0.151, 0.352, 0.551, 0.535, 0.450, 0.044, 0.078, 0.098, 0.256, 0.369, 0.045}, // 445
+	{0.225, 0.127, 0.074, 0.161, 0.360, 0.556, 0.522, 0.439, 0.042, 0.083, 0.093, 0.300, 0.372, 0.045}, // 450
+	{0.222, 0.128, 0.075, 0.172, 0.369, 0.556, 0.506, 0.426, 0.041, 0.088, 0.090, 0.331, 0.374, 0.046}, // 455
+	{0.220, 0.131, 0.077, 0.186, 0.381, 0.554, 0.488, 0.413, 0.038, 0.095, 0.088, 0.346, 0.376, 0.047}, // 460
+	{0.218, 0.134, 0.080, 0.205, 0.394, 0.549, 0.469, 0.397, 0.035, 0.103, 0.085, 0.347, 0.379, 0.048}, // 465
+	{0.216, 0.138, 0.085, 0.229, 0.403, 0.541, 0.448, 0.382, 0.033, 0.113, 0.083, 0.341, 0.384, 0.050}, // 470
+	{0.214, 0.143, 0.094, 0.254, 0.410, 0.531, 0.429, 0.366, 0.031, 0.125, 0.083, 0.328, 0.389, 0.052}, // 475
+	{0.214, 0.150, 0.109, 0.281, 0.415, 0.519, 0.408, 0.352, 0.030, 0.142, 0.085, 0.307, 0.397, 0.055}, // 480
+	{0.214, 0.159, 0.126, 0.308, 0.418, 0.504, 0.385, 0.337, 0.029, 0.162, 0.087, 0.282, 0.405, 0.057}, // 485
+	{0.216, 0.174, 0.148, 0.332, 0.419, 0.488, 0.363, 0.325, 0.028, 0.189, 0.092, 0.257, 0.416, 0.063}, // 490
+	{0.218, 0.190, 0.172, 0.352, 0.417, 0.469, 0.341, 0.310, 0.028, 0.219, 0.100, 0.231, 0.429, 0.069}, // 495
+	{0.223, 0.207, 0.198, 0.370, 0.413, 0.450, 0.324, 0.299, 0.028, 0.262, 0.108, 0.204, 0.443, 0.076}, // 500
+	{0.225, 0.225, 0.221, 0.383, 0.409, 0.431, 0.311, 0.289, 0.029, 0.305, 0.120, 0.178, 0.454, 0.083}, // 505
+	{0.226, 0.242, 0.241, 0.390, 0.403, 0.414, 0.301, 0.283, 0.030, 0.365, 0.135, 0.154, 0.461, 0.091}, // 510
+	{0.226, 0.253, 0.260, 0.394, 0.396, 0.395, 0.291, 0.276, 0.030, 0.416, 0.152, 0.129, 0.466, 0.100}, // 515
+	{0.225, 0.260, 0.278, 0.395, 0.389, 0.377, 0.283, 0.270, 0.031, 0.465, 0.174, 0.109, 0.469, 0.108}, // 520
+	{0.225, 0.264, 0.302, 0.392, 0.381, 0.358, 0.273, 0.262, 0.031, 0.509, 0.190, 0.090, 0.471, 0.119}, // 525
+	{0.227, 0.267, 0.339, 0.385, 0.372, 0.341, 0.265, 0.256, 0.032, 0.546, 0.207, 0.075, 0.474, 0.129}, // 530
+	{0.230, 0.269, 0.370, 0.377, 0.363, 0.325, 0.260, 0.251, 0.032, 0.581, 0.220, 0.062, 0.476, 0.140}, // 535
+	{0.236, 0.272, 0.392, 0.367, 0.353, 0.309, 0.257, 0.250, 0.033, 0.610, 0.230, 0.051, 0.483, 0.150}, // 540
+	{0.245, 0.276, 0.399, 0.354, 0.342, 0.293, 0.257, 0.251, 0.034, 0.634, 0.238, 0.041, 0.490, 0.157}, // 545
+	{0.253, 0.282, 0.400, 0.341, 0.331, 0.279, 0.259, 0.254, 0.035, 0.653, 0.240, 0.035, 0.506, 0.162}, // 550
+	{0.262, 0.289, 0.393, 0.327, 0.320, 0.265, 0.260, 0.258, 0.037, 0.666, 0.241, 0.029, 0.526, 0.166}, // 555
+	{0.272, 0.299, 0.380, 0.312, 0.308, 0.253, 0.260, 0.264, 0.041, 0.678, 0.239, 0.025, 0.553, 0.169}, // 560
+	{0.283, 0.309, 0.365, 0.296, 0.296, 0.241, 0.258, 0.269, 0.044, 0.687, 0.237, 0.022, 0.585, 0.172}, // 565
+	{0.298, 0.322, 0.349, 0.280, 0.284, 0.234, 0.256, 0.272, 0.048, 0.693, 0.231, 0.019, 0.618, 0.172}, // 570
+	{0.318, 0.329, 0.332, 0.263, 0.271, 0.227, 0.254, 0.274, 0.052, 0.698, 0.223, 0.017, 0.651, 0.171}, // 575
+	{0.341, 0.335, 0.315, 0.247, 0.260, 0.225, 0.254, 0.278, 0.060, 0.701, 0.214, 0.017, 0.680, 0.170}, // 580
+	{0.367, 0.339, 0.299, 0.229, 0.247, 0.222, 0.259, 0.284, 0.076, 0.704, 0.204, 0.017, 0.701, 0.168}, // 585
+	{0.390, 0.341, 0.285, 0.214, 0.232, 0.221, 0.270, 0.295, 0.102, 0.705, 0.194, 0.016, 0.717, 0.166}, // 590
+	{0.409, 0.341, 0.272, 0.198, 0.220, 0.220, 0.284, 0.316, 0.136, 0.705, 0.179, 0.016, 0.729, 0.164}, // 595
+	{0.424, 0.342, 0.264, 0.185, 0.210, 0.220, 0.302, 0.348, 0.190, 0.706, 0.168, 0.016, 0.736, 0.160}, // 600
+	{0.435, 0.342, 0.257, 0.175, 0.200, 0.220, 0.324, 0.384, 0.256, 0.707, 0.158, 0.016, 0.742, 0.157}, // 605
+	{0.442, 0.342, 0.252, 0.169, 0.194, 0.220, 0.344, 0.434, 0.336, 0.707, 0.149, 0.016, 0.745, 0.152}, // 610
+	{0.448, 0.341, 0.247, 0.164, 0.189, 0.220, 0.362, 0.482, 0.418, 0.707, 0.140, 0.014, 0.747, 0.148}, // 615
+	{0.450, 0.341, 0.241, 0.160, 0.185, 0.223, 0.377, 0.528, 0.505, 0.708, 0.133, 0.014, 0.748, 0.144}, // 620
+	{0.451, 0.339, 0.235, 0.156, 0.183, 0.227, 0.389, 0.568, 0.581, 0.708, 0.125, 0.013, 0.748, 0.141}, // 625
+	{0.451, 0.339, 0.229, 0.154, 0.180, 0.233, 0.400, 0.604, 0.641, 0.710, 0.118, 0.014, 0.748, 0.137}, // 630
+	{0.451, 0.338, 0.224, 0.152, 0.177, 0.239, 0.410, 0.629, 0.682, 0.711, 0.112, 0.014, 0.748, 0.133}, // 635
+	{0.451, 0.338, 0.220, 0.151, 0.176, 0.244, 0.420, 0.648, 0.717, 0.712, 0.106, 0.014, 0.748, 0.129}, // 640
+	{0.451, 0.337, 0.217, 0.149, 0.175, 0.251, 0.429, 0.663, 0.740, 0.714, 0.101, 0.014, 0.748, 0.126}, // 645
+	{0.450, 0.336, 0.216, 0.148, 0.175, 0.258, 0.438, 0.676, 0.758, 0.716, 0.098, 0.014, 0.748, 0.122}, // 650
+	{0.450, 0.335, 0.216, 0.148, 0.175, 0.263, 0.445, 0.685, 0.770, 0.718, 0.095, 0.014, 0.748, 0.119}, // 655
+	{0.451, 0.334, 0.219, 0.148, 0.175, 0.268, 0.452, 0.693, 0.781, 0.720, 0.093, 0.013, 0.747, 0.116}, // 660
+	{0.451, 0.332, 0.224, 0.149, 0.177, 0.273, 0.457, 0.700, 0.790, 0.722, 0.090, 0.013, 0.747, 0.114}, // 665
+	{0.453, 0.332, 0.230, 0.151, 0.180, 0.278, 0.462, 0.705, 0.797, 0.725, 0.089, 0.014, 0.747, 0.111}, // 670
+	{0.454, 0.331, 0.238, 0.154, 0.183, 0.281, 0.466, 0.709, 0.803, 0.729, 0.087, 0.014, 0.746, 0.109}, // 675
+	{0.455, 0.331, 0.251, 0.158, 0.186, 0.283, 0.468, 0.712, 0.809, 0.731, 0.086, 0.014, 0.746, 0.107}, // 680
+	{0.457, 0.330, 0.269, 0.162, 0.189, 0.286, 0.470, 0.715, 0.814, 0.735, 0.085, 0.014, 0.746, 0.106}, // 685
+	{0.458, 0.329, 0.288, 0.165, 0.192, 0.291, 0.473, 0.717, 0.819, 0.739, 0.084, 0.014, 0.745, 0.104}, // 690
+	{0.460, 0.328, 0.312, 0.168, 0.195, 0.296, 0.477, 0.719, 0.824, 0.742, 0.084, 0.014, 0.744, 0.103}, // 695
+	{0.462, 0.328, 0.340, 0.170, 0.199, 0.302, 0.483, 0.721, 0.828, 0.746, 0.084, 0.014, 0.743, 0.101}, // 700
+	{0.463, 0.327, 0.366, 0.171, 0.200, 0.313, 0.489, 0.720, 0.830, 0.748, 0.084, 0.014, 0.744, 0.100}, // 705
+	{0.464, 0.326, 0.390, 0.170, 0.200, 0.325, 0.496, 0.719, 0.831, 0.749, 0.085, 0.014, 0.745, 0.099}, // 710
+	{0.465, 0.325, 0.412, 0.168, 0.198, 0.338, 0.503, 0.722, 0.833, 0.751, 0.087, 0.014, 0.743, 0.098}, // 715
+	{0.466, 0.324, 0.431, 0.166, 0.196, 0.351, 0.511, 0.725, 0.835, 0.753, 0.089, 0.014, 0.741, 0.098}, // 720
+	{0.466, 0.324, 0.447, 0.164, 0.195, 0.364, 0.518, 0.727, 0.836, 0.754, 0.092, 0.014, 0.739, 0.098}, // 725
+	{0.466, 0.324, 0.460, 0.164, 0.195, 0.376, 0.525, 0.729, 0.836, 0.755, 0.096, 0.014, 0.738, 0.098}, // 730
+	{0.466, 0.323, 0.472, 0.165, 0.196, 0.389, 0.532, 0.730, 0.837, 0.755, 0.102, 0.014, 0.736, 0.099}, // 735
+	{0.467, 0.322, 0.481, 0.168, 0.197, 0.401, 0.539, 0.730, 0.838, 0.755, 0.110, 0.014, 0.734, 0.099}, // 740
+	{0.467, 0.321, 0.488, 0.172, 0.200, 0.413, 0.546, 0.730, 0.839, 0.755, 0.120, 0.015, 0.732, 0.100}, // 745
+	{0.467, 0.320, 0.493, 0.177, 0.203, 0.425, 0.553, 0.730, 0.839, 0.756, 0.131, 0.014, 0.730, 0.100}, // 750
+	{0.467, 0.318, 0.497, 0.181, 0.205, 0.436, 0.559, 0.730, 0.839, 0.757, 0.145, 0.014, 0.728, 0.101}, // 755
+	{0.467, 0.316, 0.500, 0.185, 0.208, 0.447, 0.565, 0.730, 0.839, 0.758, 0.161, 0.014, 0.727, 0.102}, // 760
+	{0.467, 0.315, 0.502, 0.189, 0.212, 0.458, 0.570, 0.730, 0.839, 0.759, 0.181, 0.014, 0.726, 0.103}, // 765
+	{0.467, 0.315, 0.505, 0.192, 0.215, 0.469, 0.575, 0.730, 0.839, 0.759, 0.202, 0.014, 0.725, 0.104}, // 770
+	{0.467, 0.314, 0.510, 0.194, 0.217, 0.477, 0.578, 0.730, 0.839, 0.759, 0.223, 0.014, 0.724, 0.105}, // 775
+	{0.467, 0.314, 0.516, 0.197, 0.219, 0.485, 0.581, 0.730, 0.839, 0.759, 0.245, 0.014, 0.723, 0.106}, // 780
+}
@@ -0,0 +1,1182 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// The syntax is referenced from: https://www.w3.org/TR/css-color-4/
+
+// ParseCSS parses a CSS color like "#ff8000", "rgb(255 128 0)", "oklch(0.7 0.15 60)", or "color(display-p3 1 0.5 0)".
+//
+// The supported forms are hexadecimal colors, named colors like "cornflowerblue" (see [CSSNamedColor]),
+// rgb(), rgba(), lab(), lch(), oklab(), oklch(), color(), color-mix(), and transparent.
+// The predefined spaces of color() are srgb, srgb-linear, display-p3, a98-rgb, prophoto-rgb, rec2020, xyz, xyz-d50, and xyz-d65.
+// The keyword none is treated as 0.
+//
+// The relative color syntax like "oklch(from #ff8000 l c calc(h + 180))" is supported with channel keywords and calc().
+// In the relative color syntax, the hue keyword h is a number in degrees.
+//
+// A hue is a number in degrees or an angle with a unit deg, grad, rad, or turn.
+func ParseCSS(s string) (Color, error) {
+	return ParseCSSWithOptions(s, nil)
+}
+
+// ParseCSSRelative parses a CSS color like [ParseCSS], where currentcolor and var() refer to base.
+//
+// This is useful to evaluate a relative color like "oklch(from var(--base) l c calc(h + 180))" with a given base color.
+// The name of var() is ignored and any var() refers to base.
+func ParseCSSRelative(s string, base Color) (Color, error) {
+	return ParseCSSWithOptions(s, &ParseOptions{Base: &base})
+}
+
+// ParseMode specifies how strictly [ParseCSSWithOptions] parses colors.
+type ParseMode int
+
+const (
+	// ParseModeDefault is the mode of [ParseCSS].
+	// The syntax of CSS is accepted, and out-of-range components are clamped as CSS does.
+	ParseModeDefault ParseMode = iota
+
+	// ParseModeStrict accepts only the exact syntax of the CSS specifications, for example to lint stylesheets.
+	// Unlike ParseModeDefault, the legacy syntax with commas must not mix numbers and percentages nor contain none,
+	// and components that CSS would clamp, like rgb(300 0 0) or an alpha 1.5, are errors of [ErrComponentRange].
+	ParseModeStrict
+
+	// ParseModeLenient accepts common variations of hand-written colors in addition to ParseModeDefault:
+	// hexadecimal colors without '#' like "FFAA00", whitespace between a function name and '(',
+	// commas between any components like "oklch(0.7, 0.1, 30)", an alpha without a separator like "rgb(255 0 0 0.5)",
+	// and a trailing ';'.
+	//
+	// A hexadecimal color without '#' must have a digit, or 6 or 8 digits, so that words of 3 or 4 letters
+	// like "bad" or "beef" are keywords rather than colors. Longer words of the letters a-f like "decade" are still hexadecimal colors.
+	ParseModeLenient
+)
+
+// String returns the name of the mode.
+func (m ParseMode) String() string {
+	switch m {
+	case ParseModeDefault:
+		return "default"
+	case ParseModeStrict:
+		return "strict"
+	case ParseModeLenient:
+		return "lenient"
+	default:
+		return fmt.Sprintf("ParseMode(%d)", m)
+	}
+}
+
+// ParseOptions represents options for [ParseCSSWithOptions].
+type ParseOptions struct {
+	// Mode is the parsing mode.
+	Mode ParseMode
+
+	// Base is the color referred by currentcolor and var(). See [ParseCSSRelative].
+	// If Base is nil, currentcolor and var() are errors.
+	Base *Color
+}
+
+// ParseCSSWithOptions parses a CSS color like [ParseCSS] with the options.
+// If opts is nil, the default options are used.
+//
+// The error is a *[ParseError], which wraps the category of the error like [ErrUnknownFunction].
+func ParseCSSWithOptions(s string, opts *ParseOptions) (Color, error) {
+	p := &cssParser{}
+	if opts != nil {
+		p.mode = opts.Mode
+		p.base = opts.Base
+	}
+	c, err := p.parse(s)
+	if err != nil {
+		return Color{}, withParseInput(err, s)
+	}
+	return c, nil
+}
+
+// The categories of errors of parsing colors.
+// A [ParseError] wraps one of them, so they can be tested with errors.Is.
+var (
+	// ErrSyntax represents a syntax error like a missing parenthesis or an unexpected token.
+	ErrSyntax = errors.New("iro: invalid syntax")
+
+	// ErrUnknownKeyword represents an unknown keyword like an unknown color name or an unknown angle unit.
+	ErrUnknownKeyword = errors.New("iro: unknown keyword")
+
+	// ErrUnknownFunction represents an unknown or unavailable function.
+	ErrUnknownFunction = errors.New("iro: unknown function")
+
+	// ErrUnknownSpace represents an unknown color space in color() or color-mix().
+	ErrUnknownSpace = errors.New("iro: unknown color space")
+
+	// ErrInvalidHex represents an invalid hexadecimal color.
+	ErrInvalidHex = errors.New("iro: invalid hexadecimal color")
+
+	// ErrComponentRange represents a component out of its range.
+	ErrComponentRange = errors.New("iro: component out of range")
+)
+
+// ParseError is an error of parsing a color, with the position for diagnostics.
+type ParseError struct {
+	// Input is the parsed string.
+	Input string
+
+	// Pos is the byte offset of the error in Input.
+	Pos int
+
+	// Err is the category of the error like [ErrSyntax].
+	Err error
+
+	// Message is the description of the error.
+	Message string
+}
+
+// Error implements the error interface.
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("iro: %s at %d in %q", e.Message, e.Pos, e.Input)
+}
+
+// Unwrap returns the category of the error.
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// withParseInput sets the input of err if err is a *ParseError, and returns err.
+func withParseInput(err error, s string) error {
+	if e, ok := err.(*ParseError); ok {
+		e.Input = s
+	}
+	return err
+}
+
+func newParseError(err error, pos int, format string, args ...any) *ParseError {
+	return &ParseError{
+		Pos:     pos,
+		Err:     err,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+func (p *cssParser) parse(s string) (Color, error) {
+	if p.mode == ParseModeLenient {
+		s = strings.TrimRight(s, " \t\n\r\f;")
+		// A hexadecimal color without '#'.
+		if h := strings.TrimLeft(s, " \t\n\r\f"); isBareHexColor(h) {
+			return parseHexColor(cssToken{kind: cssTokenHash, value: h, pos: len(s) - len(h)})
+		}
+	}
+
+	tokens, err := tokenizeCSS(s, p.mode == ParseModeLenient)
+	if err != nil {
+		return Color{}, err
+	}
+	p.tokens = tokens
+	c, err := p.parseColor()
+	if err != nil {
+		return Color{}, err
+	}
+	if t := p.peek(); t.kind != cssTokenEOF {
+		return Color{}, newParseError(ErrSyntax, t.pos, "unexpected %s", t)
+	}
+	return c, nil
+}
+
+// isBareHexColor reports whether s is a hexadecimal color without '#' in [ParseModeLenient]:
+// 6 or 8 hexadecimal digits, or 3 or 4 hexadecimal digits with at least one decimal digit.
+func isBareHexColor(s string) bool {
+	switch len(s) {
+	case 3, 4, 6, 8:
+	default:
+		return false
+	}
+	var hasDigit bool
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if isDigit(c) {
+			hasDigit = true
+			continue
+		}
+		if !('a' <= c && c <= 'f') && !('A' <= c && c <= 'F') {
+			return false
+		}
+	}
+	return hasDigit || len(s) == 6 || len(s) == 8
+}
+
+type cssTokenKind int
+
+const (
+	cssTokenEOF cssTokenKind = iota
+	cssTokenIdent
+	cssTokenFunction
+	cssTokenHash
+	cssTokenNumber
+	cssTokenPercentage
+	cssTokenDimension
+	cssTokenComma
+	cssTokenCloseParen
+	cssTokenDelim
+
+	// cssTokenCalc is not produced by the tokenizer but by the parser for a calc() expression.
+	cssTokenCalc
+)
+
+type cssToken struct {
+	kind cssTokenKind
+
+	// value is the name of an identifier, a function, a hash, or a delimiter.
+	// For a dimension, value is the unit in lower case.
+	value string
+
+	// number is the value of a number, a percentage, or a dimension.
+	number float64
+
+	// pos is the byte offset of the token in the source.
+	pos int
+
+	// calc is the expression of a calc() function, which is evaluated with the reference value for percentages.
+	calc func(percentRef float64) (float64, error)
+}
+
+func (t cssToken) String() string {
+	switch t.kind {
+	case cssTokenEOF:
+		return "end of input"
+	case cssTokenIdent:
+		return fmt.Sprintf("identifier %q", t.value)
+	case cssTokenFunction:
+		return fmt.Sprintf("function %q", t.value+"(")
+	case cssTokenHash:
+		return fmt.Sprintf("hash %q", "#"+t.value)
+	case cssTokenNumber:
+		return fmt.Sprintf("number %g", t.number)
+	case cssTokenPercentage:
+		return fmt.Sprintf("percentage %g%%", t.number)
+	case cssTokenDimension:
+		return fmt.Sprintf("dimension %g%s", t.number, t.value)
+	case cssTokenComma:
+		return "','"
+	case cssTokenCloseParen:
+		return "')'"
+	case cssTokenDelim:
+		return fmt.Sprintf("%q", t.value)
+	case cssTokenCalc:
+		return "calc()"
+	default:
+		return fmt.Sprintf("token(%d)", t.kind)
+	}
+}
+
+func isCSSNameStart(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || c == '_' || c >= 0x80
+}
+
+func isCSSName(c byte) bool {
+	return isCSSNameStart(c) || isDigit(c) || c == '-'
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+func isCSSSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f'
+}
+
+// startsCSSNumber reports whether a number starts at s[i].
+func startsCSSNumber(s string, i int) bool {
+	if i < len(s) && (s[i] == '+' || s[i] == '-') {
+		i++
+	}
+	if i < len(s) && isDigit(s[i]) {
+		return true
+	}
+	return i+1 < len(s) && s[i] == '.' && isDigit(s[i+1])
+}
+
+// startsCSSIdent reports whether an identifier starts at s[i].
+func startsCSSIdent(s string, i int) bool {
+	if i < len(s) && s[i] == '-' {
+		i++
+	}
+	return i < len(s) && isCSSNameStart(s[i])
+}
+
+// tokenizeCSS tokenizes s.
+// If lenient is true, whitespace between a function name and '(' is allowed.
+func tokenizeCSS(s string, lenient bool) ([]cssToken, error) {
+	var tokens []cssToken
+	i := 0
+	for i < len(s) {
+		c := s[i]
+		switch {
+		case isCSSSpace(c):
+			i++
+		case c == ',':
+			tokens = append(tokens, cssToken{kind: cssTokenComma, pos: i})
+			i++
+		case c == ')':
+			tokens = append(tokens, cssToken{kind: cssTokenCloseParen, pos: i})
+			i++
+		case c == '#':
+			start := i
+			i++
+			for i < len(s) && isCSSName(s[i]) {
+				i++
+			}
+			tokens = append(tokens, cssToken{kind: cssTokenHash, value: s[start+1 : i], pos: start})
+		case startsCSSNumber(s, i):
+			start := i
+			if s[i] == '+' || s[i] == '-' {
+				i++
+			}
+			for i < len(s) && isDigit(s[i]) {
+				i++
+			}
+			if i+1 < len(s) && s[i] == '.' && isDigit(s[i+1]) {
+				i++
+				for i < len(s) && isDigit(s[i]) {
+					i++
+				}
+			}
+			if i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+				j := i + 1
+				if j < len(s) && (s[j] == '+' || s[j] == '-') {
+					j++
+				}
+				if j < len(s) && isDigit(s[j]) {
+					i = j
+					for i < len(s) && isDigit(s[i]) {
+						i++
+					}
+				}
+			}
+			v, err := strconv.ParseFloat(s[start:i], 64)
+			if err != nil {
+				return nil, newParseError(ErrSyntax, start, "invalid number %q", s[start:i])
+			}
+			switch {
+			case i < len(s) && s[i] == '%':
+				i++
+				tokens = append(tokens, cssToken{kind: cssTokenPercentage, number: v, pos: start})
+			case startsCSSIdent(s, i):
+				unitStart := i
+				for i < len(s) && isCSSName(s[i]) {
+					i++
+				}
+				tokens = append(tokens, cssToken{kind: cssTokenDimension, value: strings.ToLower(s[unitStart:i]), number: v, pos: start})
+			default:
+				tokens = append(tokens, cssToken{kind: cssTokenNumber, number: v, pos: start})
+			}
+		case startsCSSIdent(s, i):
+			start := i
+			for i < len(s) && isCSSName(s[i]) {
+				i++
+			}
+			name := strings.ToLower(s[start:i])
+			j := i
+			if lenient {
+				for j < len(s) && isCSSSpace(s[j]) {
+					j++
+				}
+			}
+			if j < len(s) && s[j] == '(' {
+				i = j + 1
+				tokens = append(tokens, cssToken{kind: cssTokenFunction, value: name, pos: start})
+				break
+			}
+			tokens = append(tokens, cssToken{kind: cssTokenIdent, value: name, pos: start})
+		default:
+			tokens = append(tokens, cssToken{kind: cssTokenDelim, value: s[i : i+1], pos: i})
+			i++
+		}
+	}
+	tokens = append(tokens, cssToken{kind: cssTokenEOF, pos: len(s)})
+	return tokens, nil
+}
+
+type cssParser struct {
+	tokens []cssToken
+	pos    int
+
+	mode ParseMode
+
+	// base is the color referred by currentcolor and var(), if any.
+	base *Color
+
+	// channels is the values of the channel keywords in the relative color syntax.
+	channels map[string]float64
+}
+
+func (p *cssParser) peek() cssToken {
+	return p.tokens[p.pos]
+}
+
+func (p *cssParser) next() cssToken {
+	t := p.tokens[p.pos]
+	if t.kind != cssTokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *cssParser) parseColor() (Color, error) {
+	t := p.next()
+	switch t.kind {
+	case cssTokenHash:
+		return parseHexColor(t)
+	case cssTokenIdent:
+		if t.value == "transparent" {
+			return Transparent, nil
+		}
+		if t.value == "currentcolor" && p.base != nil {
+			return *p.base, nil
+		}
+		if c, ok := cssNamedColorMap[t.value]; ok {
+			return c, nil
+		}
+		return Color{}, newParseError(ErrUnknownKeyword, t.pos, "unknown color keyword %q", t.value)
+	case cssTokenFunction:
+		switch t.value {
+		case "var":
+			if p.base == nil {
+				return Color{}, newParseError(ErrUnknownFunction, t.pos, "var() is not available")
+			}
+			if err := p.skipArgs(); err != nil {
+				return Color{}, err
+			}
+			return *p.base, nil
+		case "rgb", "rgba":
+			return p.parseRGB()
+		case "lab":
+			return p.parseLab(SpaceLab)
+		case "oklab":
+			return p.parseLab(SpaceOKLab)
+		case "lch":
+			return p.parseLch(SpaceLch)
+		case "oklch":
+			return p.parseLch(SpaceOKLch)
+		case "color":
+			return p.parseColorFunction()
+		case "color-mix":
+			return p.parseColorMix(t.pos)
+		}
+		return Color{}, newParseError(ErrUnknownFunction, t.pos, "unsupported function %q", t.value)
+	default:
+		return Color{}, newParseError(ErrSyntax, t.pos, "unexpected %s", t)
+	}
+}
+
+func parseHexColor(t cssToken) (Color, error) {
+	h := t.value
+	var digits [8]uint64
+	for i := 0; i < len(h); i++ {
+		v, err := strconv.ParseUint(h[i:i+1], 16, 8)
+		if err != nil || i >= len(digits) {
+			return Color{}, newParseError(ErrInvalidHex, t.pos, "invalid hexadecimal color %q", "#"+h)
+		}
+		digits[i] = v
+	}
+
+	var rgba [4]uint64
+	switch len(h) {
+	case 3, 4:
+		for i := 0; i < len(h); i++ {
+			rgba[i] = digits[i] * 0x11
+		}
+		if len(h) == 3 {
+			rgba[3] = 0xff
+		}
+	case 6, 8:
+		for i := 0; i < len(h)/2; i++ {
+			rgba[i] = digits[2*i]<<4 | digits[2*i+1]
+		}
+		if len(h) == 6 {
+			rgba[3] = 0xff
+		}
+	default:
+		return Color{}, newParseError(ErrInvalidHex, t.pos, "invalid hexadecimal color %q", "#"+h)
+	}
+	return ColorFromSRGB(float64(rgba[0])/0xff, float64(rgba[1])/0xff, float64(rgba[2])/0xff, float64(rgba[3])/0xff), nil
+}
+
+// parseArgs parses three components and an optional alpha until the closing parenthesis.
+// If legacy is true, the components can be separated by commas.
+func (p *cssParser) parseArgs(legacy bool) (components [3]cssToken, alpha cssToken, err error) {
+	alpha = cssToken{kind: cssTokenNumber, number: 1}
+	if p.channels != nil {
+		// In the relative color syntax, the alpha is the origin's alpha by default, and the legacy syntax is not allowed.
+		alpha.number = p.channels["alpha"]
+		legacy = false
+	}
+
+	lenient := p.mode == ParseModeLenient
+	var commas bool
+	for i := range components {
+		if i > 0 && lenient {
+			if p.peek().kind == cssTokenComma {
+				p.next()
+			}
+		} else if i > 0 && legacy {
+			if p.peek().kind == cssTokenComma && (i == 1 || commas) {
+				p.next()
+				commas = true
+			} else if commas {
+				t := p.peek()
+				return components, alpha, newParseError(ErrSyntax, t.pos, "expected ',' but %s", t)
+			}
+		}
+		t, err := p.parseComponent()
+		if err != nil {
+			return components, alpha, err
+		}
+		components[i] = t
+	}
+	if commas && p.mode == ParseModeStrict {
+		if err := checkCSSLegacyComponents(components); err != nil {
+			return components, alpha, err
+		}
+	}
+
+	t := p.peek()
+	switch {
+	case t.kind == cssTokenCloseParen:
+		p.next()
+		return components, alpha, nil
+	case t.kind == cssTokenDelim && t.value == "/" && (!commas || lenient), t.kind == cssTokenComma && (commas || lenient):
+		p.next()
+	case lenient && (t.kind == cssTokenNumber || t.kind == cssTokenPercentage || t.kind == cssTokenFunction && t.value == "calc"):
+		// In the lenient mode, an alpha can follow the components without a separator.
+	default:
+		return components, alpha, newParseError(ErrSyntax, t.pos, "unexpected %s", t)
+	}
+	alpha, err = p.parseComponent()
+	if err != nil {
+		return components, alpha, err
+	}
+	if t := p.next(); t.kind != cssTokenCloseParen {
+		return components, alpha, newParseError(ErrSyntax, t.pos, "expected ')' but %s", t)
+	}
+	return components, alpha, nil
+}
+
+// checkCSSLegacyComponents checks the components of the legacy syntax with commas for the strict mode:
+// the components must be all numbers or all percentages.
+func checkCSSLegacyComponents(components [3]cssToken) error {
+	kind := cssTokenCalc
+	for _, t := range components {
+		switch t.kind {
+		case cssTokenIdent:
+			return newParseError(ErrSyntax, t.pos, "none is not allowed in the legacy syntax")
+		case cssTokenNumber, cssTokenPercentage:
+			if kind != cssTokenCalc && kind != t.kind {
+				return newParseError(ErrSyntax, t.pos, "numbers and percentages must not be mixed in the legacy syntax")
+			}
+			kind = t.kind
+		}
+	}
+	return nil
+}
+
+// parseComponent parses a component, which is a number, a percentage, a dimension, none, a channel keyword, or calc().
+// A channel keyword is resolved to a number.
+func (p *cssParser) parseComponent() (cssToken, error) {
+	t := p.next()
+	switch t.kind {
+	case cssTokenNumber, cssTokenPercentage, cssTokenDimension:
+		return t, nil
+	case cssTokenIdent:
+		if t.value == "none" {
+			return t, nil
+		}
+		if v, ok := p.channels[t.value]; ok {
+			return cssToken{kind: cssTokenNumber, number: v, pos: t.pos}, nil
+		}
+	case cssTokenFunction:
+		if t.value == "calc" {
+			f, err := p.parseCalcSum()
+			if err != nil {
+				return cssToken{}, err
+			}
+			if t := p.next(); t.kind != cssTokenCloseParen {
+				return cssToken{}, newParseError(ErrSyntax, t.pos, "expected ')' but %s", t)
+			}
+			return cssToken{kind: cssTokenCalc, calc: f, pos: t.pos}, nil
+		}
+	}
+	return cssToken{}, newParseError(ErrSyntax, t.pos, "unexpected %s", t)
+}
+
+type cssCalc = func(percentRef float64) (float64, error)
+
+// parseCalcSum parses a sum of products in calc().
+func (p *cssParser) parseCalcSum() (cssCalc, error) {
+	lhs, err := p.parseCalcProduct()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		t := p.peek()
+		if t.kind != cssTokenDelim || (t.value != "+" && t.value != "-") {
+			return lhs, nil
+		}
+		p.next()
+		rhs, err := p.parseCalcProduct()
+		if err != nil {
+			return nil, err
+		}
+		lhs = cssCalcBinary(lhs, rhs, t.value)
+	}
+}
+
+// parseCalcProduct parses a product of values in calc().
+func (p *cssParser) parseCalcProduct() (cssCalc, error) {
+	lhs, err := p.parseCalcValue()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		t := p.peek()
+		if t.kind != cssTokenDelim || (t.value != "*" && t.value != "/") {
+			return lhs, nil
+		}
+		p.next()
+		rhs, err := p.parseCalcValue()
+		if err != nil {
+			return nil, err
+		}
+		lhs = cssCalcBinary(lhs, rhs, t.value)
+	}
+}
+
+func cssCalcBinary(lhs, rhs cssCalc, op string) cssCalc {
+	return func(percentRef float64) (float64, error) {
+		a, err := lhs(percentRef)
+		if err != nil {
+			return 0, err
+		}
+		b, err := rhs(percentRef)
+		if err != nil {
+			return 0, err
+		}
+		switch op {
+		case "+":
+			return a + b, nil
+		case "-":
+			return a - b, nil
+		case "*":
+			return a * b, nil
+		case "/":
+			return a / b, nil
+		default:
+			panic(fmt.Sprintf("iro: invalid operator: %s", op))
+		}
+	}
+}
+
+// parseCalcValue parses a value or a parenthesized expression in calc().
+func (p *cssParser) parseCalcValue() (cssCalc, error) {
+	t := p.next()
+	switch t.kind {
+	case cssTokenNumber:
+		return func(float64) (float64, error) {
+			return t.number, nil
+		}, nil
+	case cssTokenPercentage:
+		return func(percentRef float64) (float64, error) {
+			return t.number / 100 * percentRef, nil
+		}, nil
+	case cssTokenDimension:
+		// An angle is treated as a number in degrees, as the hue keyword is.
+		deg, err := cssAngle(t)
+		if err != nil {
+			return nil, err
+		}
+		return func(float64) (float64, error) {
+			return deg, nil
+		}, nil
+	case cssTokenIdent:
+		var v float64
+		switch t.value {
+		case "pi":
+			v = math.Pi
+		case "e":
+			v = math.E
+		default:
+			var ok bool
+			v, ok = p.channels[t.value]
+			if !ok {
+				return nil, newParseError(ErrUnknownKeyword, t.pos, "unknown keyword %q in calc()", t.value)
+			}
+		}
+		return func(float64) (float64, error) {
+			return v, nil
+		}, nil
+	case cssTokenDelim:
+		if t.value != "(" {
+			break
+		}
+		f, err := p.parseCalcSum()
+		if err != nil {
+			return nil, err
+		}
+		if t := p.next(); t.kind != cssTokenCloseParen {
+			return nil, newParseError(ErrSyntax, t.pos, "expected ')' but %s", t)
+		}
+		return f, nil
+	case cssTokenFunction:
+		if t.value != "calc" {
+			break
+		}
+		f, err := p.parseCalcSum()
+		if err != nil {
+			return nil, err
+		}
+		if t := p.next(); t.kind != cssTokenCloseParen {
+			return nil, newParseError(ErrSyntax, t.pos, "expected ')' but %s", t)
+		}
+		return f, nil
+	}
+	return nil, newParseError(ErrSyntax, t.pos, "unexpected %s in calc()", t)
+}
+
+// skipArgs skips the tokens until the matching closing parenthesis.
+func (p *cssParser) skipArgs() error {
+	depth := 1
+	for {
+		t := p.next()
+		switch t.kind {
+		case cssTokenEOF:
+			return newParseError(ErrSyntax, t.pos, "expected ')' but %s", t)
+		case cssTokenFunction:
+			depth++
+		case cssTokenDelim:
+			if t.value == "(" {
+				depth++
+			}
+		case cssTokenCloseParen:
+			depth--
+			if depth == 0 {
+				return nil
+			}
+		}
+	}
+}
+
+// parseOrigin parses the origin color of the relative color syntax if exists.
+// parseOrigin returns nil if the arguments don't start with from.
+func (p *cssParser) parseOrigin() (*Color, error) {
+	if t := p.peek(); t.kind != cssTokenIdent || t.value != "from" {
+		return nil, nil
+	}
+	p.next()
+
+	// The channel keywords of an outer function are not available in the origin color.
+	channels := p.channels
+	p.channels = nil
+	defer func() {
+		p.channels = channels
+	}()
+
+	c, err := p.parseColor()
+	if err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// setChannels sets the channel keywords for the origin color in the given space.
+// The names are the names of the three components in order.
+func (p *cssParser) setChannels(origin Color, space Space, names [3]string, scale float64) {
+	c0, c1, c2, alpha := origin.Components(space)
+	vs := [3]float64{c0 * scale, c1 * scale, c2 * scale}
+	if space.isCylindrical() {
+		vs[2] = NormalizeHueDeg(AngleUnitDegree.FromRadians(c2))
+	}
+	p.channels = map[string]float64{
+		names[0]: vs[0],
+		names[1]: vs[1],
+		names[2]: vs[2],
+		"alpha":  alpha,
+	}
+}
+
+// cssValue returns the value of a number or a percentage. 100% corresponds to percentRef.
+func cssValue(t cssToken, percentRef float64) (float64, error) {
+	switch t.kind {
+	case cssTokenNumber:
+		return t.number, nil
+	case cssTokenPercentage:
+		return t.number / 100 * percentRef, nil
+	case cssTokenIdent:
+		// none
+		return 0, nil
+	case cssTokenCalc:
+		return t.calc(percentRef)
+	default:
+		return 0, newParseError(ErrSyntax, t.pos, "unexpected %s", t)
+	}
+}
+
+// cssAngle returns the value of an angle dimension in degrees.
+func cssAngle(t cssToken) (float64, error) {
+	u, ok := angleUnitFromCSS(t.value)
+	if !ok {
+		return 0, newParseError(ErrUnknownKeyword, t.pos, "unknown angle unit %q", t.value)
+	}
+	if u == AngleUnitDegree {
+		return t.number, nil
+	}
+	return AngleUnitDegree.FromRadians(u.ToRadians(t.number)), nil
+}
+
+// cssHue returns the value of a hue in radians.
+func cssHue(t cssToken) (float64, error) {
+	switch t.kind {
+	case cssTokenNumber:
+		return AngleUnitDegree.ToRadians(t.number), nil
+	case cssTokenDimension:
+		deg, err := cssAngle(t)
+		if err != nil {
+			return 0, err
+		}
+		return AngleUnitDegree.ToRadians(deg), nil
+	case cssTokenIdent:
+		// none
+		return 0, nil
+	case cssTokenCalc:
+		deg, err := t.calc(0)
+		if err != nil {
+			return 0, err
+		}
+		return AngleUnitDegree.ToRadians(deg), nil
+	default:
+		return 0, newParseError(ErrSyntax, t.pos, "unexpected %s", t)
+	}
+}
+
+// clamp returns v of the component t clamped to [lo, hi].
+// In the strict mode, clamp returns an error if v is outside the range.
+func (p *cssParser) clamp(t cssToken, v, lo, hi float64) (float64, error) {
+	if p.mode == ParseModeStrict && (v < lo || v > hi) {
+		return 0, newParseError(ErrComponentRange, t.pos, "%s is out of range", t)
+	}
+	return min(max(v, lo), hi), nil
+}
+
+// alpha returns the value of an alpha clamped to [0, 1].
+func (p *cssParser) alpha(t cssToken) (float64, error) {
+	a, err := cssValue(t, 1)
+	if err != nil {
+		return 0, err
+	}
+	return p.clamp(t, a, 0, 1)
+}
+
+func (p *cssParser) parseRGB() (Color, error) {
+	origin, err := p.parseOrigin()
+	if err != nil {
+		return Color{}, err
+	}
+	if origin != nil {
+		channels := p.channels
+		defer func() {
+			p.channels = channels
+		}()
+		p.setChannels(*origin, SpaceSRGB, [3]string{"r", "g", "b"}, 255)
+	}
+
+	cs, a, err := p.parseArgs(true)
+	if err != nil {
+		return Color{}, err
+	}
+	var rgb [3]float64
+	for i, t := range cs {
+		v, err := cssValue(t, 255)
+		if err != nil {
+			return Color{}, err
+		}
+		if v, err = p.clamp(t, v, 0, 255); err != nil {
+			return Color{}, err
+		}
+		rgb[i] = v / 255
+	}
+	alpha, err := p.alpha(a)
+	if err != nil {
+		return Color{}, err
+	}
+	return ColorFromSRGB(rgb[0], rgb[1], rgb[2], alpha), nil
+}
+
+// parseLab parses the arguments of lab() or oklab().
+func (p *cssParser) parseLab(space Space) (Color, error) {
+	origin, err := p.parseOrigin()
+	if err != nil {
+		return Color{}, err
+	}
+	if origin != nil {
+		channels := p.channels
+		defer func() {
+			p.channels = channels
+		}()
+		p.setChannels(*origin, space, [3]string{"l", "a", "b"}, 1)
+	}
+
+	cs, a, err := p.parseArgs(false)
+	if err != nil {
+		return Color{}, err
+	}
+	// The reference ranges for percentages.
+	lRef, abRef := 100.0, 125.0
+	if space == SpaceOKLab {
+		lRef, abRef = 1, 0.4
+	}
+	l, err := cssValue(cs[0], lRef)
+	if err != nil {
+		return Color{}, err
+	}
+	if l, err = p.clamp(cs[0], l, 0, lRef); err != nil {
+		return Color{}, err
+	}
+	aa, err := cssValue(cs[1], abRef)
+	if err != nil {
+		return Color{}, err
+	}
+	b, err := cssValue(cs[2], abRef)
+	if err != nil {
+		return Color{}, err
+	}
+	alpha, err := p.alpha(a)
+	if err != nil {
+		return Color{}, err
+	}
+	return ColorFromComponents(space, l, aa, b, alpha), nil
+}
+
+// parseLch parses the arguments of lch() or oklch().
+func (p *cssParser) parseLch(space Space) (Color, error) {
+	origin, err := p.parseOrigin()
+	if err != nil {
+		return Color{}, err
+	}
+	if origin != nil {
+		channels := p.channels
+		defer func() {
+			p.channels = channels
+		}()
+		p.setChannels(*origin, space, [3]string{"l", "c", "h"}, 1)
+	}
+
+	cs, a, err := p.parseArgs(false)
+	if err != nil {
+		return Color{}, err
+	}
+	// The reference ranges for percentages.
+	lRef, cRef := 100.0, 150.0
+	if space == SpaceOKLch {
+		lRef, cRef = 1, 0.4
+	}
+	l, err := cssValue(cs[0], lRef)
+	if err != nil {
+		return Color{}, err
+	}
+	if l, err = p.clamp(cs[0], l, 0, lRef); err != nil {
+		return Color{}, err
+	}
+	c, err := cssValue(cs[1], cRef)
+	if err != nil {
+		return Color{}, err
+	}
+	if c, err = p.clamp(cs[1], c, 0, math.Inf(1)); err != nil {
+		return Color{}, err
+	}
+	h, err := cssHue(cs[2])
+	if err != nil {
+		return Color{}, err
+	}
+	alpha, err := p.alpha(a)
+	if err != nil {
+		return Color{}, err
+	}
+	return ColorFromComponents(space, l, c, h, alpha), nil
+}
+
+// cssPredefinedSpaces is the predefined color spaces of color().
+var cssPredefinedSpaces = map[string]Space{
+	"srgb":         SpaceSRGB,
+	"srgb-linear":  SpaceLinearSRGB,
+	"display-p3":   SpaceDisplayP3,
+	"a98-rgb":      SpaceA98RGB,
+	"prophoto-rgb": SpaceProPhotoRGB,
+	"rec2020":      SpaceRec2020,
+	"xyz":          SpaceXYZ,
+	"xyz-d50":      SpaceXYZD50,
+	"xyz-d65":      SpaceXYZ,
+}
+
+func (p *cssParser) parseColorFunction() (Color, error) {
+	origin, err := p.parseOrigin()
+	if err != nil {
+		return Color{}, err
+	}
+
+	t := p.next()
+	if t.kind != cssTokenIdent {
+		return Color{}, newParseError(ErrSyntax, t.pos, "expected a color space but %s", t)
+	}
+	space, ok := cssPredefinedSpaces[t.value]
+	if !ok {
+		return Color{}, newParseError(ErrUnknownSpace, t.pos, "unknown color space %q", t.value)
+	}
+	if origin != nil {
+		channels := p.channels
+		defer func() {
+			p.channels = channels
+		}()
+		names := [3]string{"r", "g", "b"}
+		if space == SpaceXYZ || space == SpaceXYZD50 {
+			names = [3]string{"x", "y", "z"}
+		}
+		p.setChannels(*origin, space, names, 1)
+	}
+
+	cs, a, err := p.parseArgs(false)
+	if err != nil {
+		return Color{}, err
+	}
+	var vs [3]float64
+	for i, t := range cs {
+		v, err := cssValue(t, 1)
+		if err != nil {
+			return Color{}, err
+		}
+		vs[i] = v
+	}
+	alpha, err := p.alpha(a)
+	if err != nil {
+		return Color{}, err
+	}
+	return ColorFromComponents(space, vs[0], vs[1], vs[2], alpha), nil
+}
+
+// cssHueInterpolations is the hue interpolation methods of color-mix().
+var cssHueInterpolations = map[string]HueInterpolation{
+	"shorter":    HueInterpolationShorter,
+	"longer":     HueInterpolationLonger,
+	"increasing": HueInterpolationIncreasing,
+	"decreasing": HueInterpolationDecreasing,
+}
+
+// cssInterpolationSpace returns the color space of the name for color-mix().
+func cssInterpolationSpace(name string) (Space, bool) {
+	switch name {
+	case "lab":
+		return SpaceLab, true
+	case "lch":
+		return SpaceLch, true
+	case "oklab":
+		return SpaceOKLab, true
+	case "oklch":
+		return SpaceOKLch, true
+	}
+	s, ok := cssPredefinedSpaces[name]
+	return s, ok
+}
+
+// parseColorMix parses the arguments of color-mix().
+//
+// See https://www.w3.org/TR/css-color-5/#color-mix
+func (p *cssParser) parseColorMix(pos int) (Color, error) {
+	// The interpolation space is OKLab by default.
+	space := SpaceOKLab
+	hue := HueInterpolationShorter
+	if t := p.peek(); t.kind == cssTokenIdent && t.value == "in" {
+		p.next()
+		t := p.next()
+		if t.kind != cssTokenIdent {
+			return Color{}, newParseError(ErrSyntax, t.pos, "expected a color space but %s", t)
+		}
+		s, ok := cssInterpolationSpace(t.value)
+		if !ok {
+			return Color{}, newParseError(ErrUnknownSpace, t.pos, "unknown color space %q", t.value)
+		}
+		space = s
+
+		if t := p.peek(); t.kind == cssTokenIdent {
+			h, ok := cssHueInterpolations[t.value]
+			if !ok || !space.isCylindrical() {
+				return Color{}, newParseError(ErrSyntax, t.pos, "unexpected %s", t)
+			}
+			p.next()
+			if t := p.next(); t.kind != cssTokenIdent || t.value != "hue" {
+				return Color{}, newParseError(ErrSyntax, t.pos, "expected 'hue' but %s", t)
+			}
+			hue = h
+		}
+		if t := p.next(); t.kind != cssTokenComma {
+			return Color{}, newParseError(ErrSyntax, t.pos, "expected ',' but %s", t)
+		}
+	}
+
+	c0, p0, err := p.parseMixComponent()
+	if err != nil {
+		return Color{}, err
+	}
+	if t := p.next(); t.kind != cssTokenComma {
+		return Color{}, newParseError(ErrSyntax, t.pos, "expected ',' but %s", t)
+	}
+	c1, p1, err := p.parseMixComponent()
+	if err != nil {
+		return Color{}, err
+	}
+	if t := p.next(); t.kind != cssTokenCloseParen {
+		return Color{}, newParseError(ErrSyntax, t.pos, "expected ')' but %s", t)
+	}
+
+	// Normalize the percentages.
+	switch {
+	case math.IsNaN(p0) && math.IsNaN(p1):
+		p0, p1 = 0.5, 0.5
+	case math.IsNaN(p0):
+		p0 = 1 - p1
+	case math.IsNaN(p1):
+		p1 = 1 - p0
+	}
+	if p0 < 0 || p1 < 0 || p0 > 1 || p1 > 1 {
+		return Color{}, newParseError(ErrComponentRange, pos, "the percentages of color-mix() must be in [0%%, 100%%]")
+	}
+	sum := p0 + p1
+	if sum == 0 {
+		return Color{}, newParseError(ErrComponentRange, pos, "the sum of the percentages of color-mix() must not be 0%%")
+	}
+	c := MixWithHueInterpolation(c0, c1, p1/sum, space, hue)
+	if sum < 1 {
+		c = c.WithAlpha(c.Alpha() * sum)
+	}
+	return c, nil
+}
+
+// parseMixComponent parses a color and an optional percentage before or after it in color-mix().
+// The percentage is NaN if omitted.
+func (p *cssParser) parseMixComponent() (Color, float64, error) {
+	percentage := math.NaN()
+	if t := p.peek(); t.kind == cssTokenPercentage {
+		p.next()
+		percentage = t.number / 100
+	}
+	c, err := p.parseColor()
+	if err != nil {
+		return Color{}, 0, err
+	}
+	if t := p.peek(); t.kind == cssTokenPercentage && math.IsNaN(percentage) {
+		p.next()
+		percentage = t.number / 100
+	}
+	return c, percentage, nil
+}
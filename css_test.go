@@ -0,0 +1,408 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestParseCSS(t *testing.T) {
+	testCases := []struct {
+		in   string
+		want iro.Color
+	}{
+		{in: "#f80", want: iro.ColorFromSRGB(1, 0x88/255.0, 0, 1)},
+		{in: "#f808", want: iro.ColorFromSRGB(1, 0x88/255.0, 0, 0x88/255.0)},
+		{in: "#FF8000", want: iro.ColorFromSRGB(1, 0x80/255.0, 0, 1)},
+		{in: "#ff800080", want: iro.ColorFromSRGB(1, 0x80/255.0, 0, 0x80/255.0)},
+		{in: "transparent", want: iro.ColorFromSRGB(0, 0, 0, 0)},
+		{in: "cornflowerblue", want: iro.ColorFromSRGB(0x64/255.0, 0x95/255.0, 0xed/255.0, 1)},
+		{in: "RebeccaPurple", want: iro.ColorFromSRGB(0x66/255.0, 0x33/255.0, 0x99/255.0, 1)},
+		{in: "color-mix(in srgb, red, blue)", want: iro.ColorFromSRGB(0.5, 0, 0.5, 1)},
+		{in: "rgb(255 128 0)", want: iro.ColorFromSRGB(1, 128/255.0, 0, 1)},
+		{in: "rgb(255, 128, 0)", want: iro.ColorFromSRGB(1, 128/255.0, 0, 1)},
+		{in: "rgba(255, 128, 0, 0.5)", want: iro.ColorFromSRGB(1, 128/255.0, 0, 0.5)},
+		{in: "RGB(100% 50% 0% / 50%)", want: iro.ColorFromSRGB(1, 0.5, 0, 0.5)},
+		{in: "rgb(300 -10 none)", want: iro.ColorFromSRGB(1, 0, 0, 1)},
+		{in: "lab(50 20 -30)", want: iro.ColorFromLab(50, 20, -30, 1)},
+		{in: "lab(50% 16% -24% / 0.25)", want: iro.ColorFromLab(50, 20, -30, 0.25)},
+		{in: "lch(50 30 120deg)", want: iro.ColorFromLch(50, 30, 120*math.Pi/180, 1)},
+		{in: "oklab(0.7 0.1 -0.05)", want: iro.ColorFromOKLab(0.7, 0.1, -0.05, 1)},
+		{in: "oklab(70% 25% -12.5%)", want: iro.ColorFromOKLab(0.7, 0.1, -0.05, 1)},
+		{in: "oklch(0.7 0.15 60)", want: iro.ColorFromOKLch(0.7, 0.15, 60*math.Pi/180, 1)},
+		{in: "oklch(70% 37.5% 60deg / .5)", want: iro.ColorFromOKLch(0.7, 0.15, 60*math.Pi/180, 0.5)},
+		{in: "oklch(1.5 -0.1 none)", want: iro.ColorFromOKLch(1, 0, 0, 1)},
+		{in: "oklch(0.7 0.15 100grad)", want: iro.ColorFromOKLch(0.7, 0.15, math.Pi/2, 1)},
+		{in: "oklch(0.7 0.15 1.5rad)", want: iro.ColorFromOKLch(0.7, 0.15, 1.5, 1)},
+		{in: "oklch(0.7 0.15 0.25TURN)", want: iro.ColorFromOKLch(0.7, 0.15, math.Pi/2, 1)},
+		{in: "lch(50 30 -0.5turn)", want: iro.ColorFromLch(50, 30, math.Pi, 1)},
+		{in: "color(srgb 1 0.5 0)", want: iro.ColorFromSRGB(1, 0.5, 0, 1)},
+		{in: "color(srgb-linear 100% 50% 0%)", want: iro.ColorFromLinearSRGB(1, 0.5, 0, 1)},
+		{in: "color(display-p3 1 0.5 0 / 0.5)", want: iro.ColorFromDisplayP3(1, 0.5, 0, 0.5)},
+		{in: "color(a98-rgb 0.2 0.4 0.6)", want: iro.ColorFromA98RGB(0.2, 0.4, 0.6, 1)},
+		{in: "color(prophoto-rgb 0.2 0.4 0.6)", want: iro.ColorFromProPhotoRGB(0.2, 0.4, 0.6, 1)},
+		{in: "color(rec2020 0.2 0.4 0.6)", want: iro.ColorFromRec2020(0.2, 0.4, 0.6, 1)},
+		{in: "color(xyz 0.2 0.4 0.6)", want: iro.ColorFromXYZ(0.2, 0.4, 0.6, 1)},
+		{in: "color(xyz-d65 0.2 0.4 0.6)", want: iro.ColorFromXYZ(0.2, 0.4, 0.6, 1)},
+		{in: "color(xyz-d50 0.2 0.4 0.6)", want: iro.ColorFromXYZD50(0.2, 0.4, 0.6, 1)},
+		{in: "  color( rec2020 1e-1 .5 +0.6 )  ", want: iro.ColorFromRec2020(0.1, 0.5, 0.6, 1)},
+	}
+	for _, tc := range testCases {
+		got, err := iro.ParseCSS(tc.in)
+		if err != nil {
+			t.Errorf("ParseCSS(%q): %v", tc.in, err)
+			continue
+		}
+		x0, y0, z0, a0 := tc.want.XYZ()
+		x1, y1, z1, a1 := got.XYZ()
+		if !checkTol(x1, x0) || !checkTol(y1, y0) || !checkTol(z1, z0) || !checkTol(a1, a0) {
+			t.Errorf("ParseCSS(%q): got XYZ (%f, %f, %f, %f), want (%f, %f, %f, %f)", tc.in, x1, y1, z1, a1, x0, y0, z0, a0)
+		}
+	}
+}
+
+func TestParseCSSErrors(t *testing.T) {
+	for _, in := range []string{
+		"",
+		"#12",
+		"#12345",
+		"#gggggg",
+		"foo",
+		"rgb(1 2)",
+		"rgb(1 2 3 4)",
+		"rgb(1, 2 3)",
+		"rgb(1 2 3, 0.5)",
+		"rgb(1 2 3",
+		"lab(50, 20, -30)",
+		"oklch(0.7 0.1 60foo)",
+		"color(foo 1 2 3)",
+		"color(srgb 1 2 3) x",
+		"hsl(0 100% 50%)",
+		"rgb(r g b)",
+		"oklch(from #ff8000 l c x)",
+		"oklch(from #ff8000 l c calc(h + ))",
+		"oklch(from #ff8000 l c calc(h + 1)",
+		"rgb(from #ff8000 r, g, b)",
+	} {
+		if _, err := iro.ParseCSS(in); err == nil {
+			t.Errorf("ParseCSS(%q) must return an error", in)
+		}
+	}
+}
+
+func TestParseCSSRelativeColor(t *testing.T) {
+	deg := math.Pi / 180
+	orange := iro.ColorFromSRGB(1, 0x80/255.0, 0, 1)
+	ol, oc, oh, _ := orange.OKLch()
+
+	testCases := []struct {
+		in   string
+		want iro.Color
+	}{
+		{in: "rgb(from #ff8000 r g b)", want: orange},
+		{in: "rgb(from #ff8000 b g r / 0.5)", want: iro.ColorFromSRGB(0, 0x80/255.0, 1, 0.5)},
+		{in: "rgb(from #ff8000 calc(r / 2) g 255)", want: iro.ColorFromSRGB(0.5, 0x80/255.0, 1, 1)},
+		{in: "rgb(from #ff800080 r g b)", want: iro.ColorFromSRGB(1, 0x80/255.0, 0, 0x80/255.0)},
+		{in: "oklch(from #ff8000 l c h)", want: orange},
+		{in: "oklch(from #ff8000 l c calc(h + 180))", want: iro.ColorFromOKLch(ol, oc, oh+180*deg, 1)},
+		{in: "oklch(from #ff8000 l c calc(h + 180deg))", want: iro.ColorFromOKLch(ol, oc, oh+180*deg, 1)},
+		{in: "oklch(from #ff8000 l c calc(h + 0.5turn))", want: iro.ColorFromOKLch(ol, oc, oh+180*deg, 1)},
+		{in: "oklch(from #ff8000 calc(l * 0.5) calc(c * (1 + 1)) 30)", want: iro.ColorFromOKLch(ol*0.5, oc*2, 30*deg, 1)},
+		{in: "oklch(from #ff8000 calc(l - 10%) c h / calc(alpha / 2))", want: iro.ColorFromOKLch(ol-0.1, oc, oh, 0.5)},
+		{in: "lab(from oklch(from #ff8000 l c h) l a b)", want: orange},
+		{in: "color(from #ff8000 srgb r g b)", want: orange},
+		{in: "color(from #ff8000 xyz x y z)", want: orange},
+		{in: "color(from #ff8000 display-p3 r g b)", want: orange},
+	}
+	for _, tc := range testCases {
+		got, err := iro.ParseCSS(tc.in)
+		if err != nil {
+			t.Errorf("ParseCSS(%q): %v", tc.in, err)
+			continue
+		}
+		x0, y0, z0, a0 := tc.want.XYZ()
+		x1, y1, z1, a1 := got.XYZ()
+		if !checkTol(x1, x0) || !checkTol(y1, y0) || !checkTol(z1, z0) || !checkTol(a1, a0) {
+			t.Errorf("ParseCSS(%q): got XYZ (%f, %f, %f, %f), want (%f, %f, %f, %f)", tc.in, x1, y1, z1, a1, x0, y0, z0, a0)
+		}
+	}
+}
+
+func TestParseCSSRelative(t *testing.T) {
+	deg := math.Pi / 180
+	base := iro.ColorFromOKLch(0.6, 0.1, 250*deg, 1)
+
+	testCases := []struct {
+		in   string
+		want iro.Color
+	}{
+		{in: "currentcolor", want: base},
+		{in: "var(--base)", want: base},
+		{in: "oklch(from var(--base) l c calc(h + 180deg))", want: iro.ColorFromOKLch(0.6, 0.1, 70*deg, 1)},
+		{in: "oklch(from currentcolor 0.9 calc(c / 4) h)", want: iro.ColorFromOKLch(0.9, 0.025, 250*deg, 1)},
+	}
+	for _, tc := range testCases {
+		got, err := iro.ParseCSSRelative(tc.in, base)
+		if err != nil {
+			t.Errorf("ParseCSSRelative(%q): %v", tc.in, err)
+			continue
+		}
+		x0, y0, z0, a0 := tc.want.XYZ()
+		x1, y1, z1, a1 := got.XYZ()
+		if !checkTol(x1, x0) || !checkTol(y1, y0) || !checkTol(z1, z0) || !checkTol(a1, a0) {
+			t.Errorf("ParseCSSRelative(%q): got XYZ (%f, %f, %f, %f), want (%f, %f, %f, %f)", tc.in, x1, y1, z1, a1, x0, y0, z0, a0)
+		}
+	}
+
+	// Without a base, currentcolor and var() are not available.
+	for _, in := range []string{"currentcolor", "oklch(from var(--base) l c h)"} {
+		if _, err := iro.ParseCSS(in); err == nil {
+			t.Errorf("ParseCSS(%q) must return an error", in)
+		}
+	}
+}
+
+func TestParseCSSColorMix(t *testing.T) {
+	red := iro.ColorFromSRGB(1, 0, 0, 1)
+	blue := iro.ColorFromSRGB(0, 0, 1, 1)
+
+	testCases := []struct {
+		in   string
+		want iro.Color
+	}{
+		{in: "color-mix(in srgb, #f00, #00f)", want: iro.ColorFromSRGB(0.5, 0, 0.5, 1)},
+		{in: "color-mix(in srgb, #f00 30%, #00f)", want: iro.ColorFromSRGB(0.3, 0, 0.7, 1)},
+		{in: "color-mix(in srgb, 30% #f00, #00f)", want: iro.ColorFromSRGB(0.3, 0, 0.7, 1)},
+		{in: "color-mix(in srgb, #f00, #00f 30%)", want: iro.ColorFromSRGB(0.7, 0, 0.3, 1)},
+		{in: "color-mix(in srgb, #f00 60%, #00f 60%)", want: iro.ColorFromSRGB(0.5, 0, 0.5, 1)},
+		{in: "color-mix(in srgb, #f00 20%, #00f 20%)", want: iro.ColorFromSRGB(0.5, 0, 0.5, 0.4)},
+		{in: "color-mix(in srgb-linear, #f00, #00f)", want: iro.ColorFromLinearSRGB(0.5, 0, 0.5, 1)},
+		{in: "color-mix(#f00, #00f)", want: iro.Mix(red, blue, 0.5, iro.SpaceOKLab)},
+		{in: "color-mix(in oklch, #f00, #00f)", want: iro.Mix(red, blue, 0.5, iro.SpaceOKLch)},
+		{in: "color-mix(in oklch longer hue, #f00 30%, #00f)", want: iro.MixWithHueInterpolation(red, blue, 0.7, iro.SpaceOKLch, iro.HueInterpolationLonger)},
+		{in: "color-mix(in lch decreasing hue, #f00, #00f)", want: iro.MixWithHueInterpolation(red, blue, 0.5, iro.SpaceLch, iro.HueInterpolationDecreasing)},
+		{in: "color-mix(in srgb, #f00, transparent)", want: iro.ColorFromSRGB(1, 0, 0, 0.5)},
+		{in: "color-mix(in srgb, color-mix(in srgb, #f00, #00f), #000)", want: iro.ColorFromSRGB(0.25, 0, 0.25, 1)},
+	}
+	for _, tc := range testCases {
+		in := tc.in
+		got, err := iro.ParseCSS(in)
+		if err != nil {
+			t.Errorf("ParseCSS(%q): %v", in, err)
+			continue
+		}
+		x0, y0, z0, a0 := tc.want.XYZ()
+		x1, y1, z1, a1 := got.XYZ()
+		if !checkTol(x1, x0) || !checkTol(y1, y0) || !checkTol(z1, z0) || !checkTol(a1, a0) {
+			t.Errorf("ParseCSS(%q): got XYZ (%f, %f, %f, %f), want (%f, %f, %f, %f)", in, x1, y1, z1, a1, x0, y0, z0, a0)
+		}
+	}
+
+	for _, in := range []string{
+		"color-mix(in srgb #f00, #00f)",
+		"color-mix(in foo, #f00, #00f)",
+		"color-mix(in srgb longer hue, #f00, #00f)",
+		"color-mix(in oklch longer, #f00, #00f)",
+		"color-mix(in srgb, #f00 0%, #00f 0%)",
+		"color-mix(in srgb, #f00 120%, #00f)",
+		"color-mix(in srgb, #f00)",
+	} {
+		if _, err := iro.ParseCSS(in); err == nil {
+			t.Errorf("ParseCSS(%q) must return an error", in)
+		}
+	}
+}
+
+func TestParseCSSError(t *testing.T) {
+	testCases := []struct {
+		in  string
+		err error
+		pos int
+	}{
+		{in: "foo(1 2 3)", err: iro.ErrUnknownFunction, pos: 0},
+		{in: "rgb(255 0 0) foo", err: iro.ErrSyntax, pos: 13},
+		{in: "rgb(255 0", err: iro.ErrSyntax, pos: 9},
+		{in: "  bogus", err: iro.ErrUnknownKeyword, pos: 2},
+		{in: "oklch(0.7 0.1 3foo)", err: iro.ErrUnknownKeyword, pos: 14},
+		{in: "#12345", err: iro.ErrInvalidHex, pos: 0},
+		{in: "color(foo 1 0 0)", err: iro.ErrUnknownSpace, pos: 6},
+		{in: "color-mix(in srgb, #f00 120%, #00f)", err: iro.ErrComponentRange, pos: 0},
+		{in: "currentcolor", err: iro.ErrUnknownKeyword, pos: 0},
+	}
+	for _, tc := range testCases {
+		_, err := iro.ParseCSS(tc.in)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("ParseCSS(%q): got %v, want %v", tc.in, err, tc.err)
+			continue
+		}
+		var e *iro.ParseError
+		if !errors.As(err, &e) {
+			t.Errorf("ParseCSS(%q): got %T, want *iro.ParseError", tc.in, err)
+			continue
+		}
+		if e.Pos != tc.pos {
+			t.Errorf("ParseCSS(%q): position: got %d, want %d", tc.in, e.Pos, tc.pos)
+		}
+		if e.Input != tc.in {
+			t.Errorf("ParseCSS(%q): input: got %q", tc.in, e.Input)
+		}
+	}
+}
+
+func TestParseCSSStrict(t *testing.T) {
+	opts := &iro.ParseOptions{Mode: iro.ParseModeStrict}
+
+	// Valid colors are parsed as the default mode does.
+	for _, in := range []string{"#ff8000", "rgb(255, 128, 0)", "rgb(100%, 50%, 0%)", "rgb(255 128 0 / 50%)", "oklch(0.7 0.15 60)", "color(display-p3 1.2 0 0)"} {
+		got, err := iro.ParseCSSWithOptions(in, opts)
+		if err != nil {
+			t.Errorf("ParseCSSWithOptions(%q): %v", in, err)
+			continue
+		}
+		want, err := iro.ParseCSS(in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Errorf("ParseCSSWithOptions(%q): got %v, want %v", in, got, want)
+		}
+	}
+
+	testCases := []struct {
+		in  string
+		err error
+		pos int
+	}{
+		{in: "rgb(300 0 0)", err: iro.ErrComponentRange, pos: 4},
+		{in: "rgb(255 0 -1)", err: iro.ErrComponentRange, pos: 10},
+		{in: "rgb(255 0 0 / 1.5)", err: iro.ErrComponentRange, pos: 14},
+		{in: "lab(120 0 0)", err: iro.ErrComponentRange, pos: 4},
+		{in: "oklch(0.5 -0.1 30)", err: iro.ErrComponentRange, pos: 10},
+		{in: "rgb(255, 50%, 0)", err: iro.ErrSyntax, pos: 9},
+		{in: "rgb(255, none, 0)", err: iro.ErrSyntax, pos: 9},
+		{in: "FFAA00", err: iro.ErrUnknownKeyword, pos: 0},
+	}
+	for _, tc := range testCases {
+		// The default mode accepts them.
+		if !strings.HasPrefix(tc.in, "FF") {
+			if _, err := iro.ParseCSS(tc.in); err != nil {
+				t.Errorf("ParseCSS(%q): %v", tc.in, err)
+			}
+		}
+
+		_, err := iro.ParseCSSWithOptions(tc.in, opts)
+		var e *iro.ParseError
+		if !errors.As(err, &e) || !errors.Is(err, tc.err) {
+			t.Errorf("ParseCSSWithOptions(%q): got %v, want %v", tc.in, err, tc.err)
+			continue
+		}
+		if e.Pos != tc.pos {
+			t.Errorf("ParseCSSWithOptions(%q): position: got %d, want %d", tc.in, e.Pos, tc.pos)
+		}
+	}
+}
+
+func TestParseCSSLenient(t *testing.T) {
+	opts := &iro.ParseOptions{Mode: iro.ParseModeLenient}
+	testCases := []struct {
+		in   string
+		want string
+	}{
+		{in: "FFAA00", want: "#ffaa00"},
+		{in: " fa0 ", want: "#ffaa00"},
+		{in: "80ff0080", want: "#80ff0080"},
+		{in: "decade", want: "#decade"},
+		{in: "0ff", want: "#00ffff"},
+		{in: "#ffaa00;", want: "#ffaa00"},
+		{in: "rgb (255 170 0)", want: "#ffaa00"},
+		{in: "RGB(255, 170 0)", want: "#ffaa00"},
+		{in: "rgb(255 170 0 0.5)", want: "#ffaa0080"},
+		{in: "rgb(255 170 0, 50%)", want: "#ffaa0080"},
+		{in: "rgb(255, 170, 0, 0.5)", want: "#ffaa0080"},
+		{in: "oklch(1, 0, 0)", want: "#ffffff"},
+		{in: "color(srgb 1, 0.666667, 0 / 0.5);", want: "#ffaa0080"},
+	}
+	for _, tc := range testCases {
+		c, err := iro.ParseCSSWithOptions(tc.in, opts)
+		if err != nil {
+			t.Errorf("ParseCSSWithOptions(%q): %v", tc.in, err)
+			continue
+		}
+		if got := c.Hex(); got != tc.want {
+			t.Errorf("ParseCSSWithOptions(%q): got %s, want %s", tc.in, got, tc.want)
+		}
+	}
+
+	for _, in := range []string{"FFAA0", "rgb(255 170)", "rgb(255 170 0 0.5 1)", "oklch(1 0 0 0 / 1)"} {
+		if _, err := iro.ParseCSSWithOptions(in, opts); err == nil {
+			t.Errorf("ParseCSSWithOptions(%q) must return an error", in)
+		}
+	}
+
+	// Words of 3 or 4 letters of a-f are keywords rather than hexadecimal colors.
+	for _, in := range []string{"bad", "dead", "BEEF", "cafe", "face"} {
+		if _, err := iro.ParseCSSWithOptions(in, opts); !errors.Is(err, iro.ErrUnknownKeyword) {
+			t.Errorf("ParseCSSWithOptions(%q): got %v, want %v", in, err, iro.ErrUnknownKeyword)
+		}
+	}
+}
+
+func TestParseAngle(t *testing.T) {
+	testCases := []struct {
+		in   string
+		want float64
+	}{
+		{in: "90deg", want: math.Pi / 2},
+		{in: "90", want: math.Pi / 2},
+		{in: "100grad", want: math.Pi / 2},
+		{in: "0.25turn", want: math.Pi / 2},
+		{in: "1.5rad", want: 1.5},
+	}
+	for _, tc := range testCases {
+		got, err := iro.ParseAngle(tc.in)
+		if err != nil {
+			t.Errorf("ParseAngle(%q): %v", tc.in, err)
+			continue
+		}
+		if !checkTol(got, tc.want) {
+			t.Errorf("ParseAngle(%q): got %f, want %f", tc.in, got, tc.want)
+		}
+	}
+
+	errorCases := []struct {
+		in  string
+		pos int
+	}{
+		{in: "", pos: 0},
+		{in: "90deg 10deg", pos: 6},
+		{in: "none", pos: 0},
+	}
+	for _, tc := range errorCases {
+		_, err := iro.ParseAngle(tc.in)
+		var e *iro.ParseError
+		if !errors.As(err, &e) || !errors.Is(err, iro.ErrSyntax) {
+			t.Errorf("ParseAngle(%q): got %v, want a *ParseError of %v", tc.in, err, iro.ErrSyntax)
+			continue
+		}
+		if e.Pos != tc.pos || e.Input != tc.in {
+			t.Errorf("ParseAngle(%q): got position %d and input %q, want %d and %q", tc.in, e.Pos, e.Input, tc.pos, tc.in)
+		}
+	}
+}
+
+func TestParseCSSWithOptionsBase(t *testing.T) {
+	base := iro.ColorFromSRGB(1, 0, 0, 1)
+	got, err := iro.ParseCSSWithOptions("rgb (from currentcolor r g b / 0.5)", &iro.ParseOptions{Mode: iro.ParseModeLenient, Base: &base})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Hex() != "#ff000080" {
+		t.Errorf("got %s, want #ff000080", got.Hex())
+	}
+}
@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestCSSNamedColor(t *testing.T) {
+	testCases := []struct {
+		name string
+		want string
+	}{
+		{name: "rebeccapurple", want: "#663399"},
+		{name: "CornflowerBlue", want: "#6495ed"},
+		{name: "green", want: "#008000"},
+		{name: "grey", want: "#808080"},
+	}
+	for _, tc := range testCases {
+		c, ok := iro.CSSNamedColor(tc.name)
+		if !ok {
+			t.Errorf("CSSNamedColor(%q): not found", tc.name)
+			continue
+		}
+		if got := c.Hex(); got != tc.want {
+			t.Errorf("CSSNamedColor(%q): got %s, want %s", tc.name, got, tc.want)
+		}
+	}
+	for _, name := range []string{"transparent", "currentcolor", "bogus", ""} {
+		if _, ok := iro.CSSNamedColor(name); ok {
+			t.Errorf("CSSNamedColor(%q) must not be found", name)
+		}
+	}
+}
+
+func TestCSSColorNames(t *testing.T) {
+	names := iro.CSSColorNames()
+	if got, want := len(names), 148; got != want {
+		t.Errorf("len: got %d, want %d", got, want)
+	}
+	if !slices.IsSorted(names) {
+		t.Errorf("the names must be sorted")
+	}
+	for _, name := range names {
+		c, ok := iro.CSSNamedColor(name)
+		if !ok {
+			t.Errorf("CSSNamedColor(%q): not found", name)
+			continue
+		}
+		if got, err := iro.ParseCSS(name); err != nil || got != c {
+			t.Errorf("ParseCSS(%q): got %v, %v, want %v", name, got, err, c)
+		}
+	}
+
+	// Modifying the result doesn't affect the table.
+	names[0] = "foo"
+	if iro.CSSColorNames()[0] == "foo" {
+		t.Errorf("CSSColorNames must return a copy")
+	}
+}
@@ -0,0 +1,187 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"fmt"
+	"image"
+	"math"
+	"slices"
+)
+
+// CurvePoint is a control point of a Curve.
+type CurvePoint struct {
+	X float64
+	Y float64
+}
+
+// Curve is a tone curve passing through control points.
+//
+// Curve is interpolated with a monotone cubic spline (Fritsch-Carlson),
+// so the curve doesn't overshoot between control points.
+type Curve struct {
+	xs []float64
+	ys []float64
+	ms []float64
+}
+
+// NewCurve creates a Curve passing through the given points.
+//
+// NewCurve panics if there are fewer than two points or two points have the same X.
+func NewCurve(points []CurvePoint) *Curve {
+	if len(points) < 2 {
+		panic(fmt.Sprintf("iro: a curve requires at least two points but %d", len(points)))
+	}
+	ps := slices.Clone(points)
+	slices.SortFunc(ps, func(a, b CurvePoint) int {
+		switch {
+		case a.X < b.X:
+			return -1
+		case a.X > b.X:
+			return 1
+		}
+		return 0
+	})
+
+	n := len(ps)
+	c := &Curve{
+		xs: make([]float64, n),
+		ys: make([]float64, n),
+		ms: make([]float64, n),
+	}
+	for i, p := range ps {
+		if i > 0 && p.X == ps[i-1].X {
+			panic(fmt.Sprintf("iro: two points of a curve have the same X: %f", p.X))
+		}
+		c.xs[i] = p.X
+		c.ys[i] = p.Y
+	}
+
+	// Compute the tangents. See https://en.wikipedia.org/wiki/Monotone_cubic_interpolation
+	deltas := make([]float64, n-1)
+	for i := range deltas {
+		deltas[i] = (c.ys[i+1] - c.ys[i]) / (c.xs[i+1] - c.xs[i])
+	}
+	c.ms[0] = deltas[0]
+	c.ms[n-1] = deltas[n-2]
+	for i := 1; i < n-1; i++ {
+		if deltas[i-1]*deltas[i] <= 0 {
+			c.ms[i] = 0
+			continue
+		}
+		c.ms[i] = (deltas[i-1] + deltas[i]) / 2
+	}
+	for i, d := range deltas {
+		if d == 0 {
+			c.ms[i] = 0
+			c.ms[i+1] = 0
+			continue
+		}
+		a := c.ms[i] / d
+		b := c.ms[i+1] / d
+		if s := a*a + b*b; s > 9 {
+			t := 3 / math.Sqrt(s)
+			c.ms[i] = t * a * d
+			c.ms[i+1] = t * b * d
+		}
+	}
+	return c
+}
+
+// At returns the value of the curve at x.
+// Outside the control points, the values of the first and the last points are returned.
+func (c *Curve) At(x float64) float64 {
+	n := len(c.xs)
+	if x <= c.xs[0] {
+		return c.ys[0]
+	}
+	if x >= c.xs[n-1] {
+		return c.ys[n-1]
+	}
+	i, found := slices.BinarySearch(c.xs, x)
+	if found {
+		return c.ys[i]
+	}
+	i--
+
+	h := c.xs[i+1] - c.xs[i]
+	t := (x - c.xs[i]) / h
+	t2 := t * t
+	t3 := t2 * t
+	h00 := 2*t3 - 3*t2 + 1
+	h10 := t3 - 2*t2 + t
+	h01 := -2*t3 + 3*t2
+	h11 := t3 - t2
+	return h00*c.ys[i] + h10*h*c.ms[i] + h01*c.ys[i+1] + h11*h*c.ms[i+1]
+}
+
+// Levels is a levels adjustment for one channel.
+type Levels struct {
+	// InputBlack and InputWhite are the input values mapped to OutputBlack and OutputWhite.
+	// Input values outside the range are clamped.
+	InputBlack float64
+	InputWhite float64
+
+	// Gamma is the gamma of the midtones. A value greater than 1 brightens the midtones.
+	Gamma float64
+
+	// OutputBlack and OutputWhite are the output range.
+	OutputBlack float64
+	OutputWhite float64
+}
+
+// NewLevels creates a Levels with the given input points and gamma, and the output range [0, 1].
+func NewLevels(inputBlack, inputWhite, gamma float64) *Levels {
+	return &Levels{
+		InputBlack:  inputBlack,
+		InputWhite:  inputWhite,
+		Gamma:       gamma,
+		OutputBlack: 0,
+		OutputWhite: 1,
+	}
+}
+
+// At returns the adjusted value of x.
+func (l *Levels) At(x float64) float64 {
+	t := (x - l.InputBlack) / (l.InputWhite - l.InputBlack)
+	t = min(max(t, 0), 1)
+	t = math.Pow(t, 1/l.Gamma)
+	return l.OutputBlack + t*(l.OutputWhite-l.OutputBlack)
+}
+
+// ChannelAdjustment applies a function to each component of a color in a color space.
+//
+// For example, a Curve can be applied to the lightness of OKLab:
+//
+//	adj := &iro.ChannelAdjustment{
+//		Space: iro.SpaceOKLab,
+//		Funcs: [3]func(float64) float64{curve.At},
+//	}
+type ChannelAdjustment struct {
+	// Space is the color space where the functions are applied.
+	Space Space
+
+	// Funcs is the functions for the three components of Space. A nil function doesn't change the component.
+	Funcs [3]func(x float64) float64
+}
+
+// Apply returns a new Color by applying the adjustment to c.
+// The alpha value is kept.
+func (a *ChannelAdjustment) Apply(c Color) Color {
+	var cs [3]float64
+	var alpha float64
+	cs[0], cs[1], cs[2], alpha = c.Components(a.Space)
+	for i, f := range a.Funcs {
+		if f != nil {
+			cs[i] = f(cs[i])
+		}
+	}
+	return ColorFromComponents(a.Space, cs[0], cs[1], cs[2], alpha)
+}
+
+// ApplyToImage returns a new image by applying the adjustment to each pixel of img.
+// See [MapImage] for the interpretation of the pixels.
+func (a *ChannelAdjustment) ApplyToImage(img image.Image) *image.NRGBA64 {
+	return MapImage(img, a.Apply)
+}
@@ -0,0 +1,129 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestCurve(t *testing.T) {
+	points := []iro.CurvePoint{
+		{X: 1, Y: 1},
+		{X: 0, Y: 0},
+		{X: 0.25, Y: 0.15},
+		{X: 0.75, Y: 0.9},
+	}
+	c := iro.NewCurve(points)
+
+	for _, p := range points {
+		if got := c.At(p.X); got != p.Y {
+			t.Errorf("At(%f): got %f, want %f", p.X, got, p.Y)
+		}
+	}
+	if got, want := c.At(-1), 0.0; got != want {
+		t.Errorf("At(-1): got %f, want %f", got, want)
+	}
+	if got, want := c.At(2), 1.0; got != want {
+		t.Errorf("At(2): got %f, want %f", got, want)
+	}
+
+	// The curve must be monotone as the control points are.
+	prev := c.At(0)
+	for i := 1; i <= 100; i++ {
+		x := float64(i) / 100
+		v := c.At(x)
+		if v < prev {
+			t.Errorf("At(%f) = %f is less than the previous value %f", x, v, prev)
+		}
+		prev = v
+	}
+}
+
+func TestCurveLinear(t *testing.T) {
+	c := iro.NewCurve([]iro.CurvePoint{{X: 0, Y: 0.2}, {X: 1, Y: 0.8}})
+	for _, x := range []float64{0, 0.1, 0.5, 0.9, 1} {
+		want := 0.2 + 0.6*x
+		if got := c.At(x); !checkTol(got, want) {
+			t.Errorf("At(%f): got %f, want %f", x, got, want)
+		}
+	}
+}
+
+func TestNewCurvePanics(t *testing.T) {
+	for _, points := range [][]iro.CurvePoint{
+		{{X: 0, Y: 0}},
+		{{X: 0, Y: 0}, {X: 0, Y: 1}},
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("NewCurve(%v) must panic", points)
+				}
+			}()
+			iro.NewCurve(points)
+		}()
+	}
+}
+
+func TestLevels(t *testing.T) {
+	testCases := []struct {
+		levels *iro.Levels
+		in     float64
+		want   float64
+	}{
+		{levels: iro.NewLevels(0, 1, 1), in: 0.3, want: 0.3},
+		{levels: iro.NewLevels(0.2, 0.8, 1), in: 0.5, want: 0.5},
+		{levels: iro.NewLevels(0.2, 0.8, 1), in: 0.1, want: 0},
+		{levels: iro.NewLevels(0.2, 0.8, 1), in: 0.9, want: 1},
+		{levels: iro.NewLevels(0, 1, 2), in: 0.25, want: 0.5},
+		{levels: &iro.Levels{InputBlack: 0, InputWhite: 1, Gamma: 1, OutputBlack: 0.1, OutputWhite: 0.9}, in: 0.5, want: 0.5},
+		{levels: &iro.Levels{InputBlack: 0, InputWhite: 1, Gamma: 1, OutputBlack: 0.1, OutputWhite: 0.9}, in: 0, want: 0.1},
+	}
+	for _, tc := range testCases {
+		if got := tc.levels.At(tc.in); !checkTol(got, tc.want) {
+			t.Errorf("%+v: At(%f): got %f, want %f", tc.levels, tc.in, got, tc.want)
+		}
+	}
+}
+
+func TestChannelAdjustmentOKLabLightness(t *testing.T) {
+	levels := iro.NewLevels(0, 1, 2)
+	adj := &iro.ChannelAdjustment{
+		Space: iro.SpaceOKLab,
+		Funcs: [3]func(float64) float64{levels.At},
+	}
+
+	c := iro.ColorFromOKLab(0.25, 0.05, -0.05, 0.5)
+	l, a, b, alpha := adj.Apply(c).OKLab()
+	if !checkTol(l, 0.5) {
+		t.Errorf("l: got %f, want %f", l, 0.5)
+	}
+	if !checkTol(a, 0.05) {
+		t.Errorf("a: got %f, want %f", a, 0.05)
+	}
+	if !checkTol(b, -0.05) {
+		t.Errorf("b: got %f, want %f", b, -0.05)
+	}
+	if !checkTol(alpha, 0.5) {
+		t.Errorf("alpha: got %f, want %f", alpha, 0.5)
+	}
+}
+
+func TestChannelAdjustmentApplyToImage(t *testing.T) {
+	// Invert the red channel in sRGB.
+	adj := &iro.ChannelAdjustment{
+		Space: iro.SpaceSRGB,
+		Funcs: [3]func(float64) float64{func(x float64) float64 { return 1 - x }},
+	}
+	img := image.NewNRGBA(image.Rect(0, 0, 1, 1))
+	img.SetNRGBA(0, 0, color.NRGBA{R: 0x40, G: 0x80, B: 0xc0, A: 0xff})
+	got := color.NRGBAModel.Convert(adj.ApplyToImage(img).At(0, 0))
+	if want := (color.NRGBA{R: 0xbf, G: 0x80, B: 0xc0, A: 0xff}); got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,156 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"fmt"
+	"math"
+)
+
+// oklchBoundary finds the boundary of the gamut of an RGB space in OKLCh.
+type oklchBoundary struct {
+	// m is the matrix from LMS of OKLab to the linear RGB of the gamut.
+	m Matrix3
+
+	// inv is the matrix from the linear RGB of the gamut to LMS of OKLab.
+	inv Matrix3
+}
+
+func newOKLchBoundary(gamut Space) *oklchBoundary {
+	if !gamut.isRGB() {
+		panic(fmt.Sprintf("iro: gamut must be an RGB space but %s", gamut))
+	}
+	// A nonlinear RGB space has the same gamut as its linear counterpart.
+	if linear, _, _, ok := gamut.linearSpace(); ok {
+		gamut = linear
+	}
+	m := gamut.fromXYZMatrix()
+	inv := gamut.toXYZMatrix()
+	return &oklchBoundary{
+		m:   mulMat(&m, &MatrixOKLabLMSToXYZ),
+		inv: mulMat(&MatrixXYZToOKLabLMS, &inv),
+	}
+}
+
+// contains reports whether the OKLab components are inside the gamut.
+func (b *oklchBoundary) contains(l, a, bb float64) bool {
+	l0, m0, s0 := MatrixOKLabToOKLabLMS.Apply(l, a, bb)
+	r, g, bl := b.m.Apply(l0*l0*l0, m0*m0*m0, s0*s0*s0)
+	return r >= 0 && r <= 1 && g >= 0 && g <= 1 && bl >= 0 && bl <= 1
+}
+
+// maxChroma returns the maximum chroma inside the gamut at the lightness and the hue with the sine and the cosine.
+func (b *oklchBoundary) maxChroma(l, sin, cos float64) float64 {
+	if !b.contains(l, 0, 0) {
+		return 0
+	}
+	// The colors inside the gamut form an interval of chroma from the gray, so bisect it.
+	lo, hi := 0.0, 0.5
+	for i := 0; i < 8 && b.contains(l, hi*cos, hi*sin); i++ {
+		lo, hi = hi, hi*2
+	}
+	for i := 0; i < 52; i++ {
+		mid := (lo + hi) / 2
+		if b.contains(l, mid*cos, mid*sin) {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return lo
+}
+
+// oklch returns the OKLCh lightness, chroma, and hue of the linear RGB components of the gamut.
+func (b *oklchBoundary) oklch(r, g, bl float64) (l, c, h float64) {
+	l0, m0, s0 := b.inv.Apply(r, g, bl)
+	l, a, bb := MatrixOKLabLMSToOKLab.Apply(math.Cbrt(l0), math.Cbrt(m0), math.Cbrt(s0))
+	return l, math.Hypot(a, bb), math.Atan2(bb, a)
+}
+
+// hexagonEdges is the edges of the RGB cube through the primary and secondary colors,
+// as the components fixed to 1 and 0 and the component varying from 0 to 1 or from 1 to 0.
+var hexagonEdges = [...]struct {
+	one, zero, vary int
+	increasing      bool
+}{
+	{one: 0, zero: 2, vary: 1, increasing: true},  // Red to yellow.
+	{one: 1, zero: 2, vary: 0, increasing: false}, // Yellow to green.
+	{one: 1, zero: 0, vary: 2, increasing: true},  // Green to cyan.
+	{one: 2, zero: 0, vary: 1, increasing: false}, // Cyan to blue.
+	{one: 2, zero: 1, vary: 0, increasing: true},  // Blue to magenta.
+	{one: 0, zero: 1, vary: 2, increasing: false}, // Magenta to red.
+}
+
+// cusp returns the lightness and the chroma of the color with the maximum chroma inside the gamut at the hue.
+func (b *oklchBoundary) cusp(hue float64) (l, c float64) {
+	// The color with the maximum chroma at a hue is on the edges of the RGB cube between the primary and secondary colors,
+	// where one component is 1 and another is 0.
+	// The hue is not always monotonic along the edges, so find all the colors with the hue and take the one with the maximum chroma.
+	const n = 32
+	for _, e := range hexagonEdges {
+		f := func(t float64) (l, c, dh float64) {
+			var rgb [3]float64
+			rgb[e.one] = 1
+			if !e.increasing {
+				t = 1 - t
+			}
+			rgb[e.vary] = t
+			l, c, h := b.oklch(rgb[0], rgb[1], rgb[2])
+			return l, c, math.Remainder(h-hue, 2*math.Pi)
+		}
+		// The start of the edge is a primary or secondary color, which might be exactly at the hue.
+		l0, c0, d0 := f(0)
+		if math.Abs(d0) < 1e-12 && c0 > c {
+			l, c = l0, c0
+		}
+		for i := 0; i < n; i++ {
+			t0, t1 := float64(i)/n, float64(i+1)/n
+			_, _, d1 := f(t1)
+			// Skip the wraparound of the hue difference at ±π.
+			if (d0 > 0) == (d1 > 0) && d1 != 0 || math.Abs(d0-d1) > math.Pi {
+				d0 = d1
+				continue
+			}
+			lo, hi := t0, t1
+			for j := 0; j < 60; j++ {
+				mid := (lo + hi) / 2
+				if _, _, d := f(mid); (d > 0) == (d0 > 0) && d != 0 {
+					lo = mid
+				} else {
+					hi = mid
+				}
+			}
+			if ll, cc, _ := f((lo + hi) / 2); cc > c {
+				l, c = ll, cc
+			}
+			d0 = d1
+		}
+	}
+	return l, c
+}
+
+// GamutCusp returns the cusp of the gamut of an RGB space at the OKLCh hue in radians,
+// i.e. the OKLCh lightness and chroma of the color with the maximum chroma inside the gamut at the hue.
+//
+// gamut must be an RGB space like [SpaceSRGB]. A linear RGB space has the same gamut as its nonlinear counterpart.
+func GamutCusp(gamut Space, hue float64) (lightness, chroma float64) {
+	return newOKLchBoundary(gamut).cusp(hue)
+}
+
+// GamutMaxChroma returns the maximum OKLCh chroma inside the gamut of an RGB space at the OKLCh lightness and hue in radians.
+// GamutMaxChroma returns 0 if the gray of the lightness is outside the gamut, i.e. the lightness is outside [0, 1].
+//
+// gamut must be an RGB space like [SpaceSRGB]. A linear RGB space has the same gamut as its nonlinear counterpart.
+func GamutMaxChroma(gamut Space, lightness, hue float64) float64 {
+	sin, cos := math.Sincos(hue)
+	return newOKLchBoundary(gamut).maxChroma(lightness, sin, cos)
+}
+
+// MostChromatic returns the opaque color with the maximum OKLCh chroma inside the gamut of an RGB space
+// at the OKLCh hue in radians and lightness. See also [GamutMaxChroma].
+//
+// space must be an RGB space like [SpaceSRGB].
+func MostChromatic(hue, lightness float64, space Space) Color {
+	return ColorFromOKLch(lightness, GamutMaxChroma(space, lightness, hue), hue, 1)
+}
@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestGamutCusp(t *testing.T) {
+	// At the hues of the primary and secondary colors, the cusps are the colors themselves.
+	for _, gamut := range []iro.Space{iro.SpaceSRGB, iro.SpaceDisplayP3, iro.SpaceRec2020, iro.SpaceLinearRec2020} {
+		for _, rgb := range [][3]float64{{1, 0, 0}, {1, 1, 0}, {0, 1, 0}, {0, 1, 1}, {0, 0, 1}, {1, 0, 1}} {
+			l, c, h, _ := iro.ColorFromComponents(gamut, rgb[0], rgb[1], rgb[2], 1).OKLch()
+			gotL, gotC := iro.GamutCusp(gamut, h)
+			if !checkTol(gotL, l) || !checkTol(gotC, c) {
+				t.Errorf("GamutCusp(%s, %f): got (%f, %f), want (%f, %f)", gamut, h, gotL, gotC, l, c)
+			}
+		}
+	}
+}
+
+func TestGamutCuspMaxChroma(t *testing.T) {
+	// The cusp is the peak of the maximum chroma.
+	for _, gamut := range []iro.Space{iro.SpaceSRGB, iro.SpaceDisplayP3, iro.SpaceRec2020} {
+		for hd := 1.0; hd < 360; hd += 7 {
+			h := iro.AngleUnitDegree.ToRadians(hd)
+			l, c := iro.GamutCusp(gamut, h)
+			if got := iro.GamutMaxChroma(gamut, l, h); !checkTol(got, c) {
+				t.Errorf("GamutMaxChroma(%s, %f, %f): got %f, want %f", gamut, l, hd, got, c)
+			}
+			for _, dl := range []float64{-0.01, 0.01} {
+				if got := iro.GamutMaxChroma(gamut, l+dl, h); got >= c {
+					t.Errorf("GamutMaxChroma(%s, %f, %f): got %f, want less than %f", gamut, l+dl, hd, got, c)
+				}
+			}
+		}
+	}
+}
+
+func TestGamutMaxChroma(t *testing.T) {
+	for _, gamut := range []iro.Space{iro.SpaceSRGB, iro.SpaceDisplayP3, iro.SpaceRec2020} {
+		for _, l := range []float64{0.1, 0.3, 0.5, 0.7, 0.9} {
+			for hd := 0.0; hd < 360; hd += 30 {
+				h := iro.AngleUnitDegree.ToRadians(hd)
+				c := iro.GamutMaxChroma(gamut, l, h)
+				if c <= 0 {
+					t.Errorf("GamutMaxChroma(%s, %f, %f): got %f, want positive", gamut, l, hd, c)
+					continue
+				}
+				// The colors just inside and just outside the boundary.
+				r, g, b, _ := iro.ColorFromOKLch(l, c*0.999, h, 1).Components(gamut)
+				if r < 0 || r > 1 || g < 0 || g > 1 || b < 0 || b > 1 {
+					t.Errorf("GamutMaxChroma(%s, %f, %f): the color inside the boundary is out of gamut: (%f, %f, %f)", gamut, l, hd, r, g, b)
+				}
+				r, g, b, _ = iro.ColorFromOKLch(l, c*1.001, h, 1).Components(gamut)
+				if r >= 0 && r <= 1 && g >= 0 && g <= 1 && b >= 0 && b <= 1 {
+					t.Errorf("GamutMaxChroma(%s, %f, %f): the color outside the boundary is in gamut: (%f, %f, %f)", gamut, l, hd, r, g, b)
+				}
+			}
+		}
+	}
+
+	// A wider gamut has more chroma.
+	h := iro.AngleUnitDegree.ToRadians(140)
+	if s, p := iro.GamutMaxChroma(iro.SpaceSRGB, 0.8, h), iro.GamutMaxChroma(iro.SpaceDisplayP3, 0.8, h); s >= p {
+		t.Errorf("sRGB: %f, Display P3: %f, want sRGB < Display P3", s, p)
+	}
+
+	// Outside the range of lightness, there are no colors.
+	for _, l := range []float64{-0.1, 1.1} {
+		if got := iro.GamutMaxChroma(iro.SpaceSRGB, l, 0); got != 0 {
+			t.Errorf("GamutMaxChroma(sRGB, %f, 0): got %f, want 0", l, got)
+		}
+	}
+}
+
+func TestMostChromatic(t *testing.T) {
+	for _, space := range []iro.Space{iro.SpaceSRGB, iro.SpaceDisplayP3} {
+		for hd := 0.0; hd < 360; hd += 45 {
+			h := iro.AngleUnitDegree.ToRadians(hd)
+			c := iro.MostChromatic(h, 0.7, space)
+			l, ch, gotH, a := c.OKLch()
+			if !checkTol(l, 0.7) || !checkTol(ch, iro.GamutMaxChroma(space, 0.7, h)) || !checkTol(a, 1) {
+				t.Errorf("MostChromatic(%f, 0.7, %s): got (%f, %f, %f, %f)", hd, space, l, ch, gotH, a)
+			}
+			if d := iro.AngleUnitRadian.Normalize(gotH - h); !checkTol(d, 0) && !checkTol(d, 2*math.Pi) {
+				t.Errorf("MostChromatic(%f, 0.7, %s): hue: got %f, want %f", hd, space, gotH, h)
+			}
+			// The result is on the boundary of the gamut.
+			r, g, b, _ := c.Components(space)
+			if m := max(r, g, b); !checkTol(min(r, g, b), 0) && !checkTol(m, 1) {
+				t.Errorf("MostChromatic(%f, 0.7, %s): got (%f, %f, %f) in %s, want on the boundary", hd, space, r, g, b, space)
+			}
+		}
+	}
+}
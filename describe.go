@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"strings"
+)
+
+// Description is a human-readable description of a color.
+type Description struct {
+	// Hue is the name of the hue: red, orange, yellow, green, cyan, blue, purple, or pink.
+	// Hue is empty for a neutral color.
+	Hue string
+
+	// Lightness is the name of the lightness: very dark, dark, medium, light, or very light.
+	Lightness string
+
+	// Chroma is the name of the chroma: neutral, muted, moderate, or vivid.
+	Chroma string
+}
+
+// describeHues is the names of hues and the upper bounds of their OKLCh hue angles in degrees.
+var describeHues = []struct {
+	name string
+	max  float64
+}{
+	{"red", 45},
+	{"orange", 75},
+	{"yellow", 115},
+	{"green", 170},
+	{"cyan", 225},
+	{"blue", 280},
+	{"purple", 320},
+	{"pink", 345},
+	{"red", 360},
+}
+
+// Describe returns a description of c, derived from thresholds of OKLCh.
+// The alpha value is ignored.
+//
+// The thresholds are approximate and intended for accessibility texts and logging, not for precise classification.
+func Describe(c Color) Description {
+	l, ch, h, _ := c.OKLchDeg()
+
+	var d Description
+	switch {
+	case l < 0.3:
+		d.Lightness = "very dark"
+	case l < 0.5:
+		d.Lightness = "dark"
+	case l < 0.7:
+		d.Lightness = "medium"
+	case l < 0.85:
+		d.Lightness = "light"
+	default:
+		d.Lightness = "very light"
+	}
+
+	switch {
+	case ch < 0.02:
+		d.Chroma = "neutral"
+		return d
+	case ch < 0.06:
+		d.Chroma = "muted"
+	case ch < 0.13:
+		d.Chroma = "moderate"
+	default:
+		d.Chroma = "vivid"
+	}
+
+	for _, e := range describeHues {
+		if h < e.max {
+			d.Hue = e.name
+			break
+		}
+	}
+	return d
+}
+
+// String returns the description as a phrase like "dark muted blue" or "light gray".
+// The medium lightness and the moderate chroma are omitted.
+func (d Description) String() string {
+	if d.Hue == "" {
+		switch d.Lightness {
+		case "very dark":
+			return "black"
+		case "very light":
+			return "white"
+		case "medium":
+			return "gray"
+		}
+		return d.Lightness + " gray"
+	}
+
+	var words []string
+	if d.Lightness != "medium" {
+		words = append(words, d.Lightness)
+	}
+	if d.Chroma != "moderate" {
+		words = append(words, d.Chroma)
+	}
+	words = append(words, d.Hue)
+	return strings.Join(words, " ")
+}
@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestDescribe(t *testing.T) {
+	testCases := []struct {
+		color iro.Color
+		want  iro.Description
+		str   string
+	}{
+		{color: iro.Black, want: iro.Description{Lightness: "very dark", Chroma: "neutral"}, str: "black"},
+		{color: iro.White, want: iro.Description{Lightness: "very light", Chroma: "neutral"}, str: "white"},
+		{color: iro.Gray, want: iro.Description{Lightness: "medium", Chroma: "neutral"}, str: "gray"},
+		{color: iro.ColorFromSRGB(0.3, 0.3, 0.3, 1), want: iro.Description{Lightness: "dark", Chroma: "neutral"}, str: "dark gray"},
+		{color: iro.Red, want: iro.Description{Hue: "red", Lightness: "medium", Chroma: "vivid"}, str: "vivid red"},
+		{color: iro.Green, want: iro.Description{Hue: "green", Lightness: "very light", Chroma: "vivid"}, str: "very light vivid green"},
+		{color: iro.Blue, want: iro.Description{Hue: "blue", Lightness: "dark", Chroma: "vivid"}, str: "dark vivid blue"},
+		{color: iro.Yellow, want: iro.Description{Hue: "yellow", Lightness: "very light", Chroma: "vivid"}, str: "very light vivid yellow"},
+		{color: iro.ColorFromOKLchDeg(0.6, 0.1, 60, 1), want: iro.Description{Hue: "orange", Lightness: "medium", Chroma: "moderate"}, str: "orange"},
+		{color: iro.ColorFromOKLchDeg(0.4, 0.04, 250, 1), want: iro.Description{Hue: "blue", Lightness: "dark", Chroma: "muted"}, str: "dark muted blue"},
+		{color: iro.ColorFromOKLchDeg(0.8, 0.1, 340, 1), want: iro.Description{Hue: "pink", Lightness: "light", Chroma: "moderate"}, str: "light pink"},
+		{color: iro.ColorFromOKLchDeg(0.6, 0.1, 350, 1), want: iro.Description{Hue: "red", Lightness: "medium", Chroma: "moderate"}, str: "red"},
+	}
+	for _, tc := range testCases {
+		got := iro.Describe(tc.color)
+		if got != tc.want {
+			t.Errorf("Describe(%v): got %+v, want %+v", tc.color, got, tc.want)
+		}
+		if got := got.String(); got != tc.str {
+			t.Errorf("Describe(%v).String(): got %q, want %q", tc.color, got, tc.str)
+		}
+	}
+}
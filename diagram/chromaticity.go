@@ -0,0 +1,226 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+// Package diagram provides renderers of diagrams for debugging and documenting color pipelines.
+package diagram
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+
+	"github.com/hajimehoshi/iro"
+)
+
+// Projection specifies the chromaticity coordinates of a chromaticity diagram.
+type Projection int
+
+const (
+	// ProjectionXY represents the CIE 1931 xy chromaticity coordinates.
+	ProjectionXY Projection = iota
+
+	// ProjectionUV represents the CIE 1976 u'v' chromaticity coordinates.
+	ProjectionUV
+)
+
+// extent returns the maximum coordinate shown in the diagram for both axes.
+func (p Projection) extent() float64 {
+	switch p {
+	case ProjectionXY:
+		return 0.9
+	case ProjectionUV:
+		return 0.65
+	default:
+		panic(fmt.Sprintf("diagram: invalid Projection: %d", p))
+	}
+}
+
+// fromChromaticity converts the chromaticity to the coordinates of the projection.
+func (p Projection) fromChromaticity(c iro.Chromaticity) (float64, float64) {
+	if p == ProjectionXY {
+		return c.X, c.Y
+	}
+	return c.UV()
+}
+
+// toChromaticity converts the coordinates of the projection to the chromaticity.
+func (p Projection) toChromaticity(u, v float64) iro.Chromaticity {
+	if p == ProjectionXY {
+		return iro.Chromaticity{X: u, Y: v}
+	}
+	return iro.ChromaticityFromUV(u, v)
+}
+
+// ChromaticityOptions represents options for [Chromaticity].
+type ChromaticityOptions struct {
+	// Size is the width and the height of the image in pixels. The default is 512.
+	Size int
+
+	// Projection is the chromaticity coordinates. The default is ProjectionXY.
+	Projection Projection
+
+	// Gamuts is the RGB spaces like [iro.SpaceSRGB] whose gamut triangles are drawn.
+	Gamuts []iro.Space
+
+	// Colors is the colors plotted as dots.
+	Colors []iro.Color
+
+	// PlanckianLocus reports whether the Planckian locus from 1667 K to 25000 K is drawn.
+	PlanckianLocus bool
+
+	// Isotherms is the color temperatures in kelvins whose isotherms are drawn, from Duv -0.05 to 0.05.
+	Isotherms []float64
+}
+
+var (
+	outlineColor = color.NRGBA{0x40, 0x40, 0x40, 0xff}
+	gamutColor   = color.NRGBA{0, 0, 0, 0xff}
+	dotColor     = color.NRGBA{0xff, 0xff, 0xff, 0xff}
+)
+
+// Chromaticity renders a chromaticity diagram with the spectral locus of the CIE 1931 2° standard observer.
+//
+// The area inside the spectral locus is filled with approximate colors, normalized to the maximum brightness in sRGB.
+// The area outside is transparent.
+func Chromaticity(opts *ChromaticityOptions) *image.NRGBA {
+	if opts == nil {
+		opts = &ChromaticityOptions{}
+	}
+	size := opts.Size
+	if size == 0 {
+		size = 512
+	}
+	proj := opts.Projection
+	extent := proj.extent()
+
+	// toPixel converts the coordinates of the projection to pixel coordinates, where y grows downward.
+	toPixel := func(u, v float64) (float64, float64) {
+		return u / extent * float64(size), (1 - v/extent) * float64(size)
+	}
+
+	var locus [][2]float64
+	for _, p := range iro.SpectralLocus() {
+		u, v := proj.fromChromaticity(p)
+		px, py := toPixel(u, v)
+		locus = append(locus, [2]float64{px, py})
+	}
+
+	img := image.NewNRGBA(image.Rect(0, 0, size, size))
+	for j := 0; j < size; j++ {
+		for i := 0; i < size; i++ {
+			px, py := float64(i)+0.5, float64(j)+0.5
+			if !insidePolygon(locus, px, py) {
+				continue
+			}
+			u := px / float64(size) * extent
+			v := (1 - py/float64(size)) * extent
+			img.SetNRGBA(i, j, chromaticityColor(proj.toChromaticity(u, v)))
+		}
+	}
+
+	for i := range locus {
+		p0 := locus[i]
+		p1 := locus[(i+1)%len(locus)]
+		drawLine(img, p0[0], p0[1], p1[0], p1[1], outlineColor)
+	}
+
+	for _, g := range opts.Gamuts {
+		var vs [3][2]float64
+		for i := range vs {
+			var rgb [3]float64
+			rgb[i] = 1
+			c := iro.ColorFromComponents(g, rgb[0], rgb[1], rgb[2], 1).Chromaticity()
+			vs[i][0], vs[i][1] = toPixel(proj.fromChromaticity(c))
+		}
+		for i := range vs {
+			p0, p1 := vs[i], vs[(i+1)%3]
+			drawLine(img, p0[0], p0[1], p1[0], p1[1], gamutColor)
+		}
+	}
+
+	if opts.PlanckianLocus {
+		// Step evenly in mireds, where the locus is more uniform.
+		const n = 64
+		for i := 0; i < n; i++ {
+			m0 := 1e6/1667 + (1e6/25000-1e6/1667)*float64(i)/n
+			m1 := 1e6/1667 + (1e6/25000-1e6/1667)*float64(i+1)/n
+			x0, y0 := toPixel(proj.fromChromaticity(iro.PlanckianLocus(1e6 / m0)))
+			x1, y1 := toPixel(proj.fromChromaticity(iro.PlanckianLocus(1e6 / m1)))
+			drawLine(img, x0, y0, x1, y1, gamutColor)
+		}
+	}
+
+	for _, t := range opts.Isotherms {
+		// An isotherm is straight in the CIE 1960 UCS, but not in other coordinates.
+		const n = 8
+		for i := 0; i < n; i++ {
+			d0 := -0.05 + 0.1*float64(i)/n
+			d1 := -0.05 + 0.1*float64(i+1)/n
+			x0, y0 := toPixel(proj.fromChromaticity(iro.Isotherm(t, d0)))
+			x1, y1 := toPixel(proj.fromChromaticity(iro.Isotherm(t, d1)))
+			drawLine(img, x0, y0, x1, y1, gamutColor)
+		}
+	}
+
+	for _, c := range opts.Colors {
+		px, py := toPixel(proj.fromChromaticity(c.Chromaticity()))
+		drawDot(img, px, py, 3, dotColor, gamutColor)
+	}
+
+	return img
+}
+
+// chromaticityColor returns a displayable sRGB color for the chromaticity.
+func chromaticityColor(c iro.Chromaticity) color.NRGBA {
+	r, g, b, _ := c.Color(1, 1).LinearSRGB()
+	// Desaturate out-of-gamut colors toward white by clamping, and normalize the brightness.
+	r, g, b = max(r, 0), max(g, 0), max(b, 0)
+	m := max(r, g, b)
+	if m > 0 {
+		r, g, b = r/m, g/m, b/m
+	}
+	return iro.ColorFromLinearSRGB(r, g, b, 1).SRGBNRGBA()
+}
+
+// insidePolygon reports whether (x, y) is inside the polygon by the even-odd rule.
+func insidePolygon(polygon [][2]float64, x, y float64) bool {
+	var inside bool
+	for i := range polygon {
+		p0 := polygon[i]
+		p1 := polygon[(i+1)%len(polygon)]
+		if (p0[1] > y) == (p1[1] > y) {
+			continue
+		}
+		if x < p0[0]+(y-p0[1])*(p1[0]-p0[0])/(p1[1]-p0[1]) {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+func drawLine(img *image.NRGBA, x0, y0, x1, y1 float64, clr color.NRGBA) {
+	n := int(math.Ceil(max(math.Abs(x1-x0), math.Abs(y1-y0))))
+	if n == 0 {
+		img.SetNRGBA(int(x0), int(y0), clr)
+		return
+	}
+	for i := 0; i <= n; i++ {
+		t := float64(i) / float64(n)
+		img.SetNRGBA(int(math.Floor(x0+(x1-x0)*t)), int(math.Floor(y0+(y1-y0)*t)), clr)
+	}
+}
+
+func drawDot(img *image.NRGBA, x, y, radius float64, fill, stroke color.NRGBA) {
+	for j := int(math.Floor(y - radius - 1)); j <= int(math.Ceil(y+radius+1)); j++ {
+		for i := int(math.Floor(x - radius - 1)); i <= int(math.Ceil(x+radius+1)); i++ {
+			d := math.Hypot(float64(i)+0.5-x, float64(j)+0.5-y)
+			switch {
+			case d <= radius:
+				img.SetNRGBA(i, j, fill)
+			case d <= radius+1:
+				img.SetNRGBA(i, j, stroke)
+			}
+		}
+	}
+}
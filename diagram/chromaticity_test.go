@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package diagram_test
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+	"github.com/hajimehoshi/iro/diagram"
+)
+
+func TestChromaticity(t *testing.T) {
+	for _, proj := range []diagram.Projection{diagram.ProjectionXY, diagram.ProjectionUV} {
+		img := diagram.Chromaticity(&diagram.ChromaticityOptions{
+			Size:       200,
+			Projection: proj,
+			Gamuts:     []iro.Space{iro.SpaceSRGB, iro.SpaceDisplayP3},
+		})
+		if got, want := img.Bounds(), image.Rect(0, 0, 200, 200); got != want {
+			t.Fatalf("bounds: got %v, want %v", got, want)
+		}
+
+		// The top-right corner is outside the spectral locus.
+		if got := img.NRGBAAt(199, 0); got.A != 0 {
+			t.Errorf("projection=%d: the top-right corner must be transparent: %v", proj, got)
+		}
+
+		// Around the white point, the color is nearly white.
+		var wx, wy float64
+		switch proj {
+		case diagram.ProjectionXY:
+			wx, wy = 0.3127/0.9, 0.3290/0.9
+		case diagram.ProjectionUV:
+			wx, wy = 0.1978/0.65, 0.4683/0.65
+		}
+		c := img.NRGBAAt(int(wx*200), int((1-wy)*200))
+		if c.A != 0xff || c.R < 0xd0 || c.G < 0xd0 || c.B < 0xd0 {
+			t.Errorf("projection=%d: the color near the white point must be nearly white: %v", proj, c)
+		}
+	}
+}
+
+func TestChromaticityColors(t *testing.T) {
+	// The D65 white is plotted as a white dot.
+	img := diagram.Chromaticity(&diagram.ChromaticityOptions{
+		Size:   900,
+		Colors: []iro.Color{iro.ColorFromSRGB(1, 1, 1, 1)},
+	})
+	got := img.NRGBAAt(312, 900-329)
+	if want := (color.NRGBA{0xff, 0xff, 0xff, 0xff}); got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestChromaticityPlanckianLocus(t *testing.T) {
+	const size = 900
+	img := diagram.Chromaticity(&diagram.ChromaticityOptions{
+		Size:           size,
+		PlanckianLocus: true,
+		Isotherms:      []float64{6500},
+	})
+	black := color.NRGBA{0, 0, 0, 0xff}
+	for _, c := range []iro.Chromaticity{
+		iro.PlanckianLocus(2856),
+		iro.Isotherm(6500, 0.03),
+		iro.Isotherm(6500, -0.03),
+	} {
+		// The lines pass through the pixels around the point.
+		x, y := int(c.X/0.9*size), int((1-c.Y/0.9)*size)
+		var found bool
+		for j := y - 1; j <= y+1; j++ {
+			for i := x - 1; i <= x+1; i++ {
+				if img.NRGBAAt(i, j) == black {
+					found = true
+				}
+			}
+		}
+		if !found {
+			t.Errorf("no line around %v", c)
+		}
+	}
+}
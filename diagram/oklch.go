@@ -0,0 +1,165 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package diagram
+
+import (
+	"fmt"
+	"image"
+	"math"
+
+	"github.com/hajimehoshi/iro"
+)
+
+// OKLchOptions represents options for [HueWheel] and [LightnessChromaSlice].
+type OKLchOptions struct {
+	// Width and Height are the size of the image in pixels. The default is 512.
+	// For HueWheel, the smaller one is used as the diameter of the wheel.
+	Width  int
+	Height int
+
+	// MaxChroma is the OKLCh chroma at the edge of the image. The default is 0.4.
+	MaxChroma float64
+
+	// Fill is the RGB space where the pixel values are encoded, either [iro.SpaceSRGB] or [iro.SpaceDisplayP3].
+	// The colors outside the gamut of Fill are transparent.
+	// The default is iro.SpaceSRGB.
+	Fill iro.Space
+
+	// Boundaries is the RGB spaces like [iro.SpaceSRGB] whose gamut boundaries are drawn.
+	Boundaries []iro.Space
+}
+
+func (o *OKLchOptions) size() (int, int) {
+	w, h := o.Width, o.Height
+	if w == 0 {
+		w = 512
+	}
+	if h == 0 {
+		h = 512
+	}
+	return w, h
+}
+
+func (o *OKLchOptions) maxChroma() float64 {
+	if o.MaxChroma == 0 {
+		return 0.4
+	}
+	return o.MaxChroma
+}
+
+func (o *OKLchOptions) fill() iro.Space {
+	switch o.Fill {
+	case iro.SpaceSRGB, iro.SpaceDisplayP3:
+		return o.Fill
+	default:
+		panic(fmt.Sprintf("diagram: Fill must be SpaceSRGB or SpaceDisplayP3: %s", o.Fill))
+	}
+}
+
+// HueWheel renders a hue wheel of OKLCh at the given lightness.
+//
+// The hue is 0 at the right and grows counterclockwise, and the chroma grows from 0 at the center to MaxChroma at the rim.
+// The area outside the wheel is transparent.
+func HueWheel(lightness float64, opts *OKLchOptions) *image.NRGBA {
+	if opts == nil {
+		opts = &OKLchOptions{}
+	}
+	w, h := opts.size()
+	radius := float64(min(w, h)) / 2
+	maxChroma := opts.maxChroma()
+	cx, cy := float64(w)/2, float64(h)/2
+
+	return renderOKLch(w, h, opts, func(px, py float64) (iro.Color, bool) {
+		dx, dy := px-cx, cy-py
+		d := math.Hypot(dx, dy)
+		if d > radius {
+			return iro.Color{}, false
+		}
+		return iro.ColorFromOKLch(lightness, d/radius*maxChroma, math.Atan2(dy, dx), 1), true
+	})
+}
+
+// LightnessChromaSlice renders a slice of OKLCh at the given hue in radians.
+//
+// The lightness grows from 0 at the bottom to 1 at the top, and the chroma grows from 0 at the left to MaxChroma at the right.
+func LightnessChromaSlice(hue float64, opts *OKLchOptions) *image.NRGBA {
+	if opts == nil {
+		opts = &OKLchOptions{}
+	}
+	w, h := opts.size()
+	maxChroma := opts.maxChroma()
+
+	return renderOKLch(w, h, opts, func(px, py float64) (iro.Color, bool) {
+		l := 1 - py/float64(h)
+		c := px / float64(w) * maxChroma
+		return iro.ColorFromOKLch(l, c, hue, 1), true
+	})
+}
+
+// renderOKLch renders an image by calling f at the center of each pixel.
+// f returns the color at the pixel, and false if the pixel is not a part of the diagram.
+func renderOKLch(width, height int, opts *OKLchOptions, f func(px, py float64) (iro.Color, bool)) *image.NRGBA {
+	fill := opts.fill()
+
+	colors := make([]iro.Color, width*height)
+	valid := make([]bool, width*height)
+	for j := 0; j < height; j++ {
+		for i := 0; i < width; i++ {
+			idx := j*width + i
+			colors[idx], valid[idx] = f(float64(i)+0.5, float64(j)+0.5)
+		}
+	}
+
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for idx, c := range colors {
+		if !valid[idx] || !inGamut(c, fill) {
+			continue
+		}
+		r, g, b, _ := c.Components(fill)
+		img.Pix[4*idx] = toUint8(r)
+		img.Pix[4*idx+1] = toUint8(g)
+		img.Pix[4*idx+2] = toUint8(b)
+		img.Pix[4*idx+3] = 0xff
+	}
+
+	// Draw the pixels in a gamut whose neighbors are out of the gamut.
+	in := make([]bool, width*height)
+	for _, s := range opts.Boundaries {
+		for idx, c := range colors {
+			in[idx] = inGamut(c, s)
+		}
+		for j := 0; j < height; j++ {
+			for i := 0; i < width; i++ {
+				idx := j*width + i
+				if !valid[idx] || !in[idx] {
+					continue
+				}
+				if (i > 0 && !in[idx-1]) || (i < width-1 && !in[idx+1]) ||
+					(j > 0 && !in[idx-width]) || (j < height-1 && !in[idx+width]) {
+					img.SetNRGBA(i, j, gamutColor)
+				}
+			}
+		}
+	}
+
+	return img
+}
+
+// gamutEpsilon is the tolerance of the components for inGamut.
+const gamutEpsilon = 1e-6
+
+// inGamut reports whether c is inside the gamut of the RGB space.
+func inGamut(c iro.Color, space iro.Space) bool {
+	r, g, b, _ := c.Components(space)
+	for _, v := range [...]float64{r, g, b} {
+		if v < -gamutEpsilon || v > 1+gamutEpsilon {
+			return false
+		}
+	}
+	return true
+}
+
+func toUint8(v float64) uint8 {
+	return uint8(math.Round(min(max(v, 0), 1) * 0xff))
+}
@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package diagram_test
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+	"github.com/hajimehoshi/iro/diagram"
+)
+
+func TestHueWheel(t *testing.T) {
+	img := diagram.HueWheel(0.7, &diagram.OKLchOptions{
+		Width:  200,
+		Height: 200,
+	})
+	if got, want := img.Bounds(), image.Rect(0, 0, 200, 200); got != want {
+		t.Fatalf("bounds: got %v, want %v", got, want)
+	}
+
+	// The corners are outside the wheel.
+	if got := img.NRGBAAt(0, 0); got.A != 0 {
+		t.Errorf("the top-left corner must be transparent: %v", got)
+	}
+
+	// The center is nearly gray.
+	got := img.NRGBAAt(100, 100)
+	if got.A != 0xff || max(got.R, got.G, got.B)-min(got.R, got.G, got.B) > 4 {
+		t.Errorf("the center must be nearly gray: %v", got)
+	}
+
+	// The rim at chroma 0.4 is out of the sRGB gamut for any hue.
+	if got := img.NRGBAAt(199, 100); got.A != 0 {
+		t.Errorf("the rim must be transparent: %v", got)
+	}
+}
+
+func TestLightnessChromaSlice(t *testing.T) {
+	const size = 100
+	img := diagram.LightnessChromaSlice(0, &diagram.OKLchOptions{
+		Width:  size,
+		Height: size,
+	})
+
+	// The top-left pixel is nearly white and the bottom-left pixel is nearly black.
+	if got := img.NRGBAAt(0, 0); got.A != 0xff || got.R < 0xf0 || got.G < 0xf0 || got.B < 0xf0 {
+		t.Errorf("the top-left pixel must be nearly white: %v", got)
+	}
+	if got := img.NRGBAAt(0, size-1); got.A != 0xff || got.R > 0x10 || got.G > 0x10 || got.B > 0x10 {
+		t.Errorf("the bottom-left pixel must be nearly black: %v", got)
+	}
+
+	// A pixel in the gamut has the color at the pixel center.
+	const i, j = 20, 40
+	c := iro.ColorFromOKLch(1-(j+0.5)/size, (i+0.5)/size*0.4, 0, 1)
+	want := color.NRGBAModel.Convert(c.SRGBColor()).(color.NRGBA)
+	got := img.NRGBAAt(i, j)
+	if math.Abs(float64(got.R)-float64(want.R)) > 1 || math.Abs(float64(got.G)-float64(want.G)) > 1 || math.Abs(float64(got.B)-float64(want.B)) > 1 || got.A != want.A {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestLightnessChromaSliceBoundaries(t *testing.T) {
+	count := func(img *image.NRGBA) (opaque, black int) {
+		for j := 0; j < img.Bounds().Dy(); j++ {
+			for i := 0; i < img.Bounds().Dx(); i++ {
+				c := img.NRGBAAt(i, j)
+				if c.A != 0 {
+					opaque++
+				}
+				if c == (color.NRGBA{0, 0, 0, 0xff}) {
+					black++
+				}
+			}
+		}
+		return
+	}
+
+	// Display P3 is wider than sRGB.
+	const hue = 2.5
+	srgbOpaque, _ := count(diagram.LightnessChromaSlice(hue, &diagram.OKLchOptions{Width: 100, Height: 100}))
+	p3Opaque, _ := count(diagram.LightnessChromaSlice(hue, &diagram.OKLchOptions{Width: 100, Height: 100, Fill: iro.SpaceDisplayP3}))
+	if srgbOpaque >= p3Opaque {
+		t.Errorf("the P3 slice must have more opaque pixels than the sRGB slice: %d vs %d", p3Opaque, srgbOpaque)
+	}
+
+	_, black0 := count(diagram.LightnessChromaSlice(hue, &diagram.OKLchOptions{Width: 100, Height: 100}))
+	_, black1 := count(diagram.LightnessChromaSlice(hue, &diagram.OKLchOptions{Width: 100, Height: 100, Boundaries: []iro.Space{iro.SpaceSRGB, iro.SpaceDisplayP3}}))
+	if black1 <= black0 {
+		t.Errorf("the boundaries must be drawn: %d vs %d", black1, black0)
+	}
+}
@@ -0,0 +1,158 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package diagram
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+	"golang.org/x/image/math/fixed"
+
+	"github.com/hajimehoshi/iro"
+)
+
+// Label specifies the text labels of swatches.
+type Label int
+
+const (
+	// LabelNone represents no labels.
+	LabelNone Label = iota
+
+	// LabelHex represents hexadecimal sRGB labels like #ff8000.
+	LabelHex
+
+	// LabelOKLch represents OKLCh labels like oklch(0.732 0.171 53.1), where the hue is in degrees.
+	LabelOKLch
+)
+
+// text returns the label text of c.
+func (l Label) text(c iro.Color) string {
+	switch l {
+	case LabelNone:
+		return ""
+	case LabelHex:
+		return c.Hex()
+	case LabelOKLch:
+		lightness, chroma, h, _ := c.OKLch()
+		h = iro.NormalizeHueDeg(iro.AngleUnitDegree.FromRadians(h))
+		return fmt.Sprintf("oklch(%.3f %.3f %.1f)", lightness, chroma, h)
+	default:
+		panic(fmt.Sprintf("diagram: invalid Label: %d", l))
+	}
+}
+
+// SwatchOptions represents options for [Swatches] and [GradientStrip].
+type SwatchOptions struct {
+	// Width is the width of each swatch for Swatches, or the width of the whole strip for GradientStrip, in pixels.
+	// The default is wide enough for the labels for Swatches, and 512 for GradientStrip.
+	Width int
+
+	// Height is the height of the image in pixels. The default is 64.
+	Height int
+
+	// Label is the text labels drawn on the swatches, or at the stops of the gradient.
+	Label Label
+}
+
+func (o *SwatchOptions) height() int {
+	if o.Height == 0 {
+		return 64
+	}
+	return o.Height
+}
+
+// labelFace is the font face of labels.
+var labelFace = basicfont.Face7x13
+
+// labelMargin is the margin around labels in pixels.
+const labelMargin = 4
+
+// Swatches renders the colors as a horizontal strip of swatches.
+// Colors are composited over transparency as they are, encoded as sRGB.
+func Swatches(colors []iro.Color, opts *SwatchOptions) *image.NRGBA {
+	if opts == nil {
+		opts = &SwatchOptions{}
+	}
+	w := opts.Width
+	if w == 0 {
+		w = 64
+		for _, c := range colors {
+			w = max(w, font.MeasureString(labelFace, opts.Label.text(c)).Ceil()+2*labelMargin)
+		}
+	}
+	h := opts.height()
+
+	img := image.NewNRGBA(image.Rect(0, 0, w*len(colors), h))
+	for i, c := range colors {
+		clr := c.SRGBNRGBA()
+		for y := 0; y < h; y++ {
+			for x := i * w; x < (i+1)*w; x++ {
+				img.SetNRGBA(x, y, clr)
+			}
+		}
+		drawLabel(img, opts.Label.text(c), i*w+labelMargin, c)
+	}
+	return img
+}
+
+// GradientStrip renders the gradient as a horizontal strip, sampled at the center of each pixel column.
+// The labels are drawn at the stops of the gradient.
+func GradientStrip(g *iro.Gradient, opts *SwatchOptions) *image.NRGBA {
+	if opts == nil {
+		opts = &SwatchOptions{}
+	}
+	w := opts.Width
+	if w == 0 {
+		w = 512
+	}
+	h := opts.height()
+
+	// Sample the gradient over the range of its stops.
+	t0, t1 := g.Stops[0].Position, g.Stops[len(g.Stops)-1].Position
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for x := 0; x < w; x++ {
+		clr := g.At(t0 + (t1-t0)*(float64(x)+0.5)/float64(w)).SRGBNRGBA()
+		for y := 0; y < h; y++ {
+			img.SetNRGBA(x, y, clr)
+		}
+	}
+
+	for _, s := range g.Stops {
+		text := opts.Label.text(s.Color)
+		if text == "" {
+			continue
+		}
+		var x float64
+		if t1 > t0 {
+			x = (s.Position - t0) / (t1 - t0) * float64(w)
+		}
+		tw := font.MeasureString(labelFace, text).Ceil()
+		// Center the label at the stop, keeping it inside the image.
+		lx := int(x) - tw/2
+		lx = min(max(lx, labelMargin), w-tw-labelMargin)
+		drawLabel(img, text, lx, s.Color)
+	}
+	return img
+}
+
+// drawLabel draws the text at the bottom of img from x, in black or white depending on the lightness of background.
+func drawLabel(img *image.NRGBA, text string, x int, background iro.Color) {
+	if text == "" {
+		return
+	}
+	clr := color.Black
+	if l, _, _, a := background.OKLab(); l < 0.6 && a > 0.5 {
+		clr = color.White
+	}
+	d := &font.Drawer{
+		Dst:  img,
+		Src:  image.NewUniform(clr),
+		Face: labelFace,
+		Dot:  fixed.P(x, img.Bounds().Dy()-labelMargin-labelFace.Descent),
+	}
+	d.DrawString(text)
+}
@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package diagram_test
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+	"github.com/hajimehoshi/iro/diagram"
+)
+
+func TestSwatches(t *testing.T) {
+	colors := []iro.Color{
+		iro.ColorFromSRGB(1, 0, 0, 1),
+		iro.ColorFromSRGB(0, 1, 0, 1),
+		iro.ColorFromSRGB(0, 0, 1, 1),
+	}
+	img := diagram.Swatches(colors, &diagram.SwatchOptions{
+		Width:  10,
+		Height: 5,
+	})
+	if got, want := img.Bounds(), image.Rect(0, 0, 30, 5); got != want {
+		t.Fatalf("bounds: got %v, want %v", got, want)
+	}
+	for i, want := range []color.NRGBA{{0xff, 0, 0, 0xff}, {0, 0xff, 0, 0xff}, {0, 0, 0xff, 0xff}} {
+		if got := img.NRGBAAt(i*10+5, 2); got != want {
+			t.Errorf("swatch %d: got %v, want %v", i, got, want)
+		}
+	}
+}
+
+func TestSwatchesLabel(t *testing.T) {
+	colors := []iro.Color{
+		iro.ColorFromSRGB(0, 0, 0, 1),
+		iro.ColorFromSRGB(1, 1, 1, 1),
+	}
+	for _, label := range []diagram.Label{diagram.LabelHex, diagram.LabelOKLch} {
+		img := diagram.Swatches(colors, &diagram.SwatchOptions{
+			Label: label,
+		})
+		w := img.Bounds().Dx() / len(colors)
+		if w < 64 {
+			t.Errorf("label=%d: swatch width: got %d, want >= 64", label, w)
+		}
+
+		// The labels are drawn in the contrasting colors.
+		for i, want := range []color.NRGBA{{0xff, 0xff, 0xff, 0xff}, {0, 0, 0, 0xff}} {
+			var found bool
+			for y := 0; y < img.Bounds().Dy() && !found; y++ {
+				for x := i * w; x < (i+1)*w; x++ {
+					if img.NRGBAAt(x, y) == want {
+						found = true
+						break
+					}
+				}
+			}
+			if !found {
+				t.Errorf("label=%d: swatch %d: the label must be drawn in %v", label, i, want)
+			}
+		}
+	}
+}
+
+func TestGradientStrip(t *testing.T) {
+	g := iro.NewGradient(iro.ColorFromSRGB(0, 0, 0, 1), iro.ColorFromSRGB(1, 1, 1, 1))
+	img := diagram.GradientStrip(g, &diagram.SwatchOptions{
+		Width:  100,
+		Height: 10,
+	})
+	if got, want := img.Bounds(), image.Rect(0, 0, 100, 10); got != want {
+		t.Fatalf("bounds: got %v, want %v", got, want)
+	}
+	// The lightness increases from left to right.
+	var prev uint8
+	for x := 0; x < 100; x++ {
+		c := img.NRGBAAt(x, 5)
+		if c.R < prev {
+			t.Errorf("x=%d: the color must not get darker: %v", x, c)
+		}
+		prev = c.R
+	}
+}
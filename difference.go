@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"math"
+)
+
+// DeltaEOK returns the color difference ΔEOK between a and b, the Euclidean distance in OKLab.
+// The alpha values are ignored.
+//
+// See https://www.w3.org/TR/css-color-4/#color-difference-OK
+func DeltaEOK(a, b Color) float64 {
+	l0, a0, b0, _ := a.OKLab()
+	l1, a1, b1, _ := b.OKLab()
+	return math.Sqrt((l0-l1)*(l0-l1) + (a0-a1)*(a0-a1) + (b0-b1)*(b0-b1))
+}
+
+// DeltaE2000 returns the color difference CIEDE2000 between a and b with the parametric factors kL = kC = kH = 1.
+// The differences are computed in CIELAB of this package, whose white point is D50.
+// The alpha values are ignored.
+//
+// See Sharma, Wu, and Dalal, "The CIEDE2000 Color-Difference Formula: Implementation Notes, Supplementary Test Data, and Mathematical Observations" (2005).
+func DeltaE2000(a, b Color) float64 {
+	l1, a1, b1, _ := a.Lab()
+	l2, a2, b2, _ := b.Lab()
+
+	// Adjust a* to compensate the neutral colors.
+	cBar := (math.Hypot(a1, b1) + math.Hypot(a2, b2)) / 2
+	cBar7 := math.Pow(cBar, 7)
+	g := 0.5 * (1 - math.Sqrt(cBar7/(cBar7+math.Pow(25, 7))))
+	a1p := (1 + g) * a1
+	a2p := (1 + g) * a2
+
+	c1p := math.Hypot(a1p, b1)
+	c2p := math.Hypot(a2p, b2)
+	h1p := hueDeg2000(a1p, b1)
+	h2p := hueDeg2000(a2p, b2)
+
+	dLp := l2 - l1
+	dCp := c2p - c1p
+	var dhp float64
+	if c1p*c2p != 0 {
+		dhp = h2p - h1p
+		if dhp > 180 {
+			dhp -= 360
+		} else if dhp < -180 {
+			dhp += 360
+		}
+	}
+	dHp := 2 * math.Sqrt(c1p*c2p) * math.Sin(dhp*math.Pi/360)
+
+	lBarp := (l1 + l2) / 2
+	cBarp := (c1p + c2p) / 2
+	hBarp := h1p + h2p
+	if c1p*c2p != 0 {
+		switch {
+		case math.Abs(h1p-h2p) <= 180:
+			hBarp /= 2
+		case hBarp < 360:
+			hBarp = (hBarp + 360) / 2
+		default:
+			hBarp = (hBarp - 360) / 2
+		}
+	}
+
+	deg := math.Pi / 180
+	t := 1 - 0.17*math.Cos((hBarp-30)*deg) + 0.24*math.Cos(2*hBarp*deg) + 0.32*math.Cos((3*hBarp+6)*deg) - 0.20*math.Cos((4*hBarp-63)*deg)
+	dTheta := 30 * math.Exp(-((hBarp-275)/25)*((hBarp-275)/25))
+	cBarp7 := math.Pow(cBarp, 7)
+	rc := 2 * math.Sqrt(cBarp7/(cBarp7+math.Pow(25, 7)))
+	sl := 1 + 0.015*(lBarp-50)*(lBarp-50)/math.Sqrt(20+(lBarp-50)*(lBarp-50))
+	sc := 1 + 0.045*cBarp
+	sh := 1 + 0.015*cBarp*t
+	rt := -math.Sin(2*dTheta*deg) * rc
+
+	dl := dLp / sl
+	dc := dCp / sc
+	dh := dHp / sh
+	return math.Sqrt(dl*dl + dc*dc + dh*dh + rt*dc*dh)
+}
+
+// hueDeg2000 returns the hue angle in degrees in [0, 360) for CIEDE2000, where the hue of a neutral color is 0.
+func hueDeg2000(a, b float64) float64 {
+	if a == 0 && b == 0 {
+		return 0
+	}
+	return NormalizeHueDeg(math.Atan2(b, a) * 180 / math.Pi)
+}
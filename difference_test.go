@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestDeltaE2000(t *testing.T) {
+	// The test data by Sharma, Wu, and Dalal.
+	// https://hajim.rochester.edu/ece/sites/gsharma/ciede2000/
+	testCases := []struct {
+		lab1, lab2 [3]float64
+		want       float64
+	}{
+		{lab1: [3]float64{50.0000, 2.6772, -79.7751}, lab2: [3]float64{50.0000, 0.0000, -82.7485}, want: 2.0425},
+		{lab1: [3]float64{50.0000, 3.1571, -77.2803}, lab2: [3]float64{50.0000, 0.0000, -82.7485}, want: 2.8615},
+		{lab1: [3]float64{50.0000, 2.8361, -74.0200}, lab2: [3]float64{50.0000, 0.0000, -82.7485}, want: 3.4412},
+		{lab1: [3]float64{50.0000, 0.0000, 0.0000}, lab2: [3]float64{50.0000, -1.0000, 2.0000}, want: 2.3669},
+		{lab1: [3]float64{50.0000, 2.5000, 0.0000}, lab2: [3]float64{73.0000, 25.0000, -18.0000}, want: 27.1492},
+		{lab1: [3]float64{60.2574, -34.0099, 36.2677}, lab2: [3]float64{60.4626, -34.1751, 39.4387}, want: 1.2644},
+		{lab1: [3]float64{50.0000, 0.0000, 0.0000}, lab2: [3]float64{50.0000, 0.0000, 0.0000}, want: 0},
+	}
+	for _, tc := range testCases {
+		a := iro.ColorFromLab(tc.lab1[0], tc.lab1[1], tc.lab1[2], 1)
+		b := iro.ColorFromLab(tc.lab2[0], tc.lab2[1], tc.lab2[2], 1)
+		if got := iro.DeltaE2000(a, b); math.Abs(got-tc.want) > 1e-4 {
+			t.Errorf("DeltaE2000(%v, %v): got %f, want %f", tc.lab1, tc.lab2, got, tc.want)
+		}
+		if got := iro.DeltaE2000(b, a); math.Abs(got-tc.want) > 1e-4 {
+			t.Errorf("DeltaE2000(%v, %v): got %f, want %f", tc.lab2, tc.lab1, got, tc.want)
+		}
+	}
+}
+
+func TestDeltaEOK(t *testing.T) {
+	if got := iro.DeltaEOK(iro.Black, iro.White); !checkTol(got, 1) {
+		t.Errorf("DeltaEOK(Black, White): got %f, want 1", got)
+	}
+	a := iro.ColorFromOKLab(0.5, 0.1, -0.05, 1)
+	b := iro.ColorFromOKLab(0.6, 0.1, 0.05, 0.5)
+	if got, want := iro.DeltaEOK(a, b), math.Sqrt(0.02); !checkTol(got, want) {
+		t.Errorf("DeltaEOK: got %f, want %f", got, want)
+	}
+}
@@ -0,0 +1,197 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"sort"
+)
+
+// dominantMaxSamples is the maximum number of pixels sampled by [DominantColors].
+const dominantMaxSamples = 1 << 16
+
+// dominantIterations is the maximum number of iterations of the k-means clustering.
+const dominantIterations = 32
+
+// dominantPoint is a distinct color in OKLab with its population.
+type dominantPoint struct {
+	lab    [3]float64
+	weight float64
+}
+
+// DominantColors returns up to n representative opaque colors of img, ordered by the populations from the largest,
+// for example to extract a palette from a photo.
+//
+// The colors are clustered by the k-means method in OKLab, initialized deterministically,
+// so the same image always results in the same colors.
+// Fully transparent pixels are skipped. Large images are sampled at regular intervals.
+// If img has n or fewer distinct colors, they are returned as they are.
+// See [MapImage] for the interpretation of the pixels.
+//
+// DominantColors panics if n is not positive.
+func DominantColors(img image.Image, n int) []Color {
+	cs, _ := DominantColorsContext(context.Background(), img, n)
+	return cs
+}
+
+// DominantColorsContext is like [DominantColors] but stops and returns the error of ctx when ctx is done.
+// ctx is checked for each row of the sampled pixels and each iteration of the clustering.
+//
+// DominantColorsContext panics if n is not positive.
+func DominantColorsContext(ctx context.Context, img image.Image, n int) ([]Color, error) {
+	if n <= 0 {
+		panic(fmt.Sprintf("iro: n must be positive but %d", n))
+	}
+	points, err := dominantPoints(ctx, img)
+	if err != nil {
+		return nil, err
+	}
+	if len(points) == 0 {
+		return nil, nil
+	}
+	sort.SliceStable(points, func(i, j int) bool {
+		return points[i].weight > points[j].weight
+	})
+
+	if len(points) <= n {
+		cs := make([]Color, len(points))
+		for i, p := range points {
+			cs[i] = ColorFromOKLab(p.lab[0], p.lab[1], p.lab[2], 1)
+		}
+		return cs, nil
+	}
+
+	centers := initDominantCenters(points, n)
+	n = len(centers)
+	assign := make([]int, len(points))
+	weights := make([]float64, n)
+	for it := 0; it < dominantIterations; it++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		changed := it == 0
+		for i, p := range points {
+			if c := nearestCenter(centers, p.lab); c != assign[i] {
+				assign[i] = c
+				changed = true
+			}
+		}
+		if !changed {
+			break
+		}
+
+		sums := make([][3]float64, n)
+		for i := range weights {
+			weights[i] = 0
+		}
+		for i, p := range points {
+			c := assign[i]
+			for k := 0; k < 3; k++ {
+				sums[c][k] += p.lab[k] * p.weight
+			}
+			weights[c] += p.weight
+		}
+		for i := range centers {
+			// Keep an empty cluster's center as it is.
+			if weights[i] == 0 {
+				continue
+			}
+			for k := 0; k < 3; k++ {
+				centers[i][k] = sums[i][k] / weights[i]
+			}
+		}
+	}
+
+	indices := make([]int, n)
+	for i := range indices {
+		indices[i] = i
+	}
+	sort.SliceStable(indices, func(i, j int) bool {
+		return weights[indices[i]] > weights[indices[j]]
+	})
+	var cs []Color
+	for _, i := range indices {
+		if weights[i] == 0 {
+			continue
+		}
+		cs = append(cs, ColorFromOKLab(centers[i][0], centers[i][1], centers[i][2], 1))
+	}
+	return cs, nil
+}
+
+// dominantPoints returns the distinct colors of the sampled pixels of img with their populations.
+func dominantPoints(ctx context.Context, img image.Image) ([]dominantPoint, error) {
+	b := img.Bounds()
+	step := 1
+	for b.Dx()/step*(b.Dy()/step) > dominantMaxSamples {
+		step++
+	}
+
+	index := map[Color]int{}
+	var points []dominantPoint
+	for y := b.Min.Y; y < b.Max.Y; y += step {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		for x := b.Min.X; x < b.Max.X; x += step {
+			c := colorAt(img, x, y)
+			if c.alpha == 0 {
+				continue
+			}
+			c = c.WithAlpha(1)
+			if i, ok := index[c]; ok {
+				points[i].weight++
+				continue
+			}
+			l, a, bb, _ := c.OKLab()
+			index[c] = len(points)
+			points = append(points, dominantPoint{lab: [3]float64{l, a, bb}, weight: 1})
+		}
+	}
+	return points, nil
+}
+
+// initDominantCenters returns n initial centers chosen from the points sorted by the weights, like k-means++ but deterministically:
+// the first center is the most populous color, and each next one is the color maximizing the weighted squared distance to the nearest center.
+func initDominantCenters(points []dominantPoint, n int) [][3]float64 {
+	centers := [][3]float64{points[0].lab}
+	dists := make([]float64, len(points))
+	for i, p := range points {
+		dists[i] = distSq3(p.lab, centers[0])
+	}
+	for len(centers) < n {
+		best, bestScore := -1, 0.0
+		for i, p := range points {
+			if s := dists[i] * p.weight; s > bestScore {
+				best, bestScore = i, s
+			}
+		}
+		if best < 0 {
+			break
+		}
+		c := points[best].lab
+		centers = append(centers, c)
+		for i, p := range points {
+			dists[i] = min(dists[i], distSq3(p.lab, c))
+		}
+	}
+	return centers
+}
+
+func nearestCenter(centers [][3]float64, lab [3]float64) int {
+	best, bestDist := 0, distSq3(lab, centers[0])
+	for i := 1; i < len(centers); i++ {
+		if d := distSq3(lab, centers[i]); d < bestDist {
+			best, bestDist = i, d
+		}
+	}
+	return best
+}
+
+func distSq3(a, b [3]float64) float64 {
+	d0, d1, d2 := a[0]-b[0], a[1]-b[1], a[2]-b[2]
+	return d0*d0 + d1*d1 + d2*d2
+}
@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"context"
+	"errors"
+	"image"
+	"image/color"
+	"slices"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestDominantColors(t *testing.T) {
+	// Three distinct regions of noisy colors with different sizes.
+	img := image.NewNRGBA(image.Rect(0, 0, 60, 10))
+	for y := 0; y < 10; y++ {
+		for x := 0; x < 60; x++ {
+			d := uint8((x + y) % 3)
+			var c color.NRGBA
+			switch {
+			case x < 30:
+				c = color.NRGBA{R: 200 + d, G: 30, B: 30, A: 0xff}
+			case x < 50:
+				c = color.NRGBA{R: 30, G: 30 + d, B: 200, A: 0xff}
+			case x < 58:
+				c = color.NRGBA{R: 240, G: 240, B: 240 - d, A: 0xff}
+			default:
+				// Transparent pixels are skipped.
+				c = color.NRGBA{R: 0, G: 255, B: 0, A: 0}
+			}
+			img.SetNRGBA(x, y, c)
+		}
+	}
+
+	got := iro.DominantColors(img, 3)
+	want := []iro.Color{
+		iro.ColorFromSRGB(201/255.0, 30/255.0, 30/255.0, 1),
+		iro.ColorFromSRGB(30/255.0, 31/255.0, 200/255.0, 1),
+		iro.ColorFromSRGB(240/255.0, 240/255.0, 239/255.0, 1),
+	}
+	if len(got) != len(want) {
+		t.Fatalf("len: got %d, want %d", len(got), len(want))
+	}
+	for i := range got {
+		if d := iro.DeltaEOK(got[i], want[i]); d > 0.01 {
+			t.Errorf("colors[%d]: got %s, want %s (ΔE=%f)", i, got[i].Hex(), want[i].Hex(), d)
+		}
+	}
+
+	// The result is deterministic.
+	again := iro.DominantColors(img, 3)
+	for i := range got {
+		if got[i] != again[i] {
+			t.Errorf("colors[%d]: got %v and %v for the same image", i, got[i], again[i])
+		}
+	}
+}
+
+func TestDominantColorsFewColors(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 3, 1))
+	img.SetNRGBA(0, 0, color.NRGBA{R: 255, A: 0xff})
+	img.SetNRGBA(1, 0, color.NRGBA{B: 255, A: 0xff})
+	img.SetNRGBA(2, 0, color.NRGBA{B: 255, A: 0xff})
+
+	got := iro.DominantColors(img, 8)
+	want := []string{"#0000ff", "#ff0000"}
+	if len(got) != len(want) {
+		t.Fatalf("len: got %d, want %d", len(got), len(want))
+	}
+	for i := range got {
+		if got[i].Hex() != want[i] {
+			t.Errorf("colors[%d]: got %s, want %s", i, got[i].Hex(), want[i])
+		}
+	}
+
+	if got := iro.DominantColors(image.NewNRGBA(image.Rect(0, 0, 2, 2)), 3); len(got) != 0 {
+		t.Errorf("transparent image: got %v, want none", got)
+	}
+}
+
+func TestDominantColorsContext(t *testing.T) {
+	src := image.NewNRGBA(image.Rect(0, 0, 4, 4))
+	for y := 0; y < 4; y++ {
+		for x := 0; x < 4; x++ {
+			src.SetNRGBA(x, y, color.NRGBA{R: uint8(x * 64), G: uint8(y * 64), A: 0xff})
+		}
+	}
+
+	got, err := iro.DominantColorsContext(context.Background(), src, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := iro.DominantColors(src, 3); !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 0)
+	defer cancel()
+	if _, err := iro.DominantColorsContext(ctx, src, 3); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got %v, want %v", err, context.DeadlineExceeded)
+	}
+}
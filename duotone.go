@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"image"
+)
+
+// Duotone maps the lightness of colors onto a gradient, like duotone and tritone prints.
+// Duotone is a [GradientMap] of the full range of the lightness.
+type Duotone struct {
+	// Gradient is the gradient that the OKLab lightness in [0, 1] is mapped onto.
+	// The position 0 is for black and the position 1 is for white.
+	Gradient *Gradient
+}
+
+// NewDuotone creates a Duotone of the colors from the shadows to the highlights, interpolated in OKLab.
+// Two colors make a duotone and three colors make a tritone, where the second color is for the midtones.
+//
+// NewDuotone panics if the number of colors is not 2 or 3.
+func NewDuotone(colors ...Color) *Duotone {
+	if len(colors) != 2 && len(colors) != 3 {
+		panic("iro: a duotone requires 2 or 3 colors")
+	}
+	return &Duotone{
+		Gradient: NewGradient(colors...),
+	}
+}
+
+// Apply returns the color of the gradient at the OKLab lightness of c, which is perceptually uniform unlike the luminance.
+// The alpha value of c is multiplied by the alpha value of the gradient.
+func (d *Duotone) Apply(c Color) Color {
+	return (&GradientMap{Gradient: d.Gradient}).Apply(c)
+}
+
+// ApplyToImage returns a new image by applying the duotone to each pixel of img.
+// See [MapImage] for the interpretation of the pixels.
+func (d *Duotone) ApplyToImage(img image.Image) *image.NRGBA64 {
+	return MapImage(img, d.Apply)
+}
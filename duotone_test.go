@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestDuotone(t *testing.T) {
+	navy := iro.ColorFromSRGB(0.1, 0.1, 0.4, 1)
+	pink := iro.ColorFromSRGB(1, 0.6, 0.7, 1)
+	orange := iro.ColorFromSRGB(1, 0.5, 0, 1)
+
+	testCases := []struct {
+		name   string
+		colors []iro.Color
+		in     iro.Color
+		want   iro.Color
+	}{
+		{name: "black", colors: []iro.Color{navy, pink}, in: iro.ColorFromSRGB(0, 0, 0, 1), want: navy},
+		{name: "white", colors: []iro.Color{navy, pink}, in: iro.ColorFromSRGB(1, 1, 1, 1), want: pink},
+		{name: "mid gray", colors: []iro.Color{navy, pink}, in: iro.ColorFromOKLab(0.5, 0, 0, 1), want: iro.Mix(navy, pink, 0.5, iro.SpaceOKLab)},
+		{name: "tritone midtone", colors: []iro.Color{navy, orange, pink}, in: iro.ColorFromOKLab(0.5, 0, 0, 1), want: orange},
+		{name: "hue is ignored", colors: []iro.Color{navy, pink}, in: iro.ColorFromOKLab(0.5, 0.1, -0.05, 1), want: iro.Mix(navy, pink, 0.5, iro.SpaceOKLab)},
+		{name: "alpha", colors: []iro.Color{navy, pink}, in: iro.ColorFromSRGB(1, 1, 1, 0.5), want: pink.WithAlpha(0.5)},
+	}
+	for _, tc := range testCases {
+		got := iro.NewDuotone(tc.colors...).Apply(tc.in)
+		gx, gy, gz, ga := got.XYZ()
+		wx, wy, wz, wa := tc.want.XYZ()
+		if !checkTol(gx, wx) || !checkTol(gy, wy) || !checkTol(gz, wz) || !checkTol(ga, wa) {
+			t.Errorf("%s: got %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestDuotoneApplyToImage(t *testing.T) {
+	img := image.NewGray(image.Rect(0, 0, 2, 1))
+	img.SetGray(1, 0, color.Gray{0xff})
+	d := iro.NewDuotone(iro.ColorFromSRGB(0, 0, 1, 1), iro.ColorFromSRGB(1, 1, 0, 1))
+	got := d.ApplyToImage(img)
+	if c := got.NRGBA64At(0, 0); c != (color.NRGBA64{0, 0, 0xffff, 0xffff}) {
+		t.Errorf("(0, 0): got %v, want blue", c)
+	}
+	if c := got.NRGBA64At(1, 0); c != (color.NRGBA64{0xffff, 0xffff, 0, 0xffff}) {
+		t.Errorf("(1, 0): got %v, want yellow", c)
+	}
+}
+
+func TestNewDuotonePanic(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("NewDuotone must panic for a color")
+		}
+	}()
+	iro.NewDuotone(iro.ColorFromSRGB(1, 0, 0, 1))
+}
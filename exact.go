@@ -0,0 +1,202 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+)
+
+// ratMatrix is a 3×3 matrix of exact rational numbers.
+type ratMatrix [3][3]*big.Rat
+
+// rat parses a decimal or a fraction like "0.3127" or "1/3".
+func rat(s string) *big.Rat {
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		panic(fmt.Sprintf("iro: invalid rational number: %q", s))
+	}
+	return r
+}
+
+// ratFromFloat64 returns the shortest decimal representation of v as a rational number.
+// For a constant written as a decimal like 0.3127, this is the decimal rather than the nearest binary value.
+func ratFromFloat64(v float64) *big.Rat {
+	return rat(strconv.FormatFloat(v, 'g', -1, 64))
+}
+
+func ratIdentity() ratMatrix {
+	var m ratMatrix
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			m[i][j] = new(big.Rat)
+		}
+		m[i][i].SetInt64(1)
+	}
+	return m
+}
+
+// mul returns m·n.
+func (m *ratMatrix) mul(n *ratMatrix) ratMatrix {
+	var r ratMatrix
+	var t big.Rat
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			r[i][j] = new(big.Rat)
+			for k := 0; k < 3; k++ {
+				r[i][j].Add(r[i][j], t.Mul(m[i][k], n[k][j]))
+			}
+		}
+	}
+	return r
+}
+
+// apply returns m·v.
+func (m *ratMatrix) apply(v [3]*big.Rat) [3]*big.Rat {
+	var r [3]*big.Rat
+	var t big.Rat
+	for i := 0; i < 3; i++ {
+		r[i] = new(big.Rat)
+		for k := 0; k < 3; k++ {
+			r[i].Add(r[i], t.Mul(m[i][k], v[k]))
+		}
+	}
+	return r
+}
+
+// inv returns the inverse of m with the adjugate matrix.
+func (m *ratMatrix) inv() ratMatrix {
+	// cofactor returns the cofactor of the element at (i, j).
+	cofactor := func(i, j int) *big.Rat {
+		i0, i1 := (i+1)%3, (i+2)%3
+		j0, j1 := (j+1)%3, (j+2)%3
+		var a, b big.Rat
+		a.Mul(m[i0][j0], m[i1][j1])
+		b.Mul(m[i0][j1], m[i1][j0])
+		return a.Sub(&a, &b)
+	}
+
+	det := new(big.Rat)
+	for j := 0; j < 3; j++ {
+		det.Add(det, new(big.Rat).Mul(m[0][j], cofactor(0, j)))
+	}
+	if det.Sign() == 0 {
+		panic("iro: the matrix is singular")
+	}
+
+	var r ratMatrix
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			r[j][i] = new(big.Rat).Quo(cofactor(i, j), det)
+		}
+	}
+	return r
+}
+
+// ratXYZFromChromaticity returns the XYZ of the chromaticity with Y = 1.
+func ratXYZFromChromaticity(c Chromaticity) [3]*big.Rat {
+	x, y := ratFromFloat64(c.X), ratFromFloat64(c.Y)
+	X := new(big.Rat).Quo(x, y)
+	Z := new(big.Rat).SetInt64(1)
+	Z.Sub(Z, x)
+	Z.Sub(Z, y)
+	Z.Quo(Z, y)
+	return [3]*big.Rat{X, new(big.Rat).SetInt64(1), Z}
+}
+
+// ratRGBToXYZ is the exact version of [Primaries.RGBToXYZMatrix].
+// The chromaticities are the shortest decimals of the float64 values, like 0.3127.
+func ratRGBToXYZ(p Primaries) ratMatrix {
+	var m ratMatrix
+	for j, c := range []Chromaticity{p.Red, p.Green, p.Blue} {
+		v := ratXYZFromChromaticity(c)
+		for i := 0; i < 3; i++ {
+			m[i][j] = v[i]
+		}
+	}
+	inv := m.inv()
+	s := inv.apply(ratXYZFromChromaticity(p.White))
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			m[i][j].Mul(m[i][j], s[j])
+		}
+	}
+	return m
+}
+
+// ratBradfordD65ToD50 returns the Bradford chromatic adaptation matrix from D65 to D50.
+//
+// See http://www.brucelindbloom.com/index.html?Eqn_ChromAdapt.html
+func ratBradfordD65ToD50() ratMatrix {
+	var m ratMatrix
+	for i, row := range [3][3]string{
+		{"0.8951", "0.2664", "-0.1614"},
+		{"-0.7502", "1.7135", "0.0367"},
+		{"0.0389", "-0.0685", "1.0296"},
+	} {
+		for j, v := range row {
+			m[i][j] = rat(v)
+		}
+	}
+	src := m.apply(ratXYZFromChromaticity(WhiteD65))
+	dst := m.apply(ratXYZFromChromaticity(WhiteD50))
+	scale := ratIdentity()
+	for i := 0; i < 3; i++ {
+		scale[i][i].Quo(dst[i], src[i])
+	}
+	inv := m.inv()
+	r := inv.mul(&scale)
+	return r.mul(&m)
+}
+
+// ratToXYZMatrix returns the exact matrix from the linear space s to XYZ D65.
+func (s Space) ratToXYZMatrix() ratMatrix {
+	switch s {
+	case SpaceLinearSRGB:
+		return ratRGBToXYZ(PrimariesSRGB)
+	case SpaceLinearDisplayP3:
+		return ratRGBToXYZ(PrimariesDisplayP3)
+	case SpaceLinearRec2020:
+		return ratRGBToXYZ(PrimariesRec2020)
+	case SpaceLinearA98RGB:
+		return ratRGBToXYZ(PrimariesA98RGB)
+	case SpaceLinearProPhotoRGB:
+		m := ratBradfordD65ToD50()
+		m = m.inv()
+		n := ratRGBToXYZ(PrimariesProPhotoRGB)
+		return m.mul(&n)
+	case SpaceXYZ:
+		return ratIdentity()
+	case SpaceXYZD50:
+		m := ratBradfordD65ToD50()
+		return m.inv()
+	default:
+		panic(fmt.Sprintf("iro: the space must be a linear space but %s", s))
+	}
+}
+
+// ConversionMatrixRat is like [ConversionMatrix] but returns the exact matrix as rational numbers.
+//
+// The matrices are derived from the chromaticities of the primaries and the white points, and the Bradford transform, in CSS Color 4.
+// The float64 matrices of this package are the nearest values of them.
+// This is useful to generate test vectors and reference tables without rounding errors.
+//
+// ConversionMatrixRat panics if from or to is not a linear space.
+func ConversionMatrixRat(from, to Space) [3][3]*big.Rat {
+	m0 := from.ratToXYZMatrix()
+	m1 := to.ratToXYZMatrix()
+	m1 = m1.inv()
+	return m1.mul(&m0)
+}
+
+// ConvertRat converts the components in the linear space from to the components in the linear space to with exact rational arithmetic.
+// The results are newly allocated.
+//
+// ConvertRat panics if from or to is not a linear space.
+func ConvertRat(from, to Space, c0, c1, c2 *big.Rat) (*big.Rat, *big.Rat, *big.Rat) {
+	m := ratMatrix(ConversionMatrixRat(from, to))
+	v := m.apply([3]*big.Rat{c0, c1, c2})
+	return v[0], v[1], v[2]
+}
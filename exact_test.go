@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+var linearSpaces = []iro.Space{
+	iro.SpaceLinearSRGB,
+	iro.SpaceLinearDisplayP3,
+	iro.SpaceLinearRec2020,
+	iro.SpaceLinearA98RGB,
+	iro.SpaceLinearProPhotoRGB,
+	iro.SpaceXYZ,
+	iro.SpaceXYZD50,
+}
+
+func TestConversionMatrixRatCSS(t *testing.T) {
+	// The rational matrices in CSS Color 4.
+	// https://www.w3.org/TR/css-color-4/#color-conversion-code
+	testCases := []struct {
+		from, to iro.Space
+		want     [3][3]string
+	}{
+		{
+			from: iro.SpaceLinearSRGB,
+			to:   iro.SpaceXYZ,
+			want: [3][3]string{
+				{"506752/1228815", "87881/245763", "12673/70218"},
+				{"87098/409605", "175762/245763", "12673/175545"},
+				{"7918/409605", "87881/737289", "1001167/1053270"},
+			},
+		},
+		{
+			from: iro.SpaceXYZ,
+			to:   iro.SpaceLinearSRGB,
+			want: [3][3]string{
+				{"12831/3959", "-329/214", "-1974/3959"},
+				{"-851781/878810", "1648619/878810", "36519/878810"},
+				{"705/12673", "-2585/12673", "705/667"},
+			},
+		},
+		{
+			from: iro.SpaceLinearDisplayP3,
+			to:   iro.SpaceXYZ,
+			want: [3][3]string{
+				{"608311/1250200", "189793/714400", "198249/1000160"},
+				{"35783/156275", "247089/357200", "198249/2500400"},
+				{"0", "32229/714400", "5220557/5000800"},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		m := iro.ConversionMatrixRat(tc.from, tc.to)
+		for i := 0; i < 3; i++ {
+			for j := 0; j < 3; j++ {
+				want, _ := new(big.Rat).SetString(tc.want[i][j])
+				if m[i][j].Cmp(want) != 0 {
+					t.Errorf("%s to %s: [%d][%d]: got %s, want %s", tc.from, tc.to, i, j, m[i][j].RatString(), want.RatString())
+				}
+			}
+		}
+	}
+}
+
+func TestConversionMatrixRatFloat64(t *testing.T) {
+	for _, from := range linearSpaces {
+		for _, to := range linearSpaces {
+			m := iro.ConversionMatrixRat(from, to)
+			f := iro.ConversionMatrix(from, to)
+			for i := 0; i < 3; i++ {
+				for j := 0; j < 3; j++ {
+					got, _ := m[i][j].Float64()
+					if math.Abs(got-f[i][j]) > 1e-15 {
+						t.Errorf("%s to %s: [%d][%d]: got %v, want %v", from, to, i, j, got, f[i][j])
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestConvertRatRoundTrip(t *testing.T) {
+	c0, c1, c2 := big.NewRat(1, 3), big.NewRat(1, 2), big.NewRat(-1, 7)
+	for _, from := range linearSpaces {
+		for _, to := range linearSpaces {
+			v0, v1, v2 := iro.ConvertRat(from, to, c0, c1, c2)
+			w0, w1, w2 := iro.ConvertRat(to, from, v0, v1, v2)
+			if w0.Cmp(c0) != 0 || w1.Cmp(c1) != 0 || w2.Cmp(c2) != 0 {
+				t.Errorf("%s to %s and back: got (%s, %s, %s), want (%s, %s, %s)", from, to, w0, w1, w2, c0, c1, c2)
+			}
+		}
+	}
+}
+
+func TestConversionMatrixRatPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("ConversionMatrixRat(SpaceSRGB, SpaceXYZ) must panic")
+		}
+	}()
+	iro.ConversionMatrixRat(iro.SpaceSRGB, iro.SpaceXYZ)
+}
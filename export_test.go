@@ -0,0 +1,13 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+var (
+	FastCbrt    = fastCbrt
+	FastDegamma = fastDegamma
+	FastGamma   = fastGamma
+
+	MulRows        = mulRows
+	MulRowsGeneric = mulRowsGeneric
+)
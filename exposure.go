@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"image"
+	"math"
+)
+
+// Exposure is an exposure adjustment operating in linear sRGB, as a camera does.
+//
+// Adjusting the exposure of gamma-encoded values is incorrect: doubling the encoded values doesn't double the light.
+// Exposure scales the linear values instead.
+type Exposure struct {
+	// Stops is the exposure change in EV stops. Each stop doubles the light, and a negative value darkens.
+	// 0 doesn't change the colors.
+	Stops float64
+
+	// HighlightRolloff is the linear value in [0, 1) where the highlight rolloff starts.
+	// Above it, the values are compressed smoothly toward 1 instead of clipped, keeping the ratios of the channels.
+	// 0 means no rolloff, and the values can exceed 1.
+	HighlightRolloff float64
+}
+
+// Apply returns a new Color by applying the exposure to c.
+// The alpha value is kept.
+func (e *Exposure) Apply(c Color) Color {
+	s := math.Exp2(e.Stops)
+	r, g, b, alpha := c.LinearSRGB()
+	r, g, b = r*s, g*s, b*s
+
+	if k := e.HighlightRolloff; k > 0 && k < 1 {
+		if m := max(r, g, b); m > k {
+			// Compress the maximum channel with a curve whose value and slope are continuous at k and which approaches 1.
+			f := k + (1-k)*(1-math.Exp(-(m-k)/(1-k)))
+			r, g, b = r*f/m, g*f/m, b*f/m
+		}
+	}
+	return ColorFromLinearSRGB(r, g, b, alpha)
+}
+
+// ApplyToImage returns a new image by applying the exposure to each pixel of img.
+// See [MapImage] for the interpretation of the pixels.
+func (e *Exposure) ApplyToImage(img image.Image) *image.NRGBA64 {
+	return MapImage(img, e.Apply)
+}
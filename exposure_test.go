@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestExposure(t *testing.T) {
+	testCases := []struct {
+		name     string
+		exposure iro.Exposure
+		in, want [3]float64
+	}{
+		{name: "zero", exposure: iro.Exposure{}, in: [3]float64{0.2, 0.4, 0.6}, want: [3]float64{0.2, 0.4, 0.6}},
+		{name: "+1", exposure: iro.Exposure{Stops: 1}, in: [3]float64{0.1, 0.2, 0.3}, want: [3]float64{0.2, 0.4, 0.6}},
+		{name: "-2", exposure: iro.Exposure{Stops: -2}, in: [3]float64{0.4, 0.8, 1}, want: [3]float64{0.1, 0.2, 0.25}},
+		{name: "+1 without rolloff", exposure: iro.Exposure{Stops: 1}, in: [3]float64{0.8, 0.4, 0.2}, want: [3]float64{1.6, 0.8, 0.4}},
+		{name: "rolloff below the knee", exposure: iro.Exposure{Stops: 1, HighlightRolloff: 0.8}, in: [3]float64{0.1, 0.2, 0.3}, want: [3]float64{0.2, 0.4, 0.6}},
+	}
+	for _, tc := range testCases {
+		got := tc.exposure.Apply(iro.ColorFromLinearSRGB(tc.in[0], tc.in[1], tc.in[2], 0.5))
+		r, g, b, a := got.LinearSRGB()
+		if !checkTol(r, tc.want[0]) || !checkTol(g, tc.want[1]) || !checkTol(b, tc.want[2]) || !checkTol(a, 0.5) {
+			t.Errorf("%s: got (%f, %f, %f, %f), want (%f, %f, %f, 0.5)", tc.name, r, g, b, a, tc.want[0], tc.want[1], tc.want[2])
+		}
+	}
+}
+
+func TestExposureRolloff(t *testing.T) {
+	e := &iro.Exposure{Stops: 3, HighlightRolloff: 0.7}
+	r, g, b, _ := e.Apply(iro.ColorFromLinearSRGB(0.8, 0.4, 0.2, 1)).LinearSRGB()
+	if r <= 0.7 || r >= 1 {
+		t.Errorf("red: got %f, want in (0.7, 1)", r)
+	}
+	// The ratios of the channels are kept.
+	if !checkTol(g/r, 0.5) || !checkTol(b/r, 0.25) {
+		t.Errorf("ratios: got (%f, %f), want (0.5, 0.25)", g/r, b/r)
+	}
+
+	// The rolloff is monotonic.
+	prev := 0.0
+	for i := 0; i <= 100; i++ {
+		v := float64(i) / 10
+		got, _, _, _ := (&iro.Exposure{HighlightRolloff: 0.7}).Apply(iro.ColorFromLinearSRGB(v, 0, 0, 1)).LinearSRGB()
+		if got < prev || got >= 1 {
+			t.Errorf("%f: got %f, previous %f", v, got, prev)
+		}
+		prev = got
+	}
+}
+
+func TestExposureApplyToImage(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 1, 1))
+	// sRGB 0x80 is about 0.216 in linear.
+	img.SetNRGBA(0, 0, color.NRGBA{0x80, 0x80, 0x80, 0xff})
+	got := (&iro.Exposure{Stops: 1}).ApplyToImage(img)
+	want := (&iro.Exposure{Stops: 1}).Apply(iro.ColorFromSRGBNRGBA(img.NRGBAAt(0, 0))).SRGBNRGBA64()
+	if got.NRGBA64At(0, 0) != want {
+		t.Errorf("got %v, want %v", got.NRGBA64At(0, 0), want)
+	}
+	// Doubling the light is not doubling the encoded value.
+	if r := got.NRGBA64At(0, 0).R >> 8; r < 0xa0 || r > 0xc0 {
+		t.Errorf("red: got %#x, want about 0xaf", r)
+	}
+}
@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func relativeError(got, want float64) float64 {
+	if want == 0 {
+		return math.Abs(got)
+	}
+	return math.Abs((got - want) / want)
+}
+
+func TestFastCbrt(t *testing.T) {
+	var maxErr float64
+	for _, x := range []float64{0, 1e-300, 1e-10, 1e-3, 0.1, 0.5, 1, 2, 3, 27, 1000, 1e10, 1e300, -8, -0.001} {
+		maxErr = max(maxErr, relativeError(iro.FastCbrt(x), math.Cbrt(x)))
+	}
+	for i := 0; i <= 10000; i++ {
+		x := float64(i) / 10000
+		maxErr = max(maxErr, relativeError(iro.FastCbrt(x), math.Cbrt(x)))
+	}
+	if maxErr > 1e-7 {
+		t.Errorf("the maximum relative error: got %g, want <= 1e-7", maxErr)
+	}
+}
+
+func TestFastTransferFunctions(t *testing.T) {
+	var maxDegammaErr, maxGammaErr float64
+	for i := -1000; i <= 11000; i++ {
+		x := float64(i) / 10000
+		r := iro.ColorFromSRGB(x, 0, 0, 1)
+		want, _, _, _ := r.LinearSRGB()
+		maxDegammaErr = max(maxDegammaErr, relativeError(iro.FastDegamma(x), want))
+
+		l := iro.ColorFromLinearSRGB(x, 0, 0, 1)
+		want, _, _, _ = l.SRGB()
+		maxGammaErr = max(maxGammaErr, relativeError(iro.FastGamma(x), want))
+	}
+	if maxDegammaErr > 1e-7 {
+		t.Errorf("degamma: the maximum relative error: got %g, want <= 1e-7", maxDegammaErr)
+	}
+	if maxGammaErr > 1e-7 {
+		t.Errorf("gamma: the maximum relative error: got %g, want <= 1e-7", maxGammaErr)
+	}
+}
+
+func BenchmarkOKLab(b *testing.B) {
+	c := iro.ColorFromSRGB(0.2, 0.4, 0.6, 1)
+	for i := 0; i < b.N; i++ {
+		c.OKLab()
+	}
+}
+
+func BenchmarkSRGB(b *testing.B) {
+	c := iro.ColorFromSRGB(0.2, 0.4, 0.6, 1)
+	for i := 0; i < b.N; i++ {
+		c.SRGB()
+	}
+}
@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+// Package film provides a parametric model of the color rendition of photographic films.
+package film
+
+import (
+	"encoding/json"
+	"fmt"
+	"image"
+	"io"
+
+	"github.com/hajimehoshi/iro"
+	"github.com/hajimehoshi/iro/lut"
+)
+
+// Profile is a parametric film emulation profile.
+//
+// A color is converted in this order: the crosstalk matrix in linear sRGB, the tone curves of the channels in nonlinear sRGB,
+// and the saturation curve in OKLab. Grain is not simulated.
+//
+// Profile can be saved and loaded as JSON with [EncodeProfile] and [ParseProfile].
+type Profile struct {
+	// Name is the name of the profile.
+	Name string `json:"name,omitempty"`
+
+	// Crosstalk is the matrix mixing the linear sRGB channels, simulating the crosstalk between the dye layers of a film.
+	// The rows are for the output channels. The zero matrix is treated as the identity matrix.
+	Crosstalk iro.Matrix3 `json:"crosstalk"`
+
+	// Curves is the tone curves of the red, green, and blue channels in nonlinear sRGB.
+	// nil doesn't change the channel. Otherwise, a curve requires at least two points with different X (see [iro.NewCurve]).
+	Curves [3][]iro.CurvePoint `json:"curves"`
+
+	// Saturation is the curve of the scale of the OKLab chroma by the OKLab lightness.
+	// For example, the points (0, 0.8), (0.5, 1.2), and (1, 0.8) saturate the midtones and desaturate the shadows and the highlights.
+	// nil doesn't change the chroma.
+	Saturation []iro.CurvePoint `json:"saturation,omitempty"`
+}
+
+// compiledProfile is a Profile with the curves created.
+type compiledProfile struct {
+	crosstalk  *iro.Matrix3
+	curves     [3]*iro.Curve
+	saturation *iro.Curve
+}
+
+func (p *Profile) compile() *compiledProfile {
+	var c compiledProfile
+	if p.Crosstalk != (iro.Matrix3{}) {
+		c.crosstalk = &p.Crosstalk
+	}
+	for i, pts := range p.Curves {
+		if pts != nil {
+			c.curves[i] = iro.NewCurve(pts)
+		}
+	}
+	if p.Saturation != nil {
+		c.saturation = iro.NewCurve(p.Saturation)
+	}
+	return &c
+}
+
+func (c *compiledProfile) apply(clr iro.Color) iro.Color {
+	if c.crosstalk != nil {
+		r, g, b, a := clr.LinearSRGB()
+		r, g, b = c.crosstalk.Apply(r, g, b)
+		clr = iro.ColorFromLinearSRGB(r, g, b, a)
+	}
+	if c.curves != [3]*iro.Curve{} {
+		var v [3]float64
+		var a float64
+		v[0], v[1], v[2], a = clr.SRGB()
+		for i, curve := range c.curves {
+			if curve != nil {
+				v[i] = curve.At(v[i])
+			}
+		}
+		clr = iro.ColorFromSRGB(v[0], v[1], v[2], a)
+	}
+	if c.saturation != nil {
+		l, a, b, alpha := clr.OKLab()
+		s := c.saturation.At(l)
+		clr = iro.ColorFromOKLab(l, a*s, b*s, alpha)
+	}
+	return clr
+}
+
+// Apply returns a new Color by applying the profile to c.
+// The alpha value is kept.
+//
+// Apply panics if the curves of the profile are invalid.
+func (p *Profile) Apply(c iro.Color) iro.Color {
+	return p.compile().apply(c)
+}
+
+// ApplyToImage returns a new image by applying the profile to each pixel of img.
+// See [iro.MapImage] for the interpretation of the pixels.
+//
+// ApplyToImage panics if the curves of the profile are invalid.
+func (p *Profile) ApplyToImage(img image.Image) *image.NRGBA64 {
+	return iro.MapImage(img, p.compile().apply)
+}
+
+// LUT3D returns a 3D LUT of the given size baking the profile, to export it to other tools as a .cube file
+// or to apply it to images faster. See [lut.NewLUT3DFromColorFunc].
+//
+// LUT3D panics if the curves of the profile are invalid.
+func (p *Profile) LUT3D(size int) *lut.LUT3D {
+	return lut.NewLUT3DFromColorFunc(size, p.compile().apply)
+}
+
+// validate returns an error if the curves of the profile are invalid.
+func (p *Profile) validate() error {
+	check := func(name string, pts []iro.CurvePoint) error {
+		if pts == nil {
+			return nil
+		}
+		if len(pts) < 2 {
+			return fmt.Errorf("film: the %s curve requires at least two points but %d", name, len(pts))
+		}
+		xs := map[float64]struct{}{}
+		for _, pt := range pts {
+			if _, ok := xs[pt.X]; ok {
+				return fmt.Errorf("film: two points of the %s curve have the same X: %f", name, pt.X)
+			}
+			xs[pt.X] = struct{}{}
+		}
+		return nil
+	}
+	for i, name := range []string{"red", "green", "blue"} {
+		if err := check(name, p.Curves[i]); err != nil {
+			return err
+		}
+	}
+	return check("saturation", p.Saturation)
+}
+
+// ParseProfile parses a profile in JSON.
+func ParseProfile(r io.Reader) (*Profile, error) {
+	var p Profile
+	if err := json.NewDecoder(r).Decode(&p); err != nil {
+		return nil, fmt.Errorf("film: invalid profile: %w", err)
+	}
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// EncodeProfile writes the profile in JSON.
+func EncodeProfile(w io.Writer, p *Profile) error {
+	if err := p.validate(); err != nil {
+		return err
+	}
+	e := json.NewEncoder(w)
+	e.SetIndent("", "  ")
+	return e.Encode(p)
+}
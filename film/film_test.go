@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package film_test
+
+import (
+	"image"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+	"github.com/hajimehoshi/iro/film"
+	"github.com/hajimehoshi/iro/lut"
+)
+
+func sameColor(a, b iro.Color, tol float64) bool {
+	ax, ay, az, aa := a.XYZ()
+	bx, by, bz, ba := b.XYZ()
+	return math.Abs(ax-bx) <= tol && math.Abs(ay-by) <= tol && math.Abs(az-bz) <= tol && math.Abs(aa-ba) <= tol
+}
+
+func TestProfileIdentity(t *testing.T) {
+	p := &film.Profile{}
+	for _, c := range []iro.Color{iro.ColorFromSRGB(0.2, 0.5, 0.8, 1), iro.ColorFromSRGB(1, 0, 0, 0.5)} {
+		if got := p.Apply(c); !sameColor(got, c, 1e-9) {
+			t.Errorf("Apply(%v): got %v", c, got)
+		}
+	}
+}
+
+func TestProfileApply(t *testing.T) {
+	p := &film.Profile{
+		Crosstalk: iro.Matrix3{
+			{0.5, 0.5, 0},
+			{0, 1, 0},
+			{0, 0, 1},
+		},
+		Curves: [3][]iro.CurvePoint{
+			nil,
+			nil,
+			{{X: 0, Y: 0.5}, {X: 1, Y: 1}},
+		},
+		Saturation: []iro.CurvePoint{{X: 0, Y: 0}, {X: 1, Y: 0}},
+	}
+	// The crosstalk makes the linear (0.5, 0, 0), the curve makes the encoded blue 0.5, and the saturation removes the chroma.
+	rgb := iro.ColorFromLinearSRGB(0.5, 0, 0, 1)
+	r, g, _, _ := rgb.SRGB()
+	wantL, _, _, _ := iro.ColorFromSRGB(r, g, 0.5, 1).OKLab()
+
+	l, a, b, _ := p.Apply(iro.ColorFromLinearSRGB(1, 0, 0, 1)).OKLab()
+	if math.Abs(l-wantL) > 1e-9 || math.Abs(a) > 1e-9 || math.Abs(b) > 1e-9 {
+		t.Errorf("got OKLab (%f, %f, %f), want (%f, 0, 0)", l, a, b, wantL)
+	}
+}
+
+func TestProfileLUT3D(t *testing.T) {
+	p := film.NewSlide()
+	l := p.LUT3D(5)
+	// The LUT is exact at the grid points.
+	c := iro.ColorFromSRGB(0.25, 0.5, 0.75, 1)
+	if got, want := l.ApplyToColor(c, lut.InterpolationTrilinear), p.Apply(c); !sameColor(got, want, 1e-9) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	img := image.NewNRGBA(image.Rect(0, 0, 1, 1))
+	img.SetNRGBA(0, 0, c.SRGBNRGBA())
+	want := p.Apply(iro.ColorFromSRGBNRGBA(img.NRGBAAt(0, 0))).SRGBNRGBA64()
+	if got := p.ApplyToImage(img).NRGBA64At(0, 0); got != want {
+		t.Errorf("ApplyToImage: got %v, want %v", got, want)
+	}
+}
+
+func TestParseProfileError(t *testing.T) {
+	for _, data := range []string{
+		`{`,
+		`{"curves": [[{"X": 0, "Y": 0}], null, null]}`,
+		`{"saturation": [{"X": 0, "Y": 0}, {"X": 0, "Y": 1}]}`,
+	} {
+		if _, err := film.ParseProfile(strings.NewReader(data)); err == nil {
+			t.Errorf("ParseProfile(%q): got no error", data)
+		}
+	}
+}
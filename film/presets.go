@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package film
+
+import (
+	"github.com/hajimehoshi/iro"
+)
+
+// The presets are generic looks of film stocks, not measurements of specific products.
+// The rows of the crosstalk matrices sum to 1 so that the crosstalk keeps gray colors.
+
+// sCurve returns the points of an S-shaped tone curve with the black and the white levels and the contrast in the midtones.
+func sCurve(black, white, contrast float64) []iro.CurvePoint {
+	mid := (black + white) / 2
+	d := (white - black) / 4 * contrast
+	return []iro.CurvePoint{
+		{X: 0, Y: black},
+		{X: 0.25, Y: mid - d},
+		{X: 0.5, Y: mid},
+		{X: 0.75, Y: mid + d},
+		{X: 1, Y: white},
+	}
+}
+
+// NewWarmNegative creates a Profile of a warm color negative film with soft contrast and natural saturation.
+func NewWarmNegative() *Profile {
+	return &Profile{
+		Name: "Warm Negative",
+		Crosstalk: iro.Matrix3{
+			{0.92, 0.06, 0.02},
+			{0.04, 0.92, 0.04},
+			{0.02, 0.08, 0.90},
+		},
+		Curves: [3][]iro.CurvePoint{
+			sCurve(0.03, 0.98, 0.95),
+			sCurve(0.02, 0.97, 0.95),
+			sCurve(0.02, 0.94, 0.9),
+		},
+		Saturation: []iro.CurvePoint{
+			{X: 0, Y: 0.85},
+			{X: 0.5, Y: 1.05},
+			{X: 1, Y: 0.8},
+		},
+	}
+}
+
+// NewSlide creates a Profile of a color reversal (slide) film with high contrast and vivid saturation.
+func NewSlide() *Profile {
+	return &Profile{
+		Name: "Slide",
+		Crosstalk: iro.Matrix3{
+			{1.08, -0.06, -0.02},
+			{-0.04, 1.08, -0.04},
+			{-0.02, -0.06, 1.08},
+		},
+		Curves: [3][]iro.CurvePoint{
+			sCurve(0, 1, 1.2),
+			sCurve(0, 1, 1.2),
+			sCurve(0, 1, 1.25),
+		},
+		Saturation: []iro.CurvePoint{
+			{X: 0, Y: 1},
+			{X: 0.5, Y: 1.25},
+			{X: 1, Y: 1},
+		},
+	}
+}
+
+// NewBleachBypass creates a Profile of the bleach bypass process, with high contrast and low saturation.
+func NewBleachBypass() *Profile {
+	return &Profile{
+		Name: "Bleach Bypass",
+		Curves: [3][]iro.CurvePoint{
+			sCurve(0, 1, 1.3),
+			sCurve(0, 1, 1.3),
+			sCurve(0, 1, 1.3),
+		},
+		Saturation: []iro.CurvePoint{
+			{X: 0, Y: 0.5},
+			{X: 1, Y: 0.5},
+		},
+	}
+}
+
+// NewFadedPrint creates a Profile of an old faded print, with lifted bluish shadows and dimmed highlights.
+func NewFadedPrint() *Profile {
+	return &Profile{
+		Name: "Faded Print",
+		Curves: [3][]iro.CurvePoint{
+			sCurve(0.08, 0.94, 0.85),
+			sCurve(0.09, 0.93, 0.85),
+			sCurve(0.14, 0.88, 0.85),
+		},
+		Saturation: []iro.CurvePoint{
+			{X: 0, Y: 0.7},
+			{X: 1, Y: 0.7},
+		},
+	}
+}
@@ -0,0 +1,43 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package film_test
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+	"github.com/hajimehoshi/iro/film"
+)
+
+func TestPresets(t *testing.T) {
+	gray := iro.ColorFromSRGB(0.5, 0.5, 0.5, 1)
+	for _, p := range []*film.Profile{film.NewWarmNegative(), film.NewSlide(), film.NewBleachBypass(), film.NewFadedPrint()} {
+		if p.Name == "" {
+			t.Errorf("a preset must have a name")
+		}
+		var buf bytes.Buffer
+		if err := film.EncodeProfile(&buf, p); err != nil {
+			t.Fatalf("%s: %v", p.Name, err)
+		}
+		q, err := film.ParseProfile(&buf)
+		if err != nil {
+			t.Fatalf("%s: %v", p.Name, err)
+		}
+		c := iro.ColorFromSRGB(0.8, 0.3, 0.2, 1)
+		if got, want := q.Apply(c), p.Apply(c); !sameColor(got, want, 1e-12) {
+			t.Errorf("%s: round trip: got %v, want %v", p.Name, got, want)
+		}
+
+		// The crosstalk keeps gray colors.
+		if p.Crosstalk != (iro.Matrix3{}) {
+			r, g, b, _ := gray.LinearSRGB()
+			x, y, z := p.Crosstalk.Apply(r, g, b)
+			if math.Abs(x-r) > 1e-9 || math.Abs(y-g) > 1e-9 || math.Abs(z-b) > 1e-9 {
+				t.Errorf("%s: crosstalk must keep gray", p.Name)
+			}
+		}
+	}
+}
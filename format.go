@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// FormatOptions represents options for [Color.CSS].
+type FormatOptions struct {
+	// Precision is the number of significant digits of each number.
+	// For a number whose absolute value is less than 1, Precision is the number of decimal places.
+	// The default 0 means the shortest representation that parses back to exactly the same float64 value.
+	Precision int
+}
+
+func (o *FormatOptions) precision() int {
+	if o == nil || o.Precision == 0 {
+		return -1
+	}
+	return o.Precision
+}
+
+// cssSpaceNames is the CSS names of the spaces for color().
+var cssSpaceNames = map[Space]string{
+	SpaceSRGB:        "srgb",
+	SpaceLinearSRGB:  "srgb-linear",
+	SpaceDisplayP3:   "display-p3",
+	SpaceA98RGB:      "a98-rgb",
+	SpaceProPhotoRGB: "prophoto-rgb",
+	SpaceRec2020:     "rec2020",
+	SpaceXYZ:         "xyz-d65",
+	SpaceXYZD50:      "xyz-d50",
+}
+
+// CSS returns the CSS representation of c in the space, like "oklch(0.7 0.15 60)" or "color(display-p3 1 0.5 0 / 0.5)".
+// The result can be parsed with [ParseCSS].
+//
+// SpaceLab, SpaceLch, SpaceOKLab, and SpaceOKLch use their own functions, and the other spaces use color().
+// Hues are in degrees. Alpha is omitted when it is 1.
+//
+// CSS panics if the space has no CSS representation, like [SpaceLinearDisplayP3].
+func (c Color) CSS(space Space, opts *FormatOptions) string {
+	var fn string
+	switch space {
+	case SpaceLab:
+		fn = "lab("
+	case SpaceLch:
+		fn = "lch("
+	case SpaceOKLab:
+		fn = "oklab("
+	case SpaceOKLch:
+		fn = "oklch("
+	default:
+		name, ok := cssSpaceNames[space]
+		if !ok {
+			panic(fmt.Sprintf("iro: the space %s has no CSS representation", space))
+		}
+		fn = "color(" + name + " "
+	}
+
+	c0, c1, c2, alpha := c.Components(space)
+	if space.isCylindrical() {
+		c2 = NormalizeHueDeg(AngleUnitDegree.FromRadians(c2))
+	}
+
+	prec := opts.precision()
+	var b strings.Builder
+	b.WriteString(fn)
+	b.WriteString(formatCSSNumber(c0, prec))
+	b.WriteByte(' ')
+	b.WriteString(formatCSSNumber(c1, prec))
+	b.WriteByte(' ')
+	b.WriteString(formatCSSNumber(c2, prec))
+	if alpha != 1 {
+		b.WriteString(" / ")
+		b.WriteString(formatCSSNumber(alpha, prec))
+	}
+	b.WriteByte(')')
+	return b.String()
+}
+
+// formatCSSNumber formats v with prec significant digits, or the shortest representation when prec is -1.
+// A number whose absolute value is less than 1 is formatted with prec decimal places instead,
+// so that tiny errors like 1e-17 are formatted as 0.
+// NaN is formatted as none.
+func formatCSSNumber(v float64, prec int) string {
+	if math.IsNaN(v) {
+		return "none"
+	}
+	var s string
+	switch {
+	case prec < 0:
+		s = strconv.FormatFloat(v, 'g', -1, 64)
+	case math.Abs(v) < 1:
+		s = strconv.FormatFloat(v, 'f', prec, 64)
+		if strings.ContainsRune(s, '.') {
+			s = strings.TrimRight(s, "0")
+			s = strings.TrimSuffix(s, ".")
+		}
+	default:
+		s = strconv.FormatFloat(v, 'g', prec, 64)
+		// Avoid an exponent for large numbers like hues, e.g. "1.2e+02".
+		if strings.ContainsRune(s, 'e') {
+			v, _ = strconv.ParseFloat(s, 64)
+			s = strconv.FormatFloat(v, 'f', -1, 64)
+		}
+	}
+	// Avoid "-0".
+	if s == "-0" {
+		s = "0"
+	}
+	return s
+}
+
+// Hex returns the hexadecimal sRGB representation of c like "#ff8000", or "#ff800080" when the color is not opaque.
+// The components are clamped and rounded to 8 bits, so the precision is fixed.
+func (c Color) Hex() string {
+	clr := c.SRGBNRGBA()
+	if clr.A == 0xff {
+		return fmt.Sprintf("#%02x%02x%02x", clr.R, clr.G, clr.B)
+	}
+	return fmt.Sprintf("#%02x%02x%02x%02x", clr.R, clr.G, clr.B, clr.A)
+}
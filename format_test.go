@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestCSS(t *testing.T) {
+	testCases := []struct {
+		color     iro.Color
+		space     iro.Space
+		precision int
+		want      string
+	}{
+		{color: iro.ColorFromSRGB(1, 0.5, 0, 1), space: iro.SpaceSRGB, precision: 6, want: "color(srgb 1 0.5 0)"},
+		{color: iro.ColorFromSRGB(1, 0.5, 0, 0.25), space: iro.SpaceSRGB, precision: 6, want: "color(srgb 1 0.5 0 / 0.25)"},
+		{color: iro.ColorFromDisplayP3(1, 0.5, 0, 1), space: iro.SpaceDisplayP3, precision: 3, want: "color(display-p3 1 0.5 0)"},
+		{color: iro.ColorFromSRGB(1/3.0, 0, 0, 1), space: iro.SpaceSRGB, precision: 6, want: "color(srgb 0.333333 0 0)"},
+		{color: iro.ColorFromSRGB(1/3.0, 0, 0, 1), space: iro.SpaceSRGB, precision: 3, want: "color(srgb 0.333 0 0)"},
+		{color: iro.ColorFromXYZ(0.25, 0.5, 0.75, 1), space: iro.SpaceXYZ, want: "color(xyz-d65 0.25 0.5 0.75)"},
+		{color: iro.ColorFromXYZ(0.1, 1e-20, -0.5, 1), space: iro.SpaceXYZ, want: "color(xyz-d65 0.1 1e-20 -0.5)"},
+		{color: iro.ColorFromXYZ(0.0012345, -1e-20, 1.5, 1), space: iro.SpaceXYZ, precision: 3, want: "color(xyz-d65 0.001 0 1.5)"},
+		{color: iro.ColorFromOKLch(0.7, 0.15, iro.AngleUnitDegree.ToRadians(1234.5678), 1), space: iro.SpaceOKLch, precision: 3, want: "oklch(0.7 0.15 155)"},
+		{color: iro.ColorFromOKLch(0.7, 0.15, iro.AngleUnitDegree.ToRadians(-30), 1), space: iro.SpaceOKLch, precision: 4, want: "oklch(0.7 0.15 330)"},
+		{color: iro.ColorFromLab(50, 20, -30, 1), space: iro.SpaceLab, precision: 6, want: "lab(50 20 -30)"},
+		{color: iro.ColorFromOKLab(0.5, 0, 0, 1), space: iro.SpaceOKLab, precision: 6, want: "oklab(0.5 0 0)"},
+	}
+	for _, tc := range testCases {
+		opts := &iro.FormatOptions{Precision: tc.precision}
+		if got := tc.color.CSS(tc.space, opts); got != tc.want {
+			t.Errorf("CSS(%s, precision=%d): got %q, want %q", tc.space, tc.precision, got, tc.want)
+		}
+	}
+}
+
+func TestCSSRoundTrip(t *testing.T) {
+	colors := []iro.Color{
+		iro.ColorFromSRGB(0.1, 0.2, 0.3, 1),
+		iro.ColorFromSRGB(0.9, 0.45, 0.05, 0.3),
+		iro.ColorFromOKLch(0.6, 0.2, 2, 1),
+	}
+	for _, space := range []iro.Space{
+		iro.SpaceSRGB,
+		iro.SpaceLinearSRGB,
+		iro.SpaceDisplayP3,
+		iro.SpaceA98RGB,
+		iro.SpaceProPhotoRGB,
+		iro.SpaceRec2020,
+		iro.SpaceXYZ,
+		iro.SpaceXYZD50,
+		iro.SpaceLab,
+		iro.SpaceLch,
+		iro.SpaceOKLab,
+		iro.SpaceOKLch,
+	} {
+		for _, c := range colors {
+			s := c.CSS(space, nil)
+			got, err := iro.ParseCSS(s)
+			if err != nil {
+				t.Errorf("ParseCSS(%q) failed: %v", s, err)
+				continue
+			}
+			// The shortest representation parses back to the same components.
+			w0, w1, w2, wa := c.Components(space)
+			g0, g1, g2, ga := got.Components(space)
+			if !checkTol(g0, w0) || !checkTol(g1, w1) || !checkTol(g2, w2) || !checkTol(ga, wa) {
+				t.Errorf("ParseCSS(%q): got (%f, %f, %f, %f), want (%f, %f, %f, %f)", s, g0, g1, g2, ga, w0, w1, w2, wa)
+			}
+		}
+	}
+}
+
+func TestCSSPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("CSS(SpaceLinearDisplayP3) must panic")
+		}
+	}()
+	iro.White.CSS(iro.SpaceLinearDisplayP3, nil)
+}
+
+func TestHex(t *testing.T) {
+	testCases := []struct {
+		color iro.Color
+		want  string
+	}{
+		{color: iro.ColorFromSRGB(1, 0x80/255.0, 0, 1), want: "#ff8000"},
+		{color: iro.ColorFromSRGB(1, 0x80/255.0, 0, 0x80/255.0), want: "#ff800080"},
+		{color: iro.ColorFromSRGB(1.2, -0.1, 0, 1), want: "#ff0000"},
+		{color: iro.Black, want: "#000000"},
+	}
+	for _, tc := range testCases {
+		if got := tc.color.Hex(); got != tc.want {
+			t.Errorf("Hex(): got %q, want %q", got, tc.want)
+		}
+	}
+}
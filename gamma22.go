@@ -0,0 +1,38 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"math"
+)
+
+// ColorFromSRGBGamma22 builds a Color from nonlinear sRGB channels with the gamma 2.2 in [0,1] and alpha.
+//
+// Many displays and game engines decode sRGB signals with the pure power function of the gamma 2.2
+// instead of the piecewise sRGB transfer function. The difference appears in dark tones:
+// for example, the signal 0.02 is 0.00155 in linear sRGB but 0.00018 with the gamma 2.2.
+func ColorFromSRGBGamma22(r, g, b, alpha float64) Color {
+	r = degamma22(r)
+	g = degamma22(g)
+	b = degamma22(b)
+
+	return ColorFromLinearSRGB(r, g, b, alpha)
+}
+
+// SRGBGamma22 converts Color to nonlinear sRGB channels with the gamma 2.2 and alpha. See [ColorFromSRGBGamma22].
+func (c Color) SRGBGamma22() (r, g, b, a float64) {
+	r, g, b, a = c.LinearSRGB()
+	r = gamma22(r)
+	g = gamma22(g)
+	b = gamma22(b)
+	return
+}
+
+func degamma22(x float64) float64 {
+	return math.Copysign(math.Pow(math.Abs(x), 2.2), x)
+}
+
+func gamma22(x float64) float64 {
+	return math.Copysign(math.Pow(math.Abs(x), 1/2.2), x)
+}
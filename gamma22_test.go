@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestSRGBGamma22(t *testing.T) {
+	testCases := []struct {
+		r, g, b    float64
+		lr, lg, lb float64
+	}{
+		{r: 0, g: 0.5, b: 1, lr: 0, lg: 0.2176376, lb: 1},
+		{r: 0.02, g: 0.1, b: 0.2, lr: 0.000182922, lg: 0.006309573, lb: 0.028991186},
+	}
+	for _, tc := range testCases {
+		c := iro.ColorFromSRGBGamma22(tc.r, tc.g, tc.b, 1)
+		lr, lg, lb, _ := c.LinearSRGB()
+		if !checkTol(lr, tc.lr) || !checkTol(lg, tc.lg) || !checkTol(lb, tc.lb) {
+			t.Errorf("ColorFromSRGBGamma22(%f, %f, %f): got linear (%.9f, %.9f, %.9f), want (%.9f, %.9f, %.9f)", tc.r, tc.g, tc.b, lr, lg, lb, tc.lr, tc.lg, tc.lb)
+		}
+		r, g, b, _ := c.SRGBGamma22()
+		if !checkTol(r, tc.r) || !checkTol(g, tc.g) || !checkTol(b, tc.b) {
+			t.Errorf("SRGBGamma22: got (%f, %f, %f), want (%f, %f, %f)", r, g, b, tc.r, tc.g, tc.b)
+		}
+	}
+
+	// The gamma 2.2 is darker than the sRGB transfer function in dark tones.
+	_, y22, _, _ := iro.ColorFromSRGBGamma22(0.02, 0.02, 0.02, 1).XYZ()
+	_, y, _, _ := iro.ColorFromSRGB(0.02, 0.02, 0.02, 1).XYZ()
+	if y22 >= y {
+		t.Errorf("luminance: got %f, want less than %f", y22, y)
+	}
+}
+
+func TestSRGBGamma22Gamut(t *testing.T) {
+	c := iro.ColorFromSRGB(1.1, 0.5, 0, 1)
+	r, g, b, _ := c.Convert(iro.SpaceSRGBGamma22, iro.WithGamutMapping(iro.GamutMappingClip))
+	if !checkTol(r, 1) || g <= 0 || g >= 1 || !checkTol(b, 0) {
+		t.Errorf("got (%f, %f, %f), want the red clipped", r, g, b)
+	}
+	if got, want := iro.GamutMaxChroma(iro.SpaceSRGBGamma22, 0.7, 1), iro.GamutMaxChroma(iro.SpaceSRGB, 0.7, 1); !checkTol(got, want) {
+		t.Errorf("GamutMaxChroma: got %f, want %f", got, want)
+	}
+}
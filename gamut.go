@@ -0,0 +1,171 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"fmt"
+	"math"
+)
+
+// gamutResolution is the number of subdivisions of each edge of an RGB cube to compute gamut volumes.
+const gamutResolution = 64
+
+// isRGB reports whether s is an RGB space, whose gamut is the unit cube.
+func (s Space) isRGB() bool {
+	switch s {
+	case SpaceSRGB, SpaceLinearSRGB, SpaceSRGBGamma22, SpaceDisplayP3, SpaceLinearDisplayP3,
+		SpaceRec2020, SpaceLinearRec2020, SpaceA98RGB, SpaceLinearA98RGB, SpaceProPhotoRGB, SpaceLinearProPhotoRGB:
+		return true
+	}
+	return false
+}
+
+// isCylindrical reports whether s is a cylindrical space with a hue angle.
+func (s Space) isCylindrical() bool {
+	switch s {
+	case SpaceOKLch, SpaceLch:
+		return true
+	}
+	return false
+}
+
+func checkGamutSpaces(gamut, space Space) {
+	if !gamut.isRGB() {
+		panic(fmt.Sprintf("iro: gamut must be an RGB space but %s", gamut))
+	}
+	if space.isCylindrical() {
+		panic(fmt.Sprintf("iro: volumes cannot be measured in a cylindrical space %s", space))
+	}
+}
+
+// gamutPoint returns the components in space of the color at (r, g, b) in the RGB space gamut.
+func gamutPoint(gamut, space Space, r, g, b float64) [3]float64 {
+	c0, c1, c2, _ := ColorFromComponents(gamut, r, g, b, 1).Components(space)
+	return [3]float64{c0, c1, c2}
+}
+
+// GamutVolume returns the volume of the gamut of an RGB space, measured in the given space.
+//
+// gamut must be an RGB space like [SpaceSRGB]. A linear RGB space has the same gamut as its nonlinear counterpart.
+// space must not be a cylindrical space like [SpaceOKLch].
+//
+// The volume is computed from a triangulated hull of the gamut, whose vertices are mapped from a subdivided RGB cube.
+func GamutVolume(gamut, space Space) float64 {
+	checkGamutSpaces(gamut, space)
+
+	const n = gamutResolution
+	var v float64
+	// By the divergence theorem, the volume is the sum of the signed volumes of the tetrahedra
+	// formed by the origin and the triangles on the surface.
+	for k := 0; k < 3; k++ {
+		// (i, j, k) is a cyclic permutation, so that e_i x e_j = e_k.
+		i, j := (k+1)%3, (k+2)%3
+		for _, f := range []float64{0, 1} {
+			sign := 1.0
+			if f == 0 {
+				sign = -1
+			}
+			point := func(u, w int) [3]float64 {
+				var rgb [3]float64
+				rgb[i] = float64(u) / n
+				rgb[j] = float64(w) / n
+				rgb[k] = f
+				return gamutPoint(gamut, space, rgb[0], rgb[1], rgb[2])
+			}
+			for u := 0; u < n; u++ {
+				for w := 0; w < n; w++ {
+					p00, p10, p01, p11 := point(u, w), point(u+1, w), point(u, w+1), point(u+1, w+1)
+					v += sign * tripleProduct(p00, p10, p01)
+					v += sign * tripleProduct(p10, p11, p01)
+				}
+			}
+		}
+	}
+	// The mapping from the RGB cube might flip the orientation of the surface.
+	return math.Abs(v) / 6
+}
+
+// GamutCoverage returns the ratio of the volume of gamut covered by the gamut of another RGB space by, measured in the given space.
+// The result is in [0, 1].
+//
+// For example, GamutCoverage(SpaceDisplayP3, SpaceSRGB, SpaceLab) returns how much of Display P3 sRGB covers in CIELAB.
+//
+// gamut and by must be RGB spaces like [SpaceSRGB]. space must not be a cylindrical space like [SpaceOKLch].
+//
+// The ratio is computed by integrating over the subdivided RGB cube of gamut, weighted by the volumes of the mapped cells.
+func GamutCoverage(gamut, by, space Space) float64 {
+	checkGamutSpaces(gamut, space)
+	if !by.isRGB() {
+		panic(fmt.Sprintf("iro: by must be an RGB space but %s", by))
+	}
+
+	const n = gamutResolution
+	const m = n + 1
+	vertices := make([][3]float64, m*m*m)
+	for b := 0; b < m; b++ {
+		for g := 0; g < m; g++ {
+			for r := 0; r < m; r++ {
+				vertices[(b*m+g)*m+r] = gamutPoint(gamut, space, float64(r)/n, float64(g)/n, float64(b)/n)
+			}
+		}
+	}
+	at := func(r, g, b int) [3]float64 {
+		return vertices[(b*m+g)*m+r]
+	}
+
+	const eps = 1e-9
+	var total, covered float64
+	for b := 0; b < n; b++ {
+		for g := 0; g < n; g++ {
+			for r := 0; r < n; r++ {
+				// Approximate the mapped cell by a parallelepiped with the averaged edges.
+				var er, eg, eb [3]float64
+				for d := 0; d < 4; d++ {
+					d0, d1 := d&1, d>>1
+					er = add3(er, sub3(at(r+1, g+d0, b+d1), at(r, g+d0, b+d1)))
+					eg = add3(eg, sub3(at(r+d0, g+1, b+d1), at(r+d0, g, b+d1)))
+					eb = add3(eb, sub3(at(r+d0, g+d1, b+1), at(r+d0, g+d1, b)))
+				}
+				vol := math.Abs(tripleProduct(er, eg, eb)) / 64
+				total += vol
+
+				c := ColorFromComponents(gamut, (float64(r)+0.5)/n, (float64(g)+0.5)/n, (float64(b)+0.5)/n, 1)
+				c0, c1, c2, _ := c.Components(by)
+				if c0 >= -eps && c0 <= 1+eps && c1 >= -eps && c1 <= 1+eps && c2 >= -eps && c2 <= 1+eps {
+					covered += vol
+				}
+			}
+		}
+	}
+	if total == 0 {
+		return 0
+	}
+	return covered / total
+}
+
+func tripleProduct(a, b, c [3]float64) float64 {
+	return a[0]*(b[1]*c[2]-b[2]*c[1]) + a[1]*(b[2]*c[0]-b[0]*c[2]) + a[2]*(b[0]*c[1]-b[1]*c[0])
+}
+
+func add3(a, b [3]float64) [3]float64 {
+	return [3]float64{a[0] + b[0], a[1] + b[1], a[2] + b[2]}
+}
+
+func sub3(a, b [3]float64) [3]float64 {
+	return [3]float64{a[0] - b[0], a[1] - b[1], a[2] - b[2]}
+}
+
+// gamutEpsilon is the tolerance of the components for inGamut.
+const gamutEpsilon = 1e-6
+
+// inGamut reports whether c is inside the gamut of the RGB space.
+func (c Color) inGamut(space Space) bool {
+	r, g, b, _ := c.Components(space)
+	for _, v := range [...]float64{r, g, b} {
+		if v < -gamutEpsilon || v > 1+gamutEpsilon {
+			return false
+		}
+	}
+	return true
+}
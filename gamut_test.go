@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestGamutVolume(t *testing.T) {
+	// The gamut of linear sRGB in linear sRGB is the unit cube.
+	if got, want := iro.GamutVolume(iro.SpaceSRGB, iro.SpaceLinearSRGB), 1.0; !checkTol(got, want) {
+		t.Errorf("GamutVolume(sRGB, linear sRGB): got %f, want %f", got, want)
+	}
+	if got, want := iro.GamutVolume(iro.SpaceLinearDisplayP3, iro.SpaceDisplayP3), 1.0; !checkTol(got, want) {
+		t.Errorf("GamutVolume(linear Display P3, Display P3): got %f, want %f", got, want)
+	}
+
+	for _, space := range []iro.Space{iro.SpaceOKLab, iro.SpaceLab, iro.SpaceXYZ} {
+		srgb := iro.GamutVolume(iro.SpaceSRGB, space)
+		p3 := iro.GamutVolume(iro.SpaceDisplayP3, space)
+		if srgb <= 0 || p3 <= srgb {
+			t.Errorf("%s: the volume of Display P3 (%f) must be greater than the volume of sRGB (%f)", space, p3, srgb)
+		}
+	}
+}
+
+func TestGamutCoverage(t *testing.T) {
+	for _, space := range []iro.Space{iro.SpaceOKLab, iro.SpaceLab} {
+		// Display P3 covers the entire sRGB gamut.
+		if got, want := iro.GamutCoverage(iro.SpaceSRGB, iro.SpaceDisplayP3, space), 1.0; !checkTol(got, want) {
+			t.Errorf("%s: GamutCoverage(sRGB, Display P3): got %f, want %f", space, got, want)
+		}
+
+		// sRGB covers a part of Display P3. The coverage must be consistent with the volume ratio.
+		got := iro.GamutCoverage(iro.SpaceDisplayP3, iro.SpaceSRGB, space)
+		want := iro.GamutVolume(iro.SpaceSRGB, space) / iro.GamutVolume(iro.SpaceDisplayP3, space)
+		if math.Abs(got-want) > 0.01 {
+			t.Errorf("%s: GamutCoverage(Display P3, sRGB): got %f, want %f", space, got, want)
+		}
+	}
+}
+
+func TestGamutVolumePanics(t *testing.T) {
+	for _, spaces := range [][2]iro.Space{
+		{iro.SpaceOKLab, iro.SpaceOKLab},
+		{iro.SpaceSRGB, iro.SpaceOKLch},
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("GamutVolume(%s, %s) must panic", spaces[0], spaces[1])
+				}
+			}()
+			iro.GamutVolume(spaces[0], spaces[1])
+		}()
+	}
+}
@@ -1,3 +1,5 @@
 module github.com/hajimehoshi/iro
 
 go 1.21
+
+require golang.org/x/image v0.24.0
@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"image"
+	"math"
+)
+
+// LiftGammaGain is a three-way color corrector operating in linear sRGB.
+//
+// Each channel x is converted as (gain * (x + lift * (1 - x)))^(1/gamma).
+// The values of each field are for the red, green, and blue channels.
+// To use a Color as an offset, use its linear sRGB channels, e.g., by [Color.LinearSRGB].
+type LiftGammaGain struct {
+	// Lift raises the shadows while keeping the white point. 0 doesn't change the channel.
+	Lift [3]float64
+
+	// Gamma adjusts the midtones. A value greater than 1 brightens the midtones. 1 doesn't change the channel.
+	Gamma [3]float64
+
+	// Gain scales the channel while keeping the black point. 1 doesn't change the channel.
+	Gain [3]float64
+}
+
+// NewLiftGammaGain creates a LiftGammaGain that doesn't change colors.
+func NewLiftGammaGain() *LiftGammaGain {
+	return &LiftGammaGain{
+		Gamma: [3]float64{1, 1, 1},
+		Gain:  [3]float64{1, 1, 1},
+	}
+}
+
+// Apply returns a new Color by applying the correction to c.
+// The alpha value is kept.
+func (l *LiftGammaGain) Apply(c Color) Color {
+	var cs [3]float64
+	var alpha float64
+	cs[0], cs[1], cs[2], alpha = c.LinearSRGB()
+	for i, x := range cs {
+		x = l.Gain[i] * (x + l.Lift[i]*(1-x))
+		// Keep the sign so that out-of-gamut values don't become NaN.
+		cs[i] = math.Copysign(math.Pow(math.Abs(x), 1/l.Gamma[i]), x)
+	}
+	return ColorFromLinearSRGB(cs[0], cs[1], cs[2], alpha)
+}
+
+// ApplyToImage returns a new image by applying the correction to each pixel of img.
+// See [MapImage] for the interpretation of the pixels.
+func (l *LiftGammaGain) ApplyToImage(img image.Image) *image.NRGBA64 {
+	return MapImage(img, l.Apply)
+}
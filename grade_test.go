@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestLiftGammaGainIdentity(t *testing.T) {
+	c := iro.ColorFromSRGB(0.2, 0.4, 0.6, 0.8)
+	r0, g0, b0, a0 := c.SRGB()
+	r1, g1, b1, a1 := iro.NewLiftGammaGain().Apply(c).SRGB()
+	if !checkTol(r1, r0) || !checkTol(g1, g0) || !checkTol(b1, b0) || !checkTol(a1, a0) {
+		t.Errorf("got (%f, %f, %f, %f), want (%f, %f, %f, %f)", r1, g1, b1, a1, r0, g0, b0, a0)
+	}
+}
+
+func TestLiftGammaGain(t *testing.T) {
+	lgg := &iro.LiftGammaGain{
+		Lift:  [3]float64{0.1, 0, 0},
+		Gamma: [3]float64{1, 2, 1},
+		Gain:  [3]float64{1, 1, 0.5},
+	}
+
+	testCases := []struct {
+		in   [3]float64
+		want [3]float64
+	}{
+		{in: [3]float64{0, 0, 0}, want: [3]float64{0.1, 0, 0}},
+		{in: [3]float64{1, 1, 1}, want: [3]float64{1, 1, 0.5}},
+		{in: [3]float64{0.5, 0.25, 0.5}, want: [3]float64{0.55, 0.5, 0.25}},
+	}
+	for _, tc := range testCases {
+		c := iro.ColorFromLinearSRGB(tc.in[0], tc.in[1], tc.in[2], 1)
+		r, g, b, _ := lgg.Apply(c).LinearSRGB()
+		if !checkTol(r, tc.want[0]) || !checkTol(g, tc.want[1]) || !checkTol(b, tc.want[2]) {
+			t.Errorf("Apply(%v): got (%f, %f, %f), want %v", tc.in, r, g, b, tc.want)
+		}
+	}
+}
+
+func TestLiftGammaGainNegative(t *testing.T) {
+	lgg := iro.NewLiftGammaGain()
+	lgg.Gamma = [3]float64{2, 2, 2}
+	c := iro.ColorFromLinearSRGB(-0.25, 0.25, 0, 1)
+	r, g, b, _ := lgg.Apply(c).LinearSRGB()
+	if math.IsNaN(r) || math.IsNaN(g) || math.IsNaN(b) {
+		t.Fatalf("got NaN: (%f, %f, %f)", r, g, b)
+	}
+	if !checkTol(r, -0.5) || !checkTol(g, 0.5) || !checkTol(b, 0) {
+		t.Errorf("got (%f, %f, %f), want (-0.5, 0.5, 0)", r, g, b)
+	}
+}
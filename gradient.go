@@ -0,0 +1,210 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"fmt"
+	"math"
+	"slices"
+)
+
+// HueInterpolation specifies how hues are interpolated in a cylindrical space.
+//
+// See https://www.w3.org/TR/css-color-4/#hue-interpolation
+type HueInterpolation int
+
+const (
+	// HueInterpolationShorter interpolates hues along the shorter arc.
+	HueInterpolationShorter HueInterpolation = iota
+
+	// HueInterpolationLonger interpolates hues along the longer arc.
+	HueInterpolationLonger
+
+	// HueInterpolationIncreasing interpolates hues with increasing angles.
+	HueInterpolationIncreasing
+
+	// HueInterpolationDecreasing interpolates hues with decreasing angles.
+	HueInterpolationDecreasing
+)
+
+// fixup returns the hues adjusted so that a linear interpolation between them follows the method.
+func (h HueInterpolation) fixup(h0, h1 float64) (float64, float64) {
+	h0, h1 = NormalizeHue(h0), NormalizeHue(h1)
+	d := h1 - h0
+	switch h {
+	case HueInterpolationShorter:
+		if d > math.Pi {
+			h0 += 2 * math.Pi
+		} else if d < -math.Pi {
+			h1 += 2 * math.Pi
+		}
+	case HueInterpolationLonger:
+		if 0 < d && d < math.Pi {
+			h0 += 2 * math.Pi
+		} else if -math.Pi < d && d <= 0 {
+			h1 += 2 * math.Pi
+		}
+	case HueInterpolationIncreasing:
+		if d < 0 {
+			h1 += 2 * math.Pi
+		}
+	case HueInterpolationDecreasing:
+		if d > 0 {
+			h0 += 2 * math.Pi
+		}
+	default:
+		panic(fmt.Sprintf("iro: invalid HueInterpolation: %d", h))
+	}
+	return h0, h1
+}
+
+// Mix returns the color interpolated between a and b at t in the given space.
+// t = 0 returns a and t = 1 returns b.
+//
+// As in CSS, the components are interpolated in premultiplied form.
+// In a cylindrical space like [SpaceOKLch], the hue is interpolated along the shorter arc.
+// If the hue of only one color is powerless (see [Color.HuePowerless]), the hue of the other color is used,
+// so that a mix of a gray and a color doesn't go through unrelated hues.
+func Mix(a, b Color, t float64, space Space) Color {
+	return MixWithHueInterpolation(a, b, t, space, HueInterpolationShorter)
+}
+
+// MixWithHueInterpolation is like [Mix] but interpolates the hue with the given method in a cylindrical space.
+func MixWithHueInterpolation(a, b Color, t float64, space Space, hue HueInterpolation) Color {
+	a0, a1, a2, aa := a.Components(space)
+	b0, b1, b2, ba := b.Components(space)
+	alpha := lerp(aa, ba, t)
+
+	if space.isCylindrical() {
+		// As in CSS, a powerless hue is treated as missing and takes the other hue.
+		switch ap, bp := a.HuePowerless(space), b.HuePowerless(space); {
+		case ap && !bp:
+			a2 = b2
+		case bp && !ap:
+			b2 = a2
+		}
+		h0, h1 := hue.fixup(a2, b2)
+		h := lerp(h0, h1, t)
+		c0, c1 := lerp(a0*aa, b0*ba, t), lerp(a1*aa, b1*ba, t)
+		if alpha != 0 {
+			c0, c1 = c0/alpha, c1/alpha
+		}
+		return ColorFromComponents(space, c0, c1, h, alpha)
+	}
+
+	c0, c1, c2 := lerp(a0*aa, b0*ba, t), lerp(a1*aa, b1*ba, t), lerp(a2*aa, b2*ba, t)
+	if alpha != 0 {
+		c0, c1, c2 = c0/alpha, c1/alpha, c2/alpha
+	}
+	return ColorFromComponents(space, c0, c1, c2, alpha)
+}
+
+func lerp(a, b, t float64) float64 {
+	return a + (b-a)*t
+}
+
+// GradientStop is a color stop of a Gradient.
+type GradientStop struct {
+	// Position is the position of the stop, usually in [0, 1].
+	Position float64
+
+	// Color is the color at the stop.
+	Color Color
+}
+
+// Gradient is a color gradient with multiple stops.
+type Gradient struct {
+	// Stops is the color stops of the gradient. Stops must be sorted by Position.
+	Stops []GradientStop
+
+	// Space is the color space where the colors between stops are interpolated.
+	Space Space
+
+	// HueInterpolation is the method to interpolate hues when Space is a cylindrical space.
+	HueInterpolation HueInterpolation
+}
+
+// NewGradient creates a Gradient with evenly spaced stops from 0 to 1, interpolated in OKLab.
+//
+// NewGradient panics if colors is empty.
+func NewGradient(colors ...Color) *Gradient {
+	if len(colors) == 0 {
+		panic("iro: a gradient requires at least one color")
+	}
+	stops := make([]GradientStop, len(colors))
+	for i, c := range colors {
+		var pos float64
+		if len(colors) > 1 {
+			pos = float64(i) / float64(len(colors)-1)
+		}
+		stops[i] = GradientStop{
+			Position: pos,
+			Color:    c,
+		}
+	}
+	return &Gradient{
+		Stops: stops,
+		Space: SpaceOKLab,
+	}
+}
+
+// At returns the color of the gradient at t.
+// Outside the stops, the colors of the first and the last stops are returned.
+// If t is NaN, the color of the first stop is returned.
+//
+// At panics if the gradient has no stops.
+func (g *Gradient) At(t float64) Color {
+	n := len(g.Stops)
+	if n == 0 {
+		panic("iro: a gradient requires at least one stop")
+	}
+	// !(t > first) is true for NaN.
+	if !(t > g.Stops[0].Position) {
+		return g.Stops[0].Color
+	}
+	if t >= g.Stops[n-1].Position {
+		return g.Stops[n-1].Color
+	}
+
+	// Find the first stop after t.
+	i, _ := slices.BinarySearchFunc(g.Stops, t, func(s GradientStop, t float64) int {
+		switch {
+		case s.Position < t:
+			return -1
+		case s.Position > t:
+			return 1
+		}
+		return 0
+	})
+	if g.Stops[i].Position == t {
+		return g.Stops[i].Color
+	}
+	s0, s1 := g.Stops[i-1], g.Stops[i]
+	return MixWithHueInterpolation(s0.Color, s1.Color, (t-s0.Position)/(s1.Position-s0.Position), g.Space, g.HueInterpolation)
+}
+
+// Samples returns n colors sampled evenly from 0 to 1 in the gradient.
+//
+// Samples panics if n is negative.
+func (g *Gradient) Samples(n int) []Color {
+	return g.AppendSamples(make([]Color, 0, max(n, 0)), n)
+}
+
+// AppendSamples appends n colors sampled evenly from 0 to 1 in the gradient to dst and returns the extended slice.
+// See also [Gradient.Samples].
+//
+// AppendSamples panics if n is negative.
+func (g *Gradient) AppendSamples(dst []Color, n int) []Color {
+	if n < 0 {
+		panic(fmt.Sprintf("iro: the number of samples must be non-negative but %d", n))
+	}
+	for i := 0; i < n; i++ {
+		var t float64
+		if n > 1 {
+			t = float64(i) / float64(n-1)
+		}
+		dst = append(dst, g.At(t))
+	}
+	return dst
+}
@@ -0,0 +1,170 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestMix(t *testing.T) {
+	red := iro.ColorFromSRGB(1, 0, 0, 1)
+	blue := iro.ColorFromSRGB(0, 0, 1, 1)
+
+	testCases := []struct {
+		space iro.Space
+		t     float64
+		want  [4]float64
+	}{
+		{space: iro.SpaceSRGB, t: 0, want: [4]float64{1, 0, 0, 1}},
+		{space: iro.SpaceSRGB, t: 1, want: [4]float64{0, 0, 1, 1}},
+		{space: iro.SpaceSRGB, t: 0.5, want: [4]float64{0.5, 0, 0.5, 1}},
+		{space: iro.SpaceLinearSRGB, t: 0.25, want: [4]float64{0.75, 0, 0.25, 1}},
+	}
+	for _, tc := range testCases {
+		c0, c1, c2, alpha := iro.Mix(red, blue, tc.t, tc.space).Components(tc.space)
+		got := [4]float64{c0, c1, c2, alpha}
+		for i := range got {
+			if !checkTol(got[i], tc.want[i]) {
+				t.Errorf("Mix(space=%s, t=%f): got %v, want %v", tc.space, tc.t, got, tc.want)
+				break
+			}
+		}
+	}
+}
+
+func TestMixPremultiplied(t *testing.T) {
+	// A transparent color doesn't contribute its components.
+	red := iro.ColorFromSRGB(1, 0, 0, 1)
+	transparent := iro.ColorFromSRGB(0, 0, 1, 0)
+	r, g, b, a := iro.Mix(red, transparent, 0.5, iro.SpaceSRGB).SRGB()
+	if !checkTol(r, 1) || !checkTol(g, 0) || !checkTol(b, 0) || !checkTol(a, 0.5) {
+		t.Errorf("got (%f, %f, %f, %f), want (1, 0, 0, 0.5)", r, g, b, a)
+	}
+}
+
+func TestMixShorterHue(t *testing.T) {
+	// From 350° to 10°, the hue goes through 0°.
+	deg := math.Pi / 180
+	a := iro.ColorFromOKLch(0.7, 0.1, 350*deg, 1)
+	b := iro.ColorFromOKLch(0.7, 0.1, 10*deg, 1)
+	_, _, h, _ := iro.Mix(a, b, 0.5, iro.SpaceOKLch).OKLch()
+	if d := math.Abs(math.Remainder(h, 2*math.Pi)); d > 1e-6 {
+		t.Errorf("hue: got %f, want 0", h)
+	}
+}
+
+func TestGradient(t *testing.T) {
+	black := iro.ColorFromSRGB(0, 0, 0, 1)
+	white := iro.ColorFromSRGB(1, 1, 1, 1)
+	g := iro.NewGradient(black, white)
+
+	testCases := []struct {
+		t    float64
+		want float64
+	}{
+		{t: -1, want: 0},
+		{t: 0, want: 0},
+		{t: 0.25, want: 0.25},
+		{t: 0.5, want: 0.5},
+		{t: 1, want: 1},
+		{t: 2, want: 1},
+		{t: math.NaN(), want: 0},
+	}
+	for _, tc := range testCases {
+		l, a, b, _ := g.At(tc.t).OKLab()
+		if !checkTol(l, tc.want) || !checkTol(a, 0) || !checkTol(b, 0) {
+			t.Errorf("At(%f): got (%f, %f, %f), want (%f, 0, 0)", tc.t, l, a, b, tc.want)
+		}
+	}
+}
+
+func TestGradientStops(t *testing.T) {
+	g := &iro.Gradient{
+		Stops: []iro.GradientStop{
+			{Position: 0, Color: iro.ColorFromSRGB(1, 0, 0, 1)},
+			{Position: 0.2, Color: iro.ColorFromSRGB(0, 1, 0, 1)},
+			{Position: 1, Color: iro.ColorFromSRGB(0, 0, 1, 1)},
+		},
+		Space: iro.SpaceSRGB,
+	}
+	testCases := []struct {
+		t    float64
+		want [3]float64
+	}{
+		{t: 0.1, want: [3]float64{0.5, 0.5, 0}},
+		{t: 0.2, want: [3]float64{0, 1, 0}},
+		{t: 0.6, want: [3]float64{0, 0.5, 0.5}},
+	}
+	for _, tc := range testCases {
+		r, g, b, _ := g.At(tc.t).SRGB()
+		if !checkTol(r, tc.want[0]) || !checkTol(g, tc.want[1]) || !checkTol(b, tc.want[2]) {
+			t.Errorf("At(%f): got (%f, %f, %f), want %v", tc.t, r, g, b, tc.want)
+		}
+	}
+}
+
+func TestGradientSamples(t *testing.T) {
+	g := iro.NewGradient(iro.ColorFromSRGB(0, 0, 0, 1), iro.ColorFromSRGB(1, 1, 1, 1))
+	cs := g.Samples(5)
+	if got, want := len(cs), 5; got != want {
+		t.Fatalf("len: got %d, want %d", got, want)
+	}
+	for i, c := range cs {
+		l, _, _, _ := c.OKLab()
+		if want := float64(i) / 4; !checkTol(l, want) {
+			t.Errorf("Samples(5)[%d]: L: got %f, want %f", i, l, want)
+		}
+	}
+}
+
+func TestMixWithHueInterpolation(t *testing.T) {
+	deg := math.Pi / 180
+	a := iro.ColorFromOKLch(0.7, 0.1, 30*deg, 1)
+	b := iro.ColorFromOKLch(0.7, 0.1, 90*deg, 1)
+
+	testCases := []struct {
+		hue  iro.HueInterpolation
+		a, b iro.Color
+		want float64
+	}{
+		{hue: iro.HueInterpolationShorter, a: a, b: b, want: 60},
+		{hue: iro.HueInterpolationLonger, a: a, b: b, want: 240},
+		{hue: iro.HueInterpolationIncreasing, a: a, b: b, want: 60},
+		{hue: iro.HueInterpolationIncreasing, a: b, b: a, want: 240},
+		{hue: iro.HueInterpolationDecreasing, a: a, b: b, want: 240},
+		{hue: iro.HueInterpolationDecreasing, a: b, b: a, want: 60},
+	}
+	for _, tc := range testCases {
+		_, _, h, _ := iro.MixWithHueInterpolation(tc.a, tc.b, 0.5, iro.SpaceOKLch, tc.hue).OKLch()
+		got := math.Mod(h/deg+360, 360)
+		if math.Abs(got-tc.want) > 1e-6 {
+			t.Errorf("hue=%d: got %f, want %f", tc.hue, got, tc.want)
+		}
+	}
+}
+
+func TestGradientAppendSamples(t *testing.T) {
+	g := iro.NewGradient(iro.Black, iro.White)
+	dst := make([]iro.Color, 1, 8)
+	dst[0] = iro.Red
+	got := g.AppendSamples(dst, 3)
+	want := append([]iro.Color{iro.Red}, g.Samples(3)...)
+	if len(got) != len(want) {
+		t.Fatalf("len: got %d, want %d", len(got), len(want))
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			t.Errorf("AppendSamples(dst, 3)[%d]: got %v, want %v", i, got[i], want[i])
+		}
+	}
+
+	if allocs := testing.AllocsPerRun(100, func() {
+		dst = g.AppendSamples(dst[:0], 8)
+	}); allocs != 0 {
+		t.Errorf("allocations: got %f, want 0", allocs)
+	}
+}
@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"image"
+)
+
+// GradientMap maps the lightness of colors onto a gradient, like stylized thumbnails or heatmaps of grayscale data.
+//
+// The lightness is the OKLab lightness, which is perceptually uniform unlike the luminance.
+type GradientMap struct {
+	// Gradient is the gradient that the lightness is mapped onto.
+	Gradient *Gradient
+
+	// Low and High are the range of the OKLab lightness mapped to the positions 0 and 1 of the gradient.
+	// The lightness outside the range is clamped.
+	// If both are 0, the range is [0, 1], i.e. black is mapped to 0 and white is mapped to 1.
+	// If Low is greater than High, the gradient is reversed.
+	Low  float64
+	High float64
+}
+
+// Apply returns the color of the gradient at the OKLab lightness of c.
+// The alpha value of c is multiplied by the alpha value of the gradient.
+func (m *GradientMap) Apply(c Color) Color {
+	l, _, _, alpha := c.OKLab()
+	lo, hi := m.Low, m.High
+	if lo == 0 && hi == 0 {
+		hi = 1
+	}
+	var t float64
+	if lo != hi {
+		t = min(max((l-lo)/(hi-lo), 0), 1)
+	} else if l >= lo {
+		t = 1
+	}
+	g := m.Gradient.At(t)
+	return g.WithAlpha(g.alpha * alpha)
+}
+
+// ApplyToImage returns a new image by mapping each pixel of img onto the gradient.
+// See [MapImage] for the interpretation of the pixels.
+func (m *GradientMap) ApplyToImage(img image.Image) *image.NRGBA64 {
+	return MapImage(img, m.Apply)
+}
@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestGradientMap(t *testing.T) {
+	blue := iro.ColorFromSRGB(0, 0, 1, 1)
+	yellow := iro.ColorFromSRGB(1, 1, 0, 0.5)
+	g := iro.NewGradient(blue, yellow)
+
+	testCases := []struct {
+		name      string
+		low, high float64
+		l         float64
+		alpha     float64
+		want      iro.Color
+	}{
+		{name: "black", l: 0, alpha: 1, want: blue},
+		{name: "white", l: 1, alpha: 1, want: yellow},
+		{name: "middle", l: 0.5, alpha: 0.5, want: g.At(0.5).WithAlpha(g.At(0.5).Alpha() * 0.5)},
+		{name: "range", low: 0.2, high: 0.6, l: 0.3, alpha: 1, want: g.At(0.25)},
+		{name: "range clamped", low: 0.2, high: 0.6, l: 0.8, alpha: 1, want: yellow},
+		{name: "reversed", low: 1, high: 0, l: 0.25, alpha: 1, want: g.At(0.75)},
+	}
+	for _, tc := range testCases {
+		m := &iro.GradientMap{Gradient: g, Low: tc.low, High: tc.high}
+		got := m.Apply(iro.ColorFromOKLab(tc.l, 0, 0, tc.alpha))
+		if iro.DeltaEOK(got, tc.want) > 1e-9 || !checkTol(got.Alpha(), tc.want.Alpha()) {
+			t.Errorf("%s: got %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestGradientMapImage(t *testing.T) {
+	img := image.NewGray(image.Rect(0, 0, 2, 1))
+	img.SetGray(0, 0, color.Gray{Y: 0})
+	img.SetGray(1, 0, color.Gray{Y: 0xff})
+	m := &iro.GradientMap{Gradient: iro.NewGradient(iro.ColorFromSRGB(1, 0, 0, 1), iro.ColorFromSRGB(0, 1, 0, 1))}
+	dst := m.ApplyToImage(img)
+	if got, want := dst.NRGBA64At(0, 0), (color.NRGBA64{0xffff, 0, 0, 0xffff}); got != want {
+		t.Errorf("(0, 0): got %v, want %v", got, want)
+	}
+	if got, want := dst.NRGBA64At(1, 0), (color.NRGBA64{0, 0xffff, 0, 0xffff}); got != want {
+		t.Errorf("(1, 0): got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"fmt"
+	"math"
+)
+
+// Harmony specifies a color harmony, a set of colors with hues at fixed angles on the color wheel.
+type Harmony int
+
+const (
+	// HarmonyComplementary represents the color and the one with the opposite hue.
+	HarmonyComplementary Harmony = iota
+
+	// HarmonyAnalogous represents the color and the ones with the hues 30° apart on both sides.
+	HarmonyAnalogous
+
+	// HarmonyTriadic represents three colors with hues 120° apart.
+	HarmonyTriadic
+
+	// HarmonySplitComplementary represents the color and the ones with the hues 30° apart from the opposite hue on both sides.
+	HarmonySplitComplementary
+
+	// HarmonyTetradic represents four colors on a rectangle, two complementary pairs with hues 60° apart.
+	HarmonyTetradic
+
+	// HarmonySquare represents four colors with hues 90° apart.
+	HarmonySquare
+)
+
+// harmonies is the valid harmonies in order.
+var harmonies = []Harmony{
+	HarmonyComplementary,
+	HarmonyAnalogous,
+	HarmonyTriadic,
+	HarmonySplitComplementary,
+	HarmonyTetradic,
+	HarmonySquare,
+}
+
+// String returns the name of the harmony in lowercase, like "split-complementary".
+func (h Harmony) String() string {
+	switch h {
+	case HarmonyComplementary:
+		return "complementary"
+	case HarmonyAnalogous:
+		return "analogous"
+	case HarmonyTriadic:
+		return "triadic"
+	case HarmonySplitComplementary:
+		return "split-complementary"
+	case HarmonyTetradic:
+		return "tetradic"
+	case HarmonySquare:
+		return "square"
+	default:
+		return fmt.Sprintf("Harmony(%d)", h)
+	}
+}
+
+// ParseHarmony returns the harmony of the name returned by [Harmony.String].
+func ParseHarmony(name string) (Harmony, error) {
+	for _, h := range harmonies {
+		if h.String() == name {
+			return h, nil
+		}
+	}
+	return 0, fmt.Errorf("iro: unknown harmony: %q", name)
+}
+
+// offsets returns the hue offsets of the colors of the harmony in degrees.
+func (h Harmony) offsets() []float64 {
+	switch h {
+	case HarmonyComplementary:
+		return []float64{0, 180}
+	case HarmonyAnalogous:
+		return []float64{0, -30, 30}
+	case HarmonyTriadic:
+		return []float64{0, 120, 240}
+	case HarmonySplitComplementary:
+		return []float64{0, 150, 210}
+	case HarmonyTetradic:
+		return []float64{0, 60, 180, 240}
+	case HarmonySquare:
+		return []float64{0, 90, 180, 270}
+	default:
+		panic(fmt.Sprintf("iro: invalid Harmony: %d", h))
+	}
+}
+
+// Harmony returns the colors of the harmony based on c, starting with c itself.
+// The hues are rotated in OKLCh, so the OKLab lightness and chroma and the alpha value are kept.
+// The results might be outside the gamut of c. For an achromatic c, all the colors are the same as c.
+//
+// Harmony panics if h is invalid.
+func (c Color) Harmony(h Harmony) []Color {
+	offsets := h.offsets()
+	l, ch, hue, alpha := c.OKLch()
+	cs := make([]Color, len(offsets))
+	for i, o := range offsets {
+		if o == 0 {
+			cs[i] = c
+			continue
+		}
+		cs[i] = ColorFromOKLch(l, ch, hue+o*math.Pi/180, alpha)
+	}
+	return cs
+}
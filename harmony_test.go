@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestHarmony(t *testing.T) {
+	testCases := []struct {
+		harmony iro.Harmony
+		want    []float64
+	}{
+		{harmony: iro.HarmonyComplementary, want: []float64{0, 180}},
+		{harmony: iro.HarmonyAnalogous, want: []float64{0, -30, 30}},
+		{harmony: iro.HarmonyTriadic, want: []float64{0, 120, 240}},
+		{harmony: iro.HarmonySplitComplementary, want: []float64{0, 150, 210}},
+		{harmony: iro.HarmonyTetradic, want: []float64{0, 60, 180, 240}},
+		{harmony: iro.HarmonySquare, want: []float64{0, 90, 180, 270}},
+	}
+	base := iro.ColorFromOKLch(0.7, 0.1, 1, 0.5)
+	l0, c0, h0, _ := base.OKLch()
+	for _, tc := range testCases {
+		t.Run(tc.harmony.String(), func(t *testing.T) {
+			cs := base.Harmony(tc.harmony)
+			if got, want := len(cs), len(tc.want); got != want {
+				t.Fatalf("len: got %d, want %d", got, want)
+			}
+			if cs[0] != base {
+				t.Errorf("cs[0]: got %v, want %v", cs[0], base)
+			}
+			for i, c := range cs {
+				l, ch, h, alpha := c.OKLch()
+				if !checkTol(l, l0) || !checkTol(ch, c0) || !checkTol(alpha, 0.5) {
+					t.Errorf("cs[%d]: got (%f, %f, alpha %f), want (%f, %f, alpha 0.5)", i, l, ch, alpha, l0, c0)
+				}
+				want := tc.want[i] * math.Pi / 180
+				if d := math.Remainder(h-h0-want, 2*math.Pi); math.Abs(d) > 1e-6 {
+					t.Errorf("cs[%d]: hue offset: got %f, want %f", i, h-h0, want)
+				}
+			}
+
+			got, err := iro.ParseHarmony(tc.harmony.String())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tc.harmony {
+				t.Errorf("ParseHarmony(%q): got %v, want %v", tc.harmony.String(), got, tc.harmony)
+			}
+		})
+	}
+
+	if _, err := iro.ParseHarmony("monochrome"); err == nil {
+		t.Errorf("ParseHarmony(monochrome): got no error")
+	}
+}
@@ -0,0 +1,142 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"math"
+	"slices"
+)
+
+// lstarFromY returns the CIELAB lightness L* of the relative luminance y.
+func lstarFromY(y float64) float64 {
+	return 116*labF(y) - 16
+}
+
+// yFromLstar returns the relative luminance of the CIELAB lightness L*.
+func yFromLstar(l float64) float64 {
+	if l > labKappa*labEpsilon {
+		fy := (l + 16) / 116
+		return fy * fy * fy
+	}
+	return l / labKappa
+}
+
+// HCT returns the HCT components of c and alpha.
+//
+// HCT is the color space of Material Design: the hue h in radians and the chroma are the ones of CAM16
+// under the default viewing conditions (see [CAM16ViewingConditions]),
+// and the tone is the CIELAB lightness L* in [0, 100] relative to the D65 white.
+func (c Color) HCT() (h, chroma, tone, alpha float64) {
+	cam := c.CAM16(nil)
+	return cam.H, cam.C, lstarFromY(c.y), c.alpha
+}
+
+// ColorFromHCT builds a Color from HCT components (h in radians) and alpha. See [Color.HCT] for HCT.
+//
+// As Material Design does, if the color is outside the sRGB gamut,
+// the chroma is reduced to the maximum one inside the gamut with the same hue and tone.
+// If no colors with the hue and the tone are inside the gamut, the gray of the tone is returned.
+// Thus, the result is always inside the sRGB gamut.
+func ColorFromHCT(h, chroma, tone, alpha float64) Color {
+	if tone <= 0 {
+		return ColorFromXYZ(0, 0, 0, alpha)
+	}
+	if tone >= 100 {
+		x, y, z, _ := White.XYZ()
+		return ColorFromXYZ(x, y, z, alpha)
+	}
+	y := yFromLstar(tone)
+	if c := hctColor(h, chroma, y, alpha); c.inGamut(SpaceSRGB) {
+		return c
+	}
+
+	lo, hi := 0.0, chroma
+	if !hctColor(h, 0, y, alpha).inGamut(SpaceSRGB) {
+		// In very light or dark tones, the achromatic color of CAM16 might be outside the gamut
+		// while slightly chromatic colors are inside. Find the colors inside from the requested chroma.
+		const step = 0.1
+		c := min(chroma, 30)
+		for c > 0 && !hctColor(h, c, y, alpha).inGamut(SpaceSRGB) {
+			c -= step
+		}
+		if c <= 0 {
+			x, _, z, _ := White.XYZ()
+			return ColorFromXYZ(x*y, y, z*y, alpha)
+		}
+		lo, hi = c, min(c+step, chroma)
+	}
+
+	// Find the maximum chroma inside the gamut by bisection.
+	for i := 0; i < 32; i++ {
+		mid := (lo + hi) / 2
+		if hctColor(h, mid, y, alpha).inGamut(SpaceSRGB) {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return hctColor(h, lo, y, alpha)
+}
+
+// hctColor returns the color with the CAM16 hue and chroma under the default viewing conditions and the relative luminance y.
+// The result might be outside the sRGB gamut.
+func hctColor(h, chroma, y, alpha float64) Color {
+	e := defaultCAM16Env
+	// The luminance increases with J at a given hue and chroma, so find J by bisection.
+	lo, hi := 0.0, 200.0
+	for i := 0; i < 48; i++ {
+		mid := (lo + hi) / 2
+		if e.color(mid, chroma, h, alpha).y < y {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	c := e.color((lo+hi)/2, chroma, h, alpha)
+	if math.IsNaN(c.y) {
+		return ColorFromXYZ(0, 0, 0, alpha)
+	}
+	return c
+}
+
+// tonalPaletteTones is the tones of the standard tonal palettes of Material Design.
+var tonalPaletteTones = [...]int{0, 10, 20, 30, 40, 50, 60, 70, 80, 90, 95, 99, 100}
+
+// TonalPaletteTones returns the tones of the standard tonal palettes of Material Design in the ascending order.
+func TonalPaletteTones() []int {
+	return slices.Clone(tonalPaletteTones[:])
+}
+
+// TonalPalette is a Material Design tonal palette, the colors with a constant HCT hue and chroma in various tones.
+type TonalPalette struct {
+	// Hue is the HCT hue in radians.
+	Hue float64
+
+	// Chroma is the HCT chroma.
+	// The colors in light or dark tones might have less chroma to fit the sRGB gamut (see [ColorFromHCT]).
+	Chroma float64
+}
+
+// NewTonalPalette creates a TonalPalette with the HCT hue and chroma of the seed color.
+func NewTonalPalette(seed Color) *TonalPalette {
+	h, c, _, _ := seed.HCT()
+	return &TonalPalette{
+		Hue:    h,
+		Chroma: c,
+	}
+}
+
+// Tone returns the opaque color of the palette at the tone in [0, 100].
+func (p *TonalPalette) Tone(tone float64) Color {
+	return ColorFromHCT(p.Hue, p.Chroma, tone, 1)
+}
+
+// Tones returns the colors of the palette at [TonalPaletteTones], keyed by the tones.
+func (p *TonalPalette) Tones() map[int]Color {
+	m := make(map[int]Color, len(tonalPaletteTones))
+	for _, t := range tonalPaletteTones {
+		m[t] = p.Tone(float64(t))
+	}
+	return m
+}
@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestHCT(t *testing.T) {
+	// The expected values are from Material Color Utilities.
+	testCases := []struct {
+		name  string
+		color iro.Color
+		want  [3]float64
+	}{
+		{name: "red", color: iro.Red, want: [3]float64{27.408, 113.357, 53.241}},
+		{name: "green", color: iro.Green, want: [3]float64{142.139, 108.410, 87.737}},
+		{name: "blue", color: iro.Blue, want: [3]float64{282.788, 87.230, 32.302}},
+		{name: "white", color: iro.White, want: [3]float64{209.492, 2.869, 100}},
+	}
+	for _, tc := range testCases {
+		h, c, tone, _ := tc.color.HCT()
+		got := [3]float64{h * 180 / math.Pi, c, tone}
+		for i := range got {
+			if math.Abs(got[i]-tc.want[i]) > 0.1 {
+				t.Errorf("HCT(%s): got %v, want %v", tc.name, got, tc.want)
+				break
+			}
+		}
+	}
+}
+
+func TestHCTRoundTrip(t *testing.T) {
+	for _, c := range []iro.Color{
+		iro.ColorFromSRGB(0.2, 0.4, 0.6, 1),
+		iro.ColorFromSRGB(0.9, 0.5, 0.1, 0.5),
+		iro.Red,
+		iro.Blue,
+		iro.Gray,
+	} {
+		h, ch, tone, a := c.HCT()
+		got := iro.ColorFromHCT(h, ch, tone, a)
+		r0, g0, b0, a0 := c.SRGB()
+		r1, g1, b1, a1 := got.SRGB()
+		if math.Abs(r0-r1) > 1e-4 || math.Abs(g0-g1) > 1e-4 || math.Abs(b0-b1) > 1e-4 || a0 != a1 {
+			t.Errorf("round trip: got (%f, %f, %f, %f), want (%f, %f, %f, %f)", r1, g1, b1, a1, r0, g0, b0, a0)
+		}
+	}
+}
+
+func TestColorFromHCTOutOfGamut(t *testing.T) {
+	// The chroma is reduced to fit the sRGB gamut, keeping the hue and the tone.
+	for _, tone := range []float64{10, 30, 50, 70, 90} {
+		c := iro.ColorFromHCT(1, 200, tone, 1)
+		r, g, b, _ := c.SRGB()
+		if r < -1e-6 || r > 1+1e-6 || g < -1e-6 || g > 1+1e-6 || b < -1e-6 || b > 1+1e-6 {
+			t.Errorf("ColorFromHCT(1, 200, %f): got (%f, %f, %f), want inside the sRGB gamut", tone, r, g, b)
+		}
+		h, ch, gotTone, _ := c.HCT()
+		if math.Abs(gotTone-tone) > 1e-3 || math.Abs(h-1) > 1e-3 || ch >= 200 {
+			t.Errorf("ColorFromHCT(1, 200, %f): got HCT (%f, %f, %f)", tone, h, ch, gotTone)
+		}
+	}
+}
+
+func TestTonalPalette(t *testing.T) {
+	// The expected values are from Material Color Utilities.
+	want := map[int]uint32{
+		0:   0x000000,
+		10:  0x00006e,
+		20:  0x0001ac,
+		30:  0x0000ef,
+		40:  0x343dff,
+		50:  0x5a64ff,
+		60:  0x7c84ff,
+		70:  0x9da3ff,
+		80:  0xbec2ff,
+		90:  0xe0e0ff,
+		95:  0xf1efff,
+		99:  0xfffbff,
+		100: 0xffffff,
+	}
+	got := iro.NewTonalPalette(iro.Blue).Tones()
+	if len(got) != len(iro.TonalPaletteTones()) {
+		t.Fatalf("len: got %d, want %d", len(got), len(iro.TonalPaletteTones()))
+	}
+	for tone, w := range want {
+		c := got[tone].SRGBNRGBA()
+		// Allow a difference of 1 for the rounding errors.
+		for i, v := range []uint8{c.R, c.G, c.B} {
+			wv := int(w >> (16 - 8*i) & 0xff)
+			if d := int(v) - wv; d < -1 || d > 1 {
+				t.Errorf("tone %d: got %s, want #%06x", tone, got[tone].Hex(), w)
+				break
+			}
+		}
+	}
+}
+
+func TestTonalPaletteTones(t *testing.T) {
+	tones := iro.TonalPaletteTones()
+	if got, want := len(tones), 13; got != want {
+		t.Errorf("len: got %d, want %d", got, want)
+	}
+
+	// Modifying the result doesn't affect the tones.
+	tones[0] = 50
+	if iro.TonalPaletteTones()[0] != 0 {
+		t.Errorf("TonalPaletteTones must return a copy")
+	}
+	if _, ok := iro.NewTonalPalette(iro.Blue).Tones()[0]; !ok {
+		t.Errorf("Tones must have the tone 0")
+	}
+}
@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"fmt"
+	"image"
+	"sort"
+)
+
+// HistogramMatching maps the colors of an image so that the histogram of each component matches the one of a reference image.
+//
+// The histograms are matched by their cumulative distributions per component in a color space,
+// like linear sRGB, CIELAB, or OKLab. This is useful to normalize a batch of images before analyzing them.
+type HistogramMatching struct {
+	space Space
+
+	// src and ref are the sorted values of each component of the source and the reference images.
+	src [3][]float64
+	ref [3][]float64
+}
+
+// NewHistogramMatching creates a HistogramMatching that maps the colors of src so that their histograms match the ones of reference
+// in the space.
+// Fully transparent pixels are ignored.
+//
+// NewHistogramMatching panics if space is a cylindrical space like [SpaceOKLch], as hues are not ordered.
+func NewHistogramMatching(src, reference image.Image, space Space) *HistogramMatching {
+	if space.isCylindrical() {
+		panic(fmt.Sprintf("iro: histograms cannot be matched in a cylindrical space %s", space))
+	}
+	return &HistogramMatching{
+		space: space,
+		src:   sortedComponents(src, space),
+		ref:   sortedComponents(reference, space),
+	}
+}
+
+// sortedComponents returns the sorted values of each component of the opaque and translucent pixels of img in the space.
+func sortedComponents(img image.Image, space Space) [3][]float64 {
+	var vs [3][]float64
+	b := img.Bounds()
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			c0, c1, c2, alpha := colorAt(img, x, y).Components(space)
+			if alpha <= 0 {
+				continue
+			}
+			vs[0] = append(vs[0], c0)
+			vs[1] = append(vs[1], c1)
+			vs[2] = append(vs[2], c2)
+		}
+	}
+	for _, v := range vs {
+		sort.Float64s(v)
+	}
+	return vs
+}
+
+// Apply returns a new Color by mapping c.
+// The values outside the range of the source are mapped to the nearest ends of the range of the reference.
+// If the source or the reference has no pixels, Apply returns c.
+// The alpha value is kept.
+func (m *HistogramMatching) Apply(c Color) Color {
+	if len(m.src[0]) == 0 || len(m.ref[0]) == 0 {
+		return c
+	}
+	c0, c1, c2, alpha := c.Components(m.space)
+	v := [...]float64{c0, c1, c2}
+	for i := range v {
+		v[i] = quantileValue(m.ref[i], quantile(m.src[i], v[i]))
+	}
+	return ColorFromComponents(m.space, v[0], v[1], v[2], alpha)
+}
+
+// ApplyToImage returns a new image by mapping each pixel of img.
+// See [MapImage] for the interpretation of the pixels.
+func (m *HistogramMatching) ApplyToImage(img image.Image) *image.NRGBA64 {
+	return MapImage(img, m.Apply)
+}
+
+// quantile returns the position of v in the sorted values in [0, 1].
+// The position of equal values is their middle, and the position between values is linearly interpolated.
+func quantile(sorted []float64, v float64) float64 {
+	n := len(sorted)
+	if n == 1 {
+		return 0.5
+	}
+	lo := sort.SearchFloat64s(sorted, v)
+	if lo == n {
+		return 1
+	}
+	var r float64
+	if hi := sort.Search(n, func(i int) bool { return sorted[i] > v }); lo < hi {
+		// v is in the values.
+		r = float64(lo+hi-1) / 2
+	} else if lo == 0 {
+		return 0
+	} else {
+		a, b := sorted[lo-1], sorted[lo]
+		r = float64(lo-1) + (v-a)/(b-a)
+	}
+	return r / float64(n-1)
+}
+
+// quantileValue returns the value at the position q in [0, 1] of the sorted values, linearly interpolated.
+func quantileValue(sorted []float64, q float64) float64 {
+	n := len(sorted)
+	r := q * float64(n-1)
+	i := int(r)
+	if i >= n-1 {
+		return sorted[n-1]
+	}
+	f := r - float64(i)
+	return sorted[i]*(1-f) + sorted[i+1]*f
+}
@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func grayImage(levels ...uint8) *image.Gray {
+	img := image.NewGray(image.Rect(0, 0, len(levels), 1))
+	for i, l := range levels {
+		img.SetGray(i, 0, color.Gray{Y: l})
+	}
+	return img
+}
+
+func TestHistogramMatching(t *testing.T) {
+	src := grayImage(0, 64, 128, 255)
+	ref := grayImage(32, 96, 160, 224)
+	m := iro.NewHistogramMatching(src, ref, iro.SpaceSRGB)
+
+	testCases := []struct {
+		in   float64
+		want float64
+	}{
+		// The values of the source map to the values of the reference with the same ranks.
+		{in: 0, want: 32.0 / 255},
+		{in: 64.0 / 255, want: 96.0 / 255},
+		{in: 128.0 / 255, want: 160.0 / 255},
+		{in: 1, want: 224.0 / 255},
+		// The values between are interpolated.
+		{in: 96.0 / 255, want: 128.0 / 255},
+		// The values outside are clamped.
+		{in: -0.5, want: 32.0 / 255},
+		{in: 1.5, want: 224.0 / 255},
+	}
+	for _, tc := range testCases {
+		r, g, b, a := m.Apply(iro.ColorFromSRGB(tc.in, tc.in, tc.in, 0.5)).SRGB()
+		if !checkTol(r, tc.want) || !checkTol(g, tc.want) || !checkTol(b, tc.want) || !checkTol(a, 0.5) {
+			t.Errorf("%f: got (%f, %f, %f, %f), want (%f, %f, %f, 0.5)", tc.in, r, g, b, a, tc.want, tc.want, tc.want)
+		}
+	}
+}
+
+func TestHistogramMatchingTies(t *testing.T) {
+	// The equal values are mapped to the middle of their ranks.
+	src := grayImage(0, 0, 0, 255)
+	ref := grayImage(0, 85, 170, 255)
+	m := iro.NewHistogramMatching(src, ref, iro.SpaceLinearSRGB)
+	r, _, _, _ := m.Apply(iro.ColorFromSRGB(0, 0, 0, 1)).SRGB()
+	if want := 85.0 / 255; !checkTol(r, want) {
+		t.Errorf("got %f, want %f", r, want)
+	}
+}
+
+func TestHistogramMatchingImage(t *testing.T) {
+	src := image.NewNRGBA64(image.Rect(0, 0, 4, 1))
+	ref := image.NewNRGBA64(image.Rect(0, 0, 4, 1))
+	for i := 0; i < 4; i++ {
+		src.SetNRGBA64(i, 0, iro.ColorFromOKLab(0.2+0.1*float64(i), 0.02*float64(i), -0.01*float64(i), 1).SRGBNRGBA64())
+		ref.SetNRGBA64(i, 0, iro.ColorFromOKLab(0.5+0.1*float64(3-i), 0.01, 0.03*float64(i), 1).SRGBNRGBA64())
+	}
+	// Transparent pixels are ignored.
+	srcWithTransparent := image.NewNRGBA64(image.Rect(0, 0, 5, 1))
+	for i := 0; i < 4; i++ {
+		srcWithTransparent.SetNRGBA64(i, 0, src.NRGBA64At(i, 0))
+	}
+	m := iro.NewHistogramMatching(srcWithTransparent, ref, iro.SpaceOKLab)
+
+	// The matched image has the same set of values per component as the reference.
+	dst := m.ApplyToImage(src)
+	for i := 0; i < 4; i++ {
+		_, a, _, _ := iro.ColorFromSRGBNRGBA64(dst.NRGBA64At(i, 0)).OKLab()
+		if d := a - 0.01; d > 1e-4 || d < -1e-4 {
+			t.Errorf("a at %d: got %f, want 0.01", i, a)
+		}
+		l, _, _, _ := iro.ColorFromSRGBNRGBA64(dst.NRGBA64At(i, 0)).OKLab()
+		if want := 0.5 + 0.1*float64(i); l-want > 1e-4 || l-want < -1e-4 {
+			t.Errorf("L at %d: got %f, want %f", i, l, want)
+		}
+	}
+}
+
+func TestHistogramMatchingCylindrical(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("NewHistogramMatching with a cylindrical space must panic")
+		}
+	}()
+	img := grayImage(0)
+	iro.NewHistogramMatching(img, img, iro.SpaceOKLch)
+}
@@ -0,0 +1,154 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"fmt"
+	"image"
+	"math"
+	"slices"
+)
+
+// HueCurve is a smooth periodic function of hue in radians.
+//
+// HueCurve is interpolated with a periodic cubic Hermite spline,
+// so the curve wraps around smoothly between the last and the first control points.
+type HueCurve struct {
+	hs []float64
+	vs []float64
+	ms []float64
+}
+
+// NewHueCurve creates a HueCurve passing through the given points.
+// X of each point is a hue in radians, and Y is the value at the hue.
+// Hues are normalized to [0, 2π).
+//
+// NewHueCurve panics if there are no points or two points have the same normalized hue.
+func NewHueCurve(points []CurvePoint) *HueCurve {
+	if len(points) == 0 {
+		panic("iro: a hue curve requires at least one point")
+	}
+	ps := make([]CurvePoint, len(points))
+	for i, p := range points {
+		ps[i] = CurvePoint{X: NormalizeHue(p.X), Y: p.Y}
+	}
+	slices.SortFunc(ps, func(a, b CurvePoint) int {
+		switch {
+		case a.X < b.X:
+			return -1
+		case a.X > b.X:
+			return 1
+		}
+		return 0
+	})
+
+	n := len(ps)
+	c := &HueCurve{
+		hs: make([]float64, n),
+		vs: make([]float64, n),
+		ms: make([]float64, n),
+	}
+	for i, p := range ps {
+		if i > 0 && p.X == ps[i-1].X {
+			panic(fmt.Sprintf("iro: two points of a hue curve have the same hue: %f", p.X))
+		}
+		c.hs[i] = p.X
+		c.vs[i] = p.Y
+	}
+	if n == 1 {
+		return c
+	}
+	for i := range ps {
+		prev := (i + n - 1) % n
+		next := (i + 1) % n
+		d0 := (c.vs[i] - c.vs[prev]) / c.span(prev)
+		d1 := (c.vs[next] - c.vs[i]) / c.span(i)
+		c.ms[i] = (d0 + d1) / 2
+	}
+	return c
+}
+
+// span returns the distance from the i-th point to the next point, wrapping around.
+func (c *HueCurve) span(i int) float64 {
+	if i == len(c.hs)-1 {
+		return c.hs[0] + 2*math.Pi - c.hs[i]
+	}
+	return c.hs[i+1] - c.hs[i]
+}
+
+// At returns the value of the curve at the hue h in radians.
+func (c *HueCurve) At(h float64) float64 {
+	n := len(c.hs)
+	if n == 1 {
+		return c.vs[0]
+	}
+	h = NormalizeHue(h)
+
+	// Find the segment [hs[i], hs[i+1]), wrapping around.
+	i, found := slices.BinarySearch(c.hs, h)
+	if found {
+		return c.vs[i]
+	}
+	i--
+	if i < 0 {
+		i = n - 1
+	}
+	d := h - c.hs[i]
+	if d < 0 {
+		d += 2 * math.Pi
+	}
+	next := (i + 1) % n
+
+	span := c.span(i)
+	t := d / span
+	t2 := t * t
+	t3 := t2 * t
+	h00 := 2*t3 - 3*t2 + 1
+	h10 := t3 - 2*t2 + t
+	h01 := -2*t3 + 3*t2
+	h11 := t3 - t2
+	return h00*c.vs[i] + h10*span*c.ms[i] + h01*c.vs[next] + h11*span*c.ms[next]
+}
+
+// hueAdjustmentFullChroma is the OKLCh chroma at or above which hue-dependent lightness changes are fully applied.
+// Below this, the changes are reduced proportionally, as the hues of near-neutral colors are unreliable.
+const hueAdjustmentFullChroma = 0.05
+
+// HueAdjustment is a selective adjustment where the changes are smooth periodic functions of the input hue,
+// like hue-vs-hue, hue-vs-saturation, and hue-vs-lightness curves in photo editors.
+//
+// HueAdjustment operates in OKLCh.
+type HueAdjustment struct {
+	// HueShift returns the hue offset in radians for the input hue. nil doesn't change hues.
+	HueShift *HueCurve
+
+	// ChromaScale returns the chroma multiplier for the input hue. nil doesn't change chroma.
+	ChromaScale *HueCurve
+
+	// LightnessShift returns the lightness offset for the input hue. nil doesn't change lightness.
+	LightnessShift *HueCurve
+}
+
+// Apply returns a new Color by applying the adjustment to c.
+// The alpha value is kept.
+func (a *HueAdjustment) Apply(c Color) Color {
+	l, ch, h, alpha := c.OKLch()
+	h0 := h
+	if a.HueShift != nil {
+		h += a.HueShift.At(h0)
+	}
+	if a.ChromaScale != nil {
+		ch = max(ch*a.ChromaScale.At(h0), 0)
+	}
+	if a.LightnessShift != nil {
+		l += a.LightnessShift.At(h0) * min(ch/hueAdjustmentFullChroma, 1)
+	}
+	return ColorFromOKLch(l, ch, h, alpha)
+}
+
+// ApplyToImage returns a new image by applying the adjustment to each pixel of img.
+// See [MapImage] for the interpretation of the pixels.
+func (a *HueAdjustment) ApplyToImage(img image.Image) *image.NRGBA64 {
+	return MapImage(img, a.Apply)
+}
@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestHueCurve(t *testing.T) {
+	points := []iro.CurvePoint{
+		{X: 0, Y: 1},
+		{X: math.Pi / 2, Y: 2},
+		{X: -math.Pi / 2, Y: 0.5},
+	}
+	c := iro.NewHueCurve(points)
+
+	for _, p := range points {
+		if got := c.At(p.X); !checkTol(got, p.Y) {
+			t.Errorf("At(%f): got %f, want %f", p.X, got, p.Y)
+		}
+	}
+
+	// The curve is periodic.
+	for _, h := range []float64{0.3, 2, 4, 6} {
+		if got, want := c.At(h+2*math.Pi), c.At(h); !checkTol(got, want) {
+			t.Errorf("At(%f): got %f, want %f", h+2*math.Pi, got, want)
+		}
+		if got, want := c.At(h-4*math.Pi), c.At(h); !checkTol(got, want) {
+			t.Errorf("At(%f): got %f, want %f", h-4*math.Pi, got, want)
+		}
+	}
+
+	// The curve is continuous across the wraparound.
+	const eps = 1e-9
+	if got, want := c.At(2*math.Pi-eps), c.At(eps); math.Abs(got-want) > 1e-6 {
+		t.Errorf("At(2π-ε) = %f and At(ε) = %f must be close", got, want)
+	}
+}
+
+func TestHueCurveSinglePoint(t *testing.T) {
+	c := iro.NewHueCurve([]iro.CurvePoint{{X: 1, Y: 0.25}})
+	for _, h := range []float64{0, 1, 4} {
+		if got := c.At(h); got != 0.25 {
+			t.Errorf("At(%f): got %f, want 0.25", h, got)
+		}
+	}
+}
+
+func TestHueAdjustment(t *testing.T) {
+	// Rotate hues around red by 90 degrees and desaturate blues.
+	redHue := 0.5
+	blueHue := 4.5
+	a := &iro.HueAdjustment{
+		HueShift: iro.NewHueCurve([]iro.CurvePoint{
+			{X: redHue, Y: math.Pi / 2},
+			{X: blueHue, Y: 0},
+		}),
+		ChromaScale: iro.NewHueCurve([]iro.CurvePoint{
+			{X: redHue, Y: 1},
+			{X: blueHue, Y: 0.5},
+		}),
+	}
+
+	l, ch, h, alpha := a.Apply(iro.ColorFromOKLch(0.6, 0.1, redHue, 0.5)).OKLch()
+	if !checkTol(l, 0.6) || !checkTol(ch, 0.1) || !checkTol(h, redHue+math.Pi/2) || !checkTol(alpha, 0.5) {
+		t.Errorf("red: got (%f, %f, %f, %f)", l, ch, h, alpha)
+	}
+
+	l, ch, h, _ = a.Apply(iro.ColorFromOKLch(0.6, 0.1, blueHue-2*math.Pi, 1)).OKLch()
+	if !checkTol(l, 0.6) || !checkTol(ch, 0.05) || !checkTol(h, blueHue-2*math.Pi) {
+		t.Errorf("blue: got (%f, %f, %f)", l, ch, h)
+	}
+}
+
+func TestHueAdjustmentLightnessOfNeutral(t *testing.T) {
+	a := &iro.HueAdjustment{
+		LightnessShift: iro.NewHueCurve([]iro.CurvePoint{{X: 0, Y: 0.2}}),
+	}
+	l, _, _, _ := a.Apply(iro.ColorFromOKLab(0.5, 0, 0, 1)).OKLch()
+	if !checkTol(l, 0.5) {
+		t.Errorf("neutral lightness: got %f, want 0.5", l)
+	}
+	l, _, _, _ = a.Apply(iro.ColorFromOKLch(0.5, 0.2, 1, 1)).OKLch()
+	if !checkTol(l, 0.7) {
+		t.Errorf("chromatic lightness: got %f, want 0.7", l)
+	}
+}
@@ -0,0 +1,145 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"fmt"
+)
+
+// HueFamily represents a coarse family of colors.
+type HueFamily int
+
+// The hue families. The boundaries are of the OKLCh lightness L, chroma C, and hue h in degrees.
+// Black, white, gray, and brown take precedence over the hue ranges.
+const (
+	// HueFamilyRed is the hues in [20, 40), and the hues below 20 with L < 0.75.
+	HueFamilyRed HueFamily = iota
+
+	// HueFamilyOrange is the hues in [40, 75).
+	HueFamilyOrange
+
+	// HueFamilyYellow is the hues in [75, 115).
+	HueFamilyYellow
+
+	// HueFamilyGreen is the hues in [115, 170).
+	HueFamilyGreen
+
+	// HueFamilyCyan is the hues in [170, 220).
+	HueFamilyCyan
+
+	// HueFamilyBlue is the hues in [220, 290).
+	HueFamilyBlue
+
+	// HueFamilyPurple is the hues in [290, 340).
+	HueFamilyPurple
+
+	// HueFamilyPink is the hues from 340, and the hues below 20 with L >= 0.75.
+	HueFamilyPink
+
+	// HueFamilyBrown is the hues in [15, 40) with C < 0.1 and L < 0.75, in [40, 75) with L < 0.7 or with C < 0.09 and L < 0.85, and in [75, 100) with L < 0.5.
+	HueFamilyBrown
+
+	// HueFamilyGray is the colors with C < 0.04 and L in [0.25, 0.93].
+	HueFamilyGray
+
+	// HueFamilyBlack is the colors with L < 0.15, or with C < 0.04 and L < 0.25.
+	HueFamilyBlack
+
+	// HueFamilyWhite is the colors with L >= 0.95 and C < 0.05, or with C < 0.04 and L > 0.93.
+	HueFamilyWhite
+)
+
+// String returns the name of the family in lower case, like "red".
+func (h HueFamily) String() string {
+	switch h {
+	case HueFamilyRed:
+		return "red"
+	case HueFamilyOrange:
+		return "orange"
+	case HueFamilyYellow:
+		return "yellow"
+	case HueFamilyGreen:
+		return "green"
+	case HueFamilyCyan:
+		return "cyan"
+	case HueFamilyBlue:
+		return "blue"
+	case HueFamilyPurple:
+		return "purple"
+	case HueFamilyPink:
+		return "pink"
+	case HueFamilyBrown:
+		return "brown"
+	case HueFamilyGray:
+		return "gray"
+	case HueFamilyBlack:
+		return "black"
+	case HueFamilyWhite:
+		return "white"
+	default:
+		return fmt.Sprintf("HueFamily(%d)", h)
+	}
+}
+
+// HueFamilyOf returns the hue family of c. The alpha value is ignored.
+//
+// The boundaries are in OKLCh, tuned so that the CSS named colors mostly fall into the families their names suggest.
+// For example, saddlebrown and tan are brown, hotpink is pink, and indigo is purple.
+func HueFamilyOf(c Color) HueFamily {
+	l, ch, h, _ := c.OKLchDeg()
+
+	switch {
+	case l < 0.15:
+		return HueFamilyBlack
+	case l >= 0.95 && ch < 0.05:
+		return HueFamilyWhite
+	case ch < 0.04:
+		switch {
+		case l < 0.25:
+			return HueFamilyBlack
+		case l > 0.93:
+			return HueFamilyWhite
+		}
+		return HueFamilyGray
+	}
+
+	// Brown is a dark or desaturated red, orange, or yellow.
+	switch {
+	case 15 <= h && h < 40 && ch < 0.1 && l < 0.75:
+		return HueFamilyBrown
+	case 40 <= h && h < 75 && (l < 0.7 || ch < 0.09 && l < 0.85):
+		return HueFamilyBrown
+	case 75 <= h && h < 100 && l < 0.5:
+		return HueFamilyBrown
+	}
+
+	return hueFamilyOfHue(h, l)
+}
+
+// hueFamilyOfHue returns the chromatic hue family of the OKLCh hue h in degrees and the lightness l.
+func hueFamilyOfHue(h, l float64) HueFamily {
+	switch {
+	case h < 20:
+		// Light reds like pink are pink.
+		if l >= 0.75 {
+			return HueFamilyPink
+		}
+		return HueFamilyRed
+	case h < 40:
+		return HueFamilyRed
+	case h < 75:
+		return HueFamilyOrange
+	case h < 115:
+		return HueFamilyYellow
+	case h < 170:
+		return HueFamilyGreen
+	case h < 220:
+		return HueFamilyCyan
+	case h < 290:
+		return HueFamilyBlue
+	case h < 340:
+		return HueFamilyPurple
+	}
+	return HueFamilyPink
+}
@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestHueFamilyOf(t *testing.T) {
+	testCases := []struct {
+		name string
+		css  string
+		want iro.HueFamily
+	}{
+		{name: "red", css: "#ff0000", want: iro.HueFamilyRed},
+		{name: "maroon", css: "#800000", want: iro.HueFamilyRed},
+		{name: "firebrick", css: "#b22222", want: iro.HueFamilyRed},
+		{name: "orange", css: "#ffa500", want: iro.HueFamilyOrange},
+		{name: "darkorange", css: "#ff8c00", want: iro.HueFamilyOrange},
+		{name: "coral", css: "#ff7f50", want: iro.HueFamilyOrange},
+		{name: "yellow", css: "#ffff00", want: iro.HueFamilyYellow},
+		{name: "gold", css: "#ffd700", want: iro.HueFamilyYellow},
+		{name: "khaki", css: "#f0e68c", want: iro.HueFamilyYellow},
+		{name: "lime", css: "#00ff00", want: iro.HueFamilyGreen},
+		{name: "olivedrab", css: "#6b8e23", want: iro.HueFamilyGreen},
+		{name: "cyan", css: "#00ffff", want: iro.HueFamilyCyan},
+		{name: "teal", css: "#008080", want: iro.HueFamilyCyan},
+		{name: "turquoise", css: "#40e0d0", want: iro.HueFamilyCyan},
+		{name: "blue", css: "#0000ff", want: iro.HueFamilyBlue},
+		{name: "navy", css: "#000080", want: iro.HueFamilyBlue},
+		{name: "midnightblue", css: "#191970", want: iro.HueFamilyBlue},
+		{name: "indigo", css: "#4b0082", want: iro.HueFamilyPurple},
+		{name: "purple", css: "#800080", want: iro.HueFamilyPurple},
+		{name: "violet", css: "#ee82ee", want: iro.HueFamilyPurple},
+		{name: "pink", css: "#ffc0cb", want: iro.HueFamilyPink},
+		{name: "hotpink", css: "#ff69b4", want: iro.HueFamilyPink},
+		{name: "deeppink", css: "#ff1493", want: iro.HueFamilyPink},
+		{name: "saddlebrown", css: "#8b4513", want: iro.HueFamilyBrown},
+		{name: "sienna", css: "#a0522d", want: iro.HueFamilyBrown},
+		{name: "chocolate", css: "#d2691e", want: iro.HueFamilyBrown},
+		{name: "tan", css: "#d2b48c", want: iro.HueFamilyBrown},
+		{name: "rosybrown", css: "#bc8f8f", want: iro.HueFamilyBrown},
+		{name: "gray", css: "#808080", want: iro.HueFamilyGray},
+		{name: "darkslategray", css: "#2f4f4f", want: iro.HueFamilyGray},
+		{name: "black", css: "#000000", want: iro.HueFamilyBlack},
+		{name: "white", css: "#ffffff", want: iro.HueFamilyWhite},
+		{name: "beige", css: "#f5f5dc", want: iro.HueFamilyWhite},
+		{name: "floralwhite", css: "#fffaf0", want: iro.HueFamilyWhite},
+	}
+	for _, tc := range testCases {
+		c, err := iro.ParseCSS(tc.css)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := iro.HueFamilyOf(c); got != tc.want {
+			t.Errorf("HueFamilyOf(%s): got %s, want %s", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestHueFamilyString(t *testing.T) {
+	if got, want := iro.HueFamilyBrown.String(), "brown"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if got, want := iro.HueFamily(-1).String(), "HueFamily(-1)"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"image"
+	"math"
+)
+
+// HueRange is a range of hues in radians with a soft edge.
+type HueRange struct {
+	// Center is the center hue of the range in radians.
+	Center float64
+
+	// Width is the width of the range in radians, where the weight is 1.
+	Width float64
+
+	// Feather is the width of the soft edges on both sides in radians, where the weight falls smoothly from 1 to 0.
+	Feather float64
+}
+
+// Weight returns the weight of the hue h in radians in [0, 1]: 1 inside the range, 0 outside the edges, and between them in the edges.
+func (r *HueRange) Weight(h float64) float64 {
+	d := math.Abs(math.Remainder(h-r.Center, 2*math.Pi)) - r.Width/2
+	if d <= 0 {
+		return 1
+	}
+	if d >= r.Feather {
+		return 0
+	}
+	// Use smoothstep for the edges.
+	t := 1 - d/r.Feather
+	return t * t * (3 - 2*t)
+}
+
+// HueRotation rotates the hues of colors in OKLCh, keeping the OKLab lightness and chroma.
+//
+// Unlike rotating hues in HSL, the perceived brightness doesn't change.
+type HueRotation struct {
+	// Angle is the rotation in radians.
+	Angle float64
+
+	// Range is the range of the hues to rotate. The rotation angle is multiplied by the weight of the input hue.
+	// As the hues of near-neutral colors are unreliable, the weight is also reduced for the colors with small chroma.
+	// nil rotates all the hues.
+	Range *HueRange
+
+	// ProtectSkinTones reports whether skin tones are protected from the rotation.
+	// If true, the rotation angle is also multiplied by 1 - [Color.SkinToneWeight] of the input color.
+	ProtectSkinTones bool
+}
+
+// Apply returns a new Color by rotating the hue of c.
+// The result might be outside the gamut of c.
+// The alpha value is kept.
+func (r *HueRotation) Apply(c Color) Color {
+	l, ch, h, alpha := c.OKLch()
+	return ColorFromOKLch(l, ch, r.hue(l, ch, h), alpha)
+}
+
+func (r *HueRotation) hue(l, ch, h float64) float64 {
+	w := 1.0
+	if r.Range != nil {
+		w = r.Range.Weight(h) * min(ch/hueAdjustmentFullChroma, 1)
+	}
+	if r.ProtectSkinTones {
+		w *= 1 - skinToneWeight(l, ch, h)
+	}
+	return h + r.Angle*w
+}
+
+// ApplyToImage returns a new image by rotating the hue of each pixel of img.
+// See [MapImage] for the interpretation of the pixels.
+//
+// If a rotated color is outside the sRGB gamut, its chroma is reduced to fit the gamut so that the lightness is kept.
+func (r *HueRotation) ApplyToImage(img image.Image) *image.NRGBA64 {
+	b := newOKLchBoundary(SpaceSRGB)
+	return MapImage(img, func(c Color) Color {
+		l, ch, h, alpha := c.OKLch()
+		h = r.hue(l, ch, h)
+		if sin, cos := math.Sincos(h); !b.contains(l, ch*cos, ch*sin) {
+			ch = min(ch, b.maxChroma(l, sin, cos))
+		}
+		return ColorFromOKLch(l, ch, h, alpha)
+	})
+}
@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestHueRangeWeight(t *testing.T) {
+	r := &iro.HueRange{Center: 0.5, Width: 1, Feather: 0.5}
+	testCases := []struct {
+		h    float64
+		want float64
+	}{
+		{h: 0.5, want: 1},
+		{h: 0, want: 1},
+		{h: 1, want: 1},
+		{h: 1.25, want: 0.5},
+		{h: -0.25, want: 0.5},
+		{h: 2*math.Pi - 0.25, want: 0.5},
+		{h: 1.5, want: 0},
+		{h: 3, want: 0},
+	}
+	for _, tc := range testCases {
+		if got := r.Weight(tc.h); !checkTol(got, tc.want) {
+			t.Errorf("Weight(%f): got %f, want %f", tc.h, got, tc.want)
+		}
+	}
+}
+
+func TestHueRotation(t *testing.T) {
+	testCases := []struct {
+		name     string
+		rotation iro.HueRotation
+		h        float64
+		want     float64
+	}{
+		{name: "all", rotation: iro.HueRotation{Angle: 1}, h: 2, want: 3},
+		{name: "inside", rotation: iro.HueRotation{Angle: 1, Range: &iro.HueRange{Center: 2, Width: 1}}, h: 2.2, want: 3.2},
+		{name: "outside", rotation: iro.HueRotation{Angle: 1, Range: &iro.HueRange{Center: 2, Width: 1}}, h: 4, want: 4},
+		{name: "feather", rotation: iro.HueRotation{Angle: 1, Range: &iro.HueRange{Center: 2, Width: 1, Feather: 1}}, h: 3, want: 3.5},
+	}
+	for _, tc := range testCases {
+		got := tc.rotation.Apply(iro.ColorFromOKLch(0.6, 0.1, tc.h, 0.5))
+		l, c, h, a := got.OKLch()
+		if !checkTol(l, 0.6) || !checkTol(c, 0.1) || math.Abs(math.Remainder(h-tc.want, 2*math.Pi)) > 1e-6 || !checkTol(a, 0.5) {
+			t.Errorf("%s: got (%f, %f, %f, %f), want (0.6, 0.1, %f, 0.5)", tc.name, l, c, h, a, tc.want)
+		}
+	}
+}
+
+func TestHueRotationApplyToImage(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 1, 1))
+	img.SetNRGBA(0, 0, color.NRGBA{0xff, 0, 0, 0xff})
+	r := &iro.HueRotation{Angle: math.Pi}
+	got := iro.ColorFromSRGBColor(r.ApplyToImage(img).At(0, 0))
+	// The lightness is kept even though the rotated red is outside the sRGB gamut.
+	l0, _, h0, _ := iro.ColorFromSRGBNRGBA(img.NRGBAAt(0, 0)).OKLch()
+	l1, _, h1, _ := got.OKLch()
+	if math.Abs(l1-l0) > 1e-3 {
+		t.Errorf("lightness: got %f, want %f", l1, l0)
+	}
+	if d := math.Abs(math.Remainder(h1-h0-math.Pi, 2*math.Pi)); d > 1e-2 {
+		t.Errorf("hue: got %f, want %f", h1, h0+math.Pi)
+	}
+}
+
+func TestHueRotationProtectSkinTones(t *testing.T) {
+	r := &iro.HueRotation{Angle: math.Pi / 2, ProtectSkinTones: true}
+	skin := iro.ColorFromOKLch(0.7, 0.1, 55*math.Pi/180, 1)
+	if got := r.Apply(skin); iro.DeltaEOK(got, skin) > 1e-9 {
+		t.Errorf("skin: got %v, want %v", got, skin)
+	}
+	blue := iro.ColorFromOKLch(0.5, 0.15, 4.5, 1)
+	want := iro.ColorFromOKLch(0.5, 0.15, 4.5+math.Pi/2, 1)
+	if got := r.Apply(blue); iro.DeltaEOK(got, want) > 1e-9 {
+		t.Errorf("blue: got %v, want %v", got, want)
+	}
+}
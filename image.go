@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"context"
+	"image"
+)
+
+// MapImage returns a new image by applying f to each pixel of img.
+// The pixels of img are interpreted as sRGB in the same way as [ColorFromSRGBColor],
+// and the results are encoded as nonlinear sRGB.
+func MapImage(img image.Image, f func(c Color) Color) *image.NRGBA64 {
+	dst, _ := MapImageContext(context.Background(), img, f)
+	return dst
+}
+
+// MapImageContext is like [MapImage] but stops and returns the error of ctx when ctx is done.
+// ctx is checked for each row of img.
+func MapImageContext(ctx context.Context, img image.Image, f func(c Color) Color) (*image.NRGBA64, error) {
+	b := img.Bounds()
+	dst := image.NewNRGBA64(b)
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		for x := b.Min.X; x < b.Max.X; x++ {
+			dst.SetNRGBA64(x, y, f(colorAt(img, x, y)).SRGBNRGBA64())
+		}
+	}
+	return dst, nil
+}
+
+// colorAt returns the Color at (x, y) of img.
+// colorAt avoids allocating color.Color interface values for common image types.
+func colorAt(img image.Image, x, y int) Color {
+	switch img := img.(type) {
+	case *image.NRGBA:
+		return ColorFromSRGBNRGBA(img.NRGBAAt(x, y))
+	case *image.NRGBA64:
+		return ColorFromSRGBNRGBA64(img.NRGBA64At(x, y))
+	case *image.RGBA:
+		return ColorFromSRGBColor(img.RGBAAt(x, y))
+	case *image.RGBA64:
+		return ColorFromSRGBColor(img.RGBA64At(x, y))
+	case *image.Gray:
+		return ColorFromSRGBColor(img.GrayAt(x, y))
+	case *image.Gray16:
+		return ColorFromSRGBColor(img.Gray16At(x, y))
+	default:
+		return ColorFromSRGBColor(img.At(x, y))
+	}
+}
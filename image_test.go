@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"context"
+	"errors"
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestMapImage(t *testing.T) {
+	src := image.NewNRGBA(image.Rect(1, 2, 3, 4))
+	src.SetNRGBA(1, 2, color.NRGBA{R: 0xff, A: 0xff})
+	src.SetNRGBA(2, 3, color.NRGBA{G: 0x80, B: 0x40, A: 0x80})
+
+	// Swap red and blue channels.
+	got := iro.MapImage(src, func(c iro.Color) iro.Color {
+		r, g, b, a := c.SRGB()
+		return iro.ColorFromSRGB(b, g, r, a)
+	})
+	if got.Bounds() != src.Bounds() {
+		t.Fatalf("bounds: got %v, want %v", got.Bounds(), src.Bounds())
+	}
+
+	testCases := []struct {
+		x, y int
+		want color.NRGBA
+	}{
+		{x: 1, y: 2, want: color.NRGBA{B: 0xff, A: 0xff}},
+		{x: 2, y: 3, want: color.NRGBA{R: 0x40, G: 0x80, A: 0x80}},
+		{x: 2, y: 2, want: color.NRGBA{}},
+	}
+	for _, tc := range testCases {
+		if got := color.NRGBAModel.Convert(got.At(tc.x, tc.y)); got != tc.want {
+			t.Errorf("(%d, %d): got %v, want %v", tc.x, tc.y, got, tc.want)
+		}
+	}
+}
+
+func TestMapImageContext(t *testing.T) {
+	src := image.NewNRGBA(image.Rect(0, 0, 4, 4))
+
+	got, err := iro.MapImageContext(context.Background(), src, func(c iro.Color) iro.Color {
+		return c
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Bounds() != src.Bounds() {
+		t.Errorf("bounds: got %v, want %v", got.Bounds(), src.Bounds())
+	}
+
+	// Cancel in the middle of the conversion.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var count int
+	if _, err := iro.MapImageContext(ctx, src, func(c iro.Color) iro.Color {
+		count++
+		cancel()
+		return c
+	}); !errors.Is(err, context.Canceled) {
+		t.Errorf("got %v, want %v", err, context.Canceled)
+	}
+	if want := 4; count != want {
+		t.Errorf("got %d pixels converted, want %d", count, want)
+	}
+}
@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"fmt"
+	"image"
+	"math"
+	"slices"
+)
+
+// DifferenceStats is the statistics of color differences.
+type DifferenceStats struct {
+	// Mean is the mean of the differences.
+	Mean float64
+
+	// P95 is the 95th percentile of the differences.
+	P95 float64
+
+	// Max is the maximum of the differences.
+	Max float64
+}
+
+func newDifferenceStats(values []float64) DifferenceStats {
+	if len(values) == 0 {
+		return DifferenceStats{}
+	}
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+	// Use the nearest-rank method.
+	i := int(math.Ceil(0.95*float64(len(sorted)))) - 1
+	return DifferenceStats{
+		Mean: sum / float64(len(values)),
+		P95:  sorted[max(i, 0)],
+		Max:  sorted[len(sorted)-1],
+	}
+}
+
+// ImageDifference is the perceptual difference between two images.
+type ImageDifference struct {
+	// DeltaE2000 is the statistics of CIEDE2000 of the pixels. See [DeltaE2000].
+	DeltaE2000 DifferenceStats
+
+	// DeltaEOK is the statistics of ΔEOK of the pixels. See [DeltaEOK].
+	DeltaEOK DifferenceStats
+
+	width, height int
+	deltaE2000    []float64
+}
+
+// CompareImages returns the perceptual difference of the pixels between a and b.
+// Unlike PSNR, the differences are the ones perceived in the CIELAB and OKLab spaces.
+//
+// The pixels are interpreted as sRGB in the same way as [MapImage], and the alpha values are ignored.
+// The pixels are compared by the positions relative to the minimum points of the bounds.
+//
+// CompareImages panics if the sizes of a and b are different.
+func CompareImages(a, b image.Image) *ImageDifference {
+	ba, bb := a.Bounds(), b.Bounds()
+	if ba.Size() != bb.Size() {
+		panic(fmt.Sprintf("iro: the sizes of the images must be the same but %v and %v", ba.Size(), bb.Size()))
+	}
+	w, h := ba.Dx(), ba.Dy()
+	d2000 := make([]float64, 0, w*h)
+	dok := make([]float64, 0, w*h)
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			ca := colorAt(a, ba.Min.X+x, ba.Min.Y+y)
+			cb := colorAt(b, bb.Min.X+x, bb.Min.Y+y)
+			d2000 = append(d2000, DeltaE2000(ca, cb))
+			dok = append(dok, DeltaEOK(ca, cb))
+		}
+	}
+	return &ImageDifference{
+		DeltaE2000: newDifferenceStats(d2000),
+		DeltaEOK:   newDifferenceStats(dok),
+		width:      w,
+		height:     h,
+		deltaE2000: d2000,
+	}
+}
+
+// DeltaE2000At returns CIEDE2000 of the pixel at (x, y) relative to the minimum points of the bounds of the images.
+//
+// DeltaE2000At panics if (x, y) is outside the images.
+func (d *ImageDifference) DeltaE2000At(x, y int) float64 {
+	if x < 0 || x >= d.width || y < 0 || y >= d.height {
+		panic(fmt.Sprintf("iro: (%d, %d) is outside the images", x, y))
+	}
+	return d.deltaE2000[y*d.width+x]
+}
+
+// defaultHeatmapScale is the scale of heatmaps from black for no difference to white for CIEDE2000 of 10 or more.
+var defaultHeatmapScale = NewScale(NewGradient(
+	ColorFromSRGB(0, 0, 0, 1),
+	ColorFromSRGB(0, 0, 1, 1),
+	ColorFromSRGB(1, 0, 0, 1),
+	ColorFromSRGB(1, 1, 0, 1),
+	ColorFromSRGB(1, 1, 1, 1),
+), 0, 10)
+
+// Heatmap returns a false-color image of CIEDE2000 of the pixels with the scale.
+// The bounds of the result start at (0, 0).
+//
+// If scale is nil, the default scale from black for no difference to white for 10 or more is used,
+// via blue, red, and yellow.
+func (d *ImageDifference) Heatmap(scale *Scale) *image.NRGBA64 {
+	if scale == nil {
+		scale = defaultHeatmapScale
+	}
+	dst := image.NewNRGBA64(image.Rect(0, 0, d.width, d.height))
+	for y := 0; y < d.height; y++ {
+		for x := 0; x < d.width; x++ {
+			dst.SetNRGBA64(x, y, scale.At(d.deltaE2000[y*d.width+x]).SRGBNRGBA64())
+		}
+	}
+	return dst
+}
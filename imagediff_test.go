@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestCompareImages(t *testing.T) {
+	a := image.NewNRGBA(image.Rect(0, 0, 10, 10))
+	b := image.NewNRGBA(image.Rect(5, 5, 15, 15))
+	gray := color.NRGBA{0x80, 0x80, 0x80, 0xff}
+	red := color.NRGBA{0xff, 0, 0, 0xff}
+	for y := 0; y < 10; y++ {
+		for x := 0; x < 10; x++ {
+			a.SetNRGBA(x, y, gray)
+			b.SetNRGBA(x+5, y+5, gray)
+		}
+	}
+	// Only one pixel differs.
+	b.SetNRGBA(5+3, 5+4, red)
+
+	d := iro.CompareImages(a, b)
+	want2000 := iro.DeltaE2000(iro.ColorFromSRGBNRGBA(gray), iro.ColorFromSRGBNRGBA(red))
+	wantOK := iro.DeltaEOK(iro.ColorFromSRGBNRGBA(gray), iro.ColorFromSRGBNRGBA(red))
+	if got, want := d.DeltaE2000.Mean, want2000/100; !checkTol(got, want) {
+		t.Errorf("DeltaE2000.Mean: got %f, want %f", got, want)
+	}
+	if got, want := d.DeltaE2000.P95, 0.0; !checkTol(got, want) {
+		t.Errorf("DeltaE2000.P95: got %f, want %f", got, want)
+	}
+	if got, want := d.DeltaE2000.Max, want2000; !checkTol(got, want) {
+		t.Errorf("DeltaE2000.Max: got %f, want %f", got, want)
+	}
+	if got, want := d.DeltaEOK.Mean, wantOK/100; !checkTol(got, want) {
+		t.Errorf("DeltaEOK.Mean: got %f, want %f", got, want)
+	}
+	if got, want := d.DeltaEOK.Max, wantOK; !checkTol(got, want) {
+		t.Errorf("DeltaEOK.Max: got %f, want %f", got, want)
+	}
+	if got, want := d.DeltaE2000At(3, 4), want2000; !checkTol(got, want) {
+		t.Errorf("DeltaE2000At(3, 4): got %f, want %f", got, want)
+	}
+	if got, want := d.DeltaE2000At(0, 0), 0.0; !checkTol(got, want) {
+		t.Errorf("DeltaE2000At(0, 0): got %f, want %f", got, want)
+	}
+
+	heatmap := d.Heatmap(nil)
+	if got, want := heatmap.Bounds(), image.Rect(0, 0, 10, 10); got != want {
+		t.Errorf("Heatmap bounds: got %v, want %v", got, want)
+	}
+	if got, want := heatmap.NRGBA64At(0, 0), (color.NRGBA64{0, 0, 0, 0xffff}); got != want {
+		t.Errorf("Heatmap(0, 0): got %v, want %v", got, want)
+	}
+	// CIEDE2000 between gray and red is more than 10.
+	if got, want := heatmap.NRGBA64At(3, 4), (color.NRGBA64{0xffff, 0xffff, 0xffff, 0xffff}); got != want {
+		t.Errorf("Heatmap(3, 4): got %v, want %v", got, want)
+	}
+}
+
+func TestCompareImagesPanic(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("CompareImages must panic for images of different sizes")
+		}
+	}()
+	iro.CompareImages(image.NewNRGBA(image.Rect(0, 0, 2, 2)), image.NewNRGBA(image.Rect(0, 0, 2, 3)))
+}
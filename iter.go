@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+//go:build go1.23
+
+package iro
+
+import (
+	"fmt"
+	"iter"
+)
+
+// SamplesSeq returns an iterator over n colors sampled evenly from 0 to 1 in the gradient.
+// Unlike [Gradient.Samples], SamplesSeq doesn't allocate a slice, and the colors are computed lazily.
+//
+// SamplesSeq panics if n is negative.
+func (g *Gradient) SamplesSeq(n int) iter.Seq[Color] {
+	if n < 0 {
+		panic(fmt.Sprintf("iro: the number of samples must be non-negative but %d", n))
+	}
+	return func(yield func(Color) bool) {
+		for i := 0; i < n; i++ {
+			var t float64
+			if n > 1 {
+				t = float64(i) / float64(n-1)
+			}
+			if !yield(g.At(t)) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over the tones in [TonalPaletteTones] and the colors of the palette at the tones, in the order of the tones.
+// Unlike [TonalPalette.Tones], All doesn't allocate a map, and the colors are computed lazily.
+func (p *TonalPalette) All() iter.Seq2[int, Color] {
+	return func(yield func(int, Color) bool) {
+		for _, t := range tonalPaletteTones {
+			if !yield(t, p.Tone(float64(t))) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+//go:build go1.23
+
+package iro_test
+
+import (
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestGradientSamplesSeq(t *testing.T) {
+	g := iro.NewGradient(iro.ColorFromSRGB(1, 0, 0, 1), iro.ColorFromSRGB(0, 0, 1, 1))
+	for _, n := range []int{0, 1, 2, 5} {
+		want := g.Samples(n)
+		var got []iro.Color
+		for c := range g.SamplesSeq(n) {
+			got = append(got, c)
+		}
+		if len(got) != len(want) {
+			t.Fatalf("n=%d: got %d colors, want %d", n, len(got), len(want))
+		}
+		for i := range got {
+			if got[i] != want[i] {
+				t.Errorf("n=%d, i=%d: got %v, want %v", n, i, got[i], want[i])
+			}
+		}
+	}
+
+	// Breaking the loop stops the iteration.
+	var count int
+	for range g.SamplesSeq(1 << 30) {
+		count++
+		if count == 3 {
+			break
+		}
+	}
+	if count != 3 {
+		t.Errorf("break: got %d iterations, want 3", count)
+	}
+}
+
+func TestGradientSamplesSeqNegative(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("SamplesSeq(-1) must panic")
+		}
+	}()
+	iro.NewGradient(iro.ColorFromSRGB(1, 0, 0, 1)).SamplesSeq(-1)
+}
+
+func TestTonalPaletteAll(t *testing.T) {
+	p := iro.NewTonalPalette(iro.ColorFromSRGB(0.2, 0.4, 0.8, 1))
+	want := p.Tones()
+	tones := iro.TonalPaletteTones()
+	var i int
+	for tone, c := range p.All() {
+		if tone != tones[i] {
+			t.Errorf("%d: tone: got %d, want %d", i, tone, tones[i])
+		}
+		if c != want[tone] {
+			t.Errorf("tone %d: got %v, want %v", tone, c, want[tone])
+		}
+		i++
+	}
+	if i != len(tones) {
+		t.Errorf("got %d tones, want %d", i, len(tones))
+	}
+}
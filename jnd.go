@@ -0,0 +1,176 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"fmt"
+	"math"
+)
+
+// JNDModel specifies a model of the just noticeable difference (JND) between two colors.
+type JNDModel int
+
+const (
+	// JNDModelDeltaE2000 regards colors as distinguishable when CIEDE2000 is more than 1.
+	JNDModelDeltaE2000 JNDModel = iota
+
+	// JNDModelDeltaEOK regards colors as distinguishable when ΔEOK is more than 0.02.
+	JNDModelDeltaEOK
+
+	// JNDModelMacAdam regards colors as distinguishable when their chromaticities are more than 3 steps apart on the MacAdam ellipses.
+	// A step is a standard deviation of color matching, and a JND is about three of them.
+	// The luminance is ignored.
+	JNDModelMacAdam
+)
+
+const (
+	jndDeltaE2000   = 1
+	jndDeltaEOK     = 0.02
+	jndMacAdamSteps = 3
+)
+
+// Distinguishable reports whether a and b are distinguishable with the model.
+// The alpha values are ignored.
+func Distinguishable(a, b Color, model JNDModel) bool {
+	switch model {
+	case JNDModelDeltaE2000:
+		return DeltaE2000(a, b) > jndDeltaE2000
+	case JNDModelDeltaEOK:
+		return DeltaEOK(a, b) > jndDeltaEOK
+	case JNDModelMacAdam:
+		return MacAdamSteps(a.Chromaticity(), b.Chromaticity()) > jndMacAdamSteps
+	default:
+		panic(fmt.Sprintf("iro: invalid JNDModel: %d", model))
+	}
+}
+
+// ChromaticityEllipse is an ellipse on the CIE 1931 xy chromaticity diagram.
+type ChromaticityEllipse struct {
+	// Center is the center of the ellipse.
+	Center Chromaticity
+
+	// SemiMajor and SemiMinor are the lengths of the semi-axes in xy.
+	SemiMajor float64
+	SemiMinor float64
+
+	// Angle is the angle of the major axis from the x axis in radians, counterclockwise.
+	// MacAdamEllipse returns an angle in [0, π).
+	Angle float64
+}
+
+// macAdamEllipses is the 25 ellipses of MacAdam (1942), as x, y, the semi-major axis × 1000, the semi-minor axis × 1000, and the angle in degrees.
+//
+// See Wyszecki and Stiles, "Color Science" (2nd ed.), Table 2(5.4.1).
+var macAdamEllipses = [...][5]float64{
+	{0.160, 0.057, 0.85, 0.35, 62.5},
+	{0.187, 0.118, 2.2, 0.55, 77.0},
+	{0.253, 0.125, 2.5, 0.50, 55.5},
+	{0.150, 0.680, 9.6, 2.3, 105.0},
+	{0.131, 0.521, 4.7, 2.0, 112.5},
+	{0.212, 0.550, 5.8, 2.3, 100.0},
+	{0.258, 0.450, 5.0, 2.0, 92.0},
+	{0.152, 0.365, 3.8, 1.9, 110.0},
+	{0.280, 0.385, 4.0, 1.5, 75.5},
+	{0.380, 0.498, 4.4, 1.2, 70.0},
+	{0.160, 0.200, 2.1, 0.95, 104.0},
+	{0.228, 0.250, 3.1, 0.90, 72.0},
+	{0.305, 0.323, 2.3, 0.90, 58.0},
+	{0.385, 0.393, 3.8, 1.6, 65.5},
+	{0.472, 0.399, 3.2, 1.4, 51.0},
+	{0.527, 0.350, 2.6, 1.3, 20.0},
+	{0.475, 0.300, 2.9, 1.1, 28.5},
+	{0.510, 0.236, 2.4, 1.2, 29.5},
+	{0.596, 0.283, 2.6, 1.3, 13.0},
+	{0.344, 0.284, 2.3, 0.90, 60.0},
+	{0.390, 0.237, 2.5, 1.0, 47.0},
+	{0.441, 0.198, 2.8, 0.95, 34.5},
+	{0.278, 0.223, 2.4, 0.55, 57.5},
+	{0.300, 0.163, 2.9, 0.60, 54.0},
+	{0.365, 0.153, 3.6, 0.95, 40.0},
+}
+
+// macAdamMetric returns the coefficients of the quadratic form g11 dx² + 2 g12 dx dy + g22 dy² = 1 on the MacAdam ellipse at c.
+//
+// The coefficients are interpolated from the ellipses of MacAdam by inverse distance weighting.
+func macAdamMetric(c Chromaticity) (g11, g12, g22 float64) {
+	var sum float64
+	for _, e := range macAdamEllipses {
+		a, b, theta := e[2]/1000, e[3]/1000, e[4]*math.Pi/180
+		sin, cos := math.Sincos(theta)
+		// G = R diag(1/a², 1/b²) Rᵀ
+		e11 := cos*cos/(a*a) + sin*sin/(b*b)
+		e12 := sin * cos * (1/(a*a) - 1/(b*b))
+		e22 := sin*sin/(a*a) + cos*cos/(b*b)
+
+		d2 := (c.X-e[0])*(c.X-e[0]) + (c.Y-e[1])*(c.Y-e[1])
+		if d2 == 0 {
+			return e11, e12, e22
+		}
+		w := 1 / (d2 * d2)
+		g11 += w * e11
+		g12 += w * e12
+		g22 += w * e22
+		sum += w
+	}
+	return g11 / sum, g12 / sum, g22 / sum
+}
+
+// MacAdamEllipse returns the MacAdam ellipse at the chromaticity c.
+//
+// The ellipse is interpolated from the 25 ellipses of MacAdam (1942) for the CIE 1931 2° standard observer.
+// The ellipse is the standard deviation of color matching, which is one step.
+// MacAdam ellipses are usually drawn enlarged ten times for visualization.
+func MacAdamEllipse(c Chromaticity) ChromaticityEllipse {
+	g11, g12, g22 := macAdamMetric(c)
+	// The eigenvalues of G are 1/a² and 1/b².
+	mean := (g11 + g22) / 2
+	d := math.Hypot((g11-g22)/2, g12)
+	lMin, lMax := mean-d, mean+d
+	// The major axis is the eigenvector of the smaller eigenvalue.
+	angle := math.Mod(math.Atan2(lMin-g11, g12)+math.Pi, math.Pi)
+	return ChromaticityEllipse{
+		Center:    c,
+		SemiMajor: 1 / math.Sqrt(lMin),
+		SemiMinor: 1 / math.Sqrt(lMax),
+		Angle:     angle,
+	}
+}
+
+// MacAdamSteps returns the distance between the chromaticities a and b in steps of the MacAdam ellipse at their midpoint.
+func MacAdamSteps(a, b Chromaticity) float64 {
+	g11, g12, g22 := macAdamMetric(Chromaticity{X: (a.X + b.X) / 2, Y: (a.Y + b.Y) / 2})
+	dx, dy := b.X-a.X, b.Y-a.Y
+	return math.Sqrt(max(g11*dx*dx+2*g12*dx*dy+g22*dy*dy, 0))
+}
+
+// Steps returns the distance of c from the center of e, in units of e.
+// Steps returns 1 for a point on e.
+func (e *ChromaticityEllipse) Steps(c Chromaticity) float64 {
+	sin, cos := math.Sincos(e.Angle)
+	dx, dy := c.X-e.Center.X, c.Y-e.Center.Y
+	u := (dx*cos + dy*sin) / e.SemiMajor
+	v := (-dx*sin + dy*cos) / e.SemiMinor
+	return math.Hypot(u, v)
+}
+
+// Points returns n points on e at even angles, for visualization.
+// To draw an enlarged ellipse, multiply SemiMajor and SemiMinor beforehand.
+//
+// Points panics if n is negative.
+func (e *ChromaticityEllipse) Points(n int) []Chromaticity {
+	if n < 0 {
+		panic(fmt.Sprintf("iro: the number of points must be non-negative but %d", n))
+	}
+	sin, cos := math.Sincos(e.Angle)
+	ps := make([]Chromaticity, n)
+	for i := range ps {
+		ts, tc := math.Sincos(2 * math.Pi * float64(i) / float64(n))
+		u, v := e.SemiMajor*tc, e.SemiMinor*ts
+		ps[i] = Chromaticity{
+			X: e.Center.X + u*cos - v*sin,
+			Y: e.Center.Y + u*sin + v*cos,
+		}
+	}
+	return ps
+}
@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestMacAdamEllipse(t *testing.T) {
+	// The ellipses at the measured chromaticities are the ones of MacAdam.
+	testCases := []struct {
+		center   iro.Chromaticity
+		a, b     float64
+		angleDeg float64
+	}{
+		{center: iro.Chromaticity{X: 0.160, Y: 0.057}, a: 0.85e-3, b: 0.35e-3, angleDeg: 62.5},
+		{center: iro.Chromaticity{X: 0.150, Y: 0.680}, a: 9.6e-3, b: 2.3e-3, angleDeg: 105},
+		{center: iro.Chromaticity{X: 0.305, Y: 0.323}, a: 2.3e-3, b: 0.9e-3, angleDeg: 58},
+		{center: iro.Chromaticity{X: 0.596, Y: 0.283}, a: 2.6e-3, b: 1.3e-3, angleDeg: 13},
+	}
+	for _, tc := range testCases {
+		e := iro.MacAdamEllipse(tc.center)
+		if e.Center != tc.center {
+			t.Errorf("%v: center: got %v, want %v", tc.center, e.Center, tc.center)
+		}
+		if math.Abs(e.SemiMajor-tc.a) > 1e-9 || math.Abs(e.SemiMinor-tc.b) > 1e-9 {
+			t.Errorf("%v: semi-axes: got (%g, %g), want (%g, %g)", tc.center, e.SemiMajor, e.SemiMinor, tc.a, tc.b)
+		}
+		if got := e.Angle * 180 / math.Pi; math.Abs(got-tc.angleDeg) > 1e-6 {
+			t.Errorf("%v: angle: got %f, want %f", tc.center, got, tc.angleDeg)
+		}
+	}
+
+	// Between the measured ellipses, the ellipse is interpolated.
+	e := iro.MacAdamEllipse(iro.WhiteD65)
+	if e.SemiMajor < e.SemiMinor || e.SemiMinor < 0.5e-3 || e.SemiMajor > 5e-3 {
+		t.Errorf("D65: semi-axes: got (%g, %g)", e.SemiMajor, e.SemiMinor)
+	}
+}
+
+func TestChromaticityEllipsePoints(t *testing.T) {
+	e := iro.MacAdamEllipse(iro.Chromaticity{X: 0.3, Y: 0.3})
+	ps := e.Points(16)
+	if got, want := len(ps), 16; got != want {
+		t.Fatalf("len: got %d, want %d", got, want)
+	}
+	for i, p := range ps {
+		if got := e.Steps(p); !checkTol(got, 1) {
+			t.Errorf("Steps(Points(16)[%d]): got %f, want 1", i, got)
+		}
+		// MacAdamSteps agrees with Steps for small distances.
+		if got := iro.MacAdamSteps(e.Center, p); math.Abs(got-1) > 0.01 {
+			t.Errorf("MacAdamSteps(center, Points(16)[%d]): got %f, want 1", i, got)
+		}
+	}
+	if got := e.Steps(e.Center); got != 0 {
+		t.Errorf("Steps(center): got %f, want 0", got)
+	}
+}
+
+func TestDistinguishable(t *testing.T) {
+	gray := iro.ColorFromSRGB(0.5, 0.5, 0.5, 1)
+	testCases := []struct {
+		a, b  iro.Color
+		model iro.JNDModel
+		want  bool
+	}{
+		{a: gray, b: gray, model: iro.JNDModelDeltaE2000, want: false},
+		{a: gray, b: gray, model: iro.JNDModelDeltaEOK, want: false},
+		{a: gray, b: gray, model: iro.JNDModelMacAdam, want: false},
+		{a: gray, b: iro.ColorFromSRGB(0.502, 0.5, 0.5, 1), model: iro.JNDModelDeltaE2000, want: false},
+		{a: gray, b: iro.ColorFromSRGB(0.502, 0.5, 0.5, 1), model: iro.JNDModelDeltaEOK, want: false},
+		{a: gray, b: iro.ColorFromSRGB(0.6, 0.5, 0.5, 1), model: iro.JNDModelDeltaE2000, want: true},
+		{a: gray, b: iro.ColorFromSRGB(0.6, 0.5, 0.5, 1), model: iro.JNDModelDeltaEOK, want: true},
+		{a: gray, b: iro.ColorFromSRGB(0.6, 0.5, 0.5, 1), model: iro.JNDModelMacAdam, want: true},
+		// MacAdam ellipses ignore the luminance.
+		{a: gray, b: iro.ColorFromSRGB(0.6, 0.6, 0.6, 1), model: iro.JNDModelDeltaE2000, want: true},
+		{a: gray, b: iro.ColorFromSRGB(0.6, 0.6, 0.6, 1), model: iro.JNDModelMacAdam, want: false},
+	}
+	for _, tc := range testCases {
+		if got := iro.Distinguishable(tc.a, tc.b, tc.model); got != tc.want {
+			t.Errorf("Distinguishable(%v, %v, %d): got %t, want %t", tc.a, tc.b, tc.model, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,23 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+// mulRowsGeneric multiplies each triplet of src by m, and stores the results in dst.
+// dst and src must have the same length of a multiple of 3. dst and src can be the same slice.
+func mulRowsGeneric(m *Matrix3, dst, src []float64) {
+	for i := 0; i+2 < len(src); i += 3 {
+		r, g, b := src[i], src[i+1], src[i+2]
+		dst[i] = m[0][0]*r + m[0][1]*g + m[0][2]*b
+		dst[i+1] = m[1][0]*r + m[1][1]*g + m[1][2]*b
+		dst[i+2] = m[2][0]*r + m[2][1]*g + m[2][2]*b
+	}
+}
+
+// mapRows applies f to each value of src, and stores the results in dst.
+// dst and src must have the same length. dst and src can be the same slice.
+func mapRows(f func(float64) float64, dst, src []float64) {
+	for i, v := range src {
+		dst[i] = f(v)
+	}
+}
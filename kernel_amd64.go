@@ -0,0 +1,22 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+//go:build !purego
+
+package iro
+
+// mulRowsSSE2 is an SSE2 implementation of mulRowsGeneric for n triplets.
+//
+//go:noescape
+func mulRowsSSE2(m *Matrix3, dst, src *float64, n int)
+
+// mulRows multiplies each triplet of src by m, and stores the results in dst.
+// dst and src must have the same length of a multiple of 3. dst and src can be the same slice.
+func mulRows(m *Matrix3, dst, src []float64) {
+	n := len(src) / 3
+	if n == 0 {
+		return
+	}
+	_ = dst[3*n-1]
+	mulRowsSSE2(m, &dst[0], &src[0], n)
+}
@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+//go:build !purego
+
+#include "textflag.h"
+
+// func mulRowsSSE2(m *[3][3]float64, dst, src *float64, n int)
+TEXT ·mulRowsSSE2(SB), NOSPLIT, $0-32
+	MOVQ m+0(FP), AX
+	MOVQ dst+8(FP), DI
+	MOVQ src+16(FP), SI
+	MOVQ n+24(FP), CX
+
+	// X8 = [m00, m10], X9 = [m01, m11], X10 = [m02, m12]
+	MOVSD  0(AX), X8
+	MOVHPD 24(AX), X8
+	MOVSD  8(AX), X9
+	MOVHPD 32(AX), X9
+	MOVSD  16(AX), X10
+	MOVHPD 40(AX), X10
+
+	// X11 = m20, X12 = m21, X13 = m22
+	MOVSD 48(AX), X11
+	MOVSD 56(AX), X12
+	MOVSD 64(AX), X13
+
+	TESTQ CX, CX
+	JZ    done
+
+loop:
+	MOVSD 0(SI), X0
+	MOVSD 8(SI), X1
+	MOVSD 16(SI), X2
+
+	// The third component is computed with scalar operations.
+	MOVAPD X0, X3
+	MULSD  X11, X3
+	MOVAPD X1, X4
+	MULSD  X12, X4
+	ADDSD  X4, X3
+	MOVAPD X2, X4
+	MULSD  X13, X4
+	ADDSD  X4, X3
+
+	// The first and second components are computed with packed operations.
+	UNPCKLPD X0, X0
+	UNPCKLPD X1, X1
+	UNPCKLPD X2, X2
+	MULPD    X8, X0
+	MULPD    X9, X1
+	MULPD    X10, X2
+	ADDPD    X1, X0
+	ADDPD    X2, X0
+
+	MOVUPD X0, 0(DI)
+	MOVSD  X3, 16(DI)
+
+	ADDQ $24, SI
+	ADDQ $24, DI
+	DECQ CX
+	JNZ  loop
+
+done:
+	RET
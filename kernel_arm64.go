@@ -0,0 +1,24 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+//go:build !purego
+
+package iro
+
+// mulRowsNEON is a NEON implementation of mulRowsGeneric for n triplets.
+// cols is the first and second rows of the matrix in the column-major order, and row2 is the third row.
+//
+//go:noescape
+func mulRowsNEON(cols *[6]float64, row2 *[3]float64, dst, src *float64, n int)
+
+// mulRows multiplies each triplet of src by m, and stores the results in dst.
+// dst and src must have the same length of a multiple of 3. dst and src can be the same slice.
+func mulRows(m *Matrix3, dst, src []float64) {
+	n := len(src) / 3
+	if n == 0 {
+		return
+	}
+	_ = dst[3*n-1]
+	cols := [6]float64{m[0][0], m[1][0], m[0][1], m[1][1], m[0][2], m[1][2]}
+	mulRowsNEON(&cols, &m[2], &dst[0], &src[0], n)
+}
@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+//go:build !purego
+
+#include "textflag.h"
+
+// func mulRowsNEON(cols *[6]float64, row2 *[3]float64, dst, src *float64, n int)
+TEXT ·mulRowsNEON(SB), NOSPLIT, $0-40
+	MOVD cols+0(FP), R0
+	MOVD row2+8(FP), R1
+	MOVD dst+16(FP), R2
+	MOVD src+24(FP), R3
+	MOVD n+32(FP), R4
+
+	// V16 = [m00, m10], V17 = [m01, m11], V18 = [m02, m12]
+	VLD1 (R0), [V16.D2, V17.D2, V18.D2]
+
+	// F19 = m20, F20 = m21, F21 = m22
+	FMOVD 0(R1), F19
+	FMOVD 8(R1), F20
+	FMOVD 16(R1), F21
+
+	CBZ R4, done
+
+loop:
+	FMOVD 0(R3), F0
+	FMOVD 8(R3), F1
+	FMOVD 16(R3), F2
+
+	// The operations are in the same order as the fused ones of mulRowsGeneric compiled for arm64,
+	// i.e. m1*g, then plus m0*r, then plus m2*b, so that the results are the same.
+
+	// The first and second components are computed with vector operations.
+	VDUP  V0.D[0], V4.D2
+	VDUP  V1.D[0], V5.D2
+	VDUP  V2.D[0], V6.D2
+	VEOR  V7.B16, V7.B16, V7.B16
+	VFMLA V17.D2, V5.D2, V7.D2
+	VFMLA V16.D2, V4.D2, V7.D2
+	VFMLA V18.D2, V6.D2, V7.D2
+
+	// The third component is computed with scalar operations.
+	FMULD  F20, F1, F3
+	FMADDD F19, F3, F0, F3
+	FMADDD F21, F3, F2, F3
+
+	VST1  [V7.D2], (R2)
+	FMOVD F3, 16(R2)
+
+	ADD  $24, R3
+	ADD  $24, R2
+	SUB  $1, R4
+	CBNZ R4, loop
+
+done:
+	RET
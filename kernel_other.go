@@ -0,0 +1,12 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+//go:build (!amd64 && !arm64) || purego
+
+package iro
+
+// mulRows multiplies each triplet of src by m, and stores the results in dst.
+// dst and src must have the same length of a multiple of 3. dst and src can be the same slice.
+func mulRows(m *Matrix3, dst, src []float64) {
+	mulRowsGeneric(m, dst, src)
+}
@@ -0,0 +1,177 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"math"
+)
+
+// The D50 white point and the Bradford chromatic adaptation matrices are referenced from:
+// https://www.w3.org/TR/css-color-4/#color-conversion-code
+
+const (
+	d50X = 0.3457 / 0.3585
+	d50Y = 1.0
+	d50Z = (1 - 0.3457 - 0.3585) / 0.3585
+)
+
+const (
+	labEpsilon = 216.0 / 24389
+	labKappa   = 24389.0 / 27
+)
+
+func xyzD65ToD50(x, y, z float64) (float64, float64, float64) {
+	return MatrixXYZToXYZD50.Apply(x, y, z)
+}
+
+func xyzD50ToD65(x, y, z float64) (float64, float64, float64) {
+	return MatrixXYZD50ToXYZ.Apply(x, y, z)
+}
+
+// ColorFromXYZD50 builds a Color from XYZ D50 coordinates and alpha.
+// The coordinates are adapted to D65 with the Bradford transform.
+func ColorFromXYZD50(x, y, z, alpha float64) Color {
+	x, y, z = xyzD50ToD65(x, y, z)
+	return ColorFromXYZ(x, y, z, alpha)
+}
+
+// XYZD50 returns the XYZ D50 coordinates adapted with the Bradford transform and alpha.
+func (c Color) XYZD50() (x, y, z, a float64) {
+	x, y, z = xyzD65ToD50(c.x, c.y, c.z)
+	a = c.alpha
+	return
+}
+
+// ColorFromLab builds a Color from CIELAB components and alpha.
+// As CSS does, the white point of CIELAB is D50. L is in [0, 100].
+func ColorFromLab(l, a, b, alpha float64) Color {
+	x, y, z := labToXYZ(l, a, b, d50X, d50Y, d50Z)
+	return ColorFromXYZD50(x, y, z, alpha)
+}
+
+// Lab converts Color to CIELAB components and alpha.
+// As CSS does, the white point of CIELAB is D50. L is in [0, 100].
+func (c Color) Lab() (l, a, b, alpha float64) {
+	x, y, z, alpha := c.XYZD50()
+	l, a, b = xyzToLab(x, y, z, d50X, d50Y, d50Z)
+	return
+}
+
+// ColorFromLabWithWhite builds a Color from CIELAB components relative to the white point and alpha.
+// L is in [0, 100].
+//
+// The XYZ coordinates relative to the white, whose luminance is 1, are adapted to D65 with the Bradford transform.
+// This is useful for measurement data relative to an illuminant other than D50, like [WhiteA] or [WhiteC].
+// ColorFromLabWithWhite with [WhiteD50] is the same as [ColorFromLab] within the precision of the adaptation matrices.
+func ColorFromLabWithWhite(l, a, b, alpha float64, white Chromaticity) Color {
+	xn, yn, zn := white.XYZ(1)
+	x, y, z := labToXYZ(l, a, b, xn, yn, zn)
+	return colorFromXYZWithWhite(x, y, z, alpha, white)
+}
+
+// LabWithWhite converts Color to CIELAB components relative to the white point and alpha.
+// L is in [0, 100]. See [ColorFromLabWithWhite] for the adaptation to the white.
+func (c Color) LabWithWhite(white Chromaticity) (l, a, b, alpha float64) {
+	x, y, z := c.xyzWithWhite(white)
+	xn, yn, zn := white.XYZ(1)
+	l, a, b = xyzToLab(x, y, z, xn, yn, zn)
+	return l, a, b, c.alpha
+}
+
+// colorFromXYZWithWhite builds a Color from XYZ coordinates relative to the white point,
+// adapted to D65 with the Bradford transform.
+func colorFromXYZWithWhite(x, y, z, alpha float64, white Chromaticity) Color {
+	if white != WhiteD65 {
+		m := ChromaticAdaptationMatrix(white, WhiteD65)
+		x, y, z = m.Apply(x, y, z)
+	}
+	return ColorFromXYZ(x, y, z, alpha)
+}
+
+// xyzWithWhite returns the XYZ coordinates of c adapted to the white point with the Bradford transform.
+func (c Color) xyzWithWhite(white Chromaticity) (x, y, z float64) {
+	if white == WhiteD65 {
+		return c.x, c.y, c.z
+	}
+	m := ChromaticAdaptationMatrix(WhiteD65, white)
+	return m.Apply(c.x, c.y, c.z)
+}
+
+// labToXYZ returns the XYZ coordinates of CIELAB components relative to the white (xn, yn, zn).
+func labToXYZ(l, a, b, xn, yn, zn float64) (x, y, z float64) {
+	fy := (l + 16) / 116
+	fx := a/500 + fy
+	fz := fy - b/200
+
+	x = labFInv(fx) * xn
+	if l > labKappa*labEpsilon {
+		y = fy * fy * fy
+	} else {
+		y = l / labKappa
+	}
+	y *= yn
+	z = labFInv(fz) * zn
+	return
+}
+
+// xyzToLab returns the CIELAB components of XYZ coordinates relative to the white (xn, yn, zn).
+func xyzToLab(x, y, z, xn, yn, zn float64) (l, a, b float64) {
+	fx := labF(x / xn)
+	fy := labF(y / yn)
+	fz := labF(z / zn)
+	l = 116*fy - 16
+	a = 500 * (fx - fy)
+	b = 200 * (fy - fz)
+	return
+}
+
+// ColorFromLch builds a Color from CIE LCh components (h in radians) and alpha.
+// See [ColorFromLab] for the white point.
+// A NaN hue is treated as a missing hue, i.e. 0, as CSS does.
+func ColorFromLch(l, c, h, alpha float64) Color {
+	if math.IsNaN(h) {
+		h = 0
+	}
+	a := math.Cos(h) * c
+	b := math.Sin(h) * c
+	return ColorFromLab(l, a, b, alpha)
+}
+
+// ColorFromLchDeg builds a Color from CIE LCh components (h in degrees) and alpha.
+// See [ColorFromLab] for the white point.
+func ColorFromLchDeg(l, c, h, alpha float64) Color {
+	return ColorFromLch(l, c, AngleUnitDegree.ToRadians(h), alpha)
+}
+
+// Lch converts Color to CIE LCh components (h in radians) and alpha.
+// See [Color.Lab] for the white point.
+// The hue of an achromatic color is meaningless. See [Color.HuePowerless].
+func (c Color) Lch() (l, ch, h, alpha float64) {
+	l, a, b, alpha := c.Lab()
+	ch = math.Hypot(a, b)
+	h = math.Atan2(b, a)
+	return
+}
+
+// LchDeg converts Color to CIE LCh components (h in degrees in [0, 360)) and alpha.
+// See [Color.Lab] for the white point.
+func (c Color) LchDeg() (l, ch, h, alpha float64) {
+	l, ch, h, alpha = c.Lch()
+	h = NormalizeHueDeg(AngleUnitDegree.FromRadians(h))
+	return
+}
+
+func labF(t float64) float64 {
+	if t > labEpsilon {
+		return cbrt(t)
+	}
+	return (labKappa*t + 16) / 116
+}
+
+func labFInv(f float64) float64 {
+	if f3 := f * f * f; f3 > labEpsilon {
+		return f3
+	}
+	return (116*f - 16) / labKappa
+}
@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestLabRoundTrip(t *testing.T) {
+	for _, lab := range [][3]float64{{50, 20, -30}, {3, 1, -2}, {95, -10, 40}} {
+		c := iro.ColorFromLab(lab[0], lab[1], lab[2], 0.7)
+		l, a, b, alpha := c.Lab()
+		if diff, ok := check(l, lab[0]); !ok {
+			t.Errorf("l: got %f, want %f (diff=%g)", l, lab[0], diff)
+		}
+		if diff, ok := check(a, lab[1]); !ok {
+			t.Errorf("a: got %f, want %f (diff=%g)", a, lab[1], diff)
+		}
+		if diff, ok := check(b, lab[2]); !ok {
+			t.Errorf("b: got %f, want %f (diff=%g)", b, lab[2], diff)
+		}
+		if diff, ok := check(alpha, 0.7); !ok {
+			t.Errorf("alpha: got %f, want %f (diff=%g)", alpha, 0.7, diff)
+		}
+	}
+}
+
+func TestLab(t *testing.T) {
+	testCases := []struct {
+		name  string
+		color iro.Color
+		want  [3]float64
+	}{
+		{
+			name:  "White",
+			color: iro.ColorFromSRGB(1, 1, 1, 1),
+			want:  [3]float64{100, 0, 0},
+		},
+		{
+			name:  "Black",
+			color: iro.ColorFromSRGB(0, 0, 0, 1),
+			want:  [3]float64{0, 0, 0},
+		},
+		{
+			// https://www.w3.org/TR/css-color-4/#specifying-lab-lch
+			name:  "Red",
+			color: iro.ColorFromSRGB(1, 0, 0, 1),
+			want:  [3]float64{54.29, 80.80, 69.89},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			l, a, b, _ := tc.color.Lab()
+			const tol = 0.01
+			if d := l - tc.want[0]; d > tol || d < -tol {
+				t.Errorf("l: got %f, want %f", l, tc.want[0])
+			}
+			if d := a - tc.want[1]; d > tol || d < -tol {
+				t.Errorf("a: got %f, want %f", a, tc.want[1])
+			}
+			if d := b - tc.want[2]; d > tol || d < -tol {
+				t.Errorf("b: got %f, want %f", b, tc.want[2])
+			}
+		})
+	}
+}
+
+func TestLchRoundTrip(t *testing.T) {
+	c := iro.ColorFromSRGB(0.2, 0.4, 0.6, 1)
+	r0, g0, b0, _ := c.SRGB()
+	l, ch, h, alpha := c.Lch()
+	r1, g1, b1, _ := iro.ColorFromLch(l, ch, h, alpha).SRGB()
+	if !checkTol(r1, r0) || !checkTol(g1, g0) || !checkTol(b1, b0) {
+		t.Errorf("got (%f, %f, %f), want (%f, %f, %f)", r1, g1, b1, r0, g0, b0)
+	}
+}
+
+func TestLchDeg(t *testing.T) {
+	c := iro.ColorFromLchDeg(50, 30, 270, 1)
+	l, ch, h, _ := c.Lch()
+	if !checkTol(l, 50) || !checkTol(ch, 30) || !checkTol(h, -math.Pi/2) {
+		t.Errorf("Lch: got (%f, %f, %f), want (50, 30, %f)", l, ch, h, -math.Pi/2)
+	}
+	l, ch, h, _ = c.LchDeg()
+	if !checkTol(l, 50) || !checkTol(ch, 30) || !checkTol(h, 270) {
+		t.Errorf("LchDeg: got (%f, %f, %f), want (50, 30, 270)", l, ch, h)
+	}
+}
+
+func TestLabWithWhite(t *testing.T) {
+	for _, white := range []iro.Chromaticity{iro.WhiteD65, iro.WhiteD50, iro.WhiteA, iro.WhiteC, iro.WhiteE} {
+		// The white of the illuminant is adapted to the white of D65.
+		c := iro.ColorFromLabWithWhite(100, 0, 0, 1, white)
+		x, y, z, _ := c.XYZ()
+		wx, wy, wz := iro.WhiteD65.XYZ(1)
+		if !checkTol(x, wx) || !checkTol(y, wy) || !checkTol(z, wz) {
+			t.Errorf("white %v: got XYZ (%f, %f, %f), want (%f, %f, %f)", white, x, y, z, wx, wy, wz)
+		}
+
+		for _, lab := range [][3]float64{{50, 20, -30}, {3, 1, -2}, {95, -10, 40}} {
+			c := iro.ColorFromLabWithWhite(lab[0], lab[1], lab[2], 0.7, white)
+			l, a, b, alpha := c.LabWithWhite(white)
+			if !checkTol(l, lab[0]) || !checkTol(a, lab[1]) || !checkTol(b, lab[2]) || !checkTol(alpha, 0.7) {
+				t.Errorf("white %v: got (%f, %f, %f, %f), want (%f, %f, %f, 0.7)", white, l, a, b, alpha, lab[0], lab[1], lab[2])
+			}
+		}
+	}
+
+	// With the D50 white, the result is the same as Lab.
+	red := iro.ColorFromSRGB(1, 0, 0, 1)
+	l0, a0, b0, _ := red.Lab()
+	l1, a1, b1, _ := red.LabWithWhite(iro.WhiteD50)
+	const tol = 1e-4
+	if math.Abs(l0-l1) > tol || math.Abs(a0-a1) > tol || math.Abs(b0-b1) > tol {
+		t.Errorf("LabWithWhite(WhiteD50): got (%f, %f, %f), want (%f, %f, %f)", l1, a1, b1, l0, a0, b0)
+	}
+
+	// The same color has different components relative to different whites.
+	l2, a2, b2, _ := red.LabWithWhite(iro.WhiteA)
+	if math.Abs(a0-a2) < 1 && math.Abs(b0-b2) < 1 {
+		t.Errorf("LabWithWhite(WhiteA): got (%f, %f, %f), want different from D50 (%f, %f, %f)", l2, a2, b2, l0, a0, b0)
+	}
+}
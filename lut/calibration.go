@@ -0,0 +1,332 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package lut
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"image"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/hajimehoshi/iro"
+)
+
+// NewLUT1D creates a 1D LUT with the given size by sampling f in [0, 1] for each channel.
+func NewLUT1D(size int, f func(r, g, b float64) (float64, float64, float64)) *LUT1D {
+	if size < 2 {
+		panic(fmt.Sprintf("lut: size must be at least 2 but %d", size))
+	}
+	l := &LUT1D{
+		DomainMax: [3]float64{1, 1, 1},
+		Table:     make([][3]float64, size),
+	}
+	d := float64(size - 1)
+	for i := range l.Table {
+		v := float64(i) / d
+		r, g, b := f(v, v, v)
+		l.Table[i] = [3]float64{r, g, b}
+	}
+	return l
+}
+
+// Inverse returns the inverse 1D LUT of l with the given size, e.g. to undo a calibration curve.
+// The domain of the result is the range of the table of each channel, and the range of the result is the domain of l.
+//
+// The entries of each channel must be strictly increasing. Inverse returns an error otherwise.
+func (l *LUT1D) Inverse(size int) (*LUT1D, error) {
+	if size < 2 {
+		panic(fmt.Sprintf("lut: size must be at least 2 but %d", size))
+	}
+	n := len(l.Table)
+	if n < 2 {
+		return nil, fmt.Errorf("lut: at least 2 entries are required but %d", n)
+	}
+	inv := &LUT1D{
+		Table: make([][3]float64, size),
+	}
+	for c := 0; c < 3; c++ {
+		for i := 1; i < n; i++ {
+			if !(l.Table[i][c] > l.Table[i-1][c]) {
+				return nil, fmt.Errorf("lut: the entries of the channel %d must be strictly increasing", c)
+			}
+		}
+		lo, hi := l.Table[0][c], l.Table[n-1][c]
+		inv.DomainMin[c], inv.DomainMax[c] = lo, hi
+		var j int
+		for i := 0; i < size; i++ {
+			v := lo + (hi-lo)*float64(i)/float64(size-1)
+			for j < n-2 && l.Table[j+1][c] < v {
+				j++
+			}
+			t := (v - l.Table[j][c]) / (l.Table[j+1][c] - l.Table[j][c])
+			t = min(max(t, 0), 1)
+			pos := (float64(j) + t) / float64(n-1)
+			inv.Table[i][c] = l.DomainMin[c] + (l.DomainMax[c]-l.DomainMin[c])*pos
+		}
+	}
+	return inv, nil
+}
+
+// ApplyToColor applies the 1D LUT to the nonlinear sRGB channels of clr.
+// The alpha value is kept.
+//
+// For example, applying the calibration curve of a display to colors models the values sent to the display.
+func (l *LUT1D) ApplyToColor(clr iro.Color) iro.Color {
+	r, g, b, a := clr.SRGB()
+	r, g, b = l.Apply(r, g, b)
+	return iro.ColorFromSRGB(r, g, b, a)
+}
+
+// ApplyToImage returns a new image by applying the 1D LUT to the nonlinear sRGB channels of each pixel of img.
+func (l *LUT1D) ApplyToImage(img image.Image) *image.NRGBA64 {
+	return iro.MapImage(img, l.ApplyToColor)
+}
+
+// ParseCal parses a calibration file in the .cal format of ArgyllCMS as a 1D LUT.
+//
+// The fields RGB_I, RGB_R, RGB_G, and RGB_B are used, where RGB_I is the input values evenly spaced in [0, 1].
+// The other keywords are ignored.
+func ParseCal(r io.Reader) (*LUT1D, error) {
+	s := bufio.NewScanner(r)
+	var lineNo int
+	var header bool
+	var format []string
+	var inFormat, inData bool
+	var rows [][]string
+	for s.Scan() {
+		lineNo++
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if !header {
+			if line != "CAL" {
+				return nil, fmt.Errorf("lut: line %d: invalid .cal header: %q", lineNo, line)
+			}
+			header = true
+			continue
+		}
+		fields := strings.Fields(line)
+		switch {
+		case inFormat:
+			if fields[0] == "END_DATA_FORMAT" {
+				inFormat = false
+				continue
+			}
+			format = append(format, fields...)
+		case inData:
+			if fields[0] == "END_DATA" {
+				inData = false
+				continue
+			}
+			rows = append(rows, fields)
+		case fields[0] == "BEGIN_DATA_FORMAT":
+			inFormat = true
+		case fields[0] == "BEGIN_DATA":
+			inData = true
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	if !header {
+		return nil, fmt.Errorf("lut: empty .cal file")
+	}
+
+	idx := map[string]int{}
+	for i, f := range format {
+		idx[f] = i
+	}
+	var cols [4]int
+	for i, name := range [...]string{"RGB_I", "RGB_R", "RGB_G", "RGB_B"} {
+		c, ok := idx[name]
+		if !ok {
+			return nil, fmt.Errorf("lut: the field %s is missing", name)
+		}
+		cols[i] = c
+	}
+	if len(rows) < 2 {
+		return nil, fmt.Errorf("lut: at least 2 entries are required but %d", len(rows))
+	}
+
+	l := &LUT1D{
+		DomainMax: [3]float64{1, 1, 1},
+		Table:     make([][3]float64, len(rows)),
+	}
+	for i, row := range rows {
+		if len(row) != len(format) {
+			return nil, fmt.Errorf("lut: entry %d: %d values are required but %d", i, len(format), len(row))
+		}
+		var v [4]float64
+		for j, c := range cols {
+			x, err := strconv.ParseFloat(row[c], 64)
+			if err != nil {
+				return nil, fmt.Errorf("lut: entry %d: %w", i, err)
+			}
+			v[j] = x
+		}
+		if want := float64(i) / float64(len(rows)-1); math.Abs(v[0]-want) > 1e-4 {
+			return nil, fmt.Errorf("lut: entry %d: RGB_I must be %f but %f", i, want, v[0])
+		}
+		l.Table[i] = [3]float64{v[1], v[2], v[3]}
+	}
+	return l, nil
+}
+
+// EncodeCal writes the 1D LUT in the .cal format of ArgyllCMS.
+// The values are written with 6 digits after the decimal point.
+//
+// The domain of l must be [0, 1] for all the channels.
+func EncodeCal(w io.Writer, l *LUT1D) error {
+	if l.DomainMin != [3]float64{0, 0, 0} || l.DomainMax != [3]float64{1, 1, 1} {
+		return fmt.Errorf("lut: the domain must be [0, 1] for the .cal format")
+	}
+	if len(l.Table) < 2 {
+		return fmt.Errorf("lut: at least 2 entries are required but %d", len(l.Table))
+	}
+	bw := bufio.NewWriter(w)
+	fmt.Fprint(bw, "CAL\n\n")
+	fmt.Fprint(bw, "DESCRIPTOR \"Device Calibration Curves\"\n")
+	fmt.Fprint(bw, "KEYWORD \"DEVICE_CLASS\"\nDEVICE_CLASS \"DISPLAY\"\n")
+	fmt.Fprint(bw, "KEYWORD \"COLOR_REP\"\nCOLOR_REP \"RGB\"\n\n")
+	fmt.Fprint(bw, "NUMBER_OF_FIELDS 4\nBEGIN_DATA_FORMAT\nRGB_I RGB_R RGB_G RGB_B\nEND_DATA_FORMAT\n\n")
+	fmt.Fprintf(bw, "NUMBER_OF_SETS %d\nBEGIN_DATA\n", len(l.Table))
+	for i, e := range l.Table {
+		fmt.Fprintf(bw, "%.6f %.6f %.6f %.6f\n", float64(i)/float64(len(l.Table)-1), e[0], e[1], e[2])
+	}
+	fmt.Fprint(bw, "END_DATA\n")
+	return bw.Flush()
+}
+
+// The types of the vcgt tag.
+const (
+	vcgtTypeTable   = 0
+	vcgtTypeFormula = 1
+)
+
+// vcgtFormulaSize is the size of the 1D LUT built from a formula-type vcgt tag.
+const vcgtFormulaSize = 1024
+
+// ParseVCGT parses the data of a video card gamma table (vcgt) tag of an ICC profile as a 1D LUT.
+// r must start with the tag signature "vcgt".
+//
+// Both the table type and the formula type are supported.
+// A table with one channel is used for all the channels.
+// A formula is sampled into a 1D LUT with 1024 entries.
+func ParseVCGT(r io.Reader) (*LUT1D, error) {
+	var header struct {
+		Signature [4]byte
+		Reserved  uint32
+		Type      uint32
+	}
+	if err := binary.Read(r, binary.BigEndian, &header); err != nil {
+		return nil, fmt.Errorf("lut: invalid vcgt header: %w", err)
+	}
+	if string(header.Signature[:]) != "vcgt" {
+		return nil, fmt.Errorf("lut: invalid vcgt signature: %q", header.Signature[:])
+	}
+
+	switch header.Type {
+	case vcgtTypeTable:
+		var t struct {
+			Channels   uint16
+			EntryCount uint16
+			EntrySize  uint16
+		}
+		if err := binary.Read(r, binary.BigEndian, &t); err != nil {
+			return nil, fmt.Errorf("lut: invalid vcgt table: %w", err)
+		}
+		if t.Channels != 1 && t.Channels != 3 {
+			return nil, fmt.Errorf("lut: the number of the vcgt channels must be 1 or 3 but %d", t.Channels)
+		}
+		if t.EntryCount < 2 {
+			return nil, fmt.Errorf("lut: at least 2 entries are required but %d", t.EntryCount)
+		}
+		if t.EntrySize != 1 && t.EntrySize != 2 {
+			return nil, fmt.Errorf("lut: the size of the vcgt entries must be 1 or 2 but %d", t.EntrySize)
+		}
+		data := make([]byte, int(t.Channels)*int(t.EntryCount)*int(t.EntrySize))
+		if _, err := io.ReadFull(r, data); err != nil {
+			return nil, fmt.Errorf("lut: invalid vcgt table: %w", err)
+		}
+		l := &LUT1D{
+			DomainMax: [3]float64{1, 1, 1},
+			Table:     make([][3]float64, t.EntryCount),
+		}
+		maxValue := float64(int(1)<<(8*t.EntrySize) - 1)
+		for c := 0; c < 3; c++ {
+			ch := c
+			if t.Channels == 1 {
+				ch = 0
+			}
+			for i := range l.Table {
+				k := ch*int(t.EntryCount) + i
+				var v float64
+				if t.EntrySize == 1 {
+					v = float64(data[k])
+				} else {
+					v = float64(binary.BigEndian.Uint16(data[2*k:]))
+				}
+				l.Table[i][c] = v / maxValue
+			}
+		}
+		return l, nil
+	case vcgtTypeFormula:
+		var f [3][3]int32
+		if err := binary.Read(r, binary.BigEndian, &f); err != nil {
+			return nil, fmt.Errorf("lut: invalid vcgt formula: %w", err)
+		}
+		var params [3][3]float64
+		for c := range f {
+			for i := range f[c] {
+				params[c][i] = float64(f[c][i]) / 65536
+			}
+			if !(params[c][0] > 0) {
+				return nil, fmt.Errorf("lut: the vcgt gamma must be positive but %f", params[c][0])
+			}
+		}
+		return NewLUT1D(vcgtFormulaSize, func(r, g, b float64) (float64, float64, float64) {
+			var out [3]float64
+			for c, v := range [...]float64{r, g, b} {
+				gamma, lo, hi := params[c][0], params[c][1], params[c][2]
+				out[c] = lo + (hi-lo)*math.Pow(v, gamma)
+			}
+			return out[0], out[1], out[2]
+		}), nil
+	default:
+		return nil, fmt.Errorf("lut: unsupported vcgt type: %d", header.Type)
+	}
+}
+
+// EncodeVCGT writes the 1D LUT as the data of a video card gamma table (vcgt) tag of the table type with 16-bit entries.
+// The values are clamped to [0, 1].
+//
+// The domain of l must be [0, 1] for all the channels, and the number of the entries must be at most 65535.
+func EncodeVCGT(w io.Writer, l *LUT1D) error {
+	if l.DomainMin != [3]float64{0, 0, 0} || l.DomainMax != [3]float64{1, 1, 1} {
+		return fmt.Errorf("lut: the domain must be [0, 1] for a vcgt tag")
+	}
+	if len(l.Table) < 2 || len(l.Table) > math.MaxUint16 {
+		return fmt.Errorf("lut: the number of the entries must be in [2, 65535] but %d", len(l.Table))
+	}
+	var b bytes.Buffer
+	b.WriteString("vcgt")
+	b.Write(binary.BigEndian.AppendUint32(nil, 0))
+	b.Write(binary.BigEndian.AppendUint32(nil, vcgtTypeTable))
+	b.Write(binary.BigEndian.AppendUint16(nil, 3))
+	b.Write(binary.BigEndian.AppendUint16(nil, uint16(len(l.Table))))
+	b.Write(binary.BigEndian.AppendUint16(nil, 2))
+	for c := 0; c < 3; c++ {
+		for _, e := range l.Table {
+			b.Write(binary.BigEndian.AppendUint16(nil, toUint16(e[c])))
+		}
+	}
+	_, err := w.Write(b.Bytes())
+	return err
+}
@@ -0,0 +1,194 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package lut_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+	"github.com/hajimehoshi/iro/lut"
+)
+
+const testCal = `CAL
+
+DESCRIPTOR "Argyll Device Calibration State"
+ORIGINATOR "Argyll dispcal"
+KEYWORD "DEVICE_CLASS"
+DEVICE_CLASS "DISPLAY"
+KEYWORD "COLOR_REP"
+COLOR_REP "RGB"
+
+NUMBER_OF_FIELDS 4
+BEGIN_DATA_FORMAT
+RGB_I RGB_R RGB_G RGB_B
+END_DATA_FORMAT
+
+NUMBER_OF_SETS 3
+BEGIN_DATA
+0.00000 0.00000 0.01000 0.00000
+0.50000 0.45000 0.50000 0.55000
+1.00000 0.95000 1.00000 1.00000
+END_DATA
+`
+
+func TestParseCal(t *testing.T) {
+	l, err := lut.ParseCal(strings.NewReader(testCal))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := [][3]float64{{0, 0.01, 0}, {0.45, 0.5, 0.55}, {0.95, 1, 1}}
+	if len(l.Table) != len(want) {
+		t.Fatalf("len: got %d, want %d", len(l.Table), len(want))
+	}
+	for i := range want {
+		if l.Table[i] != want[i] {
+			t.Errorf("entry %d: got %v, want %v", i, l.Table[i], want[i])
+		}
+	}
+	r, g, b := l.Apply(0.25, 0.25, 0.25)
+	if math.Abs(r-0.225) > tol || math.Abs(g-0.255) > tol || math.Abs(b-0.275) > tol {
+		t.Errorf("Apply: got (%f, %f, %f), want (0.225, 0.255, 0.275)", r, g, b)
+	}
+
+	// Round trip.
+	var buf bytes.Buffer
+	if err := lut.EncodeCal(&buf, l); err != nil {
+		t.Fatal(err)
+	}
+	l2, err := lut.ParseCal(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range want {
+		if l2.Table[i] != want[i] {
+			t.Errorf("round trip: entry %d: got %v, want %v", i, l2.Table[i], want[i])
+		}
+	}
+}
+
+func TestParseCalErrors(t *testing.T) {
+	for _, src := range []string{
+		"",
+		"CGATS.17\n",
+		"CAL\nBEGIN_DATA_FORMAT\nRGB_I RGB_R RGB_G\nEND_DATA_FORMAT\nBEGIN_DATA\n0 0 0\n1 1 1\nEND_DATA\n",
+		"CAL\nBEGIN_DATA_FORMAT\nRGB_I RGB_R RGB_G RGB_B\nEND_DATA_FORMAT\nBEGIN_DATA\n0 0 0 0\n0.3 1 1 1\nEND_DATA\n",
+		"CAL\nBEGIN_DATA_FORMAT\nRGB_I RGB_R RGB_G RGB_B\nEND_DATA_FORMAT\nBEGIN_DATA\n0 0 0 0\n1 1 x 1\nEND_DATA\n",
+	} {
+		if _, err := lut.ParseCal(strings.NewReader(src)); err == nil {
+			t.Errorf("%q: got nil error", src)
+		}
+	}
+}
+
+func TestVCGT(t *testing.T) {
+	l := lut.NewLUT1D(4, func(r, g, b float64) (float64, float64, float64) {
+		return r * 0.9, math.Sqrt(g), b
+	})
+	var buf bytes.Buffer
+	if err := lut.EncodeVCGT(&buf, l); err != nil {
+		t.Fatal(err)
+	}
+	got, err := lut.ParseVCGT(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range l.Table {
+		for c := 0; c < 3; c++ {
+			if math.Abs(got.Table[i][c]-l.Table[i][c]) > 1.0/0xffff {
+				t.Errorf("entry %d channel %d: got %f, want %f", i, c, got.Table[i][c], l.Table[i][c])
+			}
+		}
+	}
+}
+
+func TestParseVCGTSingleChannel(t *testing.T) {
+	b := []byte("vcgt")
+	b = binary.BigEndian.AppendUint32(b, 0)
+	b = binary.BigEndian.AppendUint32(b, 0)
+	b = binary.BigEndian.AppendUint16(b, 1)
+	b = binary.BigEndian.AppendUint16(b, 2)
+	b = binary.BigEndian.AppendUint16(b, 1)
+	b = append(b, 0x10, 0xf0)
+	l, err := lut.ParseVCGT(bytes.NewReader(b))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := [][3]float64{{16.0 / 255, 16.0 / 255, 16.0 / 255}, {240.0 / 255, 240.0 / 255, 240.0 / 255}}
+	for i := range want {
+		if l.Table[i] != want[i] {
+			t.Errorf("entry %d: got %v, want %v", i, l.Table[i], want[i])
+		}
+	}
+}
+
+func TestParseVCGTFormula(t *testing.T) {
+	b := []byte("vcgt")
+	b = binary.BigEndian.AppendUint32(b, 0)
+	b = binary.BigEndian.AppendUint32(b, 1)
+	for c := 0; c < 3; c++ {
+		// gamma 2, min 0.1, max 0.9
+		b = binary.BigEndian.AppendUint32(b, 2<<16)
+		b = binary.BigEndian.AppendUint32(b, 6554)
+		b = binary.BigEndian.AppendUint32(b, 58982)
+	}
+	l, err := lut.ParseVCGT(bytes.NewReader(b))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, _, _ := l.Apply(0.5, 0.5, 0.5)
+	if want := 0.1 + 0.8*0.25; math.Abs(r-want) > 1e-4 {
+		t.Errorf("got %f, want %f", r, want)
+	}
+}
+
+func TestParseVCGTErrors(t *testing.T) {
+	for _, b := range [][]byte{
+		nil,
+		[]byte("mluc\x00\x00\x00\x00\x00\x00\x00\x00"),
+		[]byte("vcgt\x00\x00\x00\x00\x00\x00\x00\x02"),
+		[]byte("vcgt\x00\x00\x00\x00\x00\x00\x00\x00\x00\x02\x00\x02\x00\x01\x00\x00"),
+	} {
+		if _, err := lut.ParseVCGT(bytes.NewReader(b)); err == nil {
+			t.Errorf("%q: got nil error", b)
+		}
+	}
+}
+
+func TestLUT1DInverse(t *testing.T) {
+	l := lut.NewLUT1D(256, func(r, g, b float64) (float64, float64, float64) {
+		return math.Pow(r, 2.2), 0.1 + 0.8*g, math.Sqrt(b)
+	})
+	inv, err := l.Inverse(256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i <= 10; i++ {
+		v := float64(i) / 10
+		r, g, b := l.Apply(v, v, v)
+		r, g, b = inv.Apply(r, g, b)
+		if math.Abs(r-v) > 2e-3 || math.Abs(g-v) > 2e-3 || math.Abs(b-v) > 2e-3 {
+			t.Errorf("%f: got (%f, %f, %f)", v, r, g, b)
+		}
+	}
+
+	flat := &lut.LUT1D{DomainMax: [3]float64{1, 1, 1}, Table: [][3]float64{{0, 0, 0}, {0, 1, 1}}}
+	if _, err := flat.Inverse(16); err == nil {
+		t.Errorf("non-increasing: got nil error")
+	}
+}
+
+func TestLUT1DApplyToColor(t *testing.T) {
+	l := lut.NewLUT1D(2, func(r, g, b float64) (float64, float64, float64) {
+		return 0.5 * r, g, b
+	})
+	got := l.ApplyToColor(iro.ColorFromSRGB(1, 0.5, 0.25, 0.5))
+	r, g, b, a := got.SRGB()
+	if math.Abs(r-0.5) > tol || math.Abs(g-0.5) > tol || math.Abs(b-0.25) > tol || math.Abs(a-0.5) > tol {
+		t.Errorf("got (%f, %f, %f, %f), want (0.5, 0.5, 0.25, 0.5)", r, g, b, a)
+	}
+}
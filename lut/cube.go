@@ -0,0 +1,422 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+// Package lut provides lookup tables (LUTs) for color grading.
+package lut
+
+import (
+	"bufio"
+	"fmt"
+	"image"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/hajimehoshi/iro"
+)
+
+// Interpolation specifies how a 3D LUT is interpolated between its entries.
+type Interpolation int
+
+const (
+	// InterpolationTrilinear interpolates the 8 surrounding entries.
+	InterpolationTrilinear Interpolation = iota
+
+	// InterpolationTetrahedral interpolates the 4 entries of the tetrahedron including the input.
+	// This is usually more accurate than trilinear interpolation for neutral colors.
+	InterpolationTetrahedral
+)
+
+// LUT1D is a per-channel 1D LUT.
+type LUT1D struct {
+	// DomainMin and DomainMax are the input values mapped to the first and the last entries.
+	DomainMin [3]float64
+	DomainMax [3]float64
+
+	// Table is the entries of the LUT.
+	Table [][3]float64
+}
+
+// LUT3D is a 3D LUT.
+type LUT3D struct {
+	// DomainMin and DomainMax are the input values mapped to the first and the last entries.
+	DomainMin [3]float64
+	DomainMax [3]float64
+
+	// Size is the number of entries in each dimension.
+	Size int
+
+	// Table is the entries of the LUT, where red changes fastest and blue changes slowest.
+	// The length is Size^3.
+	Table [][3]float64
+}
+
+// Cube represents a LUT in the .cube format, used by Adobe and DaVinci Resolve.
+//
+// A Cube can have a 1D LUT, a 3D LUT, or both.
+// If it has both, the 1D LUT is applied first as a shaper, as DaVinci Resolve does.
+type Cube struct {
+	// Title is the title of the LUT.
+	Title string
+
+	// LUT1D is the 1D LUT. LUT1D is nil if there is no 1D LUT.
+	LUT1D *LUT1D
+
+	// LUT3D is the 3D LUT. LUT3D is nil if there is no 3D LUT.
+	LUT3D *LUT3D
+}
+
+// ParseCube parses a .cube file.
+//
+// Both the Adobe keywords (DOMAIN_MIN and DOMAIN_MAX) and the DaVinci Resolve keywords
+// (LUT_1D_INPUT_RANGE and LUT_3D_INPUT_RANGE) are supported.
+func ParseCube(r io.Reader) (*Cube, error) {
+	var cube Cube
+	var size1D, size3D int
+	domainMin := [3]float64{0, 0, 0}
+	domainMax := [3]float64{1, 1, 1}
+	var range1D, range3D *[2]float64
+	var entries [][3]float64
+
+	s := bufio.NewScanner(r)
+	var lineNo int
+	for s.Scan() {
+		lineNo++
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		switch fields[0] {
+		case "TITLE":
+			title := strings.TrimSpace(strings.TrimPrefix(line, "TITLE"))
+			if len(title) < 2 || title[0] != '"' || title[len(title)-1] != '"' {
+				return nil, fmt.Errorf("lut: line %d: invalid TITLE: %q", lineNo, line)
+			}
+			cube.Title = title[1 : len(title)-1]
+		case "LUT_1D_SIZE", "LUT_3D_SIZE":
+			if len(fields) != 2 {
+				return nil, fmt.Errorf("lut: line %d: invalid %s: %q", lineNo, fields[0], line)
+			}
+			n, err := strconv.Atoi(fields[1])
+			if err != nil || n < 2 {
+				return nil, fmt.Errorf("lut: line %d: invalid %s: %q", lineNo, fields[0], line)
+			}
+			if fields[0] == "LUT_1D_SIZE" {
+				size1D = n
+			} else {
+				size3D = n
+			}
+		case "DOMAIN_MIN", "DOMAIN_MAX":
+			v, err := parseTriplet(fields[1:])
+			if err != nil {
+				return nil, fmt.Errorf("lut: line %d: invalid %s: %w", lineNo, fields[0], err)
+			}
+			if fields[0] == "DOMAIN_MIN" {
+				domainMin = v
+			} else {
+				domainMax = v
+			}
+		case "LUT_1D_INPUT_RANGE", "LUT_3D_INPUT_RANGE":
+			if len(fields) != 3 {
+				return nil, fmt.Errorf("lut: line %d: invalid %s: %q", lineNo, fields[0], line)
+			}
+			min, err := strconv.ParseFloat(fields[1], 64)
+			if err != nil {
+				return nil, fmt.Errorf("lut: line %d: invalid %s: %w", lineNo, fields[0], err)
+			}
+			max, err := strconv.ParseFloat(fields[2], 64)
+			if err != nil {
+				return nil, fmt.Errorf("lut: line %d: invalid %s: %w", lineNo, fields[0], err)
+			}
+			if fields[0] == "LUT_1D_INPUT_RANGE" {
+				range1D = &[2]float64{min, max}
+			} else {
+				range3D = &[2]float64{min, max}
+			}
+		default:
+			v, err := parseTriplet(fields)
+			if err != nil {
+				return nil, fmt.Errorf("lut: line %d: unknown keyword or invalid entry: %q", lineNo, line)
+			}
+			entries = append(entries, v)
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+
+	if size1D == 0 && size3D == 0 {
+		return nil, fmt.Errorf("lut: neither LUT_1D_SIZE nor LUT_3D_SIZE is specified")
+	}
+	if want := size1D + size3D*size3D*size3D; len(entries) != want {
+		return nil, fmt.Errorf("lut: the number of entries must be %d but %d", want, len(entries))
+	}
+	for i := range domainMin {
+		if domainMin[i] >= domainMax[i] {
+			return nil, fmt.Errorf("lut: DOMAIN_MIN must be less than DOMAIN_MAX")
+		}
+	}
+
+	if size1D > 0 {
+		l := &LUT1D{
+			DomainMin: domainMin,
+			DomainMax: domainMax,
+			Table:     entries[:size1D],
+		}
+		if range1D != nil {
+			l.DomainMin = [3]float64{range1D[0], range1D[0], range1D[0]}
+			l.DomainMax = [3]float64{range1D[1], range1D[1], range1D[1]}
+		}
+		cube.LUT1D = l
+	}
+	if size3D > 0 {
+		l := &LUT3D{
+			DomainMin: domainMin,
+			DomainMax: domainMax,
+			Size:      size3D,
+			Table:     entries[size1D:],
+		}
+		if range3D != nil {
+			l.DomainMin = [3]float64{range3D[0], range3D[0], range3D[0]}
+			l.DomainMax = [3]float64{range3D[1], range3D[1], range3D[1]}
+		}
+		cube.LUT3D = l
+	}
+	return &cube, nil
+}
+
+func parseTriplet(fields []string) ([3]float64, error) {
+	if len(fields) != 3 {
+		return [3]float64{}, fmt.Errorf("lut: 3 values are required but %d", len(fields))
+	}
+	var v [3]float64
+	for i, f := range fields {
+		x, err := strconv.ParseFloat(f, 64)
+		if err != nil {
+			return [3]float64{}, err
+		}
+		v[i] = x
+	}
+	return v, nil
+}
+
+// normalize maps v in [min, max] to [0, n-1] with clamping.
+func normalize(v, min, max float64, n int) float64 {
+	t := (v - min) / (max - min)
+	if !(t > 0) {
+		return 0
+	}
+	if t > 1 {
+		t = 1
+	}
+	return t * float64(n-1)
+}
+
+func split(pos float64, n int) (i int, t float64) {
+	f := math.Floor(pos)
+	i = int(f)
+	if i >= n-1 {
+		return n - 2, 1
+	}
+	return i, pos - f
+}
+
+// Apply applies the 1D LUT to the channels with linear interpolation.
+// Channels outside the domain are clamped.
+func (l *LUT1D) Apply(r, g, b float64) (float64, float64, float64) {
+	in := [3]float64{r, g, b}
+	var out [3]float64
+	n := len(l.Table)
+	for c := range in {
+		i, t := split(normalize(in[c], l.DomainMin[c], l.DomainMax[c], n), n)
+		out[c] = l.Table[i][c] + (l.Table[i+1][c]-l.Table[i][c])*t
+	}
+	return out[0], out[1], out[2]
+}
+
+// Apply applies the 3D LUT to the channels with the given interpolation.
+// Channels outside the domain are clamped.
+func (l *LUT3D) Apply(r, g, b float64, interpolation Interpolation) (float64, float64, float64) {
+	n := l.Size
+	ri, rt := split(normalize(r, l.DomainMin[0], l.DomainMax[0], n), n)
+	gi, gt := split(normalize(g, l.DomainMin[1], l.DomainMax[1], n), n)
+	bi, bt := split(normalize(b, l.DomainMin[2], l.DomainMax[2], n), n)
+
+	at := func(dr, dg, db int) [3]float64 {
+		return l.Table[(bi+db)*n*n+(gi+dg)*n+(ri+dr)]
+	}
+
+	var out [3]float64
+	switch interpolation {
+	case InterpolationTrilinear:
+		c000, c100, c010, c110 := at(0, 0, 0), at(1, 0, 0), at(0, 1, 0), at(1, 1, 0)
+		c001, c101, c011, c111 := at(0, 0, 1), at(1, 0, 1), at(0, 1, 1), at(1, 1, 1)
+		for c := range out {
+			c00 := c000[c] + (c100[c]-c000[c])*rt
+			c10 := c010[c] + (c110[c]-c010[c])*rt
+			c01 := c001[c] + (c101[c]-c001[c])*rt
+			c11 := c011[c] + (c111[c]-c011[c])*rt
+			c0 := c00 + (c10-c00)*gt
+			c1 := c01 + (c11-c01)*gt
+			out[c] = c0 + (c1-c0)*bt
+		}
+	case InterpolationTetrahedral:
+		c000, c111 := at(0, 0, 0), at(1, 1, 1)
+		// Pick the tetrahedron by the order of the fractions.
+		var c1, c2 [3]float64
+		var t0, t1, t2 float64
+		switch {
+		case rt > gt && gt > bt:
+			c1, c2 = at(1, 0, 0), at(1, 1, 0)
+			t0, t1, t2 = rt, gt, bt
+		case rt > gt && rt > bt:
+			c1, c2 = at(1, 0, 0), at(1, 0, 1)
+			t0, t1, t2 = rt, bt, gt
+		case rt > gt:
+			c1, c2 = at(0, 0, 1), at(1, 0, 1)
+			t0, t1, t2 = bt, rt, gt
+		case bt > gt:
+			c1, c2 = at(0, 0, 1), at(0, 1, 1)
+			t0, t1, t2 = bt, gt, rt
+		case bt > rt:
+			c1, c2 = at(0, 1, 0), at(0, 1, 1)
+			t0, t1, t2 = gt, bt, rt
+		default:
+			c1, c2 = at(0, 1, 0), at(1, 1, 0)
+			t0, t1, t2 = gt, rt, bt
+		}
+		for c := range out {
+			out[c] = c000[c] + (c1[c]-c000[c])*t0 + (c2[c]-c1[c])*t1 + (c111[c]-c2[c])*t2
+		}
+	default:
+		panic(fmt.Sprintf("lut: invalid Interpolation: %d", interpolation))
+	}
+	return out[0], out[1], out[2]
+}
+
+// Apply applies the LUT to the channels.
+// The 1D LUT is applied first if exists, and then the 3D LUT with the given interpolation is applied if exists.
+func (c *Cube) Apply(r, g, b float64, interpolation Interpolation) (float64, float64, float64) {
+	if c.LUT1D != nil {
+		r, g, b = c.LUT1D.Apply(r, g, b)
+	}
+	if c.LUT3D != nil {
+		r, g, b = c.LUT3D.Apply(r, g, b, interpolation)
+	}
+	return r, g, b
+}
+
+// ApplyToColor applies the LUT to the nonlinear sRGB channels of clr.
+// The alpha value is kept.
+func (c *Cube) ApplyToColor(clr iro.Color, interpolation Interpolation) iro.Color {
+	r, g, b, a := clr.SRGB()
+	r, g, b = c.Apply(r, g, b, interpolation)
+	return iro.ColorFromSRGB(r, g, b, a)
+}
+
+// ApplyToImage returns a new image by applying the LUT to the nonlinear sRGB channels of each pixel of img.
+func (c *Cube) ApplyToImage(img image.Image, interpolation Interpolation) *image.NRGBA64 {
+	return iro.MapImage(img, func(clr iro.Color) iro.Color {
+		return c.ApplyToColor(clr, interpolation)
+	})
+}
+
+// NewLUT3D creates a 3D LUT with the given size by sampling f in [0, 1]^3.
+func NewLUT3D(size int, f func(r, g, b float64) (float64, float64, float64)) *LUT3D {
+	if size < 2 {
+		panic(fmt.Sprintf("lut: size must be at least 2 but %d", size))
+	}
+	l := &LUT3D{
+		DomainMax: [3]float64{1, 1, 1},
+		Size:      size,
+		Table:     make([][3]float64, 0, size*size*size),
+	}
+	d := float64(size - 1)
+	for b := 0; b < size; b++ {
+		for g := 0; g < size; g++ {
+			for r := 0; r < size; r++ {
+				rr, gg, bb := f(float64(r)/d, float64(g)/d, float64(b)/d)
+				l.Table = append(l.Table, [3]float64{rr, gg, bb})
+			}
+		}
+	}
+	return l
+}
+
+// NewLUT3DFromColorFunc creates a 3D LUT with the given size by baking f.
+// The input and output channels of the LUT are nonlinear sRGB, in the same way as [Cube.ApplyToColor].
+func NewLUT3DFromColorFunc(size int, f func(c iro.Color) iro.Color) *LUT3D {
+	return NewLUT3D(size, func(r, g, b float64) (float64, float64, float64) {
+		r, g, b, _ = f(iro.ColorFromSRGB(r, g, b, 1)).SRGB()
+		return r, g, b
+	})
+}
+
+// EncodeCube writes c in the .cube format.
+//
+// If c has both a 1D LUT and a 3D LUT, the domains are written with the DaVinci Resolve keywords
+// (LUT_1D_INPUT_RANGE and LUT_3D_INPUT_RANGE), and the domain of each LUT must be the same for all the channels.
+// Otherwise, the domain is written with the Adobe keywords (DOMAIN_MIN and DOMAIN_MAX).
+//
+// The values are written with 6 digits after the decimal point.
+func EncodeCube(w io.Writer, c *Cube) error {
+	if c.LUT1D == nil && c.LUT3D == nil {
+		return fmt.Errorf("lut: the cube must have a 1D LUT or a 3D LUT")
+	}
+	if c.LUT3D != nil && len(c.LUT3D.Table) != c.LUT3D.Size*c.LUT3D.Size*c.LUT3D.Size {
+		return fmt.Errorf("lut: the number of the 3D LUT entries must be %d but %d", c.LUT3D.Size*c.LUT3D.Size*c.LUT3D.Size, len(c.LUT3D.Table))
+	}
+
+	bw := bufio.NewWriter(w)
+	if c.Title != "" {
+		if strings.ContainsAny(c.Title, "\"\n") {
+			return fmt.Errorf("lut: the title must not include a double quote or a newline: %q", c.Title)
+		}
+		fmt.Fprintf(bw, "TITLE \"%s\"\n", c.Title)
+	}
+
+	if c.LUT1D != nil && c.LUT3D != nil {
+		for _, d := range []struct {
+			keyword string
+			min     [3]float64
+			max     [3]float64
+			size    string
+		}{
+			{"LUT_1D", c.LUT1D.DomainMin, c.LUT1D.DomainMax, strconv.Itoa(len(c.LUT1D.Table))},
+			{"LUT_3D", c.LUT3D.DomainMin, c.LUT3D.DomainMax, strconv.Itoa(c.LUT3D.Size)},
+		} {
+			if d.min[0] != d.min[1] || d.min[0] != d.min[2] || d.max[0] != d.max[1] || d.max[0] != d.max[2] {
+				return fmt.Errorf("lut: the domain of %s must be the same for all the channels", d.keyword)
+			}
+			fmt.Fprintf(bw, "%s_SIZE %s\n", d.keyword, d.size)
+			fmt.Fprintf(bw, "%s_INPUT_RANGE %.6f %.6f\n", d.keyword, d.min[0], d.max[0])
+		}
+	} else {
+		var min, max [3]float64
+		if c.LUT1D != nil {
+			fmt.Fprintf(bw, "LUT_1D_SIZE %d\n", len(c.LUT1D.Table))
+			min, max = c.LUT1D.DomainMin, c.LUT1D.DomainMax
+		} else {
+			fmt.Fprintf(bw, "LUT_3D_SIZE %d\n", c.LUT3D.Size)
+			min, max = c.LUT3D.DomainMin, c.LUT3D.DomainMax
+		}
+		if min != [3]float64{0, 0, 0} || max != [3]float64{1, 1, 1} {
+			fmt.Fprintf(bw, "DOMAIN_MIN %.6f %.6f %.6f\n", min[0], min[1], min[2])
+			fmt.Fprintf(bw, "DOMAIN_MAX %.6f %.6f %.6f\n", max[0], max[1], max[2])
+		}
+	}
+
+	var entries [][3]float64
+	if c.LUT1D != nil {
+		entries = append(entries, c.LUT1D.Table...)
+	}
+	if c.LUT3D != nil {
+		entries = append(entries, c.LUT3D.Table...)
+	}
+	for _, e := range entries {
+		fmt.Fprintf(bw, "%.6f %.6f %.6f\n", e[0], e[1], e[2])
+	}
+	return bw.Flush()
+}
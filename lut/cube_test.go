@@ -0,0 +1,252 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package lut_test
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+	"github.com/hajimehoshi/iro/lut"
+)
+
+const tol = 1e-6
+
+// swapCube returns a 3D .cube file that maps (r, g, b) to (g, b, r).
+func swapCube(size int) string {
+	var sb strings.Builder
+	sb.WriteString("# Swap channels\n")
+	sb.WriteString("TITLE \"Swap\"\n")
+	fmt.Fprintf(&sb, "LUT_3D_SIZE %d\n\n", size)
+	for b := 0; b < size; b++ {
+		for g := 0; g < size; g++ {
+			for r := 0; r < size; r++ {
+				d := float64(size - 1)
+				fmt.Fprintf(&sb, "%f %f %f\n", float64(g)/d, float64(b)/d, float64(r)/d)
+			}
+		}
+	}
+	return sb.String()
+}
+
+func TestParseCube3D(t *testing.T) {
+	cube, err := lut.ParseCube(strings.NewReader(swapCube(5)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := cube.Title, "Swap"; got != want {
+		t.Errorf("Title: got %q, want %q", got, want)
+	}
+	if cube.LUT1D != nil {
+		t.Errorf("LUT1D: got %v, want nil", cube.LUT1D)
+	}
+	if cube.LUT3D == nil {
+		t.Fatalf("LUT3D: got nil")
+	}
+	if got, want := cube.LUT3D.Size, 5; got != want {
+		t.Errorf("Size: got %d, want %d", got, want)
+	}
+
+	for _, interp := range []lut.Interpolation{lut.InterpolationTrilinear, lut.InterpolationTetrahedral} {
+		for _, in := range [][3]float64{{0, 0, 0}, {1, 1, 1}, {0.1, 0.7, 0.35}, {0.9, 0.2, 0.55}, {0.3, 0.3, 0.8}} {
+			r, g, b := cube.Apply(in[0], in[1], in[2], interp)
+			if math.Abs(r-in[1]) > tol || math.Abs(g-in[2]) > tol || math.Abs(b-in[0]) > tol {
+				t.Errorf("Apply(%v, interpolation=%d): got (%f, %f, %f), want (%f, %f, %f)", in, interp, r, g, b, in[1], in[2], in[0])
+			}
+		}
+	}
+}
+
+func TestParseCube1D(t *testing.T) {
+	const src = `LUT_1D_SIZE 3
+DOMAIN_MIN 0 0 0
+DOMAIN_MAX 2 2 2
+0 0 0
+0.25 0.5 0.75
+1 1 1
+`
+	cube, err := lut.ParseCube(strings.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cube.LUT3D != nil {
+		t.Errorf("LUT3D: got %v, want nil", cube.LUT3D)
+	}
+
+	testCases := []struct {
+		in   float64
+		want [3]float64
+	}{
+		{in: 0, want: [3]float64{0, 0, 0}},
+		{in: 1, want: [3]float64{0.25, 0.5, 0.75}},
+		{in: 1.5, want: [3]float64{0.625, 0.75, 0.875}},
+		{in: 3, want: [3]float64{1, 1, 1}},
+		{in: -1, want: [3]float64{0, 0, 0}},
+	}
+	for _, tc := range testCases {
+		r, g, b := cube.Apply(tc.in, tc.in, tc.in, lut.InterpolationTrilinear)
+		if math.Abs(r-tc.want[0]) > tol || math.Abs(g-tc.want[1]) > tol || math.Abs(b-tc.want[2]) > tol {
+			t.Errorf("Apply(%f): got (%f, %f, %f), want %v", tc.in, r, g, b, tc.want)
+		}
+	}
+}
+
+func TestParseCubeShaper(t *testing.T) {
+	// A Resolve-style LUT with a 1D shaper that maps [0, 4] to [0, 1] and an identity 3D LUT.
+	const src = `LUT_1D_SIZE 2
+LUT_1D_INPUT_RANGE 0 4
+LUT_3D_SIZE 2
+LUT_3D_INPUT_RANGE 0 1
+0 0 0
+1 1 1
+0 0 0
+1 0 0
+0 1 0
+1 1 0
+0 0 1
+1 0 1
+0 1 1
+1 1 1
+`
+	cube, err := lut.ParseCube(strings.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, g, b := cube.Apply(1, 2, 3, lut.InterpolationTetrahedral)
+	if math.Abs(r-0.25) > tol || math.Abs(g-0.5) > tol || math.Abs(b-0.75) > tol {
+		t.Errorf("Apply: got (%f, %f, %f), want (0.25, 0.5, 0.75)", r, g, b)
+	}
+}
+
+func TestParseCubeErrors(t *testing.T) {
+	testCases := []struct {
+		name string
+		src  string
+	}{
+		{
+			name: "NoSize",
+			src:  "0 0 0\n1 1 1\n",
+		},
+		{
+			name: "TooFewEntries",
+			src:  "LUT_1D_SIZE 3\n0 0 0\n1 1 1\n",
+		},
+		{
+			name: "UnknownKeyword",
+			src:  "LUT_1D_SIZE 2\nFOO 1\n0 0 0\n1 1 1\n",
+		},
+		{
+			name: "InvalidDomain",
+			src:  "LUT_1D_SIZE 2\nDOMAIN_MIN 1 1 1\nDOMAIN_MAX 0 0 0\n0 0 0\n1 1 1\n",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := lut.ParseCube(strings.NewReader(tc.src)); err == nil {
+				t.Errorf("ParseCube must return an error")
+			}
+		})
+	}
+}
+
+func TestCubeApplyToImage(t *testing.T) {
+	cube, err := lut.ParseCube(strings.NewReader(swapCube(3)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	img := image.NewNRGBA(image.Rect(0, 0, 1, 1))
+	img.SetNRGBA(0, 0, color.NRGBA{R: 0xff, G: 0x80, B: 0, A: 0xff})
+
+	got := cube.ApplyToImage(img, lut.InterpolationTetrahedral).NRGBA64At(0, 0)
+	want := iro.ColorFromSRGBColor(color.NRGBA{R: 0x80, G: 0, B: 0xff, A: 0xff}).SRGBColor().(color.NRGBA64)
+	if got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestNewLUT3DFromColorFunc(t *testing.T) {
+	// Invert the lightness in OKLab.
+	f := func(c iro.Color) iro.Color {
+		l, a, b, alpha := c.OKLab()
+		return iro.ColorFromOKLab(1-l, a, b, alpha)
+	}
+	l := lut.NewLUT3DFromColorFunc(17, f)
+	if got, want := len(l.Table), 17*17*17; got != want {
+		t.Fatalf("len(Table): got %d, want %d", got, want)
+	}
+
+	// The entries on the grid must match f exactly.
+	in := iro.ColorFromSRGB(0.25, 0.5, 0.75, 1)
+	r0, g0, b0, _ := f(in).SRGB()
+	r1, g1, b1 := l.Apply(0.25, 0.5, 0.75, lut.InterpolationTetrahedral)
+	if math.Abs(r1-r0) > tol || math.Abs(g1-g0) > tol || math.Abs(b1-b0) > tol {
+		t.Errorf("got (%f, %f, %f), want (%f, %f, %f)", r1, g1, b1, r0, g0, b0)
+	}
+}
+
+func TestEncodeCubeRoundTrip(t *testing.T) {
+	testCases := []struct {
+		name string
+		cube *lut.Cube
+	}{
+		{
+			name: "3D",
+			cube: &lut.Cube{
+				Title: "Swap",
+				LUT3D: lut.NewLUT3D(3, func(r, g, b float64) (float64, float64, float64) {
+					return g, b, r
+				}),
+			},
+		},
+		{
+			name: "1D",
+			cube: &lut.Cube{
+				LUT1D: &lut.LUT1D{
+					DomainMin: [3]float64{0, 0, 0},
+					DomainMax: [3]float64{2, 2, 2},
+					Table:     [][3]float64{{0, 0, 0}, {0.25, 0.5, 0.75}, {1, 1, 1}},
+				},
+			},
+		},
+		{
+			name: "Shaper",
+			cube: &lut.Cube{
+				LUT1D: &lut.LUT1D{
+					DomainMin: [3]float64{0, 0, 0},
+					DomainMax: [3]float64{4, 4, 4},
+					Table:     [][3]float64{{0, 0, 0}, {1, 1, 1}},
+				},
+				LUT3D: lut.NewLUT3D(2, func(r, g, b float64) (float64, float64, float64) {
+					return r, g, b
+				}),
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var sb strings.Builder
+			if err := lut.EncodeCube(&sb, tc.cube); err != nil {
+				t.Fatal(err)
+			}
+			got, err := lut.ParseCube(strings.NewReader(sb.String()))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Title != tc.cube.Title {
+				t.Errorf("Title: got %q, want %q", got.Title, tc.cube.Title)
+			}
+			for _, in := range [][3]float64{{0, 0, 0}, {0.2, 0.5, 0.9}, {1, 1, 1}} {
+				r0, g0, b0 := tc.cube.Apply(in[0], in[1], in[2], lut.InterpolationTrilinear)
+				r1, g1, b1 := got.Apply(in[0], in[1], in[2], lut.InterpolationTrilinear)
+				if math.Abs(r1-r0) > tol || math.Abs(g1-g0) > tol || math.Abs(b1-b0) > tol {
+					t.Errorf("Apply(%v): got (%f, %f, %f), want (%f, %f, %f)", in, r1, g1, b1, r0, g0, b0)
+				}
+			}
+		})
+	}
+}
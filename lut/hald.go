@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package lut
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+
+	"github.com/hajimehoshi/iro"
+)
+
+// A HALD CLUT image of level L represents a 3D LUT of size L^2 as an image of L^3 x L^3 pixels.
+// The entries are laid out in row-major order, where red changes fastest and blue changes slowest.
+// See https://www.quelsolaar.com/technology/clut.html
+
+// LUT3DFromHaldImage creates a 3D LUT from a HALD CLUT image.
+// The pixels of img are interpreted as nonlinear sRGB, and the alpha values are ignored.
+func LUT3DFromHaldImage(img image.Image) (*LUT3D, error) {
+	b := img.Bounds()
+	if b.Dx() != b.Dy() {
+		return nil, fmt.Errorf("lut: a HALD image must be square but %dx%d", b.Dx(), b.Dy())
+	}
+	level := haldLevel(b.Dx())
+	if level == 0 {
+		return nil, fmt.Errorf("lut: the size of a HALD image must be a cube of an integer but %d", b.Dx())
+	}
+
+	size := level * level
+	l := &LUT3D{
+		DomainMax: [3]float64{1, 1, 1},
+		Size:      size,
+		Table:     make([][3]float64, 0, size*size*size),
+	}
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			c := color.NRGBA64Model.Convert(img.At(x, y)).(color.NRGBA64)
+			l.Table = append(l.Table, [3]float64{
+				float64(c.R) / 0xffff,
+				float64(c.G) / 0xffff,
+				float64(c.B) / 0xffff,
+			})
+		}
+	}
+	return l, nil
+}
+
+// haldLevel returns the level of a HALD image with the given width, or 0 if the width is invalid.
+func haldLevel(width int) int {
+	for level := 2; level*level*level <= width; level++ {
+		if level*level*level == width {
+			return level
+		}
+	}
+	return 0
+}
+
+// HaldImage creates a HALD CLUT image of the given level by sampling the 3D LUT with the given interpolation.
+// The size of the result is level^3 x level^3.
+func (l *LUT3D) HaldImage(level int, interpolation Interpolation) *image.NRGBA64 {
+	return newHaldImage(level, func(r, g, b float64) (float64, float64, float64) {
+		return l.Apply(r, g, b, interpolation)
+	})
+}
+
+// IdentityHaldImage creates a HALD CLUT image of the given level that doesn't change colors.
+// An identity image can be edited in image editors to create a HALD CLUT.
+func IdentityHaldImage(level int) *image.NRGBA64 {
+	return newHaldImage(level, func(r, g, b float64) (float64, float64, float64) {
+		return r, g, b
+	})
+}
+
+func newHaldImage(level int, f func(r, g, b float64) (float64, float64, float64)) *image.NRGBA64 {
+	if level < 2 {
+		panic(fmt.Sprintf("lut: level must be at least 2 but %d", level))
+	}
+	size := level * level
+	width := size * level
+	img := image.NewNRGBA64(image.Rect(0, 0, width, width))
+	d := float64(size - 1)
+	var i int
+	for b := 0; b < size; b++ {
+		for g := 0; g < size; g++ {
+			for r := 0; r < size; r++ {
+				rr, gg, bb := f(float64(r)/d, float64(g)/d, float64(b)/d)
+				img.SetNRGBA64(i%width, i/width, color.NRGBA64{
+					R: toUint16(rr),
+					G: toUint16(gg),
+					B: toUint16(bb),
+					A: 0xffff,
+				})
+				i++
+			}
+		}
+	}
+	return img
+}
+
+func toUint16(v float64) uint16 {
+	return uint16(min(max(v*0xffff+0.5, 0), 0xffff))
+}
+
+// DecodeHald decodes a HALD CLUT PNG image as a 3D LUT.
+func DecodeHald(r io.Reader) (*LUT3D, error) {
+	img, err := png.Decode(r)
+	if err != nil {
+		return nil, err
+	}
+	return LUT3DFromHaldImage(img)
+}
+
+// EncodeHald encodes the 3D LUT as a 16-bit HALD CLUT PNG image of the given level.
+func EncodeHald(w io.Writer, l *LUT3D, level int, interpolation Interpolation) error {
+	return png.Encode(w, l.HaldImage(level, interpolation))
+}
+
+// ApplyToColor applies the 3D LUT to the nonlinear sRGB channels of clr.
+// The alpha value is kept.
+func (l *LUT3D) ApplyToColor(clr iro.Color, interpolation Interpolation) iro.Color {
+	r, g, b, a := clr.SRGB()
+	r, g, b = l.Apply(r, g, b, interpolation)
+	return iro.ColorFromSRGB(r, g, b, a)
+}
+
+// ApplyToImage returns a new image by applying the 3D LUT to the nonlinear sRGB channels of each pixel of img.
+func (l *LUT3D) ApplyToImage(img image.Image, interpolation Interpolation) *image.NRGBA64 {
+	return iro.MapImage(img, func(clr iro.Color) iro.Color {
+		return l.ApplyToColor(clr, interpolation)
+	})
+}
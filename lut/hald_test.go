@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package lut_test
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/iro/lut"
+)
+
+func TestIdentityHaldImage(t *testing.T) {
+	img := lut.IdentityHaldImage(4)
+	if got, want := img.Bounds(), image.Rect(0, 0, 64, 64); got != want {
+		t.Fatalf("bounds: got %v, want %v", got, want)
+	}
+	l, err := lut.LUT3DFromHaldImage(img)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := l.Size, 16; got != want {
+		t.Errorf("Size: got %d, want %d", got, want)
+	}
+	for _, in := range [][3]float64{{0, 0, 0}, {0.2, 0.5, 0.9}, {1, 1, 1}} {
+		r, g, b := l.Apply(in[0], in[1], in[2], lut.InterpolationTetrahedral)
+		// The entries are quantized to 16 bits.
+		const tol = 1.0 / 0xffff
+		if math.Abs(r-in[0]) > tol || math.Abs(g-in[1]) > tol || math.Abs(b-in[2]) > tol {
+			t.Errorf("Apply(%v): got (%f, %f, %f)", in, r, g, b)
+		}
+	}
+}
+
+func TestHaldPNGRoundTrip(t *testing.T) {
+	want := lut.NewLUT3D(9, func(r, g, b float64) (float64, float64, float64) {
+		return 1 - r, g * g, b
+	})
+	var buf bytes.Buffer
+	if err := lut.EncodeHald(&buf, want, 3, lut.InterpolationTrilinear); err != nil {
+		t.Fatal(err)
+	}
+	got, err := lut.DecodeHald(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Size != want.Size {
+		t.Fatalf("Size: got %d, want %d", got.Size, want.Size)
+	}
+	for i := range want.Table {
+		for c := 0; c < 3; c++ {
+			if math.Abs(got.Table[i][c]-want.Table[i][c]) > 1.0/0xffff {
+				t.Errorf("Table[%d][%d]: got %f, want %f", i, c, got.Table[i][c], want.Table[i][c])
+			}
+		}
+	}
+}
+
+func TestLUT3DFromHaldImageErrors(t *testing.T) {
+	for _, r := range []image.Rectangle{
+		image.Rect(0, 0, 8, 9),
+		image.Rect(0, 0, 10, 10),
+	} {
+		if _, err := lut.LUT3DFromHaldImage(image.NewNRGBA(r)); err == nil {
+			t.Errorf("LUT3DFromHaldImage with %v must return an error", r)
+		}
+	}
+}
+
+func TestLUT3DApplyToImage(t *testing.T) {
+	l := lut.NewLUT3D(2, func(r, g, b float64) (float64, float64, float64) {
+		return 1 - r, 1 - g, 1 - b
+	})
+	img := image.NewNRGBA(image.Rect(0, 0, 1, 1))
+	img.SetNRGBA(0, 0, color.NRGBA{R: 0xff, G: 0x33, A: 0x80})
+	got := color.NRGBAModel.Convert(l.ApplyToImage(img, lut.InterpolationTrilinear).At(0, 0))
+	if want := (color.NRGBA{G: 0xcc, B: 0xff, A: 0x80}); got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"math"
+)
+
+// ColorFromLuv builds a Color from CIELUV components relative to the white point and alpha.
+// L is in [0, 100].
+//
+// CIELUV has no de facto standard white point, so the white must be specified, like [WhiteD65] or [WhiteC].
+// See [ColorFromLabWithWhite] for the adaptation to the white.
+func ColorFromLuv(l, u, v, alpha float64, white Chromaticity) Color {
+	if l <= 0 {
+		return ColorFromXYZ(0, 0, 0, alpha)
+	}
+	un, vn := white.UV()
+	up := u/(13*l) + un
+	vp := v/(13*l) + vn
+	y := yFromLstar(l)
+	if vp == 0 {
+		return colorFromXYZWithWhite(0, y, 0, alpha, white)
+	}
+	x := y * 9 * up / (4 * vp)
+	z := y * (12 - 3*up - 20*vp) / (4 * vp)
+	return colorFromXYZWithWhite(x, y, z, alpha, white)
+}
+
+// Luv converts Color to CIELUV components relative to the white point and alpha.
+// L is in [0, 100]. See [ColorFromLuv] for the white point.
+func (c Color) Luv(white Chromaticity) (l, u, v, alpha float64) {
+	x, y, z := c.xyzWithWhite(white)
+	l = lstarFromY(y)
+	d := x + 15*y + 3*z
+	if d == 0 {
+		return l, 0, 0, c.alpha
+	}
+	un, vn := white.UV()
+	u = 13 * l * (4*x/d - un)
+	v = 13 * l * (9*y/d - vn)
+	return l, u, v, c.alpha
+}
+
+// ColorFromLchuv builds a Color from CIE LCh(uv) components (h in radians) relative to the white point and alpha.
+// See [ColorFromLuv] for the white point.
+func ColorFromLchuv(l, c, h, alpha float64, white Chromaticity) Color {
+	sin, cos := math.Sincos(h)
+	return ColorFromLuv(l, cos*c, sin*c, alpha, white)
+}
+
+// Lchuv converts Color to CIE LCh(uv) components (h in radians) relative to the white point and alpha.
+// See [ColorFromLuv] for the white point.
+func (c Color) Lchuv(white Chromaticity) (l, ch, h, alpha float64) {
+	l, u, v, alpha := c.Luv(white)
+	return l, math.Hypot(u, v), math.Atan2(v, u), alpha
+}
@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestLuv(t *testing.T) {
+	testCases := []struct {
+		name  string
+		color iro.Color
+		want  [3]float64
+	}{
+		{
+			name:  "White",
+			color: iro.ColorFromSRGB(1, 1, 1, 1),
+			want:  [3]float64{100, 0, 0},
+		},
+		{
+			name:  "Black",
+			color: iro.ColorFromSRGB(0, 0, 0, 1),
+			want:  [3]float64{0, 0, 0},
+		},
+		{
+			name:  "Red",
+			color: iro.ColorFromSRGB(1, 0, 0, 1),
+			want:  [3]float64{53.24, 175.01, 37.76},
+		},
+		{
+			name:  "Blue",
+			color: iro.ColorFromSRGB(0, 0, 1, 1),
+			want:  [3]float64{32.30, -9.40, -130.35},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			l, u, v, _ := tc.color.Luv(iro.WhiteD65)
+			const tol = 0.01
+			if math.Abs(l-tc.want[0]) > tol {
+				t.Errorf("l: got %f, want %f", l, tc.want[0])
+			}
+			if math.Abs(u-tc.want[1]) > tol {
+				t.Errorf("u: got %f, want %f", u, tc.want[1])
+			}
+			if math.Abs(v-tc.want[2]) > tol {
+				t.Errorf("v: got %f, want %f", v, tc.want[2])
+			}
+		})
+	}
+}
+
+func TestLuvRoundTrip(t *testing.T) {
+	for _, white := range []iro.Chromaticity{iro.WhiteD65, iro.WhiteD50, iro.WhiteA, iro.WhiteC} {
+		for _, luv := range [][3]float64{{50, 20, -30}, {3, 1, -2}, {95, -10, 40}, {0, 0, 0}} {
+			c := iro.ColorFromLuv(luv[0], luv[1], luv[2], 0.7, white)
+			l, u, v, alpha := c.Luv(white)
+			if !checkTol(l, luv[0]) || !checkTol(u, luv[1]) || !checkTol(v, luv[2]) || !checkTol(alpha, 0.7) {
+				t.Errorf("white %v: got (%f, %f, %f, %f), want (%f, %f, %f, 0.7)", white, l, u, v, alpha, luv[0], luv[1], luv[2])
+			}
+		}
+	}
+}
+
+func TestLuvWhite(t *testing.T) {
+	// The white of the illuminant is adapted to the white of D65.
+	c := iro.ColorFromLuv(100, 0, 0, 1, iro.WhiteC)
+	l, u, v, _ := c.Luv(iro.WhiteD65)
+	if !checkTol(l, 100) || !checkTol(u, 0) || !checkTol(v, 0) {
+		t.Errorf("got (%f, %f, %f), want (100, 0, 0)", l, u, v)
+	}
+}
+
+func TestLchuvRoundTrip(t *testing.T) {
+	c := iro.ColorFromLchuv(60, 40, 2, 1, iro.WhiteD65)
+	l, ch, h, _ := c.Lchuv(iro.WhiteD65)
+	if !checkTol(l, 60) || !checkTol(ch, 40) || !checkTol(h, 2) {
+		t.Errorf("got (%f, %f, %f), want (60, 40, 2)", l, ch, h)
+	}
+}
@@ -0,0 +1,254 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"fmt"
+)
+
+// Matrix3 is a 3×3 matrix. Matrix3[i][j] is the element at the row i and the column j.
+// A Matrix3 converts a column vector of components v to m·v.
+type Matrix3 [3][3]float64
+
+// Apply returns m·(x, y, z).
+func (m Matrix3) Apply(x, y, z float64) (float64, float64, float64) {
+	return m[0][0]*x + m[0][1]*y + m[0][2]*z,
+		m[1][0]*x + m[1][1]*y + m[1][2]*z,
+		m[2][0]*x + m[2][1]*y + m[2][2]*z
+}
+
+// Inverse returns the inverse matrix of m.
+//
+// Inverse panics if m is singular.
+func (m Matrix3) Inverse() Matrix3 {
+	var r Matrix3
+	// The transposed matrix of the cofactors.
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			i0, i1 := (i+1)%3, (i+2)%3
+			j0, j1 := (j+1)%3, (j+2)%3
+			r[j][i] = m[i0][j0]*m[i1][j1] - m[i0][j1]*m[i1][j0]
+		}
+	}
+	det := m[0][0]*r[0][0] + m[0][1]*r[1][0] + m[0][2]*r[2][0]
+	if det == 0 {
+		panic("iro: the matrix is singular")
+	}
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			r[i][j] /= det
+		}
+	}
+	return r
+}
+
+// The matrices used by the conversions of this package.
+// The conversions use exactly these values, so code doing custom math can reuse them.
+// These must not be modified.
+//
+// Unless otherwise noted, the matrices are from the sample code of CSS Color 4:
+// https://www.w3.org/TR/css-color-4/#color-conversion-code
+var (
+	// MatrixLinearSRGBToXYZ is the matrix from linear sRGB to XYZ D65.
+	// The elements are the rational numbers derived from the sRGB primaries and the D65 chromaticity (0.3127, 0.3290).
+	MatrixLinearSRGBToXYZ = Matrix3{
+		{506752.0 / 1228815, 87881.0 / 245763, 12673.0 / 70218},
+		{87098.0 / 409605, 175762.0 / 245763, 12673.0 / 175545},
+		{7918.0 / 409605, 87881.0 / 737289, 1001167.0 / 1053270},
+	}
+
+	// MatrixXYZToLinearSRGB is the matrix from XYZ D65 to linear sRGB, the inverse of [MatrixLinearSRGBToXYZ].
+	MatrixXYZToLinearSRGB = Matrix3{
+		{12831.0 / 3959, -329.0 / 214, -1974.0 / 3959},
+		{-851781.0 / 878810, 1648619.0 / 878810, 36519.0 / 878810},
+		{705.0 / 12673, -2585.0 / 12673, 705.0 / 667},
+	}
+
+	// MatrixLinearDisplayP3ToXYZ is the matrix from linear Display P3 to XYZ D65.
+	// The elements are the rational numbers derived from the DCI-P3 primaries and the D65 chromaticity (0.3127, 0.3290).
+	MatrixLinearDisplayP3ToXYZ = Matrix3{
+		{608311.0 / 1250200, 189793.0 / 714400, 198249.0 / 1000160},
+		{35783.0 / 156275, 247089.0 / 357200, 198249.0 / 2500400},
+		{0, 32229.0 / 714400, 5220557.0 / 5000800},
+	}
+
+	// MatrixXYZToLinearDisplayP3 is the matrix from XYZ D65 to linear Display P3, the inverse of [MatrixLinearDisplayP3ToXYZ].
+	MatrixXYZToLinearDisplayP3 = Matrix3{
+		{446124.0 / 178915, -333277.0 / 357830, -72051.0 / 178915},
+		{-14852.0 / 17905, 63121.0 / 35810, 423.0 / 17905},
+		{11844.0 / 330415, -50337.0 / 660830, 316169.0 / 330415},
+	}
+
+	// MatrixLinearRec2020ToXYZ is the matrix from linear Rec. 2020 to XYZ D65.
+	MatrixLinearRec2020ToXYZ = Matrix3{
+		{0.6369580483012914, 0.14461690358620832, 0.1688809751641721},
+		{0.2627002120112671, 0.6779980715188708, 0.05930171646986196},
+		{0, 0.028072693049087428, 1.060985057710791},
+	}
+
+	// MatrixXYZToLinearRec2020 is the matrix from XYZ D65 to linear Rec. 2020, the inverse of [MatrixLinearRec2020ToXYZ].
+	MatrixXYZToLinearRec2020 = Matrix3{
+		{1.716651187971268, -0.355670783776392, -0.253366281373660},
+		{-0.666684351832489, 1.616481236634939, 0.0157685458139111},
+		{0.017639857445311, -0.042770613257809, 0.942103121235474},
+	}
+
+	// MatrixLinearA98RGBToXYZ is the matrix from linear Adobe RGB (1998) to XYZ D65.
+	MatrixLinearA98RGBToXYZ = Matrix3{
+		{0.5766690429101305, 0.1855582379065463, 0.1882286462349947},
+		{0.29734497525053605, 0.6273635662554661, 0.07529145849399788},
+		{0.02703136138641234, 0.07068885253582723, 0.9913375368376388},
+	}
+
+	// MatrixXYZToLinearA98RGB is the matrix from XYZ D65 to linear Adobe RGB (1998), the inverse of [MatrixLinearA98RGBToXYZ].
+	MatrixXYZToLinearA98RGB = Matrix3{
+		{2.0415879038107465, -0.5650069742788596, -0.34473135077832956},
+		{-0.9692436362808795, 1.8759675015077202, 0.04155505740717557},
+		{0.013444280632031142, -0.11836239223101838, 1.0151749943912054},
+	}
+
+	// MatrixLinearProPhotoRGBToXYZD50 is the matrix from linear ProPhoto RGB to XYZ D50.
+	// Note that the white point of ProPhoto RGB is D50.
+	MatrixLinearProPhotoRGBToXYZD50 = Matrix3{
+		{0.7977666449006423, 0.13518129740053308, 0.0313477341283922},
+		{0.2880748288194013, 0.711835234241873, 0.00008993693872564},
+		{0, 0, 0.8251046025104602},
+	}
+
+	// MatrixXYZD50ToLinearProPhotoRGB is the matrix from XYZ D50 to linear ProPhoto RGB, the inverse of [MatrixLinearProPhotoRGBToXYZD50].
+	MatrixXYZD50ToLinearProPhotoRGB = Matrix3{
+		{1.3457868816471583, -0.25557208737979464, -0.05110186497554526},
+		{-0.5446307051249019, 1.5082477428451468, 0.02052744743642139},
+		{0, 0, 1.2119675456389452},
+	}
+
+	// MatrixXYZToXYZD50 is the Bradford chromatic adaptation matrix from XYZ D65 to XYZ D50.
+	MatrixXYZToXYZD50 = Matrix3{
+		{1.0479297925449969, 0.022946870601609652, -0.05019226628920524},
+		{0.02962780877005599, 0.9904344267538799, -0.017073799063418826},
+		{-0.009243040646204504, 0.015055191490298152, 0.7518742814281371},
+	}
+
+	// MatrixXYZD50ToXYZ is the Bradford chromatic adaptation matrix from XYZ D50 to XYZ D65, the inverse of [MatrixXYZToXYZD50].
+	MatrixXYZD50ToXYZ = Matrix3{
+		{0.955473421488075, -0.02309845494876471, 0.06325924320057072},
+		{-0.0283697093338637, 1.0099953980813041, 0.021041441191917323},
+		{0.012314014864481998, -0.020507649298898964, 1.330365926242124},
+	}
+
+	// MatrixXYZToOKLabLMS is the matrix M1 of OKLab from XYZ D65 to LMS.
+	// This is recalculated by CSS Color 4 with the D65 chromaticity (0.3127, 0.3290), and is slightly different from the original one.
+	// See https://bottosson.github.io/posts/oklab/ for the original one.
+	MatrixXYZToOKLabLMS = Matrix3{
+		{0.8190224379967030, 0.3619062600528904, -0.1288737815209879},
+		{0.0329836539323885, 0.9292868615863434, 0.0361446663506424},
+		{0.0481771893596242, 0.2642395317527308, 0.6335478284694309},
+	}
+
+	// MatrixOKLabLMSToOKLab is the matrix M2 of OKLab from the cube roots of LMS to OKLab.
+	MatrixOKLabLMSToOKLab = Matrix3{
+		{0.2104542683093140, 0.7936177747023054, -0.0040720430116193},
+		{1.9779985324311684, -2.4285922420485799, 0.4505937096174110},
+		{0.0259040424655478, 0.7827717124575296, -0.8086757549230774},
+	}
+
+	// MatrixOKLabToOKLabLMS is the matrix from OKLab to the cube roots of LMS, the inverse of [MatrixOKLabLMSToOKLab].
+	MatrixOKLabToOKLabLMS = Matrix3{
+		{1, 0.3963377773761749, 0.2158037573099136},
+		{1, -0.1055613458156586, -0.0638541728258133},
+		{1, -0.0894841775298119, -1.2914855480194092},
+	}
+
+	// MatrixOKLabLMSToXYZ is the matrix from LMS of OKLab to XYZ D65, the inverse of [MatrixXYZToOKLabLMS].
+	MatrixOKLabLMSToXYZ = Matrix3{
+		{1.2268798758459243, -0.5578149944602171, 0.2813910456659647},
+		{-0.0405757452148008, 1.1122868032803170, -0.0717110580655164},
+		{-0.0763729366746601, -0.4214933324022432, 1.5869240198367816},
+	}
+
+	// MatrixXYZToBradfordLMS is the matrix of the Bradford transform from XYZ to the cone responses.
+	MatrixXYZToBradfordLMS = Matrix3{
+		{0.8951, 0.2664, -0.1614},
+		{-0.7502, 1.7135, 0.0367},
+		{0.0389, -0.0685, 1.0296},
+	}
+)
+
+// ChromaticAdaptationMatrix returns the Bradford chromatic adaptation matrix in XYZ from the white point from to the white point to.
+// The luminance of the white is kept.
+//
+// For example, ChromaticAdaptationMatrix(WhiteD65, WhiteD50) is [MatrixXYZToXYZD50].
+func ChromaticAdaptationMatrix(from, to Chromaticity) Matrix3 {
+	xs, ys, zs := from.XYZ(1)
+	xd, yd, zd := to.XYZ(1)
+	ls, ms, ss := MatrixXYZToBradfordLMS.Apply(xs, ys, zs)
+	ld, md, sd := MatrixXYZToBradfordLMS.Apply(xd, yd, zd)
+	scale := Matrix3{
+		{ld / ls, 0, 0},
+		{0, md / ms, 0},
+		{0, 0, sd / ss},
+	}
+	inv := MatrixXYZToBradfordLMS.Inverse()
+	m := mulMat(&scale, &MatrixXYZToBradfordLMS)
+	return mulMat(&inv, &m)
+}
+
+// ConversionMatrix returns the matrix converting the components in the space from to the space to.
+//
+// from and to must be linear spaces: linear RGB spaces like [SpaceLinearSRGB], [SpaceXYZ], or [SpaceXYZD50].
+// For a nonlinear RGB space like [SpaceSRGB], use the matrix of its linear counterpart with the transfer functions.
+// The matrix can be passed to GPU shaders to do the same conversion as this package.
+//
+// ConversionMatrix panics if from or to is not a linear space.
+func ConversionMatrix(from, to Space) Matrix3 {
+	if !from.isLinear() {
+		panic(fmt.Sprintf("iro: the space must be a linear space but %s", from))
+	}
+	if !to.isLinear() {
+		panic(fmt.Sprintf("iro: the space must be a linear space but %s", to))
+	}
+	m0 := from.toXYZMatrix()
+	m1 := to.fromXYZMatrix()
+	return mulMat(&m1, &m0)
+}
+
+// RowMajor returns the elements of m in row-major order.
+func (m Matrix3) RowMajor() [9]float64 {
+	var v [9]float64
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			v[3*i+j] = m[i][j]
+		}
+	}
+	return v
+}
+
+// ColumnMajor returns the elements of m in column-major order, like the constructors of mat3 in GLSL.
+func (m Matrix3) ColumnMajor() [9]float64 {
+	var v [9]float64
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			v[3*j+i] = m[i][j]
+		}
+	}
+	return v
+}
+
+// RowMajor32 returns the elements of m in row-major order as float32 values.
+func (m Matrix3) RowMajor32() [9]float32 {
+	return toFloat32s(m.RowMajor())
+}
+
+// ColumnMajor32 returns the elements of m in column-major order as float32 values.
+func (m Matrix3) ColumnMajor32() [9]float32 {
+	return toFloat32s(m.ColumnMajor())
+}
+
+func toFloat32s(v [9]float64) [9]float32 {
+	var r [9]float32
+	for i, x := range v {
+		r[i] = float32(x)
+	}
+	return r
+}
@@ -0,0 +1,202 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestConversionMatrix(t *testing.T) {
+	// https://www.w3.org/TR/css-color-4/#color-conversion-code
+	got := iro.ConversionMatrix(iro.SpaceLinearSRGB, iro.SpaceXYZ).RowMajor()
+	want := [9]float64{
+		506752.0 / 1228815, 87881.0 / 245763, 12673.0 / 70218,
+		87098.0 / 409605, 175762.0 / 245763, 12673.0 / 175545,
+		7918.0 / 409605, 87881.0 / 737289, 1001167.0 / 1053270,
+	}
+	for i := range got {
+		if math.Abs(got[i]-want[i]) > 1e-15 {
+			t.Errorf("LinearSRGB to XYZ: [%d]: got %v, want %v", i, got[i], want[i])
+		}
+	}
+
+	id := iro.ConversionMatrix(iro.SpaceLinearDisplayP3, iro.SpaceLinearDisplayP3)
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			want := 0.0
+			if i == j {
+				want = 1
+			}
+			if math.Abs(id[i][j]-want) > 1e-15 {
+				t.Errorf("LinearDisplayP3 to LinearDisplayP3: [%d][%d]: got %v, want %v", i, j, id[i][j], want)
+			}
+		}
+	}
+}
+
+func TestConversionMatrixConverts(t *testing.T) {
+	spaces := []iro.Space{
+		iro.SpaceLinearSRGB,
+		iro.SpaceLinearDisplayP3,
+		iro.SpaceLinearRec2020,
+		iro.SpaceLinearA98RGB,
+		iro.SpaceLinearProPhotoRGB,
+		iro.SpaceXYZ,
+		iro.SpaceXYZD50,
+	}
+	c := iro.ColorFromSRGB(0.2, 0.5, 0.8, 1)
+	for _, from := range spaces {
+		for _, to := range spaces {
+			m := iro.ConversionMatrix(from, to)
+			v0, v1, v2, _ := c.Components(from)
+			w0, w1, w2, _ := c.Components(to)
+			for i, want := range []float64{w0, w1, w2} {
+				got := m[i][0]*v0 + m[i][1]*v1 + m[i][2]*v2
+				if !checkTol(got, want) {
+					t.Errorf("%s to %s: [%d]: got %f, want %f", from, to, i, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestMatrix3Order(t *testing.T) {
+	m := iro.Matrix3{
+		{1, 2, 3},
+		{4, 5, 6},
+		{7, 8, 9},
+	}
+	if got, want := m.RowMajor(), [9]float64{1, 2, 3, 4, 5, 6, 7, 8, 9}; got != want {
+		t.Errorf("RowMajor: got %v, want %v", got, want)
+	}
+	if got, want := m.ColumnMajor(), [9]float64{1, 4, 7, 2, 5, 8, 3, 6, 9}; got != want {
+		t.Errorf("ColumnMajor: got %v, want %v", got, want)
+	}
+	if got, want := m.RowMajor32(), [9]float32{1, 2, 3, 4, 5, 6, 7, 8, 9}; got != want {
+		t.Errorf("RowMajor32: got %v, want %v", got, want)
+	}
+	if got, want := m.ColumnMajor32(), [9]float32{1, 4, 7, 2, 5, 8, 3, 6, 9}; got != want {
+		t.Errorf("ColumnMajor32: got %v, want %v", got, want)
+	}
+}
+
+func TestConversionMatrixPanics(t *testing.T) {
+	for _, spaces := range [][2]iro.Space{
+		{iro.SpaceSRGB, iro.SpaceXYZ},
+		{iro.SpaceXYZ, iro.SpaceOKLab},
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("ConversionMatrix(%s, %s) must panic", spaces[0], spaces[1])
+				}
+			}()
+			iro.ConversionMatrix(spaces[0], spaces[1])
+		}()
+	}
+}
+
+func TestNamedMatrices(t *testing.T) {
+	testCases := []struct {
+		name     string
+		m, inv   iro.Matrix3
+		from, to iro.Space
+	}{
+		{name: "LinearSRGB", m: iro.MatrixLinearSRGBToXYZ, inv: iro.MatrixXYZToLinearSRGB, from: iro.SpaceLinearSRGB, to: iro.SpaceXYZ},
+		{name: "LinearDisplayP3", m: iro.MatrixLinearDisplayP3ToXYZ, inv: iro.MatrixXYZToLinearDisplayP3, from: iro.SpaceLinearDisplayP3, to: iro.SpaceXYZ},
+		{name: "LinearRec2020", m: iro.MatrixLinearRec2020ToXYZ, inv: iro.MatrixXYZToLinearRec2020, from: iro.SpaceLinearRec2020, to: iro.SpaceXYZ},
+		{name: "LinearA98RGB", m: iro.MatrixLinearA98RGBToXYZ, inv: iro.MatrixXYZToLinearA98RGB, from: iro.SpaceLinearA98RGB, to: iro.SpaceXYZ},
+		{name: "LinearProPhotoRGB", m: iro.MatrixLinearProPhotoRGBToXYZD50, inv: iro.MatrixXYZD50ToLinearProPhotoRGB, from: iro.SpaceLinearProPhotoRGB, to: iro.SpaceXYZD50},
+		{name: "XYZD50", m: iro.MatrixXYZD50ToXYZ, inv: iro.MatrixXYZToXYZD50, from: iro.SpaceXYZD50, to: iro.SpaceXYZ},
+		{name: "OKLabLMS", m: iro.MatrixOKLabLMSToXYZ, inv: iro.MatrixXYZToOKLabLMS},
+		{name: "OKLab", m: iro.MatrixOKLabToOKLabLMS, inv: iro.MatrixOKLabLMSToOKLab},
+	}
+	for _, tc := range testCases {
+		// The matrices are the inverses of each other.
+		for j := 0; j < 3; j++ {
+			var v [3]float64
+			v[j] = 1
+			x, y, z := tc.m.Apply(v[0], v[1], v[2])
+			r0, r1, r2 := tc.inv.Apply(x, y, z)
+			for i, got := range []float64{r0, r1, r2} {
+				if math.Abs(got-v[i]) > 1e-9 {
+					t.Errorf("%s: inverse: [%d][%d]: got %v, want %v", tc.name, i, j, got, v[i])
+				}
+			}
+		}
+
+		// The matrices are the ones used by the conversions.
+		if tc.from == tc.to {
+			continue
+		}
+		got := iro.ConversionMatrix(tc.from, tc.to)
+		for i := 0; i < 3; i++ {
+			for j := 0; j < 3; j++ {
+				if math.Abs(got[i][j]-tc.m[i][j]) > 1e-15 {
+					t.Errorf("%s: ConversionMatrix: [%d][%d]: got %v, want %v", tc.name, i, j, got[i][j], tc.m[i][j])
+				}
+			}
+		}
+	}
+}
+
+func TestMatrix3Apply(t *testing.T) {
+	m := iro.Matrix3{
+		{1, 2, 3},
+		{4, 5, 6},
+		{7, 8, 9},
+	}
+	x, y, z := m.Apply(1, 0, -1)
+	if x != -2 || y != -2 || z != -2 {
+		t.Errorf("Apply(1, 0, -1): got (%v, %v, %v), want (-2, -2, -2)", x, y, z)
+	}
+}
+
+func TestMatrix3Inverse(t *testing.T) {
+	m := iro.Matrix3{
+		{1, 2, 3},
+		{0, 1, 4},
+		{5, 6, 0},
+	}
+	want := iro.Matrix3{
+		{-24, 18, 5},
+		{20, -15, -4},
+		{-5, 4, 1},
+	}
+	if got := m.Inverse(); got != want {
+		t.Errorf("Inverse: got %v, want %v", got, want)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Inverse of a singular matrix must panic")
+		}
+	}()
+	iro.Matrix3{{1, 2, 3}, {2, 4, 6}, {0, 0, 1}}.Inverse()
+}
+
+func TestChromaticAdaptationMatrix(t *testing.T) {
+	testCases := []struct {
+		name     string
+		from, to iro.Chromaticity
+		want     iro.Matrix3
+	}{
+		{name: "D65 to D50", from: iro.WhiteD65, to: iro.WhiteD50, want: iro.MatrixXYZToXYZD50},
+		{name: "D50 to D65", from: iro.WhiteD50, to: iro.WhiteD65, want: iro.MatrixXYZD50ToXYZ},
+		{name: "D65 to D65", from: iro.WhiteD65, to: iro.WhiteD65, want: iro.Matrix3{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}}},
+	}
+	for _, tc := range testCases {
+		got := iro.ChromaticAdaptationMatrix(tc.from, tc.to)
+		for i := 0; i < 3; i++ {
+			for j := 0; j < 3; j++ {
+				if math.Abs(got[i][j]-tc.want[i][j]) > 1e-9 {
+					t.Errorf("%s: [%d][%d]: got %v, want %v", tc.name, i, j, got[i][j], tc.want[i][j])
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,29 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"math"
+)
+
+// MetamerismIndex returns the special metamerism index for a change in illuminant, as defined in CIE 15,
+// of the two surfaces with the spectral reflectances a and b.
+//
+// The index is the CIE 1976 color difference ΔE*ab between the surfaces under the test illuminant.
+// The surfaces are supposed to match under the reference illuminant.
+// If they don't match exactly, the difference in CIELAB under the reference illuminant
+// is subtracted from the difference under the test illuminant (the additive correction).
+// CIELAB is relative to the white of each illuminant, i.e. the perfect reflecting diffuser under the illuminant.
+//
+// See [SPD.Chromaticity] for the color matching functions.
+func MetamerismIndex(a, b, reference, test *SPD) float64 {
+	lr0, ar0, br0 := a.ReflectanceLab(reference, Observer2Degree)
+	lr1, ar1, br1 := b.ReflectanceLab(reference, Observer2Degree)
+	lt0, at0, bt0 := a.ReflectanceLab(test, Observer2Degree)
+	lt1, at1, bt1 := b.ReflectanceLab(test, Observer2Degree)
+	dl := (lt0 - lt1) - (lr0 - lr1)
+	da := (at0 - at1) - (ar0 - ar1)
+	db := (bt0 - bt1) - (br0 - br1)
+	return math.Sqrt(dl*dl + da*da + db*db)
+}
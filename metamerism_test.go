@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+// sampleSPD samples f from 360 nm to 830 nm at 5 nm intervals.
+func sampleSPD(f func(lambda float64) float64) *iro.SPD {
+	s := &iro.SPD{Start: 360, Interval: 5}
+	for l := 360; l <= 830; l += 5 {
+		s.Values = append(s.Values, f(float64(l)))
+	}
+	return s
+}
+
+// metamer returns a spectral reflectance that matches the flat gray 0.5 under the illuminant,
+// by adding a wavy spectrum with its tristimulus values cancelled out.
+func metamer(illuminant *iro.SPD) *iro.SPD {
+	wave := sampleSPD(func(lambda float64) float64 { return math.Sin(lambda / 15) })
+	var basis [3]*iro.SPD
+	for i, mean := range []float64{450, 550, 620} {
+		basis[i] = sampleSPD(func(lambda float64) float64 {
+			d := (lambda - mean) / 30
+			return math.Exp(-d * d / 2)
+		})
+	}
+	var m iro.Matrix3
+	for i, b := range basis {
+		m[0][i], m[1][i], m[2][i] = b.ReflectanceXYZ(illuminant)
+	}
+	c0, c1, c2 := m.Inverse().Apply(wave.ReflectanceXYZ(illuminant))
+	return sampleSPD(func(lambda float64) float64 {
+		v := wave.At(lambda) - c0*basis[0].At(lambda) - c1*basis[1].At(lambda) - c2*basis[2].At(lambda)
+		return 0.5 + 0.1*v
+	})
+}
+
+func TestMetamerismIndex(t *testing.T) {
+	gray := sampleSPD(func(float64) float64 { return 0.5 })
+	m := metamer(iro.IlluminantD65)
+
+	// The metamer matches the gray under D65.
+	x0, y0, z0 := gray.ReflectanceXYZ(iro.IlluminantD65)
+	x1, y1, z1 := m.ReflectanceXYZ(iro.IlluminantD65)
+	if !checkTol(x0, x1) || !checkTol(y0, y1) || !checkTol(z0, z1) {
+		t.Errorf("metamer: got (%f, %f, %f), want (%f, %f, %f)", x1, y1, z1, x0, y0, z0)
+	}
+
+	if got := iro.MetamerismIndex(gray, gray, iro.IlluminantD65, iro.IlluminantA); got != 0 {
+		t.Errorf("MetamerismIndex(gray, gray): got %f, want 0", got)
+	}
+	if got := iro.MetamerismIndex(gray, m, iro.IlluminantD65, iro.IlluminantD65); !checkTol(got, 0) {
+		t.Errorf("MetamerismIndex(gray, metamer, D65, D65): got %f, want 0", got)
+	}
+	mi := iro.MetamerismIndex(gray, m, iro.IlluminantD65, iro.IlluminantA)
+	if mi < 0.1 {
+		t.Errorf("MetamerismIndex(gray, metamer, D65, A): got %f, want a visible difference", mi)
+	}
+	if got := iro.MetamerismIndex(m, gray, iro.IlluminantD65, iro.IlluminantA); !checkTol(got, mi) {
+		t.Errorf("MetamerismIndex(metamer, gray, D65, A): got %f, want %f", got, mi)
+	}
+
+	// A mismatch under the reference illuminant is corrected additively.
+	lighter := sampleSPD(func(float64) float64 { return 0.6 })
+	if got := iro.MetamerismIndex(gray, lighter, iro.IlluminantD65, iro.IlluminantA); got > 0.1 {
+		t.Errorf("MetamerismIndex(gray, lighter, D65, A): got %f, want almost 0", got)
+	}
+}
@@ -0,0 +1,180 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+)
+
+// GamutMapping specifies how colors outside the gamut of an RGB space are brought into the gamut.
+type GamutMapping int
+
+const (
+	// GamutMappingNone keeps the components as they are, even if they are outside [0, 1].
+	GamutMappingNone GamutMapping = iota
+
+	// GamutMappingClip clamps each component to [0, 1].
+	GamutMappingClip
+
+	// GamutMappingChroma reduces the OKLCh chroma until the color fits the gamut, keeping the OKLCh lightness and hue.
+	// The lightness is clamped to [0, 1].
+	GamutMappingChroma
+)
+
+// String returns the name of m.
+func (m GamutMapping) String() string {
+	switch m {
+	case GamutMappingNone:
+		return "none"
+	case GamutMappingClip:
+		return "clip"
+	case GamutMappingChroma:
+		return "chroma"
+	default:
+		return fmt.Sprintf("GamutMapping(%d)", int(m))
+	}
+}
+
+// ConversionOption is an option of conversions like [Color.Convert] and [ConvertImage].
+type ConversionOption func(o *conversionOptions)
+
+type conversionOptions struct {
+	gamutMapping GamutMapping
+	dither       bool
+	fastMath     bool
+}
+
+func newConversionOptions(opts []ConversionOption) *conversionOptions {
+	o := &conversionOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// WithGamutMapping specifies how colors are brought into the gamut of the destination space.
+// The gamut mapping applies only to RGB spaces. The default is [GamutMappingNone].
+func WithGamutMapping(m GamutMapping) ConversionOption {
+	return func(o *conversionOptions) {
+		o.gamutMapping = m
+	}
+}
+
+// WithDither reports whether the quantization errors to integer components are diffused with the Floyd-Steinberg dithering.
+// The dithering applies only to conversions to images. Without this option, the components are rounded.
+func WithDither(dither bool) ConversionOption {
+	return func(o *conversionOptions) {
+		o.dither = dither
+	}
+}
+
+// WithFastMath makes the sRGB transfer function, also used by Display P3, approximated as the build tag irofast does.
+// See the build tag irofast for the precision of the approximation.
+func WithFastMath() ConversionOption {
+	return func(o *conversionOptions) {
+		o.fastMath = true
+	}
+}
+
+// components returns the components of c in the space with the options.
+func (o *conversionOptions) components(c Color, space Space) (c0, c1, c2, alpha float64) {
+	rgb := space.isRGB()
+	if rgb && o.gamutMapping == GamutMappingChroma {
+		l, ch, h, a := c.OKLch()
+		l = min(max(l, 0), 1)
+		c = ColorFromOKLch(l, min(ch, GamutMaxChroma(space, l, h)), h, a)
+	}
+
+	if o.fastMath && (space == SpaceSRGB || space == SpaceDisplayP3) {
+		linear, _, _, _ := space.linearSpace()
+		c0, c1, c2, alpha = c.Components(linear)
+		c0, c1, c2 = fastGamma(c0), fastGamma(c1), fastGamma(c2)
+	} else {
+		c0, c1, c2, alpha = c.Components(space)
+	}
+
+	// The chroma reduction also clamps the components to remove tiny errors of the boundary search.
+	if rgb && o.gamutMapping != GamutMappingNone {
+		c0, c1, c2 = clamp01(c0), clamp01(c1), clamp01(c2)
+	}
+	return
+}
+
+func clamp01(v float64) float64 {
+	return min(max(v, 0), 1)
+}
+
+// Convert converts c to the components in the space and alpha with the options.
+//
+// Without options, Convert is the same as [Color.Components].
+func (c Color) Convert(space Space, opts ...ConversionOption) (c0, c1, c2, alpha float64) {
+	return newConversionOptions(opts).components(c, space)
+}
+
+// ConvertImage returns a new 8-bit nonlinear sRGB image by applying f to each pixel of img with the options.
+// See [MapImage] for the interpretation of the pixels. If f is nil, the pixels are converted as they are.
+//
+// Unlike [MapImage], the default gamut mapping is [GamutMappingClip] since the components of images are clamped anyway.
+// With [WithDither], the errors of the color components are diffused in nonlinear sRGB, and the errors of fully transparent pixels are not diffused.
+func ConvertImage(img image.Image, f func(c Color) Color, opts ...ConversionOption) *image.NRGBA {
+	dst, _ := ConvertImageContext(context.Background(), img, f, opts...)
+	return dst
+}
+
+// ConvertImageContext is like [ConvertImage] but stops and returns the error of ctx when ctx is done.
+// ctx is checked for each row of img.
+func ConvertImageContext(ctx context.Context, img image.Image, f func(c Color) Color, opts ...ConversionOption) (*image.NRGBA, error) {
+	o := newConversionOptions(append([]ConversionOption{WithGamutMapping(GamutMappingClip)}, opts...))
+	bounds := img.Bounds()
+	dst := image.NewNRGBA(bounds)
+	w := bounds.Dx()
+	// errs is the diffused errors of the current and the next rows, with a margin at both ends.
+	var errs [2][][3]float64
+	if o.dither {
+		errs = [2][][3]float64{make([][3]float64, w+2), make([][3]float64, w+2)}
+	}
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		cur, next := errs[0], errs[1]
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			c := colorAt(img, x, y)
+			if f != nil {
+				c = f(c)
+			}
+			r, g, b, alpha := o.components(c, SpaceSRGB)
+			if !o.dither || alpha <= 0 {
+				dst.SetNRGBA(x, y, color.NRGBA{R: toUint8(r), G: toUint8(g), B: toUint8(b), A: toUint8(alpha)})
+				continue
+			}
+
+			i := x - bounds.Min.X + 1
+			v := [3]float64{r + cur[i][0], g + cur[i][1], b + cur[i][2]}
+			var q [3]uint8
+			for j := range v {
+				q[j] = toUint8(v[j])
+				d := v[j] - float64(q[j])/0xff
+				// Don't accumulate the errors that cannot be represented, like ones of out-of-range components.
+				d = math.Max(math.Min(d, 0.5/0xff), -0.5/0xff)
+				cur[i+1][j] += d * 7 / 16
+				next[i-1][j] += d * 3 / 16
+				next[i][j] += d * 5 / 16
+				next[i+1][j] += d * 1 / 16
+			}
+			dst.SetNRGBA(x, y, color.NRGBA{R: q[0], G: q[1], B: q[2], A: toUint8(alpha)})
+		}
+		if o.dither {
+			for i := range cur {
+				cur[i] = [3]float64{}
+			}
+			errs[0], errs[1] = next, cur
+		}
+	}
+	return dst, nil
+}
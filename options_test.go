@@ -0,0 +1,214 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"context"
+	"errors"
+	"image"
+	"image/color"
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestConvert(t *testing.T) {
+	p3Red := iro.ColorFromDisplayP3(1, 0, 0, 1)
+
+	testCases := []struct {
+		name                string
+		c                   iro.Color
+		space               iro.Space
+		opts                []iro.ConversionOption
+		want0, want1, want2 float64
+	}{
+		{
+			name:  "none",
+			c:     iro.ColorFromSRGB(0.2, 0.4, 0.6, 1),
+			space: iro.SpaceSRGB,
+			want0: 0.2,
+			want1: 0.4,
+			want2: 0.6,
+		},
+		{
+			name:  "clip",
+			c:     iro.ColorFromSRGB(1.2, -0.1, 0.5, 1),
+			space: iro.SpaceSRGB,
+			opts:  []iro.ConversionOption{iro.WithGamutMapping(iro.GamutMappingClip)},
+			want0: 1,
+			want1: 0,
+			want2: 0.5,
+		},
+		{
+			name:  "clip in a non-RGB space",
+			c:     iro.ColorFromSRGB(1.2, -0.1, 0.5, 1),
+			space: iro.SpaceXYZ,
+			opts:  []iro.ConversionOption{iro.WithGamutMapping(iro.GamutMappingClip)},
+		},
+		{
+			name:  "fast math",
+			c:     iro.ColorFromSRGB(0.2, 0.4, 0.6, 1),
+			space: iro.SpaceSRGB,
+			opts:  []iro.ConversionOption{iro.WithFastMath()},
+			want0: 0.2,
+			want1: 0.4,
+			want2: 0.6,
+		},
+		{
+			name:  "fast math in Display P3",
+			c:     p3Red,
+			space: iro.SpaceDisplayP3,
+			opts:  []iro.ConversionOption{iro.WithFastMath()},
+			want0: 1,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.space == iro.SpaceXYZ {
+				tc.want0, tc.want1, tc.want2, _ = tc.c.Components(tc.space)
+			}
+			c0, c1, c2, alpha := tc.c.Convert(tc.space, tc.opts...)
+			if !checkTol(c0, tc.want0) || !checkTol(c1, tc.want1) || !checkTol(c2, tc.want2) || alpha != 1 {
+				t.Errorf("got (%f, %f, %f, %f), want (%f, %f, %f, 1)", c0, c1, c2, alpha, tc.want0, tc.want1, tc.want2)
+			}
+		})
+	}
+}
+
+func TestConvertGamutMappingChroma(t *testing.T) {
+	for _, c := range []iro.Color{
+		iro.ColorFromDisplayP3(1, 0, 0, 1),
+		iro.ColorFromDisplayP3(0, 1, 0, 0.5),
+		iro.ColorFromOKLch(0.7, 0.4, 4, 1),
+		iro.ColorFromOKLch(1.2, 0.1, 1, 1),
+	} {
+		r, g, b, alpha := c.Convert(iro.SpaceSRGB, iro.WithGamutMapping(iro.GamutMappingChroma))
+		for _, v := range []float64{r, g, b} {
+			if v < 0 || v > 1 {
+				t.Errorf("%v: got (%f, %f, %f), want inside the gamut", c, r, g, b)
+			}
+		}
+		if _, _, _, a := c.OKLch(); alpha != a {
+			t.Errorf("%v: alpha: got %f, want %f", c, alpha, a)
+		}
+
+		l0, ch0, h0, _ := c.OKLch()
+		l, ch, h, _ := iro.ColorFromSRGB(r, g, b, 1).OKLch()
+		if want := min(l0, 1); math.Abs(l-want) > 1e-3 {
+			t.Errorf("%v: lightness: got %f, want %f", c, l, want)
+		}
+		if ch > 1e-3 && math.Abs(math.Remainder(h-h0, 2*math.Pi)) > 1e-2 {
+			t.Errorf("%v: hue: got %f, want %f", c, h, h0)
+		}
+		if ch > ch0+1e-6 {
+			t.Errorf("%v: chroma: got %f, want at most %f", c, ch, ch0)
+		}
+	}
+
+	// Colors inside the gamut are kept.
+	c := iro.ColorFromSRGB(0.2, 0.4, 0.6, 1)
+	r, g, b, _ := c.Convert(iro.SpaceSRGB, iro.WithGamutMapping(iro.GamutMappingChroma))
+	if !checkTol(r, 0.2) || !checkTol(g, 0.4) || !checkTol(b, 0.6) {
+		t.Errorf("got (%f, %f, %f), want (0.2, 0.4, 0.6)", r, g, b)
+	}
+}
+
+func TestGamutMappingString(t *testing.T) {
+	testCases := []struct {
+		m    iro.GamutMapping
+		want string
+	}{
+		{iro.GamutMappingNone, "none"},
+		{iro.GamutMappingClip, "clip"},
+		{iro.GamutMappingChroma, "chroma"},
+		{iro.GamutMapping(100), "GamutMapping(100)"},
+	}
+	for _, tc := range testCases {
+		if got := tc.m.String(); got != tc.want {
+			t.Errorf("%d: got %q, want %q", int(tc.m), got, tc.want)
+		}
+	}
+}
+
+func TestConvertImage(t *testing.T) {
+	src := image.NewNRGBA64(image.Rect(1, 2, 3, 4))
+	src.SetNRGBA64(1, 2, color.NRGBA64{R: 0xffff, A: 0xffff})
+	src.SetNRGBA64(2, 3, color.NRGBA64{G: 0x8080, B: 0x4040, A: 0x8080})
+
+	got := iro.ConvertImage(src, nil)
+	if got.Bounds() != src.Bounds() {
+		t.Fatalf("bounds: got %v, want %v", got.Bounds(), src.Bounds())
+	}
+	testCases := []struct {
+		x, y int
+		want color.NRGBA
+	}{
+		{x: 1, y: 2, want: color.NRGBA{R: 0xff, A: 0xff}},
+		{x: 2, y: 3, want: color.NRGBA{G: 0x80, B: 0x40, A: 0x80}},
+		{x: 2, y: 2, want: color.NRGBA{}},
+	}
+	for _, tc := range testCases {
+		if got := got.NRGBAAt(tc.x, tc.y); got != tc.want {
+			t.Errorf("(%d, %d): got %v, want %v", tc.x, tc.y, got, tc.want)
+		}
+	}
+
+	// The default gamut mapping clips the components.
+	got = iro.ConvertImage(src, func(c iro.Color) iro.Color {
+		return iro.ColorFromDisplayP3(0, 1, 0, 1)
+	})
+	if got, want := got.NRGBAAt(1, 2), (color.NRGBA{G: 0xff, A: 0xff}); got != want {
+		t.Errorf("clip: got %v, want %v", got, want)
+	}
+}
+
+func TestConvertImageDither(t *testing.T) {
+	const w, h = 16, 16
+	// The gray between the 8-bit levels 0x80 and 0x81.
+	src := image.NewNRGBA64(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			src.SetNRGBA64(x, y, color.NRGBA64{R: 0x80c0, G: 0x80c0, B: 0x80c0, A: 0xffff})
+		}
+	}
+
+	// Without dithering, all the pixels are the same.
+	got := iro.ConvertImage(src, nil)
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			if c, want := got.NRGBAAt(x, y), (color.NRGBA{R: 0x80, G: 0x80, B: 0x80, A: 0xff}); c != want {
+				t.Fatalf("no dither (%d, %d): got %v, want %v", x, y, c, want)
+			}
+		}
+	}
+
+	// With dithering, the average is kept.
+	got = iro.ConvertImage(src, nil, iro.WithDither(true))
+	var sum int
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			c := got.NRGBAAt(x, y)
+			if c.R != 0x80 && c.R != 0x81 {
+				t.Fatalf("dither (%d, %d): got %v, want 0x80 or 0x81", x, y, c)
+			}
+			sum += int(c.R)
+		}
+	}
+	avg := float64(sum) / (w * h)
+	if want := float64(0x80c0) / 0xffff * 0xff; math.Abs(avg-want) > 0.05 {
+		t.Errorf("dither: got average %f, want %f", avg, want)
+	}
+}
+
+func TestConvertImageContext(t *testing.T) {
+	src := image.NewNRGBA(image.Rect(0, 0, 4, 4))
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	for _, dither := range []bool{false, true} {
+		if _, err := iro.ConvertImageContext(ctx, src, nil, iro.WithDither(dither)); !errors.Is(err, context.Canceled) {
+			t.Errorf("dither=%t: got %v, want %v", dither, err, context.Canceled)
+		}
+	}
+}
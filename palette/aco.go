@@ -0,0 +1,166 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package palette
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/hajimehoshi/iro"
+)
+
+// The color spaces of ACO files.
+const (
+	acoRGB  = 0
+	acoHSB  = 1
+	acoCMYK = 2
+	acoLab  = 7
+	acoGray = 8
+)
+
+// ParseACO parses a Photoshop color swatch (.aco) file.
+//
+// Both version 1, without names, and version 2, with names, are supported.
+// If a file has both, as Photoshop writes, the version 2 section is used.
+//
+// RGB, HSB, CMYK, Lab, and Grayscale colors are supported. RGB and HSB are interpreted as sRGB,
+// and Lab is interpreted as CIELAB with the D50 white point.
+// CMYK and Grayscale are the amounts of ink, where 0 is the full ink for CMYK and no ink for Grayscale, as Photoshop stores.
+func ParseACO(r io.Reader) ([]Swatch, error) {
+	br := bufio.NewReader(r)
+	swatches, err := parseACOSection(br, 1)
+	if err != nil {
+		return nil, err
+	}
+	swatches2, err := parseACOSection(br, 2)
+	if errors.Is(err, io.EOF) {
+		return swatches, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return swatches2, nil
+}
+
+// parseACOSection parses a section of the version.
+// parseACOSection returns io.EOF if there are no more sections.
+func parseACOSection(r io.Reader, version uint16) ([]Swatch, error) {
+	var header struct {
+		Version uint16
+		Count   uint16
+	}
+	if err := binary.Read(r, binary.BigEndian, &header); err != nil {
+		if version == 2 && err == io.EOF {
+			return nil, io.EOF
+		}
+		return nil, fmt.Errorf("palette: invalid ACO header: %w", err)
+	}
+	if header.Version != version {
+		return nil, fmt.Errorf("palette: ACO version must be %d but %d", version, header.Version)
+	}
+
+	swatches := make([]Swatch, 0, header.Count)
+	for i := 0; i < int(header.Count); i++ {
+		var v struct {
+			Space  uint16
+			Values [4]uint16
+		}
+		if err := binary.Read(r, binary.BigEndian, &v); err != nil {
+			return nil, fmt.Errorf("palette: color %d: %w", i, err)
+		}
+		s, err := acoSwatch(v.Space, v.Values)
+		if err != nil {
+			return nil, fmt.Errorf("palette: color %d: %w", i, err)
+		}
+		if version == 2 {
+			name, err := readUTF16String(r, true)
+			if err != nil {
+				return nil, fmt.Errorf("palette: color %d: invalid name: %w", i, err)
+			}
+			s.Name = name
+		}
+		swatches = append(swatches, s)
+	}
+	return swatches, nil
+}
+
+func acoSwatch(space uint16, v [4]uint16) (Swatch, error) {
+	f := func(i int) float64 {
+		return float64(v[i]) / 0xffff
+	}
+	switch space {
+	case acoRGB:
+		return Swatch{Color: iro.ColorFromSRGB(f(0), f(1), f(2), 1), Model: ModelRGB}, nil
+	case acoHSB:
+		return Swatch{Color: colorFromHSB(f(0)*360, f(1), f(2)), Model: ModelHSB}, nil
+	case acoCMYK:
+		return Swatch{Color: colorFromCMYK(1-f(0), 1-f(1), 1-f(2), 1-f(3)), Model: ModelCMYK}, nil
+	case acoLab:
+		l := float64(v[0]) / 100
+		a := float64(int16(v[1])) / 100
+		b := float64(int16(v[2])) / 100
+		return Swatch{Color: iro.ColorFromLab(l, a, b, 1), Model: ModelLab}, nil
+	case acoGray:
+		g := 1 - float64(v[0])/10000
+		return Swatch{Color: iro.ColorFromSRGB(g, g, g, 1), Model: ModelGray}, nil
+	default:
+		return Swatch{}, fmt.Errorf("unsupported color space: %d", space)
+	}
+}
+
+// EncodeACO writes the swatches in the Photoshop color swatch (.aco) format.
+//
+// Both the version 1 and the version 2 sections are written, as Photoshop does.
+// The colors are stored in the models of the swatches. Group is ignored.
+func EncodeACO(w io.Writer, swatches []Swatch) error {
+	if len(swatches) > math.MaxUint16 {
+		return fmt.Errorf("palette: too many swatches for ACO: %d", len(swatches))
+	}
+	var b []byte
+	for _, version := range []uint16{1, 2} {
+		b = binary.BigEndian.AppendUint16(b, version)
+		b = binary.BigEndian.AppendUint16(b, uint16(len(swatches)))
+		for _, s := range swatches {
+			space, v := acoValues(s)
+			b = binary.BigEndian.AppendUint16(b, space)
+			for _, x := range v {
+				b = binary.BigEndian.AppendUint16(b, x)
+			}
+			if version == 2 {
+				b = appendUTF16String(b, s.Name, true)
+			}
+		}
+	}
+	_, err := w.Write(b)
+	return err
+}
+
+func acoValues(s Swatch) (uint16, [4]uint16) {
+	u := func(v float64) uint16 {
+		return uint16(math.Round(clamp01(v) * 0xffff))
+	}
+	switch s.Model {
+	case ModelHSB:
+		h, sat, v := hsb(s.Color)
+		return acoHSB, [4]uint16{u(h / 360), u(sat), u(v)}
+	case ModelCMYK:
+		c, m, y, k := cmyk(s.Color)
+		return acoCMYK, [4]uint16{u(1 - c), u(1 - m), u(1 - y), u(1 - k)}
+	case ModelLab:
+		l, a, b, _ := s.Color.Lab()
+		i := func(v, lo, hi float64) uint16 {
+			return uint16(int16(math.Round(min(max(v, lo), hi) * 100)))
+		}
+		return acoLab, [4]uint16{uint16(math.Round(min(max(l, 0), 100) * 100)), i(a, -128, 127), i(b, -128, 127)}
+	case ModelGray:
+		return acoGray, [4]uint16{uint16(math.Round((1 - gray(s.Color)) * 10000))}
+	default:
+		r, g, b := srgb(s.Color)
+		return acoRGB, [4]uint16{u(r), u(g), u(b)}
+	}
+}
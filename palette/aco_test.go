@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package palette_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+	"github.com/hajimehoshi/iro/palette"
+)
+
+func TestACORoundTrip(t *testing.T) {
+	in := testSwatches()
+	var buf bytes.Buffer
+	if err := palette.EncodeACO(&buf, in); err != nil {
+		t.Fatal(err)
+	}
+	out, err := palette.ParseACO(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(out), len(in); got != want {
+		t.Fatalf("len: got %d, want %d", got, want)
+	}
+	for i := range in {
+		// ACO doesn't support groups.
+		want := in[i]
+		got := out[i]
+		if got.Name != want.Name || got.Model != want.Model || got.Group != "" {
+			t.Errorf("swatch %d: got %q (%s, %q), want %q (%s, %q)", i, got.Name, got.Model, got.Group, want.Name, want.Model, "")
+		}
+		// Lab is stored in 0.01 steps and Gray is stored in 0.0001 steps.
+		if !sameSRGB(got.Color, want.Color, 1e-3) {
+			t.Errorf("swatch %d: got %v, want %v", i, got.Color, want.Color)
+		}
+	}
+}
+
+func TestParseACOVersion1(t *testing.T) {
+	// A version 1 file with the RGB color (1, 0, 1) and the gray of no ink.
+	data := []byte{
+		0, 1, 0, 2,
+		0, 0, 0xff, 0xff, 0, 0, 0xff, 0xff, 0, 0,
+		0, 8, 0, 0, 0, 0, 0, 0, 0, 0,
+	}
+	out, err := palette.ParseACO(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []palette.Swatch{
+		{Color: iro.ColorFromSRGB(1, 0, 1, 1), Model: palette.ModelRGB},
+		{Color: iro.ColorFromSRGB(1, 1, 1, 1), Model: palette.ModelGray},
+	}
+	if len(out) != len(want) {
+		t.Fatalf("len: got %d, want %d", len(out), len(want))
+	}
+	for i := range want {
+		if out[i].Name != "" || out[i].Model != want[i].Model || !sameSRGB(out[i].Color, want[i].Color, 1e-6) {
+			t.Errorf("swatch %d: got %+v, want %+v", i, out[i], want[i])
+		}
+	}
+}
+
+func TestParseACOError(t *testing.T) {
+	testCases := []struct {
+		name string
+		data []byte
+	}{
+		{name: "empty", data: nil},
+		{name: "version", data: []byte{0, 3, 0, 0}},
+		{name: "truncated", data: []byte{0, 1, 0, 1, 0, 0, 0xff}},
+		{name: "space", data: []byte{0, 1, 0, 1, 0, 99, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{name: "version 2", data: []byte{0, 1, 0, 0, 0, 1, 0, 0}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := palette.ParseACO(bytes.NewReader(tc.data)); err == nil {
+				t.Errorf("ParseACO: got no error")
+			}
+		})
+	}
+}
@@ -0,0 +1,253 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package palette
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"unicode/utf16"
+
+	"github.com/hajimehoshi/iro"
+)
+
+// The block types of ASE files.
+const (
+	aseBlockColor      = 0x0001
+	aseBlockGroupStart = 0xc001
+	aseBlockGroupEnd   = 0xc002
+)
+
+// maxBlockLength is the maximum length of an ASE block in bytes, to reject broken files.
+const maxBlockLength = 1 << 20
+
+// aseColorTypeNormal is the color type of process colors that are not global.
+const aseColorTypeNormal = 2
+
+// ParseASE parses an Adobe Swatch Exchange (.ase) file.
+//
+// RGB, CMYK, LAB, and Gray colors are supported. RGB is interpreted as sRGB,
+// and LAB is interpreted as CIELAB with the D50 white point, where L is stored in [0, 1].
+// Gray is the sRGB gray level in [0, 1].
+// The color types (global, spot, and normal) are ignored.
+func ParseASE(r io.Reader) ([]Swatch, error) {
+	br := bufio.NewReader(r)
+	var header struct {
+		Signature [4]byte
+		Major     uint16
+		Minor     uint16
+		Blocks    uint32
+	}
+	if err := binary.Read(br, binary.BigEndian, &header); err != nil {
+		return nil, fmt.Errorf("palette: invalid ASE header: %w", err)
+	}
+	if string(header.Signature[:]) != "ASEF" {
+		return nil, fmt.Errorf("palette: invalid ASE signature: %q", header.Signature[:])
+	}
+	if header.Major != 1 {
+		return nil, fmt.Errorf("palette: unsupported ASE version: %d.%d", header.Major, header.Minor)
+	}
+
+	var swatches []Swatch
+	var group string
+	for i := uint32(0); i < header.Blocks; i++ {
+		var blockType uint16
+		var length uint32
+		if err := binary.Read(br, binary.BigEndian, &blockType); err != nil {
+			return nil, fmt.Errorf("palette: block %d: %w", i, err)
+		}
+		if err := binary.Read(br, binary.BigEndian, &length); err != nil {
+			return nil, fmt.Errorf("palette: block %d: %w", i, err)
+		}
+		if length > maxBlockLength {
+			return nil, fmt.Errorf("palette: block %d: too long block: %d bytes", i, length)
+		}
+		data := make([]byte, length)
+		if _, err := io.ReadFull(br, data); err != nil {
+			return nil, fmt.Errorf("palette: block %d: %w", i, err)
+		}
+		d := bytes.NewReader(data)
+
+		switch blockType {
+		case aseBlockGroupStart:
+			name, err := readUTF16String(d, false)
+			if err != nil {
+				return nil, fmt.Errorf("palette: block %d: invalid group name: %w", i, err)
+			}
+			group = name
+		case aseBlockGroupEnd:
+			group = ""
+		case aseBlockColor:
+			s, err := parseASEColor(d)
+			if err != nil {
+				return nil, fmt.Errorf("palette: block %d: %w", i, err)
+			}
+			s.Group = group
+			swatches = append(swatches, s)
+		default:
+			// Skip unknown blocks.
+		}
+	}
+	return swatches, nil
+}
+
+func parseASEColor(r io.Reader) (Swatch, error) {
+	name, err := readUTF16String(r, false)
+	if err != nil {
+		return Swatch{}, fmt.Errorf("invalid color name: %w", err)
+	}
+	var model [4]byte
+	if _, err := io.ReadFull(r, model[:]); err != nil {
+		return Swatch{}, fmt.Errorf("invalid color model: %w", err)
+	}
+
+	var n int
+	var m Model
+	switch string(model[:]) {
+	case "RGB ":
+		n, m = 3, ModelRGB
+	case "CMYK":
+		n, m = 4, ModelCMYK
+	case "LAB ":
+		n, m = 3, ModelLab
+	case "Gray":
+		n, m = 1, ModelGray
+	default:
+		return Swatch{}, fmt.Errorf("unsupported color model: %q", model[:])
+	}
+	v := make([]float32, n)
+	if err := binary.Read(r, binary.BigEndian, v); err != nil {
+		return Swatch{}, fmt.Errorf("invalid color values: %w", err)
+	}
+
+	var c iro.Color
+	switch m {
+	case ModelRGB:
+		c = iro.ColorFromSRGB(float64(v[0]), float64(v[1]), float64(v[2]), 1)
+	case ModelCMYK:
+		c = colorFromCMYK(float64(v[0]), float64(v[1]), float64(v[2]), float64(v[3]))
+	case ModelLab:
+		c = iro.ColorFromLab(float64(v[0])*100, float64(v[1]), float64(v[2]), 1)
+	case ModelGray:
+		c = iro.ColorFromSRGB(float64(v[0]), float64(v[0]), float64(v[0]), 1)
+	}
+	return Swatch{
+		Name:  name,
+		Color: c,
+		Model: m,
+	}, nil
+}
+
+// EncodeASE writes the swatches in the Adobe Swatch Exchange (.ase) format.
+//
+// The colors are stored in the models of the swatches. HSB is not supported by ASE and is stored as RGB.
+// Consecutive swatches with the same non-empty Group are written in a group.
+func EncodeASE(w io.Writer, swatches []Swatch) error {
+	var blocks [][]byte
+	block := func(blockType uint16, data []byte) {
+		b := binary.BigEndian.AppendUint16(nil, blockType)
+		b = binary.BigEndian.AppendUint32(b, uint32(len(data)))
+		blocks = append(blocks, append(b, data...))
+	}
+
+	var group string
+	for _, s := range swatches {
+		if s.Group != group {
+			if group != "" {
+				block(aseBlockGroupEnd, nil)
+			}
+			if s.Group != "" {
+				block(aseBlockGroupStart, appendUTF16String(nil, s.Group, false))
+			}
+			group = s.Group
+		}
+
+		data := appendUTF16String(nil, s.Name, false)
+		var v []float64
+		switch s.Model {
+		case ModelCMYK:
+			data = append(data, "CMYK"...)
+			c, m, y, k := cmyk(s.Color)
+			v = []float64{c, m, y, k}
+		case ModelLab:
+			data = append(data, "LAB "...)
+			l, a, b, _ := s.Color.Lab()
+			v = []float64{l / 100, a, b}
+		case ModelGray:
+			data = append(data, "Gray"...)
+			v = []float64{gray(s.Color)}
+		default:
+			data = append(data, "RGB "...)
+			r, g, b := srgb(s.Color)
+			v = []float64{r, g, b}
+		}
+		for _, f := range v {
+			data = binary.BigEndian.AppendUint32(data, math.Float32bits(float32(f)))
+		}
+		data = binary.BigEndian.AppendUint16(data, aseColorTypeNormal)
+		block(aseBlockColor, data)
+	}
+	if group != "" {
+		block(aseBlockGroupEnd, nil)
+	}
+
+	b := []byte("ASEF")
+	b = binary.BigEndian.AppendUint16(b, 1)
+	b = binary.BigEndian.AppendUint16(b, 0)
+	b = binary.BigEndian.AppendUint32(b, uint32(len(blocks)))
+	for _, bl := range blocks {
+		b = append(b, bl...)
+	}
+	_, err := w.Write(b)
+	return err
+}
+
+// readUTF16String reads a null-terminated UTF-16BE string prefixed with its length in code units including the terminator.
+// If long is true, the length is 32 bits. Otherwise, the length is 16 bits.
+func readUTF16String(r io.Reader, long bool) (string, error) {
+	var n uint32
+	if long {
+		if err := binary.Read(r, binary.BigEndian, &n); err != nil {
+			return "", err
+		}
+	} else {
+		var n16 uint16
+		if err := binary.Read(r, binary.BigEndian, &n16); err != nil {
+			return "", err
+		}
+		n = uint32(n16)
+	}
+	if n == 0 {
+		return "", nil
+	}
+	if n > 1<<16 {
+		return "", fmt.Errorf("too long string: %d", n)
+	}
+	units := make([]uint16, n)
+	if err := binary.Read(r, binary.BigEndian, units); err != nil {
+		return "", err
+	}
+	if units[n-1] == 0 {
+		units = units[:n-1]
+	}
+	return string(utf16.Decode(units)), nil
+}
+
+// appendUTF16String appends a null-terminated UTF-16BE string prefixed with its length in code units including the terminator.
+// If long is true, the length is 32 bits. Otherwise, the length is 16 bits.
+func appendUTF16String(b []byte, s string, long bool) []byte {
+	units := append(utf16.Encode([]rune(s)), 0)
+	if long {
+		b = binary.BigEndian.AppendUint32(b, uint32(len(units)))
+	} else {
+		b = binary.BigEndian.AppendUint16(b, uint16(len(units)))
+	}
+	for _, u := range units {
+		b = binary.BigEndian.AppendUint16(b, u)
+	}
+	return b
+}
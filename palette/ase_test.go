@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package palette_test
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+	"github.com/hajimehoshi/iro/palette"
+)
+
+// testSwatches returns swatches in all the models.
+func testSwatches() []palette.Swatch {
+	return []palette.Swatch{
+		{Name: "Red", Color: iro.ColorFromSRGB(1, 0, 0, 1), Model: palette.ModelRGB, Group: "Primary"},
+		{Name: "Teal", Color: iro.ColorFromSRGB(0, 0.5, 0.5, 1), Model: palette.ModelCMYK, Group: "Primary"},
+		{Name: "Sand", Color: iro.ColorFromSRGB(0.8, 0.7, 0.5, 1), Model: palette.ModelLab},
+		{Name: "灰色", Color: iro.ColorFromSRGB(0.25, 0.25, 0.25, 1), Model: palette.ModelGray, Group: "Neutral"},
+		{Name: "", Color: iro.ColorFromSRGB(0.2, 0.4, 0.9, 1), Model: palette.ModelHSB},
+	}
+}
+
+// sameSRGB reports whether the sRGB components of the colors are within the tolerance.
+func sameSRGB(a, b iro.Color, tol float64) bool {
+	r0, g0, b0, _ := a.SRGB()
+	r1, g1, b1, _ := b.SRGB()
+	return math.Abs(r0-r1) <= tol && math.Abs(g0-g1) <= tol && math.Abs(b0-b1) <= tol
+}
+
+func TestASERoundTrip(t *testing.T) {
+	in := testSwatches()
+	var buf bytes.Buffer
+	if err := palette.EncodeASE(&buf, in); err != nil {
+		t.Fatal(err)
+	}
+	out, err := palette.ParseASE(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(out), len(in); got != want {
+		t.Fatalf("len: got %d, want %d", got, want)
+	}
+	for i := range in {
+		want := in[i]
+		// ASE doesn't support HSB.
+		if want.Model == palette.ModelHSB {
+			want.Model = palette.ModelRGB
+		}
+		got := out[i]
+		if got.Name != want.Name || got.Model != want.Model || got.Group != want.Group {
+			t.Errorf("swatch %d: got %q (%s, %q), want %q (%s, %q)", i, got.Name, got.Model, got.Group, want.Name, want.Model, want.Group)
+		}
+		if !sameSRGB(got.Color, want.Color, 1e-5) {
+			t.Errorf("swatch %d: got %v, want %v", i, got.Color, want.Color)
+		}
+	}
+}
+
+func TestParseASE(t *testing.T) {
+	// A file with one RGB color "A" of (1, 0.5, 0).
+	data := []byte{
+		'A', 'S', 'E', 'F', 0, 1, 0, 0, 0, 0, 0, 1,
+		0, 1, 0, 0, 0, 22,
+		0, 2, 0, 'A', 0, 0,
+		'R', 'G', 'B', ' ',
+		0x3f, 0x80, 0, 0, 0x3f, 0, 0, 0, 0, 0, 0, 0,
+		0, 2,
+	}
+	out, err := palette.ParseASE(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(out) != 1 {
+		t.Fatalf("len: got %d, want 1", len(out))
+	}
+	if got, want := out[0].Name, "A"; got != want {
+		t.Errorf("Name: got %q, want %q", got, want)
+	}
+	if want := iro.ColorFromSRGB(1, 0.5, 0, 1); !sameSRGB(out[0].Color, want, 1e-6) {
+		t.Errorf("Color: got %v, want %v", out[0].Color, want)
+	}
+}
+
+func TestParseASEError(t *testing.T) {
+	testCases := []struct {
+		name string
+		data []byte
+	}{
+		{name: "empty", data: nil},
+		{name: "signature", data: []byte{'A', 'S', 'E', 'X', 0, 1, 0, 0, 0, 0, 0, 0}},
+		{name: "version", data: []byte{'A', 'S', 'E', 'F', 0, 2, 0, 0, 0, 0, 0, 0}},
+		{name: "truncated", data: []byte{'A', 'S', 'E', 'F', 0, 1, 0, 0, 0, 0, 0, 1, 0, 1, 0, 0, 0, 22, 0}},
+		{name: "model", data: []byte{'A', 'S', 'E', 'F', 0, 1, 0, 0, 0, 0, 0, 1, 0, 1, 0, 0, 0, 10, 0, 1, 0, 0, 'X', 'Y', 'Z', ' ', 0, 2}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := palette.ParseASE(bytes.NewReader(tc.data)); err == nil {
+				t.Errorf("ParseASE: got no error")
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package palette
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+)
+
+// identifierWords returns the words of the group and the name of the swatch at the index for identifiers in source code.
+// The words are split at the characters other than ASCII letters and digits, and at the boundaries of camel case.
+func identifierWords(s Swatch, index int) []string {
+	var words []string
+	for _, part := range swatchPath(s, index) {
+		var word []rune
+		flush := func() {
+			if len(word) > 0 {
+				words = append(words, strings.ToLower(string(word)))
+				word = word[:0]
+			}
+		}
+		runes := []rune(part)
+		for i, r := range runes {
+			if r > unicode.MaxASCII || !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+				flush()
+				continue
+			}
+			// Split "primaryRed" into "primary" and "red", and "HTTPServer" into "http" and "server".
+			if unicode.IsUpper(r) && len(word) > 0 {
+				prev := word[len(word)-1]
+				if !unicode.IsUpper(prev) || i+1 < len(runes) && unicode.IsLower(runes[i+1]) {
+					flush()
+				}
+			}
+			word = append(word, r)
+		}
+		flush()
+	}
+	if len(words) == 0 || unicode.IsDigit(rune(words[0][0])) {
+		words = append([]string{"color"}, words...)
+	}
+	return words
+}
+
+// camelCase returns the words in lower camel case like "primaryRed".
+func camelCase(words []string) string {
+	var b strings.Builder
+	for i, w := range words {
+		if i > 0 {
+			w = strings.ToUpper(w[:1]) + w[1:]
+		}
+		b.WriteString(w)
+	}
+	return b.String()
+}
+
+// uniqueIdentifiers returns the identifiers of the swatches, or an error if any identifiers are duplicated.
+func uniqueIdentifiers(swatches []Swatch, f func(words []string) string) ([]string, error) {
+	ids := make([]string, len(swatches))
+	seen := map[string]int{}
+	for i, s := range swatches {
+		id := f(identifierWords(s, i))
+		if j, ok := seen[id]; ok {
+			return nil, fmt.Errorf("palette: swatches %d and %d have the same identifier: %s", j, i, id)
+		}
+		seen[id] = i
+		ids[i] = id
+	}
+	return ids, nil
+}
+
+// EncodeFlutter writes the swatches as a Dart class of Flutter with the color constants.
+//
+// The names of the constants are the groups and the names of the swatches in lower camel case, like primaryRed.
+// Swatches without names are named by their 1-based indices, like color3.
+// The colors are clamped to the sRGB gamut and written in 8 bits.
+//
+// className is the name of the class. If className is empty, "AppColors" is used.
+func EncodeFlutter(w io.Writer, swatches []Swatch, className string) error {
+	if className == "" {
+		className = "AppColors"
+	}
+	ids, err := uniqueIdentifiers(swatches, camelCase)
+	if err != nil {
+		return err
+	}
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, "import 'package:flutter/material.dart';")
+	fmt.Fprintln(bw)
+	fmt.Fprintf(bw, "class %s {\n", className)
+	fmt.Fprintf(bw, "  %s._();\n", className)
+	if len(swatches) > 0 {
+		fmt.Fprintln(bw)
+	}
+	for i, s := range swatches {
+		c := s.Color.SRGBNRGBA()
+		fmt.Fprintf(bw, "  static const Color %s = Color(0x%02X%02X%02X%02X);\n", ids[i], c.A, c.R, c.G, c.B)
+	}
+	fmt.Fprintln(bw, "}")
+	return bw.Flush()
+}
+
+// EncodeAndroidXML writes the swatches as color resources of Android.
+//
+// The names of the resources are the groups and the names of the swatches in snake case, like primary_red.
+// Swatches without names are named by their 1-based indices, like color_3.
+// The colors are clamped to the sRGB gamut and written in 8 bits, like #FF8000, or #80FF8000 if they are not opaque.
+func EncodeAndroidXML(w io.Writer, swatches []Swatch) error {
+	ids, err := uniqueIdentifiers(swatches, func(words []string) string {
+		return strings.Join(words, "_")
+	})
+	if err != nil {
+		return err
+	}
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, `<?xml version="1.0" encoding="utf-8"?>`)
+	fmt.Fprintln(bw, "<resources>")
+	for i, s := range swatches {
+		c := s.Color.SRGBNRGBA()
+		if c.A == 0xff {
+			fmt.Fprintf(bw, "    <color name=\"%s\">#%02X%02X%02X</color>\n", ids[i], c.R, c.G, c.B)
+		} else {
+			fmt.Fprintf(bw, "    <color name=\"%s\">#%02X%02X%02X%02X</color>\n", ids[i], c.A, c.R, c.G, c.B)
+		}
+	}
+	fmt.Fprintln(bw, "</resources>")
+	return bw.Flush()
+}
@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package palette_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+	"github.com/hajimehoshi/iro/palette"
+)
+
+var codeSwatches = []palette.Swatch{
+	{Name: "Primary Red", Group: "brand", Color: iro.ColorFromSRGB(1, 0, 0, 1)},
+	{Name: "darkOlive-green", Color: iro.ColorFromSRGB(0.2, 0.3, 0.1, 1)},
+	{Name: "", Color: iro.ColorFromSRGB(0, 0, 0, 0.5)},
+}
+
+func TestEncodeFlutter(t *testing.T) {
+	var buf bytes.Buffer
+	if err := palette.EncodeFlutter(&buf, codeSwatches, ""); err != nil {
+		t.Fatal(err)
+	}
+	want := `import 'package:flutter/material.dart';
+
+class AppColors {
+  AppColors._();
+
+  static const Color brandPrimaryRed = Color(0xFFFF0000);
+  static const Color darkOliveGreen = Color(0xFF334D1A);
+  static const Color color3 = Color(0x80000000);
+}
+`
+	if got := buf.String(); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestEncodeAndroidXML(t *testing.T) {
+	var buf bytes.Buffer
+	if err := palette.EncodeAndroidXML(&buf, codeSwatches); err != nil {
+		t.Fatal(err)
+	}
+	want := `<?xml version="1.0" encoding="utf-8"?>
+<resources>
+    <color name="brand_primary_red">#FF0000</color>
+    <color name="dark_olive_green">#334D1A</color>
+    <color name="color_3">#80000000</color>
+</resources>
+`
+	if got := buf.String(); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestEncodeCodeDuplicated(t *testing.T) {
+	swatches := []palette.Swatch{{Name: "Sky Blue"}, {Name: "sky-blue"}}
+	if err := palette.EncodeFlutter(&bytes.Buffer{}, swatches, ""); err == nil {
+		t.Errorf("EncodeFlutter: got no error")
+	}
+	if err := palette.EncodeAndroidXML(&bytes.Buffer{}, swatches); err == nil {
+		t.Errorf("EncodeAndroidXML: got no error")
+	}
+}
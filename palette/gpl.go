@@ -0,0 +1,150 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package palette
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/hajimehoshi/iro"
+)
+
+// GPL represents a GIMP palette (.gpl) file.
+type GPL struct {
+	// Name is the name of the palette.
+	Name string
+
+	// Columns is the number of columns to display the palette with. Zero means unspecified.
+	Columns int
+
+	// Swatches is the colors of the palette. The models of the swatches are always ModelRGB.
+	Swatches []Swatch
+}
+
+// ParseGPL parses a GIMP palette (.gpl) file.
+//
+// The colors are interpreted as 8-bit sRGB.
+// The "Channels: RGBA" header of Aseprite is also supported, where each color has the alpha as the fourth value.
+func ParseGPL(r io.Reader) (*GPL, error) {
+	s := bufio.NewScanner(r)
+	if !s.Scan() {
+		if err := s.Err(); err != nil {
+			return nil, fmt.Errorf("palette: invalid GPL header: %w", err)
+		}
+		return nil, fmt.Errorf("palette: invalid GPL header: empty file")
+	}
+	if line := strings.TrimSpace(strings.TrimPrefix(s.Text(), "\ufeff")); line != "GIMP Palette" {
+		return nil, fmt.Errorf("palette: invalid GPL header: %q", line)
+	}
+
+	var gpl GPL
+	channels := 3
+	lineNo := 1
+	for s.Scan() {
+		lineNo++
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if k, v, ok := strings.Cut(line, ":"); ok && len(gpl.Swatches) == 0 {
+			switch strings.TrimSpace(k) {
+			case "Name":
+				gpl.Name = strings.TrimSpace(v)
+				continue
+			case "Columns":
+				n, err := strconv.Atoi(strings.TrimSpace(v))
+				if err != nil || n < 0 {
+					return nil, fmt.Errorf("palette: line %d: invalid Columns: %q", lineNo, line)
+				}
+				gpl.Columns = n
+				continue
+			case "Channels":
+				switch strings.TrimSpace(v) {
+				case "RGB":
+					channels = 3
+				case "RGBA":
+					channels = 4
+				default:
+					return nil, fmt.Errorf("palette: line %d: invalid Channels: %q", lineNo, line)
+				}
+				continue
+			}
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) < channels {
+			return nil, fmt.Errorf("palette: line %d: invalid color: %q", lineNo, line)
+		}
+		var v [4]float64
+		v[3] = 255
+		for i := 0; i < channels; i++ {
+			n, err := strconv.Atoi(fields[i])
+			if err != nil || n < 0 || n > 255 {
+				return nil, fmt.Errorf("palette: line %d: invalid color: %q", lineNo, line)
+			}
+			v[i] = float64(n)
+		}
+		gpl.Swatches = append(gpl.Swatches, Swatch{
+			Name:  strings.Join(fields[channels:], " "),
+			Color: iro.ColorFromSRGB(v[0]/255, v[1]/255, v[2]/255, v[3]/255),
+			Model: ModelRGB,
+		})
+	}
+	if err := s.Err(); err != nil {
+		return nil, fmt.Errorf("palette: %w", err)
+	}
+	return &gpl, nil
+}
+
+// EncodeGPL writes the palette in the GIMP palette (.gpl) format.
+//
+// The colors are written as 8-bit sRGB regardless of the models of the swatches.
+// If any color is translucent, the "Channels: RGBA" header of Aseprite is written with the alpha values.
+func EncodeGPL(w io.Writer, gpl *GPL) error {
+	if strings.ContainsAny(gpl.Name, "\r\n") {
+		return fmt.Errorf("palette: the name must not include a newline: %q", gpl.Name)
+	}
+	if gpl.Columns < 0 {
+		return fmt.Errorf("palette: the number of columns must not be negative: %d", gpl.Columns)
+	}
+	var rgba bool
+	for _, s := range gpl.Swatches {
+		if strings.ContainsAny(s.Name, "\r\n") {
+			return fmt.Errorf("palette: the name of a swatch must not include a newline: %q", s.Name)
+		}
+		if _, _, _, a := s.Color.SRGB(); a < 1 {
+			rgba = true
+		}
+	}
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, "GIMP Palette")
+	if gpl.Name != "" {
+		fmt.Fprintf(bw, "Name: %s\n", gpl.Name)
+	}
+	if gpl.Columns > 0 {
+		fmt.Fprintf(bw, "Columns: %d\n", gpl.Columns)
+	}
+	if rgba {
+		fmt.Fprintln(bw, "Channels: RGBA")
+	}
+	fmt.Fprintln(bw, "#")
+	u8 := func(v float64) int {
+		return int(math.Round(clamp01(v) * 255))
+	}
+	for _, s := range gpl.Swatches {
+		r, g, b := srgb(s.Color)
+		fmt.Fprintf(bw, "%3d %3d %3d", u8(r), u8(g), u8(b))
+		if rgba {
+			_, _, _, a := s.Color.SRGB()
+			fmt.Fprintf(bw, " %3d", u8(a))
+		}
+		fmt.Fprintf(bw, "\t%s\n", strings.TrimSpace(s.Name))
+	}
+	return bw.Flush()
+}
@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package palette_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+	"github.com/hajimehoshi/iro/palette"
+)
+
+const testGPL = `GIMP Palette
+Name: Test Palette
+Columns: 4
+#
+# A comment
+255   0   0	Red
+  0 128 255	Sky Blue
+ 16  16  16
+`
+
+func TestParseGPL(t *testing.T) {
+	gpl, err := palette.ParseGPL(strings.NewReader(testGPL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := gpl.Name, "Test Palette"; got != want {
+		t.Errorf("Name: got %q, want %q", got, want)
+	}
+	if got, want := gpl.Columns, 4; got != want {
+		t.Errorf("Columns: got %d, want %d", got, want)
+	}
+	want := []palette.Swatch{
+		{Name: "Red", Color: iro.ColorFromSRGB(1, 0, 0, 1)},
+		{Name: "Sky Blue", Color: iro.ColorFromSRGB(0, 128.0/255, 1, 1)},
+		{Name: "", Color: iro.ColorFromSRGB(16.0/255, 16.0/255, 16.0/255, 1)},
+	}
+	if len(gpl.Swatches) != len(want) {
+		t.Fatalf("len: got %d, want %d", len(gpl.Swatches), len(want))
+	}
+	for i, w := range want {
+		got := gpl.Swatches[i]
+		if got.Name != w.Name || got.Model != palette.ModelRGB || !sameSRGB(got.Color, w.Color, 1e-6) {
+			t.Errorf("swatch %d: got %+v, want %+v", i, got, w)
+		}
+	}
+}
+
+func TestGPLRoundTrip(t *testing.T) {
+	for _, alpha := range []float64{1, 0.5} {
+		in := &palette.GPL{
+			Name:    "Round Trip",
+			Columns: 8,
+			Swatches: []palette.Swatch{
+				{Name: "Orange", Color: iro.ColorFromSRGB(1, 0.5, 0, 1)},
+				{Name: "Dark Olive Green", Color: iro.ColorFromSRGB(0.2, 0.3, 0.1, alpha)},
+			},
+		}
+		var buf bytes.Buffer
+		if err := palette.EncodeGPL(&buf, in); err != nil {
+			t.Fatal(err)
+		}
+		if got, want := strings.Contains(buf.String(), "Channels: RGBA"), alpha < 1; got != want {
+			t.Errorf("alpha=%f: Channels: RGBA: got %t, want %t", alpha, got, want)
+		}
+		out, err := palette.ParseGPL(&buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if out.Name != in.Name || out.Columns != in.Columns {
+			t.Errorf("alpha=%f: got %q (columns: %d), want %q (columns: %d)", alpha, out.Name, out.Columns, in.Name, in.Columns)
+		}
+		if len(out.Swatches) != len(in.Swatches) {
+			t.Fatalf("alpha=%f: len: got %d, want %d", alpha, len(out.Swatches), len(in.Swatches))
+		}
+		for i := range in.Swatches {
+			got, want := out.Swatches[i], in.Swatches[i]
+			_, _, _, ga := got.Color.SRGB()
+			_, _, _, wa := want.Color.SRGB()
+			if got.Name != want.Name || !sameSRGB(got.Color, want.Color, 0.5/255) || ga < wa-0.5/255 || ga > wa+0.5/255 {
+				t.Errorf("alpha=%f: swatch %d: got %+v, want %+v", alpha, i, got, want)
+			}
+		}
+	}
+}
+
+func TestParseGPLError(t *testing.T) {
+	testCases := []struct {
+		name string
+		data string
+	}{
+		{name: "empty", data: ""},
+		{name: "header", data: "JASC-PAL\n"},
+		{name: "columns", data: "GIMP Palette\nColumns: x\n"},
+		{name: "channels", data: "GIMP Palette\nChannels: CMYK\n"},
+		{name: "short", data: "GIMP Palette\n255 0\n"},
+		{name: "range", data: "GIMP Palette\n256 0 0 Red\n"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := palette.ParseGPL(strings.NewReader(tc.data)); err == nil {
+				t.Errorf("ParseGPL: got no error")
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package palette
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/hajimehoshi/iro"
+)
+
+// ParseHex parses a hex palette (.hex) file, the list of hexadecimal sRGB colors like "ff8000" separated by newlines,
+// which Lospec and many pixel art tools use.
+//
+// The colors can have a leading "#", and can have 3, 4, 6, or 8 digits as CSS hexadecimal colors.
+// Empty lines are ignored. The swatches have no names.
+func ParseHex(r io.Reader) ([]Swatch, error) {
+	var swatches []Swatch
+	s := bufio.NewScanner(r)
+	var lineNo int
+	for s.Scan() {
+		lineNo++
+		line := strings.TrimSpace(s.Text())
+		if lineNo == 1 {
+			line = strings.TrimPrefix(line, "\ufeff")
+		}
+		if line == "" {
+			continue
+		}
+		c, err := iro.ParseCSS("#" + strings.TrimPrefix(line, "#"))
+		if err != nil {
+			return nil, fmt.Errorf("palette: line %d: invalid color: %q", lineNo, line)
+		}
+		swatches = append(swatches, Swatch{
+			Color: c,
+			Model: ModelRGB,
+		})
+	}
+	if err := s.Err(); err != nil {
+		return nil, fmt.Errorf("palette: %w", err)
+	}
+	return swatches, nil
+}
+
+// EncodeHex writes the swatches in the hex palette (.hex) format, like "ff8000" for each line.
+//
+// The colors are clamped to the sRGB gamut and written with 6 digits, or with 8 digits if they are not opaque.
+// The names of the swatches are not written.
+func EncodeHex(w io.Writer, swatches []Swatch) error {
+	bw := bufio.NewWriter(w)
+	for _, s := range swatches {
+		fmt.Fprintln(bw, strings.TrimPrefix(s.Color.Hex(), "#"))
+	}
+	return bw.Flush()
+}
@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package palette_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+	"github.com/hajimehoshi/iro/palette"
+)
+
+func TestParseHex(t *testing.T) {
+	got, err := palette.ParseHex(strings.NewReader("ff0044\n#00FF00\r\n\nf00\n00000080\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []iro.Color{
+		iro.ColorFromSRGB(1, 0, 68.0/255, 1),
+		iro.ColorFromSRGB(0, 1, 0, 1),
+		iro.ColorFromSRGB(1, 0, 0, 1),
+		iro.ColorFromSRGB(0, 0, 0, 128.0/255),
+	}
+	if len(got) != len(want) {
+		t.Fatalf("len: got %d, want %d", len(got), len(want))
+	}
+	for i, w := range want {
+		if !sameSRGB(got[i].Color, w, 1e-6) || got[i].Color.Alpha() != w.Alpha() {
+			t.Errorf("swatch %d: got %v, want %v", i, got[i].Color, w)
+		}
+	}
+}
+
+func TestParseHexError(t *testing.T) {
+	for _, data := range []string{"ff00\nzzzzzz\n", "ff00000\n", "rgb(0 0 0)\n"} {
+		if _, err := palette.ParseHex(strings.NewReader(data)); err == nil {
+			t.Errorf("ParseHex(%q): got no error", data)
+		}
+	}
+}
+
+func TestEncodeHex(t *testing.T) {
+	swatches := []palette.Swatch{
+		{Name: "Red", Color: iro.ColorFromSRGB(1, 0, 0, 1)},
+		{Color: iro.ColorFromSRGB(0, 128.0/255, 1, 1)},
+		{Color: iro.ColorFromSRGB(0, 0, 0, 128.0/255)},
+	}
+	var buf bytes.Buffer
+	if err := palette.EncodeHex(&buf, swatches); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := buf.String(), "ff0000\n0080ff\n00000080\n"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
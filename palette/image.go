@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package palette
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+
+	"github.com/hajimehoshi/iro"
+)
+
+// ParsePNG parses a PNG image of a palette strip, the colors of a palette lined up in a row or a grid,
+// as Lospec and other communities distribute palettes. See [SwatchesFromImage] for the details.
+func ParsePNG(r io.Reader) ([]Swatch, error) {
+	img, err := png.Decode(r)
+	if err != nil {
+		return nil, fmt.Errorf("palette: %w", err)
+	}
+	return SwatchesFromImage(img), nil
+}
+
+// SwatchesFromImage extracts the colors of a palette strip image in order.
+//
+// The pixels are scanned from the top row to the bottom row and from left to right.
+// Runs of identical pixels in a row and rows identical to the previous row are treated as one color,
+// so that scaled strips like 8x ones have the same colors as the original ones.
+// Fully transparent pixels, used as padding, are skipped.
+// Colors that appear again after other colors are kept, as a palette might have the same colors at different positions.
+//
+// The colors are interpreted as sRGB, and the swatches have no names.
+func SwatchesFromImage(img image.Image) []Swatch {
+	b := img.Bounds()
+	var swatches []Swatch
+	row := make([]color.NRGBA64, b.Dx())
+	prevRow := make([]color.NRGBA64, b.Dx())
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			row[x-b.Min.X] = color.NRGBA64Model.Convert(img.At(x, y)).(color.NRGBA64)
+		}
+		if y > b.Min.Y && equalRows(row, prevRow) {
+			continue
+		}
+		for i, c := range row {
+			if c.A == 0 {
+				continue
+			}
+			if i > 0 && row[i-1] == c {
+				continue
+			}
+			swatches = append(swatches, Swatch{
+				Color: iro.ColorFromSRGBNRGBA64(c),
+				Model: ModelRGB,
+			})
+		}
+		row, prevRow = prevRow, row
+	}
+	return swatches
+}
+
+func equalRows(a, b []color.NRGBA64) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package palette_test
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+	"github.com/hajimehoshi/iro/palette"
+)
+
+// stripImage returns an image of the colors in a grid with the columns, where each color is scaled by the scale.
+func stripImage(colors []color.NRGBA, columns, scale int) *image.NRGBA {
+	rows := (len(colors) + columns - 1) / columns
+	img := image.NewNRGBA(image.Rect(0, 0, columns*scale, rows*scale))
+	for i, c := range colors {
+		x0, y0 := i%columns*scale, i/columns*scale
+		for y := y0; y < y0+scale; y++ {
+			for x := x0; x < x0+scale; x++ {
+				img.SetNRGBA(x, y, c)
+			}
+		}
+	}
+	return img
+}
+
+func TestParsePNG(t *testing.T) {
+	colors := []color.NRGBA{
+		{0x1a, 0x1c, 0x2c, 0xff},
+		{0x5d, 0x27, 0x5d, 0xff},
+		{0xb1, 0x3e, 0x53, 0xff},
+		{0x1a, 0x1c, 0x2c, 0xff},
+		{0xef, 0x7d, 0x57, 0xff},
+	}
+	testCases := []struct {
+		name    string
+		columns int
+		scale   int
+	}{
+		{name: "1x", columns: 5, scale: 1},
+		{name: "8x", columns: 5, scale: 8},
+		{name: "grid", columns: 2, scale: 4},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := png.Encode(&buf, stripImage(colors, tc.columns, tc.scale)); err != nil {
+				t.Fatal(err)
+			}
+			got, err := palette.ParsePNG(&buf)
+			if err != nil {
+				t.Fatal(err)
+			}
+			// The last row of the grid has a transparent padding.
+			if len(got) != len(colors) {
+				t.Fatalf("len: got %d, want %d", len(got), len(colors))
+			}
+			for i, c := range colors {
+				if want := iro.ColorFromSRGBNRGBA(c); !sameSRGB(got[i].Color, want, 1e-6) {
+					t.Errorf("swatch %d: got %v, want %v", i, got[i].Color, want)
+				}
+			}
+		})
+	}
+}
+
+func TestParsePNGError(t *testing.T) {
+	if _, err := palette.ParsePNG(bytes.NewReader([]byte("GIF89a"))); err == nil {
+		t.Errorf("ParsePNG: got no error")
+	}
+}
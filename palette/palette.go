@@ -0,0 +1,135 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+// Package palette provides readers and writers of palette files of design and pixel art tools.
+package palette
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/hajimehoshi/iro"
+)
+
+// Model specifies the color model in which a color is stored in a palette file.
+type Model int
+
+const (
+	// ModelRGB represents sRGB.
+	ModelRGB Model = iota
+
+	// ModelCMYK represents CMYK, converted from and to sRGB naively without any color profiles.
+	ModelCMYK
+
+	// ModelLab represents CIELAB with the D50 white point, the same as [iro.Color.Lab].
+	ModelLab
+
+	// ModelGray represents grayscale, converted from and to sRGB gray.
+	ModelGray
+
+	// ModelHSB represents HSB (HSV) of sRGB.
+	ModelHSB
+)
+
+// String implements fmt.Stringer.
+func (m Model) String() string {
+	switch m {
+	case ModelRGB:
+		return "RGB"
+	case ModelCMYK:
+		return "CMYK"
+	case ModelLab:
+		return "Lab"
+	case ModelGray:
+		return "Gray"
+	case ModelHSB:
+		return "HSB"
+	default:
+		return fmt.Sprintf("Model(%d)", m)
+	}
+}
+
+// Swatch is a named color in a palette.
+type Swatch struct {
+	// Name is the name of the swatch. Name might be empty.
+	Name string
+
+	// Color is the color of the swatch.
+	Color iro.Color
+
+	// Model is the color model in which the color is stored in the file.
+	// Encoders store the color in the model if the format supports it, or in RGB otherwise.
+	Model Model
+
+	// Group is the name of the group of the swatch. Only ASE files have groups.
+	Group string
+}
+
+// colorFromCMYK returns the color of the CMYK components in [0, 1].
+func colorFromCMYK(c, m, y, k float64) iro.Color {
+	return iro.ColorFromSRGB((1-c)*(1-k), (1-m)*(1-k), (1-y)*(1-k), 1)
+}
+
+// cmyk returns the CMYK components in [0, 1] of the color.
+func cmyk(clr iro.Color) (c, m, y, k float64) {
+	r, g, b := srgb(clr)
+	k = 1 - max(r, g, b)
+	if k == 1 {
+		return 0, 0, 0, 1
+	}
+	return (1 - r - k) / (1 - k), (1 - g - k) / (1 - k), (1 - b - k) / (1 - k), k
+}
+
+// gray returns the sRGB gray level in [0, 1] of the color, the average of the components.
+func gray(clr iro.Color) float64 {
+	r, g, b := srgb(clr)
+	return (r + g + b) / 3
+}
+
+// colorFromHSB returns the color of HSB components, where the hue is in degrees and the others are in [0, 1].
+func colorFromHSB(h, s, v float64) iro.Color {
+	h = math.Mod(h, 360)
+	if h < 0 {
+		h += 360
+	}
+	f := func(n float64) float64 {
+		k := math.Mod(n+h/60, 6)
+		return v - v*s*max(0, min(k, 4-k, 1))
+	}
+	return iro.ColorFromSRGB(f(5), f(3), f(1), 1)
+}
+
+// hsb returns the HSB components of the color, where the hue is in degrees and the others are in [0, 1].
+func hsb(clr iro.Color) (h, s, v float64) {
+	r, g, b := srgb(clr)
+	v = max(r, g, b)
+	d := v - min(r, g, b)
+	if v > 0 {
+		s = d / v
+	}
+	if d == 0 {
+		return 0, s, v
+	}
+	switch v {
+	case r:
+		h = 60 * math.Mod((g-b)/d, 6)
+	case g:
+		h = 60 * ((b-r)/d + 2)
+	default:
+		h = 60 * ((r-g)/d + 4)
+	}
+	if h < 0 {
+		h += 360
+	}
+	return h, s, v
+}
+
+// srgb returns the sRGB components of the color clamped to [0, 1].
+func srgb(clr iro.Color) (r, g, b float64) {
+	r, g, b, _ = clr.SRGB()
+	return clamp01(r), clamp01(g), clamp01(b)
+}
+
+func clamp01(v float64) float64 {
+	return min(max(v, 0), 1)
+}
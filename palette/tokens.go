@@ -0,0 +1,465 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package palette
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/hajimehoshi/iro"
+)
+
+// ParseDesignTokens parses a JSON file of the W3C Design Tokens Community Group format and returns the color tokens.
+//
+// The name of a swatch is the name of the token, and the group of a swatch is the path of the groups joined with dots, like "brand.primary".
+// The tokens are returned in the order in the file. Tokens of the other types than color are ignored.
+//
+// The values of the color tokens can be objects with colorSpace, components, and alpha,
+// or strings that [iro.ParseCSS] accepts, like "#ff8000", as the older drafts of the format specify.
+// Aliases like "{brand.primary}" are resolved.
+// Colors in CIELAB and CIE LCh have ModelLab, and the others have ModelRGB.
+func ParseDesignTokens(r io.Reader) ([]Swatch, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("palette: %w", err)
+	}
+	p := &tokenParser{
+		tokens: map[string]*designToken{},
+	}
+	if err := p.parseGroup(data, nil, ""); err != nil {
+		return nil, err
+	}
+
+	var swatches []Swatch
+	for _, t := range p.order {
+		s, ok, err := p.resolve(t, 0)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		swatches = append(swatches, s)
+	}
+	return swatches, nil
+}
+
+// designToken is a token in a design token file.
+type designToken struct {
+	path  []string
+	typ   string
+	value json.RawMessage
+}
+
+type tokenParser struct {
+	tokens map[string]*designToken
+	order  []*designToken
+}
+
+// jsonMember is a member of a JSON object.
+type jsonMember struct {
+	key   string
+	value json.RawMessage
+}
+
+// jsonObject returns the members of the JSON object in order, or false if data is not an object.
+func jsonObject(data json.RawMessage) ([]jsonMember, bool, error) {
+	d := json.NewDecoder(bytes.NewReader(data))
+	t, err := d.Token()
+	if err != nil {
+		return nil, false, err
+	}
+	if t != json.Delim('{') {
+		return nil, false, nil
+	}
+	var members []jsonMember
+	for d.More() {
+		t, err := d.Token()
+		if err != nil {
+			return nil, false, err
+		}
+		var v json.RawMessage
+		if err := d.Decode(&v); err != nil {
+			return nil, false, err
+		}
+		members = append(members, jsonMember{key: t.(string), value: v})
+	}
+	return members, true, nil
+}
+
+func (p *tokenParser) parseGroup(data json.RawMessage, path []string, typ string) error {
+	members, ok, err := jsonObject(data)
+	if err != nil {
+		return fmt.Errorf("palette: invalid design tokens: %w", err)
+	}
+	if !ok {
+		if len(path) == 0 {
+			return fmt.Errorf("palette: design tokens must be a JSON object")
+		}
+		return fmt.Errorf("palette: %s must be a JSON object", strings.Join(path, "."))
+	}
+
+	var value json.RawMessage
+	for _, m := range members {
+		switch m.key {
+		case "$type":
+			if err := json.Unmarshal(m.value, &typ); err != nil {
+				return fmt.Errorf("palette: invalid $type of %s: %w", strings.Join(path, "."), err)
+			}
+		case "$value":
+			value = m.value
+		}
+	}
+	if value != nil {
+		if len(path) == 0 {
+			return fmt.Errorf("palette: the root of design tokens must be a group")
+		}
+		t := &designToken{
+			path:  path,
+			typ:   typ,
+			value: value,
+		}
+		p.tokens[strings.Join(path, ".")] = t
+		p.order = append(p.order, t)
+		return nil
+	}
+
+	for _, m := range members {
+		if strings.HasPrefix(m.key, "$") {
+			continue
+		}
+		child := append(path[:len(path):len(path)], m.key)
+		if err := p.parseGroup(m.value, child, typ); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// maxAliasDepth is the maximum depth of the chains of aliases, to reject circular references.
+const maxAliasDepth = 64
+
+// resolve returns the swatch of the token, or false if the token is not a color.
+func (p *tokenParser) resolve(t *designToken, depth int) (Swatch, bool, error) {
+	name := strings.Join(t.path, ".")
+	if depth > maxAliasDepth {
+		return Swatch{}, false, fmt.Errorf("palette: too deep or circular aliases at %s", name)
+	}
+	if t.typ != "" && t.typ != "color" {
+		return Swatch{}, false, nil
+	}
+
+	var str string
+	if err := json.Unmarshal(t.value, &str); err == nil {
+		if strings.HasPrefix(str, "{") && strings.HasSuffix(str, "}") {
+			target, ok := p.tokens[str[1:len(str)-1]]
+			if !ok {
+				return Swatch{}, false, fmt.Errorf("palette: unknown alias %s at %s", str, name)
+			}
+			s, ok, err := p.resolve(target, depth+1)
+			if err != nil || !ok {
+				return Swatch{}, ok, err
+			}
+			return t.swatch(s.Color, s.Model), true, nil
+		}
+		if t.typ == "" {
+			return Swatch{}, false, nil
+		}
+		c, err := iro.ParseCSS(str)
+		if err != nil {
+			return Swatch{}, false, fmt.Errorf("palette: invalid color at %s: %w", name, err)
+		}
+		return t.swatch(c, ModelRGB), true, nil
+	}
+	if t.typ == "" {
+		return Swatch{}, false, nil
+	}
+
+	var v struct {
+		ColorSpace string            `json:"colorSpace"`
+		Components []json.RawMessage `json:"components"`
+		Alpha      *float64          `json:"alpha"`
+	}
+	if err := json.Unmarshal(t.value, &v); err != nil {
+		return Swatch{}, false, fmt.Errorf("palette: invalid color at %s: %w", name, err)
+	}
+	if len(v.Components) != 3 {
+		return Swatch{}, false, fmt.Errorf("palette: a color must have 3 components but %d at %s", len(v.Components), name)
+	}
+	var comps [3]float64
+	for i, raw := range v.Components {
+		// "none" is a missing component, which is treated as 0.
+		if string(raw) == `"none"` {
+			continue
+		}
+		if err := json.Unmarshal(raw, &comps[i]); err != nil {
+			return Swatch{}, false, fmt.Errorf("palette: invalid component at %s: %w", name, err)
+		}
+	}
+	alpha := 1.0
+	if v.Alpha != nil {
+		alpha = *v.Alpha
+	}
+	c, m, err := colorFromTokenComponents(v.ColorSpace, comps, alpha)
+	if err != nil {
+		return Swatch{}, false, fmt.Errorf("palette: %s: %w", name, err)
+	}
+	return t.swatch(c, m), true, nil
+}
+
+func (t *designToken) swatch(c iro.Color, m Model) Swatch {
+	return Swatch{
+		Name:  t.path[len(t.path)-1],
+		Color: c,
+		Model: m,
+		Group: strings.Join(t.path[:len(t.path)-1], "."),
+	}
+}
+
+// tokenSpaces is the color spaces of design tokens that correspond to the spaces of iro.
+var tokenSpaces = map[string]iro.Space{
+	"srgb":         iro.SpaceSRGB,
+	"srgb-linear":  iro.SpaceLinearSRGB,
+	"display-p3":   iro.SpaceDisplayP3,
+	"a98-rgb":      iro.SpaceA98RGB,
+	"prophoto-rgb": iro.SpaceProPhotoRGB,
+	"rec2020":      iro.SpaceRec2020,
+	"xyz-d65":      iro.SpaceXYZ,
+	"xyz-d50":      iro.SpaceXYZD50,
+	"lab":          iro.SpaceLab,
+	"lch":          iro.SpaceLch,
+	"oklab":        iro.SpaceOKLab,
+	"oklch":        iro.SpaceOKLch,
+}
+
+func colorFromTokenComponents(space string, c [3]float64, alpha float64) (iro.Color, Model, error) {
+	switch space {
+	case "hsl":
+		// Convert HSL to HSB.
+		s, l := c[1]/100, c[2]/100
+		v := l + s*min(l, 1-l)
+		var sv float64
+		if v > 0 {
+			sv = 2 * (1 - l/v)
+		}
+		return colorFromHSB(c[0], sv, v).WithAlpha(alpha), ModelRGB, nil
+	case "hwb":
+		w, b := c[1]/100, c[2]/100
+		if w+b >= 1 {
+			g := w / (w + b)
+			return iro.ColorFromSRGB(g, g, g, alpha), ModelRGB, nil
+		}
+		v := 1 - b
+		return colorFromHSB(c[0], 1-w/v, v).WithAlpha(alpha), ModelRGB, nil
+	}
+	s, ok := tokenSpaces[space]
+	if !ok {
+		return iro.Color{}, 0, fmt.Errorf("unsupported color space: %q", space)
+	}
+	if s == iro.SpaceLch || s == iro.SpaceOKLch {
+		c[2] = iro.AngleUnitDegree.ToRadians(c[2])
+	}
+	m := ModelRGB
+	if s == iro.SpaceLab || s == iro.SpaceLch {
+		m = ModelLab
+	}
+	return iro.ColorFromComponents(s, c[0], c[1], c[2], alpha), m, nil
+}
+
+// EncodeDesignTokens writes the swatches as color tokens in the JSON format of the W3C Design Tokens Community Group.
+//
+// The tokens are nested in the groups of the swatches split by dots. Swatches without names are named by their 1-based indices, like "3".
+// Names must not include dots, braces, or start with "$".
+//
+// Swatches with ModelLab are written in CIELAB. The other swatches are written in sRGB,
+// or in OKLCh if they are outside the sRGB gamut so as not to lose the colors.
+// The sRGB hexadecimal fallback is written for all the colors.
+func EncodeDesignTokens(w io.Writer, swatches []Swatch) error {
+	return encodeTokenTree(w, swatches, func(s Swatch) (any, error) {
+		space, name := iro.SpaceSRGB, "srgb"
+		if s.Model == ModelLab {
+			space, name = iro.SpaceLab, "lab"
+		} else if r, g, b, _ := s.Color.SRGB(); !inUnit(r) || !inUnit(g) || !inUnit(b) {
+			space, name = iro.SpaceOKLch, "oklch"
+		}
+		c0, c1, c2, alpha := s.Color.Components(space)
+		if space == iro.SpaceOKLch {
+			c2 = iro.AngleUnitDegree.FromRadians(iro.NormalizeHue(c2))
+		}
+		var comps []any
+		for _, c := range []float64{c0, c1, c2} {
+			if math.IsNaN(c) {
+				comps = append(comps, "none")
+				continue
+			}
+			comps = append(comps, json.Number(strconv.FormatFloat(roundComponent(c), 'f', -1, 64)))
+		}
+		type value struct {
+			ColorSpace string   `json:"colorSpace"`
+			Components []any    `json:"components"`
+			Alpha      *float64 `json:"alpha,omitempty"`
+			Hex        string   `json:"hex"`
+		}
+		v := value{
+			ColorSpace: name,
+			Components: comps,
+			Hex:        s.Color.WithAlpha(1).Hex(),
+		}
+		if alpha != 1 {
+			v.Alpha = &alpha
+		}
+		return struct {
+			Type  string `json:"$type"`
+			Value value  `json:"$value"`
+		}{
+			Type:  "color",
+			Value: v,
+		}, nil
+	})
+}
+
+// EncodeJSON writes the swatches as a simple nested JSON object of hexadecimal sRGB strings like "#ff8000",
+// which many front-end tools accept as theme colors.
+//
+// The swatches are nested as [EncodeDesignTokens] does.
+// The colors are clamped to the sRGB gamut, and the alpha is written only when the color is not opaque, like "#ff800080".
+func EncodeJSON(w io.Writer, swatches []Swatch) error {
+	return encodeTokenTree(w, swatches, func(s Swatch) (any, error) {
+		return s.Color.Hex(), nil
+	})
+}
+
+// inUnit reports whether v is in [0, 1] within a tolerance.
+func inUnit(v float64) bool {
+	const eps = 1e-6
+	return v >= -eps && v <= 1+eps
+}
+
+// roundComponent rounds v to 6 decimal places to hide tiny errors of conversions.
+func roundComponent(v float64) float64 {
+	v = math.Round(v*1e6) / 1e6
+	if v == 0 {
+		// Avoid -0.
+		return 0
+	}
+	return v
+}
+
+// tokenNode is a group or a token in a tree of tokens.
+type tokenNode struct {
+	name     string
+	swatch   *Swatch
+	children []*tokenNode
+}
+
+func (n *tokenNode) child(name string) *tokenNode {
+	for _, c := range n.children {
+		if c.name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+// swatchName returns the name of the swatch at the index, or the 1-based index if the name is empty.
+func swatchName(s Swatch, index int) string {
+	if s.Name == "" {
+		return strconv.Itoa(index + 1)
+	}
+	return s.Name
+}
+
+// swatchPath returns the path of the groups and the name of the swatch at the index.
+func swatchPath(s Swatch, index int) []string {
+	var path []string
+	if s.Group != "" {
+		path = strings.Split(s.Group, ".")
+	}
+	return append(path, swatchName(s, index))
+}
+
+func validTokenName(name string) bool {
+	return name != "" && !strings.HasPrefix(name, "$") && !strings.ContainsAny(name, ".{}")
+}
+
+func encodeTokenTree(w io.Writer, swatches []Swatch, leaf func(s Swatch) (any, error)) error {
+	root := &tokenNode{}
+	for i := range swatches {
+		s := &swatches[i]
+		if strings.Contains(s.Name, ".") {
+			return fmt.Errorf("palette: the name of a swatch must not include a dot: %q", s.Name)
+		}
+		path := swatchPath(*s, i)
+		n := root
+		for j, name := range path {
+			if !validTokenName(name) {
+				return fmt.Errorf("palette: invalid token name: %q", name)
+			}
+			c := n.child(name)
+			if j == len(path)-1 {
+				if c != nil {
+					return fmt.Errorf("palette: duplicated token: %s", strings.Join(path, "."))
+				}
+				n.children = append(n.children, &tokenNode{name: name, swatch: s})
+				break
+			}
+			if c == nil {
+				c = &tokenNode{name: name}
+				n.children = append(n.children, c)
+			} else if c.swatch != nil {
+				return fmt.Errorf("palette: a token and a group have the same name: %s", strings.Join(path[:j+1], "."))
+			}
+			n = c
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := writeTokenNode(&buf, root, leaf); err != nil {
+		return err
+	}
+	var out bytes.Buffer
+	if err := json.Indent(&out, buf.Bytes(), "", "  "); err != nil {
+		return fmt.Errorf("palette: %w", err)
+	}
+	out.WriteByte('\n')
+	_, err := out.WriteTo(w)
+	return err
+}
+
+func writeTokenNode(buf *bytes.Buffer, n *tokenNode, leaf func(s Swatch) (any, error)) error {
+	if n.swatch != nil {
+		v, err := leaf(*n.swatch)
+		if err != nil {
+			return err
+		}
+		b, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Errorf("palette: %w", err)
+		}
+		buf.Write(b)
+		return nil
+	}
+	buf.WriteByte('{')
+	for i, c := range n.children {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		b, err := json.Marshal(c.name)
+		if err != nil {
+			return fmt.Errorf("palette: %w", err)
+		}
+		buf.Write(b)
+		buf.WriteByte(':')
+		if err := writeTokenNode(buf, c, leaf); err != nil {
+			return err
+		}
+	}
+	buf.WriteByte('}')
+	return nil
+}
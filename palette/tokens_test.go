@@ -0,0 +1,154 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package palette_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+	"github.com/hajimehoshi/iro/palette"
+)
+
+const testDesignTokens = `{
+  "brand": {
+    "$type": "color",
+    "primary": {
+      "$value": {"colorSpace": "srgb", "components": [1, 0.5, 0], "hex": "#ff8000"}
+    },
+    "secondary": {
+      "$value": {"colorSpace": "oklch", "components": [0.5, 0.1, 270], "alpha": 0.5}
+    },
+    "legacy": {"$value": "#00ff00"}
+  },
+  "spacing": {
+    "small": {"$type": "dimension", "$value": {"value": 4, "unit": "px"}}
+  },
+  "surface": {"$type": "color", "$value": {"colorSpace": "hsl", "components": [0, 100, 25]}},
+  "paper": {"$type": "color", "$value": {"colorSpace": "lab", "components": [90, "none", 5]}},
+  "accent": {"$value": "{brand.primary}"}
+}`
+
+func TestParseDesignTokens(t *testing.T) {
+	got, err := palette.ParseDesignTokens(strings.NewReader(testDesignTokens))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []palette.Swatch{
+		{Name: "primary", Group: "brand", Color: iro.ColorFromSRGB(1, 0.5, 0, 1)},
+		{Name: "secondary", Group: "brand", Color: iro.ColorFromOKLchDeg(0.5, 0.1, 270, 0.5)},
+		{Name: "legacy", Group: "brand", Color: iro.ColorFromSRGB(0, 1, 0, 1)},
+		{Name: "surface", Color: iro.ColorFromSRGB(0.5, 0, 0, 1)},
+		{Name: "paper", Color: iro.ColorFromLab(90, 0, 5, 1), Model: palette.ModelLab},
+		{Name: "accent", Color: iro.ColorFromSRGB(1, 0.5, 0, 1)},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("len: got %d, want %d", len(got), len(want))
+	}
+	for i := range want {
+		g, w := got[i], want[i]
+		if g.Name != w.Name || g.Group != w.Group || g.Model != w.Model || !sameSRGB(g.Color, w.Color, 1e-6) || g.Color.Alpha() != w.Color.Alpha() {
+			t.Errorf("swatch %d: got %+v, want %+v", i, g, w)
+		}
+	}
+}
+
+func TestDesignTokensRoundTrip(t *testing.T) {
+	in := []palette.Swatch{
+		{Name: "primary", Group: "brand.light", Color: iro.ColorFromSRGB(0.2, 0.4, 0.6, 1)},
+		{Name: "secondary", Group: "brand.light", Color: iro.ColorFromSRGB(0.9, 0.1, 0.3, 0.75)},
+		{Name: "paper", Color: iro.ColorFromLab(95, 1, 4, 1), Model: palette.ModelLab},
+		{Name: "vivid", Color: iro.ColorFromDisplayP3(0, 1, 0, 1)},
+		{Color: iro.ColorFromSRGB(0, 0, 0, 1)},
+	}
+	var buf bytes.Buffer
+	if err := palette.EncodeDesignTokens(&buf, in); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), `"oklch"`) {
+		t.Errorf("a color outside the sRGB gamut must be written in OKLCh:\n%s", buf.String())
+	}
+	out, err := palette.ParseDesignTokens(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(out) != len(in) {
+		t.Fatalf("len: got %d, want %d", len(out), len(in))
+	}
+	for i := range in {
+		want := in[i]
+		if want.Name == "" {
+			want.Name = "5"
+		}
+		got := out[i]
+		if got.Name != want.Name || got.Group != want.Group || got.Model != want.Model || !sameSRGB(got.Color, want.Color, 1e-5) {
+			t.Errorf("swatch %d: got %+v, want %+v", i, got, want)
+		}
+	}
+}
+
+func TestParseDesignTokensError(t *testing.T) {
+	testCases := []struct {
+		name string
+		data string
+	}{
+		{name: "syntax", data: `{"a": `},
+		{name: "array", data: `[]`},
+		{name: "space", data: `{"a": {"$type": "color", "$value": {"colorSpace": "cmyk", "components": [0, 0, 0]}}}`},
+		{name: "components", data: `{"a": {"$type": "color", "$value": {"colorSpace": "srgb", "components": [0, 0]}}}`},
+		{name: "css", data: `{"a": {"$type": "color", "$value": "not a color"}}`},
+		{name: "unknown alias", data: `{"a": {"$type": "color", "$value": "{b}"}}`},
+		{name: "circular alias", data: `{"a": {"$type": "color", "$value": "{b}"}, "b": {"$type": "color", "$value": "{a}"}}`},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := palette.ParseDesignTokens(strings.NewReader(tc.data)); err == nil {
+				t.Errorf("ParseDesignTokens: got no error")
+			}
+		})
+	}
+}
+
+func TestEncodeJSON(t *testing.T) {
+	in := []palette.Swatch{
+		{Name: "primary", Group: "brand", Color: iro.ColorFromSRGB(1, 128.0/255, 0, 1)},
+		{Name: "overlay", Group: "brand", Color: iro.ColorFromSRGB(0, 0, 0, 0.5)},
+		{Name: "white", Color: iro.ColorFromSRGB(1, 1, 1, 1)},
+	}
+	var buf bytes.Buffer
+	if err := palette.EncodeJSON(&buf, in); err != nil {
+		t.Fatal(err)
+	}
+	want := `{
+  "brand": {
+    "primary": "#ff8000",
+    "overlay": "#00000080"
+  },
+  "white": "#ffffff"
+}
+`
+	if got := buf.String(); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestEncodeJSONError(t *testing.T) {
+	testCases := []struct {
+		name     string
+		swatches []palette.Swatch
+	}{
+		{name: "dot", swatches: []palette.Swatch{{Name: "a.b"}}},
+		{name: "dollar", swatches: []palette.Swatch{{Name: "$a"}}},
+		{name: "duplicated", swatches: []palette.Swatch{{Name: "a"}, {Name: "a"}}},
+		{name: "token and group", swatches: []palette.Swatch{{Name: "a"}, {Name: "b", Group: "a"}}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := palette.EncodeJSON(&bytes.Buffer{}, tc.swatches); err == nil {
+				t.Errorf("EncodeJSON: got no error")
+			}
+		})
+	}
+}
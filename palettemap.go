@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"context"
+	"image"
+	"math"
+)
+
+// PaletteMapping recolors colors with only the colors of a palette, like for pixel art or brand-compliant rendering.
+//
+// Each color is replaced with the nearest palette color in OKLab.
+type PaletteMapping struct {
+	// Palette is the colors to use. Palette must not be empty. The alpha values of the palette are ignored.
+	Palette []Color
+
+	// LightnessWeight is the weight of the difference of the OKLab lightness relative to the differences of a and b.
+	// Values greater than 1 prefer palette colors with similar lightness,
+	// which preserves the lightness relationships of the input at the cost of the hues.
+	// 0 is treated as 1.
+	LightnessWeight float64
+
+	// Dither reports whether ApplyToImage diffuses the quantization errors in OKLab with the Floyd-Steinberg dithering.
+	Dither bool
+}
+
+type paletteMappingEntry struct {
+	color   Color
+	l, a, b float64
+}
+
+func (p *PaletteMapping) entries() []paletteMappingEntry {
+	if len(p.Palette) == 0 {
+		panic("iro: a palette must not be empty")
+	}
+	es := make([]paletteMappingEntry, len(p.Palette))
+	for i, c := range p.Palette {
+		l, a, b, _ := c.OKLab()
+		es[i] = paletteMappingEntry{color: c, l: l, a: a, b: b}
+	}
+	return es
+}
+
+// nearest returns the index of the entry nearest to the OKLab components.
+func (p *PaletteMapping) nearest(es []paletteMappingEntry, l, a, b float64) int {
+	w := p.LightnessWeight
+	if w == 0 {
+		w = 1
+	}
+	var idx int
+	minDist := math.Inf(1)
+	for i, e := range es {
+		dl, da, db := (e.l-l)*w, e.a-a, e.b-b
+		if d := dl*dl + da*da + db*db; d < minDist {
+			minDist = d
+			idx = i
+		}
+	}
+	return idx
+}
+
+// Apply returns the palette color nearest to c with the alpha value of c.
+//
+// Apply panics if the palette is empty.
+func (p *PaletteMapping) Apply(c Color) Color {
+	es := p.entries()
+	l, a, b, alpha := c.OKLab()
+	return es[p.nearest(es, l, a, b)].color.WithAlpha(alpha)
+}
+
+// ApplyToImage returns a new image by recoloring each pixel of img with the palette.
+// See [MapImage] for the interpretation of the pixels.
+// The alpha values are kept, and the errors of fully transparent pixels are not diffused.
+//
+// ApplyToImage panics if the palette is empty.
+func (p *PaletteMapping) ApplyToImage(img image.Image) *image.NRGBA64 {
+	dst, _ := p.ApplyToImageContext(context.Background(), img)
+	return dst
+}
+
+// ApplyToImageContext is like [PaletteMapping.ApplyToImage] but stops and returns the error of ctx when ctx is done.
+// ctx is checked for each row of img.
+//
+// ApplyToImageContext panics if the palette is empty.
+func (p *PaletteMapping) ApplyToImageContext(ctx context.Context, img image.Image) (*image.NRGBA64, error) {
+	es := p.entries()
+	if !p.Dither {
+		return MapImageContext(ctx, img, func(c Color) Color {
+			l, a, b, alpha := c.OKLab()
+			return es[p.nearest(es, l, a, b)].color.WithAlpha(alpha)
+		})
+	}
+
+	bounds := img.Bounds()
+	dst := image.NewNRGBA64(bounds)
+	w := bounds.Dx()
+	// errs is the diffused errors of the current and the next rows, with a margin at both ends.
+	errs := [2][][3]float64{make([][3]float64, w+2), make([][3]float64, w+2)}
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		cur, next := errs[0], errs[1]
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			l, a, b, alpha := colorAt(img, x, y).OKLab()
+			i := x - bounds.Min.X + 1
+			if alpha <= 0 {
+				dst.SetNRGBA64(x, y, es[p.nearest(es, l, a, b)].color.WithAlpha(alpha).SRGBNRGBA64())
+				continue
+			}
+			l += cur[i][0]
+			a += cur[i][1]
+			b += cur[i][2]
+			e := es[p.nearest(es, l, a, b)]
+			dst.SetNRGBA64(x, y, e.color.WithAlpha(alpha).SRGBNRGBA64())
+			d := [3]float64{l - e.l, a - e.a, b - e.b}
+			for j := range d {
+				cur[i+1][j] += d[j] * 7 / 16
+				next[i-1][j] += d[j] * 3 / 16
+				next[i][j] += d[j] * 5 / 16
+				next[i+1][j] += d[j] * 1 / 16
+			}
+		}
+		for i := range cur {
+			cur[i] = [3]float64{}
+		}
+		errs[0], errs[1] = next, cur
+	}
+	return dst, nil
+}
@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"context"
+	"errors"
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestPaletteMapping(t *testing.T) {
+	black := iro.ColorFromSRGB(0, 0, 0, 1)
+	white := iro.ColorFromSRGB(1, 1, 1, 1)
+	red := iro.ColorFromSRGB(1, 0, 0, 1)
+	p := &iro.PaletteMapping{Palette: []iro.Color{black, white, red}}
+
+	testCases := []struct {
+		in   iro.Color
+		want iro.Color
+	}{
+		{in: iro.ColorFromSRGB(0.1, 0.1, 0.1, 1), want: black},
+		{in: iro.ColorFromSRGB(0.9, 0.9, 0.9, 0.5), want: white.WithAlpha(0.5)},
+		{in: iro.ColorFromSRGB(0.8, 0.1, 0.2, 1), want: red},
+	}
+	for _, tc := range testCases {
+		if got := p.Apply(tc.in); got != tc.want {
+			t.Errorf("Apply(%v): got %v, want %v", tc.in, got, tc.want)
+		}
+	}
+}
+
+func TestPaletteMappingLightnessWeight(t *testing.T) {
+	darkBlue := iro.ColorFromOKLch(0.45, 0.15, 4.5, 1)
+	lightGray := iro.ColorFromOKLch(0.6, 0, 0, 1)
+	in := iro.ColorFromOKLch(0.55, 0.15, 4.5, 1)
+
+	p := &iro.PaletteMapping{Palette: []iro.Color{darkBlue, lightGray}}
+	if got := p.Apply(in); got != darkBlue {
+		t.Errorf("LightnessWeight 0: got %v, want %v", got, darkBlue)
+	}
+	p.LightnessWeight = 4
+	if got := p.Apply(in); got != lightGray {
+		t.Errorf("LightnessWeight 4: got %v, want %v", got, lightGray)
+	}
+}
+
+func TestPaletteMappingDither(t *testing.T) {
+	black := iro.ColorFromOKLab(0, 0, 0, 1)
+	white := iro.ColorFromOKLab(1, 0, 0, 1)
+	mid := iro.ColorFromOKLab(0.5, 0, 0, 1).SRGBNRGBA64()
+
+	const w, h = 16, 16
+	img := image.NewNRGBA64(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.SetNRGBA64(x, y, mid)
+		}
+	}
+
+	p := &iro.PaletteMapping{Palette: []iro.Color{black, white}}
+	// Without dithering, all the pixels are the same.
+	dst := p.ApplyToImage(img)
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			if got, want := dst.NRGBA64At(x, y), dst.NRGBA64At(0, 0); got != want {
+				t.Fatalf("no dither (%d, %d): got %v, want %v", x, y, got, want)
+			}
+		}
+	}
+
+	// With dithering, the average lightness is kept.
+	p.Dither = true
+	dst = p.ApplyToImage(img)
+	var whites int
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			switch c := dst.NRGBA64At(x, y); c {
+			case (color.NRGBA64{0xffff, 0xffff, 0xffff, 0xffff}):
+				whites++
+			case (color.NRGBA64{0, 0, 0, 0xffff}):
+			default:
+				t.Fatalf("dither (%d, %d): got %v, want black or white", x, y, c)
+			}
+		}
+	}
+	if whites < w*h/2-8 || whites > w*h/2+8 {
+		t.Errorf("dither: got %d white pixels, want about %d", whites, w*h/2)
+	}
+}
+
+func TestPaletteMappingEmpty(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Apply with an empty palette must panic")
+		}
+	}()
+	(&iro.PaletteMapping{}).Apply(iro.ColorFromSRGB(0, 0, 0, 1))
+}
+
+func TestPaletteMappingContext(t *testing.T) {
+	src := image.NewNRGBA(image.Rect(0, 0, 4, 4))
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	for _, dither := range []bool{false, true} {
+		p := &iro.PaletteMapping{
+			Palette: []iro.Color{iro.ColorFromSRGB(0, 0, 0, 1)},
+			Dither:  dither,
+		}
+		if _, err := p.ApplyToImageContext(ctx, src); !errors.Is(err, context.Canceled) {
+			t.Errorf("dither=%t: got %v, want %v", dither, err, context.Canceled)
+		}
+	}
+}
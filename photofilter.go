@@ -0,0 +1,174 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"fmt"
+	"image"
+	"math"
+)
+
+// photoFilterBaseTemperature is the color temperature in kelvins that the mired shift of a PhotoFilter is relative to.
+const photoFilterBaseTemperature = 6500
+
+// PhotoFilter is a photographic filter built on chromatic adaptation and tone operations.
+//
+// The operations are applied in this order: the saturation, the mired shift, the exposure, and the tone curve.
+type PhotoFilter struct {
+	// Saturation is the scale of the OKLab chroma. 0 makes colors gray, and 1 doesn't change the chroma.
+	Saturation float64
+
+	// MiredShift is the shift of the color temperature of the light in mireds (10⁶/K), like the ones of color conversion camera filters.
+	// A positive value warms and a negative value cools the colors.
+	// The colors are adapted with the Bradford transform from the Planckian radiator at 6500 K to the one of the shifted temperature,
+	// clamped to [1667 K, 25000 K]. 0 doesn't change the colors.
+	MiredShift float64
+
+	// Exposure is the exposure change in EV stops in linear sRGB. See [Exposure]. 0 doesn't change the colors.
+	Exposure float64
+
+	// Tone is the tone curve applied to the OKLab lightness. Nil doesn't change the lightness.
+	Tone *Curve
+}
+
+// NewPhotoFilter creates a PhotoFilter that doesn't change colors.
+func NewPhotoFilter() *PhotoFilter {
+	return &PhotoFilter{
+		Saturation: 1,
+	}
+}
+
+// NewSepiaFilter creates a PhotoFilter of the sepia tone.
+// Colors are made gray, warmed to about 3500 K, and lowered in contrast like old prints.
+func NewSepiaFilter() *PhotoFilter {
+	return &PhotoFilter{
+		Saturation: 0,
+		MiredShift: 1e6/3500 - 1e6/photoFilterBaseTemperature,
+		Tone: NewCurve([]CurvePoint{
+			{X: 0, Y: 0.08},
+			{X: 0.5, Y: 0.52},
+			{X: 1, Y: 0.95},
+		}),
+	}
+}
+
+// NewDayForNightFilter creates a PhotoFilter of the day-for-night effect, simulating a night scene from a scene shot in daylight.
+// Colors are desaturated, cooled, underexposed by 2 stops, and compressed in the highlights.
+func NewDayForNightFilter() *PhotoFilter {
+	return &PhotoFilter{
+		Saturation: 0.6,
+		MiredShift: -100,
+		Exposure:   -2,
+		Tone: NewCurve([]CurvePoint{
+			{X: 0, Y: 0},
+			{X: 0.5, Y: 0.45},
+			{X: 1, Y: 0.75},
+		}),
+	}
+}
+
+// Wratten is a Kodak Wratten light balancing filter for cameras.
+type Wratten int
+
+const (
+	// Wratten81 to Wratten81EF are the warming filters of the 81 series.
+	Wratten81 Wratten = iota
+	Wratten81A
+	Wratten81B
+	Wratten81C
+	Wratten81D
+	Wratten81EF
+
+	// Wratten82 to Wratten82C are the cooling filters of the 82 series.
+	Wratten82
+	Wratten82A
+	Wratten82B
+	Wratten82C
+)
+
+// MiredShift returns the shift of the color temperature of the filter in mireds.
+//
+// MiredShift panics if w is invalid.
+func (w Wratten) MiredShift() float64 {
+	switch w {
+	case Wratten81:
+		return 9
+	case Wratten81A:
+		return 18
+	case Wratten81B:
+		return 27
+	case Wratten81C:
+		return 35
+	case Wratten81D:
+		return 42
+	case Wratten81EF:
+		return 52
+	case Wratten82:
+		return -10
+	case Wratten82A:
+		return -21
+	case Wratten82B:
+		return -32
+	case Wratten82C:
+		return -45
+	default:
+		panic(fmt.Sprintf("iro: invalid Wratten: %d", w))
+	}
+}
+
+// NewWrattenFilter creates a PhotoFilter of the warming or cooling filter, which shifts the color temperature by [Wratten.MiredShift].
+func NewWrattenFilter(w Wratten) *PhotoFilter {
+	return &PhotoFilter{
+		Saturation: 1,
+		MiredShift: w.MiredShift(),
+	}
+}
+
+// Apply returns a new Color by applying the filter to c.
+// The alpha value is kept.
+func (f *PhotoFilter) Apply(c Color) Color {
+	return f.apply(c, f.adaptationMatrix())
+}
+
+func (f *PhotoFilter) adaptationMatrix() *Matrix3 {
+	if f.MiredShift == 0 {
+		return nil
+	}
+	m := 1e6/photoFilterBaseTemperature + f.MiredShift
+	t := float64(planckianMax)
+	if m > 0 {
+		t = min(1e6/m, planckianMax)
+	}
+	mat := ChromaticAdaptationMatrix(PlanckianLocus(photoFilterBaseTemperature), PlanckianLocus(t))
+	return &mat
+}
+
+func (f *PhotoFilter) apply(c Color, adapt *Matrix3) Color {
+	if f.Saturation != 1 {
+		l, a, b, alpha := c.OKLab()
+		c = ColorFromOKLab(l, a*f.Saturation, b*f.Saturation, alpha)
+	}
+	if adapt != nil {
+		x, y, z := adapt.Apply(c.x, c.y, c.z)
+		c = ColorFromXYZ(x, y, z, c.alpha)
+	}
+	if f.Exposure != 0 {
+		s := math.Exp2(f.Exposure)
+		c = ColorFromXYZ(c.x*s, c.y*s, c.z*s, c.alpha)
+	}
+	if f.Tone != nil {
+		l, a, b, alpha := c.OKLab()
+		c = ColorFromOKLab(f.Tone.At(l), a, b, alpha)
+	}
+	return c
+}
+
+// ApplyToImage returns a new image by applying the filter to each pixel of img.
+// See [MapImage] for the interpretation of the pixels.
+func (f *PhotoFilter) ApplyToImage(img image.Image) *image.NRGBA64 {
+	adapt := f.adaptationMatrix()
+	return MapImage(img, func(c Color) Color {
+		return f.apply(c, adapt)
+	})
+}
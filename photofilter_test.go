@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"image"
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestNewPhotoFilter(t *testing.T) {
+	c := iro.ColorFromSRGB(0.2, 0.5, 0.8, 0.5)
+	got := iro.NewPhotoFilter().Apply(c)
+	gx, gy, gz, ga := got.XYZ()
+	wx, wy, wz, wa := c.XYZ()
+	if !checkTol(gx, wx) || !checkTol(gy, wy) || !checkTol(gz, wz) || !checkTol(ga, wa) {
+		t.Errorf("got %v, want %v", got, c)
+	}
+}
+
+func TestWrattenFilter(t *testing.T) {
+	gray := iro.ColorFromSRGB(0.5, 0.5, 0.5, 1)
+	_, grayY, _, _ := gray.XYZ()
+	for _, w := range []iro.Wratten{iro.Wratten81, iro.Wratten81A, iro.Wratten81B, iro.Wratten81C, iro.Wratten81D, iro.Wratten81EF, iro.Wratten82, iro.Wratten82A, iro.Wratten82B, iro.Wratten82C} {
+		got := iro.NewWrattenFilter(w).Apply(gray)
+		r, _, b, _ := got.SRGB()
+		warming := w.MiredShift() > 0
+		if warming && r <= b || !warming && r >= b {
+			t.Errorf("Wratten %d: got (r: %f, b: %f), warming: %t", w, r, b, warming)
+		}
+		// The luminance is kept.
+		if _, y, _, _ := got.XYZ(); math.Abs(y-grayY) > 1e-3 {
+			t.Errorf("Wratten %d: luminance: got %f", w, y)
+		}
+	}
+
+	// Stronger filters shift more.
+	_, _, b81A, _ := iro.NewWrattenFilter(iro.Wratten81A).Apply(gray).SRGB()
+	_, _, b81C, _ := iro.NewWrattenFilter(iro.Wratten81C).Apply(gray).SRGB()
+	if b81C >= b81A {
+		t.Errorf("81C: got blue %f, want less than 81A %f", b81C, b81A)
+	}
+}
+
+func TestSepiaFilter(t *testing.T) {
+	f := iro.NewSepiaFilter()
+	// Colors of the same lightness have the same sepia tone.
+	a := f.Apply(iro.ColorFromOKLch(0.6, 0.1, 1, 1))
+	b := f.Apply(iro.ColorFromOKLch(0.6, 0.1, 4, 1))
+	if d := iro.DeltaEOK(a, b); d > 1e-6 {
+		t.Errorf("DeltaEOK: got %f, want 0", d)
+	}
+	// The tone is warm.
+	if cct, _ := a.Chromaticity().CorrelatedColorTemperature(); cct < 3000 || cct > 4000 {
+		t.Errorf("CCT: got %f, want about 3500", cct)
+	}
+}
+
+func TestDayForNightFilter(t *testing.T) {
+	f := iro.NewDayForNightFilter()
+	in := iro.ColorFromSRGB(0.8, 0.7, 0.5, 1)
+	got := f.Apply(in)
+	l0, c0, _, _ := in.OKLch()
+	l1, c1, _, _ := got.OKLch()
+	if l1 >= l0 || c1 >= c0 {
+		t.Errorf("got (L: %f, C: %f), want darker and less chromatic than (L: %f, C: %f)", l1, c1, l0, c0)
+	}
+	if r, _, b, _ := got.SRGB(); r >= b {
+		t.Errorf("got (r: %f, b: %f), want bluish", r, b)
+	}
+
+	img := image.NewNRGBA(image.Rect(0, 0, 1, 1))
+	img.SetNRGBA(0, 0, in.SRGBNRGBA())
+	want := f.Apply(iro.ColorFromSRGBNRGBA(img.NRGBAAt(0, 0))).SRGBNRGBA64()
+	if got := f.ApplyToImage(img).NRGBA64At(0, 0); got != want {
+		t.Errorf("ApplyToImage: got %v, want %v", got, want)
+	}
+}
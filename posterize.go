@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"fmt"
+	"image"
+	"math"
+)
+
+// posterizeMaxChroma is the OKLCh chroma range quantized by Posterize, covering the chroma of sRGB and Display P3.
+const posterizeMaxChroma = 0.4
+
+// Posterize quantizes colors to levels in OKLCh.
+//
+// Unlike posterizing each channel of sRGB, quantizing the OKLab lightness makes perceptually even tonal steps.
+type Posterize struct {
+	// Levels is the number of levels of the lightness in [0, 1]. Levels must be at least 2.
+	Levels int
+
+	// ChromaLevels is the number of levels of the chroma in [0, 0.4]. 0 doesn't quantize the chroma.
+	ChromaLevels int
+
+	// HueLevels is the number of levels of the hue, evenly dividing the circle from 0. 0 doesn't quantize the hue.
+	HueLevels int
+}
+
+// quantize returns v in [0, max] quantized to n levels.
+func quantize(v, max float64, n int) float64 {
+	d := float64(n - 1)
+	return math.Round(min(v/max, 1)*d) / d * max
+}
+
+// Apply returns a new Color by posterizing c.
+// The result might be outside the gamut of c when the chroma or the hue is quantized.
+// The alpha value is kept.
+//
+// Apply panics if the levels are invalid.
+func (p *Posterize) Apply(c Color) Color {
+	if p.Levels < 2 {
+		panic(fmt.Sprintf("iro: Levels must be at least 2 but %d", p.Levels))
+	}
+	if p.ChromaLevels < 0 || p.ChromaLevels == 1 {
+		panic(fmt.Sprintf("iro: ChromaLevels must be 0 or at least 2 but %d", p.ChromaLevels))
+	}
+	if p.HueLevels < 0 {
+		panic(fmt.Sprintf("iro: HueLevels must not be negative but %d", p.HueLevels))
+	}
+	l, ch, h, alpha := c.OKLch()
+	l = quantize(min(max(l, 0), 1), 1, p.Levels)
+	if p.ChromaLevels > 0 {
+		ch = quantize(ch, posterizeMaxChroma, p.ChromaLevels)
+	}
+	if p.HueLevels > 0 {
+		step := 2 * math.Pi / float64(p.HueLevels)
+		h = NormalizeHue(math.Round(h/step) * step)
+	}
+	return ColorFromOKLch(l, ch, h, alpha)
+}
+
+// ApplyToImage returns a new image by posterizing each pixel of img.
+// See [MapImage] for the interpretation of the pixels.
+//
+// ApplyToImage panics if the levels are invalid.
+func (p *Posterize) ApplyToImage(img image.Image) *image.NRGBA64 {
+	return MapImage(img, p.Apply)
+}
@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestPosterize(t *testing.T) {
+	testCases := []struct {
+		name      string
+		posterize iro.Posterize
+		l, c, h   float64
+		wantL     float64
+		wantC     float64
+		wantH     float64
+	}{
+		{name: "lightness", posterize: iro.Posterize{Levels: 5}, l: 0.3, c: 0.1, h: 1, wantL: 0.25, wantC: 0.1, wantH: 1},
+		{name: "lightness up", posterize: iro.Posterize{Levels: 5}, l: 0.4, c: 0.1, h: 1, wantL: 0.5, wantC: 0.1, wantH: 1},
+		{name: "lightness two levels", posterize: iro.Posterize{Levels: 2}, l: 0.45, c: 0, h: 0, wantL: 0, wantC: 0, wantH: 0},
+		{name: "chroma", posterize: iro.Posterize{Levels: 3, ChromaLevels: 5}, l: 0.5, c: 0.12, h: 1, wantL: 0.5, wantC: 0.1, wantH: 1},
+		{name: "hue", posterize: iro.Posterize{Levels: 3, HueLevels: 4}, l: 0.5, c: 0.1, h: 1.4, wantL: 0.5, wantC: 0.1, wantH: math.Pi / 2},
+		{name: "hue wraparound", posterize: iro.Posterize{Levels: 3, HueLevels: 4}, l: 0.5, c: 0.1, h: 6, wantL: 0.5, wantC: 0.1, wantH: 0},
+	}
+	for _, tc := range testCases {
+		got := tc.posterize.Apply(iro.ColorFromOKLch(tc.l, tc.c, tc.h, 0.5))
+		l, c, h, a := got.OKLch()
+		if c < 1e-9 {
+			h = tc.wantH
+		}
+		if math.Abs(l-tc.wantL) > 1e-9 || math.Abs(c-tc.wantC) > 1e-9 || math.Abs(math.Remainder(h-tc.wantH, 2*math.Pi)) > 1e-9 || !checkTol(a, 0.5) {
+			t.Errorf("%s: got (%f, %f, %f, %f), want (%f, %f, %f, 0.5)", tc.name, l, c, h, a, tc.wantL, tc.wantC, tc.wantH)
+		}
+	}
+}
+
+func TestPosterizeApplyToImage(t *testing.T) {
+	img := image.NewGray(image.Rect(0, 0, 256, 1))
+	for x := 0; x < 256; x++ {
+		img.SetGray(x, 0, color.Gray{uint8(x)})
+	}
+	got := (&iro.Posterize{Levels: 4}).ApplyToImage(img)
+	levels := map[color.NRGBA64]struct{}{}
+	for x := 0; x < 256; x++ {
+		levels[got.NRGBA64At(x, 0)] = struct{}{}
+	}
+	if len(levels) != 4 {
+		t.Errorf("levels: got %d, want 4", len(levels))
+	}
+}
+
+func TestPosterizePanic(t *testing.T) {
+	for _, p := range []iro.Posterize{{Levels: 1}, {Levels: 2, ChromaLevels: 1}, {Levels: 2, HueLevels: -1}} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%+v: Apply must panic", p)
+				}
+			}()
+			p.Apply(iro.ColorFromSRGB(0.5, 0.5, 0.5, 1))
+		}()
+	}
+}
@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"fmt"
+)
+
+// The chroma under which the hue is powerless, as the conversion code of CSS Color 4 uses.
+//
+// See https://www.w3.org/TR/css-color-4/#color-conversion-code
+const (
+	powerlessChromaOKLch = 0.000004
+	powerlessChromaLch   = 0.0015
+)
+
+// HuePowerless reports whether the hue of c in the cylindrical space is powerless, i.e. c is achromatic in the space.
+//
+// As CSS Color 4 defines, the hue is powerless when the chroma is 0,
+// and in the conversions, when the chroma is less than a small epsilon: 0.000004 for [SpaceOKLch] and 0.0015 for [SpaceLch].
+// The hue of a powerless color returned by [Color.OKLch] or [Color.Lch] is meaningless,
+// and [Mix] treats it as missing.
+//
+// HuePowerless panics if space is not a cylindrical space like [SpaceOKLch].
+func (c Color) HuePowerless(space Space) bool {
+	switch space {
+	case SpaceOKLch:
+		_, ch, _, _ := c.OKLch()
+		return ch < powerlessChromaOKLch
+	case SpaceLch:
+		_, ch, _, _ := c.Lch()
+		return ch < powerlessChromaLch
+	default:
+		panic(fmt.Sprintf("iro: the space must be a cylindrical space but %s", space))
+	}
+}
+
+// WithOKHue returns the color with the OKLCh hue h in radians, keeping the OKLCh lightness, chroma, and alpha.
+//
+// If the OKLCh hue of c is powerless (see [Color.HuePowerless]), c is returned as it is,
+// as the hue of an achromatic color has no effect.
+func (c Color) WithOKHue(h float64) Color {
+	if c.HuePowerless(SpaceOKLch) {
+		return c
+	}
+	l, ch, _, alpha := c.OKLch()
+	return ColorFromOKLch(l, ch, h, alpha)
+}
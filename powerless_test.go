@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestHuePowerless(t *testing.T) {
+	testCases := []struct {
+		name string
+		c    iro.Color
+		want bool
+	}{
+		{name: "white", c: iro.White, want: true},
+		{name: "black", c: iro.Black, want: true},
+		{name: "gray", c: iro.Gray, want: true},
+		{name: "transparent gray", c: iro.Gray.WithAlpha(0), want: true},
+		{name: "red", c: iro.Red, want: false},
+		{name: "grayish blue", c: iro.ColorFromSRGB(0.5, 0.5, 0.52, 1), want: false},
+	}
+	for _, tc := range testCases {
+		for _, space := range []iro.Space{iro.SpaceOKLch, iro.SpaceLch} {
+			if got := tc.c.HuePowerless(space); got != tc.want {
+				t.Errorf("%s: %s: got %t, want %t", tc.name, space, got, tc.want)
+			}
+		}
+	}
+}
+
+func TestHuePowerlessPanic(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("HuePowerless(SpaceSRGB) must panic")
+		}
+	}()
+	iro.White.HuePowerless(iro.SpaceSRGB)
+}
+
+func TestMixPowerlessHue(t *testing.T) {
+	for _, space := range []iro.Space{iro.SpaceOKLch, iro.SpaceLch} {
+		for _, gray := range []iro.Color{iro.White, iro.Black, iro.Gray} {
+			for _, c := range []iro.Color{iro.Red, iro.Blue, iro.ColorFromSRGB(0.2, 0.8, 0.4, 1)} {
+				_, _, want, _ := c.Components(space)
+				for _, t0 := range []float64{0.25, 0.5, 0.75} {
+					for _, m := range []iro.Color{iro.Mix(gray, c, t0, space), iro.Mix(c, gray, t0, space)} {
+						if _, _, h, _ := m.Components(space); math.Abs(math.Remainder(h-want, 2*math.Pi)) > 1e-6 {
+							t.Errorf("%s: mix of %v and %v at %f: hue: got %f, want %f", space, gray, c, t0, h, want)
+						}
+					}
+				}
+			}
+		}
+	}
+
+	// Mixing achromatic colors results in an achromatic color.
+	if m := iro.Mix(iro.White, iro.Black, 0.5, iro.SpaceOKLch); !m.HuePowerless(iro.SpaceOKLch) {
+		_, ch, _, _ := m.OKLch()
+		t.Errorf("mix of white and black: chroma: got %g, want 0", ch)
+	}
+}
+
+func TestColorFromOKLchNaNHue(t *testing.T) {
+	if got, want := iro.ColorFromOKLch(0.5, 0.1, math.NaN(), 1), iro.ColorFromOKLch(0.5, 0.1, 0, 1); got != want {
+		t.Errorf("OKLch: got %v, want %v", got, want)
+	}
+	if got, want := iro.ColorFromLch(50, 10, math.NaN(), 1), iro.ColorFromLch(50, 10, 0, 1); got != want {
+		t.Errorf("Lch: got %v, want %v", got, want)
+	}
+}
+
+func TestWithOKHue(t *testing.T) {
+	if got := iro.Gray.WithOKHue(2); got != iro.Gray {
+		t.Errorf("gray: got %v, want %v", got, iro.Gray)
+	}
+
+	c := iro.ColorFromOKLch(0.6, 0.1, 1, 0.5)
+	l, ch, h, alpha := c.WithOKHue(3).OKLch()
+	if !checkTol(l, 0.6) || !checkTol(ch, 0.1) || !checkTol(h, 3) || alpha != 0.5 {
+		t.Errorf("got (%f, %f, %f, %f), want (0.6, 0.1, 3, 0.5)", l, ch, h, alpha)
+	}
+}
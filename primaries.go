@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+// Primaries represents the chromaticities of the red, green, and blue primaries and the white point of an RGB space.
+type Primaries struct {
+	Red   Chromaticity
+	Green Chromaticity
+	Blue  Chromaticity
+	White Chromaticity
+}
+
+// The standard primaries.
+var (
+	// PrimariesSRGB is the primaries of sRGB, which are the same as ITU-R BT.709.
+	PrimariesSRGB = Primaries{
+		Red:   Chromaticity{X: 0.640, Y: 0.330},
+		Green: Chromaticity{X: 0.300, Y: 0.600},
+		Blue:  Chromaticity{X: 0.150, Y: 0.060},
+		White: WhiteD65,
+	}
+
+	// PrimariesDisplayP3 is the primaries of Display P3, which are the DCI-P3 primaries with the D65 white point.
+	PrimariesDisplayP3 = Primaries{
+		Red:   Chromaticity{X: 0.680, Y: 0.320},
+		Green: Chromaticity{X: 0.265, Y: 0.690},
+		Blue:  Chromaticity{X: 0.150, Y: 0.060},
+		White: WhiteD65,
+	}
+
+	// PrimariesRec2020 is the primaries of ITU-R BT.2020.
+	PrimariesRec2020 = Primaries{
+		Red:   Chromaticity{X: 0.708, Y: 0.292},
+		Green: Chromaticity{X: 0.170, Y: 0.797},
+		Blue:  Chromaticity{X: 0.131, Y: 0.046},
+		White: WhiteD65,
+	}
+
+	// PrimariesA98RGB is the primaries of Adobe RGB (1998).
+	PrimariesA98RGB = Primaries{
+		Red:   Chromaticity{X: 0.6400, Y: 0.3300},
+		Green: Chromaticity{X: 0.2100, Y: 0.7100},
+		Blue:  Chromaticity{X: 0.1500, Y: 0.0600},
+		White: WhiteD65,
+	}
+
+	// PrimariesProPhotoRGB is the primaries of ProPhoto RGB, whose white point is D50.
+	PrimariesProPhotoRGB = Primaries{
+		Red:   Chromaticity{X: 0.734699, Y: 0.265301},
+		Green: Chromaticity{X: 0.159597, Y: 0.840403},
+		Blue:  Chromaticity{X: 0.036598, Y: 0.000105},
+		White: WhiteD50,
+	}
+
+	// PrimariesACESAP0 is the ACES primaries 0 used by ACES2065-1, whose white point is the ACES white point near D60.
+	PrimariesACESAP0 = Primaries{
+		Red:   Chromaticity{X: 0.7347, Y: 0.2653},
+		Green: Chromaticity{X: 0.0000, Y: 1.0000},
+		Blue:  Chromaticity{X: 0.0001, Y: -0.0770},
+		White: Chromaticity{X: 0.32168, Y: 0.33767},
+	}
+
+	// PrimariesACESAP1 is the ACES primaries 1 used by ACEScg and ACEScc, whose white point is the ACES white point near D60.
+	PrimariesACESAP1 = Primaries{
+		Red:   Chromaticity{X: 0.713, Y: 0.293},
+		Green: Chromaticity{X: 0.165, Y: 0.830},
+		Blue:  Chromaticity{X: 0.128, Y: 0.044},
+		White: Chromaticity{X: 0.32168, Y: 0.33767},
+	}
+)
+
+// RGBToXYZMatrix returns the matrix from linear RGB of the primaries to XYZ.
+// The white (1, 1, 1) is converted to the XYZ of the white point with Y = 1.
+//
+// The XYZ is relative to the white point of the primaries without chromatic adaptation.
+// For example, the matrix of [PrimariesProPhotoRGB] converts to XYZ D50.
+//
+// See http://www.brucelindbloom.com/index.html?Eqn_RGB_XYZ_Matrix.html
+func (p Primaries) RGBToXYZMatrix() Matrix3 {
+	var m Matrix3
+	for j, c := range []Chromaticity{p.Red, p.Green, p.Blue} {
+		m[0][j], m[1][j], m[2][j] = c.XYZ(1)
+	}
+	inv := m.Inverse()
+	sr, sg, sb := inv.Apply(p.White.XYZ(1))
+	for i := 0; i < 3; i++ {
+		m[i][0] *= sr
+		m[i][1] *= sg
+		m[i][2] *= sb
+	}
+	return m
+}
+
+// XYZToRGBMatrix returns the matrix from XYZ to linear RGB of the primaries, the inverse of [Primaries.RGBToXYZMatrix].
+func (p Primaries) XYZToRGBMatrix() Matrix3 {
+	m := p.RGBToXYZMatrix()
+	return m.Inverse()
+}
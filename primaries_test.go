@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestPrimariesRGBToXYZMatrix(t *testing.T) {
+	testCases := []struct {
+		name      string
+		primaries iro.Primaries
+		want      iro.Matrix3
+		tol       float64
+	}{
+		{name: "sRGB", primaries: iro.PrimariesSRGB, want: iro.MatrixLinearSRGBToXYZ, tol: 1e-15},
+		{name: "Display P3", primaries: iro.PrimariesDisplayP3, want: iro.MatrixLinearDisplayP3ToXYZ, tol: 1e-15},
+		{name: "Rec. 2020", primaries: iro.PrimariesRec2020, want: iro.MatrixLinearRec2020ToXYZ, tol: 1e-15},
+		{name: "Adobe RGB (1998)", primaries: iro.PrimariesA98RGB, want: iro.MatrixLinearA98RGBToXYZ, tol: 1e-15},
+		{name: "ProPhoto RGB", primaries: iro.PrimariesProPhotoRGB, want: iro.MatrixLinearProPhotoRGBToXYZD50, tol: 1e-15},
+		// https://github.com/ampas/aces-dev/blob/master/transforms/ctl/README-MATRIX.md
+		{
+			name:      "ACES AP0",
+			primaries: iro.PrimariesACESAP0,
+			want: iro.Matrix3{
+				{0.9525523959, 0, 0.0000936786},
+				{0.3439664498, 0.7281660966, -0.0721325464},
+				{0, 0, 1.0088251844},
+			},
+			tol: 1e-9,
+		},
+		{
+			name:      "ACES AP1",
+			primaries: iro.PrimariesACESAP1,
+			want: iro.Matrix3{
+				{0.6624541811, 0.1340042065, 0.1561876870},
+				{0.2722287168, 0.6740817658, 0.0536895174},
+				{-0.0055746495, 0.0040607335, 1.0103391003},
+			},
+			tol: 1e-9,
+		},
+	}
+	for _, tc := range testCases {
+		got := tc.primaries.RGBToXYZMatrix()
+		inv := tc.primaries.XYZToRGBMatrix()
+		for i := 0; i < 3; i++ {
+			for j := 0; j < 3; j++ {
+				if math.Abs(got[i][j]-tc.want[i][j]) > tc.tol {
+					t.Errorf("%s: RGBToXYZMatrix: [%d][%d]: got %v, want %v", tc.name, i, j, got[i][j], tc.want[i][j])
+				}
+			}
+		}
+
+		// The white is converted to the white point.
+		x, y, z := got.Apply(1, 1, 1)
+		wx, wy, wz := tc.primaries.White.XYZ(1)
+		if !checkTol(x, wx) || !checkTol(y, wy) || !checkTol(z, wz) {
+			t.Errorf("%s: white: got (%f, %f, %f), want (%f, %f, %f)", tc.name, x, y, z, wx, wy, wz)
+		}
+		r, g, b := inv.Apply(x, y, z)
+		if !checkTol(r, 1) || !checkTol(g, 1) || !checkTol(b, 1) {
+			t.Errorf("%s: XYZToRGBMatrix: got (%f, %f, %f), want (1, 1, 1)", tc.name, r, g, b)
+		}
+	}
+}
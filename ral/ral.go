@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+// Package ral provides the codes and the names of the RAL Classic color collection with approximate colors.
+//
+// The colors are approximations for screen display: 8-bit sRGB values as commonly circulated for RAL Classic,
+// not derived from the colorimetric reference data of RAL gGmbH nor verified against it.
+// They can noticeably differ from the physical reference samples.
+// Use the reference samples and their official data for color-critical work like paint matching.
+package ral
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/hajimehoshi/iro"
+)
+
+// Color is a color of RAL Classic.
+type Color struct {
+	// Code is the four-digit code like 1000 for RAL 1000.
+	Code int
+
+	// Name is the English name like "Green beige".
+	Name string
+
+	// Color is an approximate color for screen display. See the package documentation.
+	Color iro.Color
+}
+
+// String returns the code with the prefix like "RAL 1000".
+func (c Color) String() string {
+	return fmt.Sprintf("RAL %d", c.Code)
+}
+
+// colors is the colors built from the table.
+var colors = func() []Color {
+	cs := make([]Color, len(table))
+	for i, e := range table {
+		cs[i] = Color{
+			Code: e.code,
+			Name: e.name,
+			Color: iro.ColorFromSRGB(
+				float64(e.rgb>>16)/0xff,
+				float64((e.rgb>>8)&0xff)/0xff,
+				float64(e.rgb&0xff)/0xff,
+				1),
+		}
+	}
+	return cs
+}()
+
+// Colors returns all the colors of RAL Classic in the order of the codes.
+func Colors() []Color {
+	return append([]Color(nil), colors...)
+}
+
+// Lookup returns the color of the given code.
+// The code can be like "RAL 1000", "ral1000", or "1000".
+func Lookup(code string) (Color, bool) {
+	code = strings.TrimSpace(code)
+	if len(code) >= 3 && strings.EqualFold(code[:3], "RAL") {
+		code = strings.TrimSpace(code[3:])
+	}
+	n, err := strconv.Atoi(code)
+	if err != nil {
+		return Color{}, false
+	}
+	for _, c := range colors {
+		if c.Code == n {
+			return c, true
+		}
+	}
+	return Color{}, false
+}
+
+// Nearest returns the color of RAL Classic whose approximate color is nearest to c in OKLab.
+// The alpha value of c is ignored.
+//
+// As the colors are approximations, Nearest suggests a visually similar code,
+// and the result is not a colorimetric match against the reference samples.
+func Nearest(c iro.Color) Color {
+	l0, a0, b0, _ := c.OKLab()
+	var nearest Color
+	minDist := math.Inf(1)
+	for _, rc := range colors {
+		l1, a1, b1, _ := rc.Color.OKLab()
+		dl, da, db := l1-l0, a1-a0, b1-b0
+		if d := dl*dl + da*da + db*db; d < minDist {
+			minDist = d
+			nearest = rc
+		}
+	}
+	return nearest
+}
@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package ral_test
+
+import (
+	"image/color"
+	"slices"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+	"github.com/hajimehoshi/iro/ral"
+)
+
+func TestColors(t *testing.T) {
+	cs := ral.Colors()
+	if len(cs) < 200 {
+		t.Errorf("len: got %d, want >= 200", len(cs))
+	}
+	if !slices.IsSortedFunc(cs, func(a, b ral.Color) int {
+		return a.Code - b.Code
+	}) {
+		t.Errorf("the colors must be sorted by the codes")
+	}
+	for i := 1; i < len(cs); i++ {
+		if cs[i].Code == cs[i-1].Code {
+			t.Errorf("duplicated code: %d", cs[i].Code)
+		}
+	}
+
+	// Modifying the result doesn't affect the table.
+	cs[0].Name = "foo"
+	if ral.Colors()[0].Name == "foo" {
+		t.Errorf("Colors must return a copy")
+	}
+}
+
+func TestLookup(t *testing.T) {
+	for _, code := range []string{"RAL 3020", "ral3020", "3020", " RAL 3020 "} {
+		c, ok := ral.Lookup(code)
+		if !ok {
+			t.Errorf("Lookup(%q): not found", code)
+			continue
+		}
+		if got, want := c.Name, "Traffic red"; got != want {
+			t.Errorf("Lookup(%q): name: got %q, want %q", code, got, want)
+		}
+		if got, want := c.String(), "RAL 3020"; got != want {
+			t.Errorf("Lookup(%q): String(): got %q, want %q", code, got, want)
+		}
+		got := color.NRGBAModel.Convert(c.Color.SRGBColor()).(color.NRGBA)
+		if want := (color.NRGBA{0xbb, 0x1e, 0x10, 0xff}); got != want {
+			t.Errorf("Lookup(%q): color: got %v, want %v", code, got, want)
+		}
+	}
+	for _, code := range []string{"RAL 1234", "", "RAL", "foo"} {
+		if _, ok := ral.Lookup(code); ok {
+			t.Errorf("Lookup(%q) must not be found", code)
+		}
+	}
+}
+
+func TestNearest(t *testing.T) {
+	// Every color of the table is the nearest to itself.
+	for _, c := range ral.Colors() {
+		if got := ral.Nearest(c.Color); got.Code != c.Code {
+			t.Errorf("Nearest(%s): got %s", c, got)
+		}
+	}
+
+	if got, want := ral.Nearest(iro.ColorFromSRGB(0, 0, 0, 1)).Code, 9005; got != want {
+		t.Errorf("Nearest(black): got %d, want %d", got, want)
+	}
+}
@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"image"
+	"math"
+)
+
+// replacementMinChroma is the OKLCh chroma under which a source color of ColorReplacement is regarded as achromatic.
+const replacementMinChroma = 1e-4
+
+// ColorReplacement replaces colors similar to a source color with a target color, like "change the shirt from red to blue".
+//
+// The colors are selected by ΔEOK from the source color, so the selection is a range of the hue, the chroma, and the lightness around it.
+// The selected colors are shifted relatively in OKLCh by the difference between the source and the target,
+// so shades and textures of the selected area are kept.
+type ColorReplacement struct {
+	// Source is the color to replace.
+	Source Color
+
+	// Target is the color to replace the source with.
+	Target Color
+
+	// Tolerance is ΔEOK from the source within which colors are fully replaced.
+	Tolerance float64
+
+	// Feather is the width of ΔEOK beyond Tolerance where the replacement falls smoothly from full to none.
+	Feather float64
+
+	// ProtectSkinTones reports whether skin tones are protected from the replacement.
+	// If true, the weight is reduced by [Color.SkinToneWeight].
+	ProtectSkinTones bool
+}
+
+// Weight returns the strength of the replacement for c in [0, 1]. See [DeltaEOK].
+func (r *ColorReplacement) Weight(c Color) float64 {
+	w := 1.0
+	if d := DeltaEOK(c, r.Source) - r.Tolerance; d > 0 {
+		if d >= r.Feather {
+			return 0
+		}
+		w = 1 - smoothstep(0, r.Feather, d)
+	}
+	if r.ProtectSkinTones {
+		w *= 1 - c.SkinToneWeight()
+	}
+	return w
+}
+
+// Apply returns a new Color by replacing c.
+// The result might be outside the gamut of c.
+// The alpha value is kept.
+func (r *ColorReplacement) Apply(c Color) Color {
+	w := r.Weight(c)
+	if w == 0 {
+		return c
+	}
+	ls, cs, hs, _ := r.Source.OKLch()
+	lt, ct, ht, _ := r.Target.OKLch()
+	l, ch, h, alpha := c.OKLch()
+
+	l += (lt - ls) * w
+	if cs < replacementMinChroma {
+		// The source has no hue, so add the chroma and take the hue of the target.
+		ch = max(ch+(ct-cs)*w, 0)
+		h = ht
+	} else {
+		// Scale the chroma to keep the variations of the chroma.
+		ch *= 1 + (ct/cs-1)*w
+		h += math.Remainder(ht-hs, 2*math.Pi) * w
+	}
+	if math.IsNaN(h) {
+		h = 0
+	}
+	return ColorFromOKLch(l, ch, h, alpha)
+}
+
+// ApplyToImage returns a new image by replacing each pixel of img.
+// See [MapImage] for the interpretation of the pixels.
+func (r *ColorReplacement) ApplyToImage(img image.Image) *image.NRGBA64 {
+	return MapImage(img, r.Apply)
+}
@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestColorReplacement(t *testing.T) {
+	red := iro.ColorFromOKLch(0.6, 0.2, 0.5, 1)
+	blue := iro.ColorFromOKLch(0.5, 0.15, 4.5, 1)
+	r := &iro.ColorReplacement{
+		Source:    red,
+		Target:    blue,
+		Tolerance: 0.05,
+		Feather:   0.1,
+	}
+
+	// The source becomes the target.
+	if got := r.Apply(red); iro.DeltaEOK(got, blue) > 1e-9 {
+		t.Errorf("Apply(red): got %v, want %v", got, blue)
+	}
+
+	// A darker shade of the source becomes a darker shade of the target.
+	shade := iro.ColorFromOKLch(0.57, 0.18, 0.5, 0.5)
+	l, c, h, a := r.Apply(shade).OKLch()
+	if !checkTol(l, 0.47) || !checkTol(c, 0.135) || !checkTol(iro.NormalizeHue(h), 4.5) || !checkTol(a, 0.5) {
+		t.Errorf("Apply(shade): got (%f, %f, %f, %f), want (0.47, 0.135, 4.5, 0.5)", l, c, h, a)
+	}
+
+	// A far color is not changed.
+	green := iro.ColorFromOKLch(0.7, 0.15, 2.5, 1)
+	if got := r.Apply(green); got != green {
+		t.Errorf("Apply(green): got %v, want %v", got, green)
+	}
+
+	// A color in the feather is partially changed.
+	mid := iro.ColorFromOKLch(0.6, 0.1, 0.5, 1)
+	if w := r.Weight(mid); w <= 0 || w >= 1 {
+		t.Errorf("Weight(mid): got %f, want in (0, 1)", w)
+	}
+}
+
+func TestColorReplacementWeight(t *testing.T) {
+	src := iro.ColorFromOKLab(0.5, 0.1, 0, 1)
+	r := &iro.ColorReplacement{Source: src, Tolerance: 0.1, Feather: 0.2}
+	testCases := []struct {
+		d    float64
+		want float64
+	}{
+		{d: 0, want: 1},
+		{d: 0.1, want: 1},
+		{d: 0.2, want: 0.5},
+		{d: 0.3, want: 0},
+		{d: 0.5, want: 0},
+	}
+	for _, tc := range testCases {
+		if got := r.Weight(iro.ColorFromOKLab(0.5+tc.d, 0.1, 0, 1)); math.Abs(got-tc.want) > 1e-9 {
+			t.Errorf("ΔEOK %f: got %f, want %f", tc.d, got, tc.want)
+		}
+	}
+}
+
+func TestColorReplacementGraySource(t *testing.T) {
+	gray := iro.ColorFromSRGB(0.5, 0.5, 0.5, 1)
+	target := iro.ColorFromOKLch(0.6, 0.1, 1, 1)
+	r := &iro.ColorReplacement{Source: gray, Target: target}
+	if got := r.Apply(gray); iro.DeltaEOK(got, target) > 1e-9 {
+		t.Errorf("got %v, want %v", got, target)
+	}
+
+	img := image.NewNRGBA(image.Rect(0, 0, 1, 1))
+	img.SetNRGBA(0, 0, color.NRGBA{0x80, 0x80, 0x80, 0xff})
+	want := r.Apply(iro.ColorFromSRGBNRGBA(img.NRGBAAt(0, 0))).SRGBNRGBA64()
+	if got := r.ApplyToImage(img).NRGBA64At(0, 0); got != want {
+		t.Errorf("ApplyToImage: got %v, want %v", got, want)
+	}
+}
+
+func TestColorReplacementProtectSkinTones(t *testing.T) {
+	skin, err := iro.ParseCSS("#c68642")
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := &iro.ColorReplacement{
+		Source:           skin,
+		Target:           iro.ColorFromOKLch(0.5, 0.15, 4.5, 1),
+		ProtectSkinTones: true,
+	}
+	if got := r.Apply(skin); got != skin {
+		t.Errorf("got %v, want %v", got, skin)
+	}
+}
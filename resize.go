@@ -0,0 +1,202 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"fmt"
+	"image"
+	"math"
+)
+
+// ResizeFilter specifies a filter to resample an image.
+type ResizeFilter int
+
+const (
+	// ResizeFilterCatmullRom is the Catmull-Rom cubic filter. This is the default.
+	ResizeFilterCatmullRom ResizeFilter = iota
+
+	// ResizeFilterBox is the box filter, which averages the covered pixels when downsampling.
+	ResizeFilterBox
+
+	// ResizeFilterLinear is the triangle filter, i.e. bilinear interpolation when upsampling.
+	ResizeFilterLinear
+
+	// ResizeFilterLanczos3 is the Lanczos filter with 3 lobes.
+	ResizeFilterLanczos3
+)
+
+// radius returns the radius of the filter for upsampling.
+func (f ResizeFilter) radius() float64 {
+	switch f {
+	case ResizeFilterCatmullRom:
+		return 2
+	case ResizeFilterBox:
+		return 0.5
+	case ResizeFilterLinear:
+		return 1
+	case ResizeFilterLanczos3:
+		return 3
+	default:
+		panic(fmt.Sprintf("iro: invalid ResizeFilter: %d", f))
+	}
+}
+
+// weight returns the weight of the filter at the distance x.
+func (f ResizeFilter) weight(x float64) float64 {
+	x = math.Abs(x)
+	switch f {
+	case ResizeFilterCatmullRom:
+		switch {
+		case x < 1:
+			return (3*x-5)*x*x/2 + 1
+		case x < 2:
+			return ((-x+5)*x-8)*x/2 + 2
+		}
+		return 0
+	case ResizeFilterBox:
+		if x <= 0.5 {
+			return 1
+		}
+		return 0
+	case ResizeFilterLinear:
+		return max(1-x, 0)
+	case ResizeFilterLanczos3:
+		if x == 0 {
+			return 1
+		}
+		if x < 3 {
+			px := math.Pi * x
+			return 3 * math.Sin(px) * math.Sin(px/3) / (px * px)
+		}
+		return 0
+	default:
+		panic(fmt.Sprintf("iro: invalid ResizeFilter: %d", f))
+	}
+}
+
+// ResizeOptions represents options for [ResizeImage].
+type ResizeOptions struct {
+	// Filter is the filter to resample the image.
+	Filter ResizeFilter
+
+	// OKLab reports whether the pixels are filtered in OKLab instead of linear sRGB.
+	// Filtering in OKLab keeps the chroma of fine details of different hues better,
+	// while filtering in linear sRGB is physically correct as light.
+	OKLab bool
+}
+
+// resizeWeight is a weight of a source pixel for a destination pixel.
+type resizeWeight struct {
+	index  int
+	weight float64
+}
+
+// resizeWeights returns the weights of the source pixels for each destination pixel.
+func resizeWeights(srcN, dstN int, filter ResizeFilter) [][]resizeWeight {
+	scale := float64(srcN) / float64(dstN)
+	// When downsampling, the filter is stretched to cover the source pixels.
+	stretch := max(scale, 1)
+	support := filter.radius() * stretch
+
+	weights := make([][]resizeWeight, dstN)
+	for i := range weights {
+		center := (float64(i)+0.5)*scale - 0.5
+		var ws []resizeWeight
+		var sum float64
+		for j := int(math.Floor(center - support)); j <= int(math.Ceil(center+support)); j++ {
+			w := filter.weight((float64(j) - center) / stretch)
+			if w == 0 {
+				continue
+			}
+			// Extend the edges.
+			idx := min(max(j, 0), srcN-1)
+			if n := len(ws); n > 0 && ws[n-1].index == idx {
+				ws[n-1].weight += w
+			} else {
+				ws = append(ws, resizeWeight{index: idx, weight: w})
+			}
+			sum += w
+		}
+		for k := range ws {
+			ws[k].weight /= sum
+		}
+		weights[i] = ws
+	}
+	return weights
+}
+
+// ResizeImage returns a new image of the size resized from img.
+// The bounds of the result start at (0, 0).
+//
+// Unlike resizers operating on the encoded sRGB values, which darken and fringe fine details,
+// ResizeImage filters the pixels in linear light (or optionally in OKLab) with alpha premultiplied, and encodes the results as sRGB.
+// See [MapImage] for the interpretation of the pixels.
+//
+// If opts is nil, the default options are used.
+//
+// ResizeImage panics if width or height is negative.
+func ResizeImage(img image.Image, width, height int, opts *ResizeOptions) *image.NRGBA64 {
+	if width < 0 || height < 0 {
+		panic(fmt.Sprintf("iro: the size must not be negative but (%d, %d)", width, height))
+	}
+	if opts == nil {
+		opts = &ResizeOptions{}
+	}
+	dst := image.NewNRGBA64(image.Rect(0, 0, width, height))
+	b := img.Bounds()
+	sw, sh := b.Dx(), b.Dy()
+	if width == 0 || height == 0 || sw == 0 || sh == 0 {
+		return dst
+	}
+
+	space := SpaceLinearSRGB
+	if opts.OKLab {
+		space = SpaceOKLab
+	}
+
+	// Convert the pixels to the premultiplied components in the space.
+	src := make([]float64, sw*sh*4)
+	for y := 0; y < sh; y++ {
+		for x := 0; x < sw; x++ {
+			c0, c1, c2, a := colorAt(img, b.Min.X+x, b.Min.Y+y).Components(space)
+			i := (y*sw + x) * 4
+			src[i], src[i+1], src[i+2], src[i+3] = c0*a, c1*a, c2*a, a
+		}
+	}
+
+	// Filter horizontally, and then vertically.
+	xws := resizeWeights(sw, width, opts.Filter)
+	tmp := make([]float64, width*sh*4)
+	for y := 0; y < sh; y++ {
+		for x, ws := range xws {
+			d := tmp[(y*width+x)*4 : (y*width+x)*4+4]
+			for _, w := range ws {
+				s := src[(y*sw+w.index)*4 : (y*sw+w.index)*4+4]
+				for k := range d {
+					d[k] += s[k] * w.weight
+				}
+			}
+		}
+	}
+	yws := resizeWeights(sh, height, opts.Filter)
+	for y, ws := range yws {
+		for x := 0; x < width; x++ {
+			var d [4]float64
+			for _, w := range ws {
+				s := tmp[(w.index*width+x)*4 : (w.index*width+x)*4+4]
+				for k := range d {
+					d[k] += s[k] * w.weight
+				}
+			}
+			// Filters with negative lobes can make the alpha out of [0, 1].
+			a := min(max(d[3], 0), 1)
+			var c Color
+			if d[3] > 0 {
+				c = ColorFromComponents(space, d[0]/d[3], d[1]/d[3], d[2]/d[3], a)
+			}
+			dst.SetNRGBA64(x, y, c.SRGBNRGBA64())
+		}
+	}
+	return dst
+}
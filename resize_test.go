@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestResizeImageLinearLight(t *testing.T) {
+	// A checkerboard of black and white.
+	img := image.NewGray(image.Rect(0, 0, 32, 32))
+	for y := 0; y < 32; y++ {
+		for x := 0; x < 32; x++ {
+			if (x+y)%2 == 0 {
+				img.SetGray(x, y, color.Gray{0xff})
+			}
+		}
+	}
+	for _, filter := range []iro.ResizeFilter{iro.ResizeFilterBox, iro.ResizeFilterLinear, iro.ResizeFilterCatmullRom, iro.ResizeFilterLanczos3} {
+		got := iro.ResizeImage(img, 8, 8, &iro.ResizeOptions{Filter: filter})
+		if got.Bounds() != image.Rect(0, 0, 8, 8) {
+			t.Fatalf("filter %d: bounds: got %v", filter, got.Bounds())
+		}
+		// The box filter averages the pixels exactly. The other filters have small aliasing.
+		tol := 0.02
+		if filter == iro.ResizeFilterBox {
+			tol = 1e-3
+		}
+		// Check the pixels away from the edges.
+		for y := 2; y < 6; y++ {
+			for x := 2; x < 6; x++ {
+				// The average of black and white in linear light is 0.5, not the sRGB value 0.5.
+				r, g, b, a := iro.ColorFromSRGBColor(got.At(x, y)).LinearSRGB()
+				if math.Abs(r-0.5) > tol || math.Abs(g-0.5) > tol || math.Abs(b-0.5) > tol || !checkTol(a, 1) {
+					t.Errorf("filter %d: (%d, %d): got (%f, %f, %f, %f), want (0.5, 0.5, 0.5, 1)", filter, x, y, r, g, b, a)
+				}
+			}
+		}
+	}
+}
+
+func TestResizeImagePremultiplied(t *testing.T) {
+	// An opaque red pixel and a transparent green pixel.
+	img := image.NewNRGBA(image.Rect(10, 10, 12, 11))
+	img.SetNRGBA(10, 10, color.NRGBA{0xff, 0, 0, 0xff})
+	img.SetNRGBA(11, 10, color.NRGBA{0, 0xff, 0, 0})
+	for _, oklab := range []bool{false, true} {
+		got := iro.ResizeImage(img, 1, 1, &iro.ResizeOptions{Filter: iro.ResizeFilterBox, OKLab: oklab})
+		// The transparent green must not be mixed.
+		c := got.NRGBA64At(0, 0)
+		if c.R != 0xffff || c.G != 0 || c.B != 0 || c.A != 0x8000 {
+			t.Errorf("OKLab=%t: got %v, want {0xffff 0 0 0x8000}", oklab, c)
+		}
+	}
+}
+
+func TestResizeImageIdentity(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 3, 2))
+	for i := range img.Pix {
+		img.Pix[i] = uint8(i * 17)
+	}
+	for i := 3; i < len(img.Pix); i += 4 {
+		img.Pix[i] = 0xff
+	}
+	for _, filter := range []iro.ResizeFilter{iro.ResizeFilterBox, iro.ResizeFilterLinear, iro.ResizeFilterCatmullRom, iro.ResizeFilterLanczos3} {
+		got := iro.ResizeImage(img, 3, 2, &iro.ResizeOptions{Filter: filter})
+		for y := 0; y < 2; y++ {
+			for x := 0; x < 3; x++ {
+				want := color.NRGBA64Model.Convert(img.NRGBAAt(x, y)).(color.NRGBA64)
+				if got := got.NRGBA64At(x, y); got != want {
+					t.Errorf("filter %d: (%d, %d): got %v, want %v", filter, x, y, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestResizeImageUpsample(t *testing.T) {
+	img := image.NewGray(image.Rect(0, 0, 2, 1))
+	img.SetGray(1, 0, color.Gray{0xff})
+	got := iro.ResizeImage(img, 4, 1, nil)
+	// The result is monotonic from black to white.
+	prev := -1.0
+	for x := 0; x < 4; x++ {
+		r, _, _, _ := iro.ColorFromSRGBColor(got.At(x, 0)).LinearSRGB()
+		if r < prev {
+			t.Errorf("(%d, 0): got %f, previous %f", x, r, prev)
+		}
+		prev = r
+	}
+}
@@ -0,0 +1,184 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"fmt"
+)
+
+// mulMat returns the product of the matrices a and b.
+func mulMat(a, b *Matrix3) Matrix3 {
+	var m Matrix3
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			m[i][j] = a[i][0]*b[0][j] + a[i][1]*b[1][j] + a[i][2]*b[2][j]
+		}
+	}
+	return m
+}
+
+// linearSpace returns the linear space and the transfer functions of the nonlinear RGB space.
+// linearSpace returns false if space is not a nonlinear RGB space.
+func (s Space) linearSpace() (linear Space, decode, encode func(float64) float64, ok bool) {
+	switch s {
+	case SpaceSRGB, SpaceDisplayP3:
+		lut := degammaLUT()
+		decode = func(x float64) float64 {
+			return degammaWithLUT(lut, x)
+		}
+		if s == SpaceSRGB {
+			return SpaceLinearSRGB, decode, gamma, true
+		}
+		return SpaceLinearDisplayP3, decode, gamma, true
+	case SpaceSRGBGamma22:
+		return SpaceLinearSRGB, degamma22, gamma22, true
+	case SpaceRec2020:
+		return SpaceLinearRec2020, rec2020Degamma, rec2020Gamma, true
+	case SpaceA98RGB:
+		return SpaceLinearA98RGB, a98Degamma, a98Gamma, true
+	case SpaceProPhotoRGB:
+		return SpaceLinearProPhotoRGB, prophotoDegamma, prophotoGamma, true
+	}
+	return 0, nil, nil, false
+}
+
+// isLinear reports whether s is linearly related to XYZ.
+func (s Space) isLinear() bool {
+	switch s {
+	case SpaceLinearSRGB, SpaceLinearDisplayP3, SpaceLinearRec2020, SpaceLinearA98RGB, SpaceLinearProPhotoRGB, SpaceXYZ, SpaceXYZD50:
+		return true
+	}
+	return false
+}
+
+// toXYZMatrix returns the matrix from the linear space s to XYZ D65.
+func (s Space) toXYZMatrix() Matrix3 {
+	var m Matrix3
+	for j := 0; j < 3; j++ {
+		var v [3]float64
+		v[j] = 1
+		m[0][j], m[1][j], m[2][j], _ = ColorFromComponents(s, v[0], v[1], v[2], 1).XYZ()
+	}
+	return m
+}
+
+// fromXYZMatrix returns the matrix from XYZ D65 to the linear space s.
+func (s Space) fromXYZMatrix() Matrix3 {
+	var m Matrix3
+	for j := 0; j < 3; j++ {
+		var v [3]float64
+		v[j] = 1
+		m[0][j], m[1][j], m[2][j], _ = ColorFromXYZ(v[0], v[1], v[2], 1).Components(s)
+	}
+	return m
+}
+
+// rowStage is a stage of a row conversion, either a matrix multiplication m or an element-wise function f.
+type rowStage struct {
+	m *Matrix3
+	f func(float64) float64
+}
+
+func (r *rowStage) apply(dst, src []float64) {
+	if r.m != nil {
+		mulRows(r.m, dst, src)
+		return
+	}
+	mapRows(r.f, dst, src)
+}
+
+func cube(x float64) float64 {
+	return x * x * x
+}
+
+// rowStages returns the stages to convert from the space from to the space to.
+// rowStages returns false if the conversion has no fast path.
+func rowStages(from, to Space) ([]rowStage, bool) {
+	var stages []rowStage
+	appendMatrix := func(m Matrix3) {
+		// Fuse consecutive matrices.
+		if n := len(stages); n > 0 && stages[n-1].m != nil {
+			fused := mulMat(&m, stages[n-1].m)
+			stages[n-1].m = &fused
+			return
+		}
+		stages = append(stages, rowStage{m: &m})
+	}
+
+	// Decode from to XYZ D65.
+	switch {
+	case from == SpaceOKLab:
+		appendMatrix(MatrixOKLabToOKLabLMS)
+		stages = append(stages, rowStage{f: cube})
+		appendMatrix(MatrixOKLabLMSToXYZ)
+	case from.isLinear():
+		appendMatrix(from.toXYZMatrix())
+	default:
+		linear, decode, _, ok := from.linearSpace()
+		if !ok {
+			return nil, false
+		}
+		stages = append(stages, rowStage{f: decode})
+		appendMatrix(linear.toXYZMatrix())
+	}
+
+	// Encode XYZ D65 to to.
+	switch {
+	case to == SpaceOKLab:
+		appendMatrix(MatrixXYZToOKLabLMS)
+		stages = append(stages, rowStage{f: cbrt})
+		appendMatrix(MatrixOKLabLMSToOKLab)
+	case to.isLinear():
+		appendMatrix(to.fromXYZMatrix())
+	default:
+		linear, _, encode, ok := to.linearSpace()
+		if !ok {
+			return nil, false
+		}
+		appendMatrix(linear.fromXYZMatrix())
+		stages = append(stages, rowStage{f: encode})
+	}
+	return stages, true
+}
+
+// ConvertRow converts interleaved triplets of components in src from the space from to the space to,
+// and stores them in dst. Alpha values are not included.
+// This is useful to convert rows of pixels at once.
+//
+// dst is reused if it has enough capacity. The returned slice has the same length as src.
+// dst can be src itself to convert in place, but must not partially overlap src.
+//
+// For linear spaces, nonlinear RGB spaces, and OKLab, ConvertRow multiplies fused matrices with SIMD instructions
+// on amd64 and arm64 unless the build tag purego is specified,
+// and applies the transfer functions component by component, with lookup tables where possible.
+// The maximum error against [Color.Components] is 1e-6 except for very dark colors in OKLab.
+// For other spaces, the components are converted one by one with [ColorFromComponents] and [Color.Components].
+//
+// ConvertRow panics if the length of src is not a multiple of 3.
+func ConvertRow(dst, src []float64, from, to Space) []float64 {
+	if len(src)%3 != 0 {
+		panic(fmt.Sprintf("iro: the length of src must be a multiple of 3 but %d", len(src)))
+	}
+	if cap(dst) < len(src) {
+		dst = make([]float64, len(src))
+	}
+	dst = dst[:len(src)]
+
+	stages, ok := rowStages(from, to)
+	if !ok {
+		for i := 0; i < len(src); i += 3 {
+			dst[i], dst[i+1], dst[i+2], _ = ColorFromComponents(from, src[i], src[i+1], src[i+2], 1).Components(to)
+		}
+		return dst
+	}
+
+	for i, s := range stages {
+		if i == 0 {
+			s.apply(dst, src)
+			continue
+		}
+		s.apply(dst, dst)
+	}
+	return dst
+}
@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestMulRows(t *testing.T) {
+	m := iro.Matrix3{
+		{0.1, 0.2, 0.3},
+		{-0.4, 0.5, 0.6},
+		{0.7, -0.8, 0.9},
+	}
+	r := rand.New(rand.NewSource(1))
+	for n := 0; n < 10; n++ {
+		src := make([]float64, 3*n)
+		for i := range src {
+			src[i] = r.Float64()*2 - 1
+		}
+		got := make([]float64, len(src))
+		want := make([]float64, len(src))
+		iro.MulRows(&m, got, src)
+		iro.MulRowsGeneric(&m, want, src)
+		for i := range got {
+			if math.Abs(got[i]-want[i]) > 1e-15 {
+				t.Errorf("n=%d: [%d]: got %f, want %f", n, i, got[i], want[i])
+			}
+		}
+
+		// In place.
+		iro.MulRows(&m, src, src)
+		for i := range src {
+			if math.Abs(src[i]-want[i]) > 1e-15 {
+				t.Errorf("n=%d: in place: [%d]: got %f, want %f", n, i, src[i], want[i])
+			}
+		}
+	}
+}
+
+func TestConvertRow(t *testing.T) {
+	spaces := []iro.Space{
+		iro.SpaceSRGB,
+		iro.SpaceLinearSRGB,
+		iro.SpaceDisplayP3,
+		iro.SpaceLinearDisplayP3,
+		iro.SpaceOKLab,
+		iro.SpaceXYZ,
+		iro.SpaceLab,
+		iro.SpaceRec2020,
+		iro.SpaceLinearRec2020,
+		iro.SpaceA98RGB,
+		iro.SpaceProPhotoRGB,
+		iro.SpaceXYZD50,
+		iro.SpaceSRGBGamma22,
+	}
+
+	r := rand.New(rand.NewSource(1))
+	var colors []iro.Color
+	for i := 0; i < 64; i++ {
+		colors = append(colors, iro.ColorFromSRGB(0.05+0.95*r.Float64(), 0.05+0.95*r.Float64(), 0.05+0.95*r.Float64(), 1))
+	}
+
+	for _, from := range spaces {
+		for _, to := range spaces {
+			src := make([]float64, 0, 3*len(colors))
+			for _, c := range colors {
+				c0, c1, c2, _ := c.Components(from)
+				src = append(src, c0, c1, c2)
+			}
+			got := iro.ConvertRow(nil, src, from, to)
+			if len(got) != len(src) {
+				t.Fatalf("%s to %s: len: got %d, want %d", from, to, len(got), len(src))
+			}
+			for i := 0; i < len(src); i += 3 {
+				w0, w1, w2, _ := iro.ColorFromComponents(from, src[i], src[i+1], src[i+2], 1).Components(to)
+				// Lab components are in [0, 100].
+				tol := 1e-6
+				if to == iro.SpaceLab {
+					tol = 1e-4
+				}
+				for j, want := range []float64{w0, w1, w2} {
+					if diff := math.Abs(got[i+j] - want); diff > tol {
+						t.Errorf("%s to %s: [%d]: got %f, want %f (diff=%g)", from, to, i+j, got[i+j], want, diff)
+					}
+				}
+			}
+
+			// In place.
+			inPlace := iro.ConvertRow(src, src, from, to)
+			for i := range got {
+				if inPlace[i] != got[i] {
+					t.Errorf("%s to %s: in place: [%d]: got %f, want %f", from, to, i, inPlace[i], got[i])
+				}
+			}
+		}
+	}
+}
+
+func TestConvertRowPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("ConvertRow must panic")
+		}
+	}()
+	iro.ConvertRow(nil, make([]float64, 4), iro.SpaceSRGB, iro.SpaceOKLab)
+}
+
+func BenchmarkConvertRowSRGBToOKLab(b *testing.B) {
+	// A row of a 4K frame.
+	src := make([]float64, 3*3840)
+	for i := range src {
+		src[i] = float64(i%256) / 255
+	}
+	dst := make([]float64, len(src))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		dst = iro.ConvertRow(dst, src, iro.SpaceSRGB, iro.SpaceOKLab)
+	}
+}
+
+func BenchmarkConvertRowSRGBToOKLabPerPixel(b *testing.B) {
+	src := make([]float64, 3*3840)
+	for i := range src {
+		src[i] = float64(i%256) / 255
+	}
+	dst := make([]float64, len(src))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < len(src); j += 3 {
+			dst[j], dst[j+1], dst[j+2], _ = iro.ColorFromSRGB(src[j], src[j+1], src[j+2], 1).OKLab()
+		}
+	}
+}
@@ -0,0 +1,303 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+)
+
+// ScaleTransform specifies how the domain of a Scale is transformed before being mapped onto the gradient.
+type ScaleTransform int
+
+const (
+	// ScaleTransformLinear represents no transform.
+	ScaleTransformLinear ScaleTransform = iota
+
+	// ScaleTransformLog represents the logarithm. Min and Max must be positive.
+	// Non-positive values are mapped to the position 0 of the gradient.
+	ScaleTransformLog
+
+	// ScaleTransformSymlog represents the bi-symmetric logarithm sign(v)·log(1 + |v|/Constant),
+	// which is linear near 0 and logarithmic for large magnitudes, and works for 0 and negative values.
+	ScaleTransformSymlog
+
+	// ScaleTransformPow represents the power sign(v)·|v|^Exponent.
+	ScaleTransformPow
+)
+
+// Scale maps a numeric domain onto a Gradient, like a color scale of heatmaps and choropleths.
+type Scale struct {
+	// Gradient is the gradient that the domain is mapped onto.
+	// Min is mapped to the position 0 and Max is mapped to the position 1 of the gradient.
+	Gradient *Gradient
+
+	// Min and Max are the domain of the scale. Values outside the domain are clamped.
+	// Min can be greater than Max.
+	Min float64
+	Max float64
+
+	// NaNColor is the color for NaN values. The default is the zero Color, a transparent black.
+	NaNColor Color
+
+	// Reverse reports whether the gradient is reversed, i.e. Min is mapped to the position 1.
+	Reverse bool
+
+	// Transform is the transform of the domain.
+	Transform ScaleTransform
+
+	// Constant is the constant of ScaleTransformSymlog. If Constant is 0, 1 is used.
+	Constant float64
+
+	// Exponent is the exponent of ScaleTransformPow. If Exponent is 0, 1 is used.
+	Exponent float64
+}
+
+// NewScale creates a Scale mapping the domain [min, max] onto the gradient.
+func NewScale(g *Gradient, min, max float64) *Scale {
+	return &Scale{
+		Gradient: g,
+		Min:      min,
+		Max:      max,
+	}
+}
+
+// transform returns v transformed by the Transform.
+func (s *Scale) transform(v float64) float64 {
+	switch s.Transform {
+	case ScaleTransformLinear:
+		return v
+	case ScaleTransformLog:
+		return math.Log(v)
+	case ScaleTransformSymlog:
+		c := s.Constant
+		if c == 0 {
+			c = 1
+		}
+		return math.Copysign(math.Log1p(math.Abs(v)/c), v)
+	case ScaleTransformPow:
+		e := s.Exponent
+		if e == 0 {
+			e = 1
+		}
+		return math.Copysign(math.Pow(math.Abs(v), e), v)
+	default:
+		panic(fmt.Sprintf("iro: invalid ScaleTransform: %d", s.Transform))
+	}
+}
+
+// position returns the position in [0, 1] of v in the gradient.
+//
+// position panics if the transform is ScaleTransformLog and Min or Max is not positive.
+func (s *Scale) position(v float64) float64 {
+	if s.Transform == ScaleTransformLog && !(s.Min > 0 && s.Max > 0) {
+		panic(fmt.Sprintf("iro: Min and Max of a scale with ScaleTransformLog must be positive but %g and %g", s.Min, s.Max))
+	}
+	var t float64
+	switch {
+	case s.Min == s.Max:
+		// The domain is degenerate. Use the middle of the gradient.
+		t = 0.5
+	case s.Transform == ScaleTransformLog && !(v > 0):
+		// Non-positive values have no logarithm.
+		t = 0
+	default:
+		t0, t1 := s.transform(s.Min), s.transform(s.Max)
+		t = (s.transform(v) - t0) / (t1 - t0)
+	}
+	// !(t > 0) is true for NaN, e.g. when the domain has infinities.
+	if !(t > 0) {
+		t = 0
+	}
+	t = min(t, 1)
+	if s.Reverse {
+		t = 1 - t
+	}
+	return t
+}
+
+// At returns the color for the value v.
+//
+// At panics if the gradient has no stops, or the transform is ScaleTransformLog and Min or Max is not positive.
+func (s *Scale) At(v float64) Color {
+	if math.IsNaN(v) {
+		return s.NaNColor
+	}
+	return s.Gradient.At(s.position(v))
+}
+
+// DivergingScale maps a numeric domain onto two gradients on both sides of a midpoint,
+// like a color scale of signed anomalies.
+type DivergingScale struct {
+	// Lower is the gradient that the domain [Min, Mid] is mapped onto.
+	// Min is mapped to the position 0 and Mid is mapped to the position 1 of the gradient.
+	Lower *Gradient
+
+	// Upper is the gradient that the domain [Mid, Max] is mapped onto.
+	// Mid is mapped to the position 0 and Max is mapped to the position 1 of the gradient.
+	Upper *Gradient
+
+	// Min, Mid, and Max are the domain of the scale. Values outside the domain are clamped.
+	// Mid should be between Min and Max.
+	Min float64
+	Mid float64
+	Max float64
+
+	// NaNColor is the color for NaN values. The default is the zero Color, a transparent black.
+	NaNColor Color
+
+	// Transform, Constant, and Exponent are the transform of the domain. See [Scale].
+	Transform ScaleTransform
+	Constant  float64
+	Exponent  float64
+}
+
+// NewDivergingScale creates a DivergingScale mapping the domain [min, mid] onto lower and [mid, max] onto upper.
+// Usually, the last stop of lower and the first stop of upper have the same neutral color.
+func NewDivergingScale(lower, upper *Gradient, min, mid, max float64) *DivergingScale {
+	return &DivergingScale{
+		Lower: lower,
+		Upper: upper,
+		Min:   min,
+		Mid:   mid,
+		Max:   max,
+	}
+}
+
+// At returns the color for the value v. The midpoint itself is mapped with Upper.
+//
+// At panics if either gradient has no stops, or the transform is ScaleTransformLog and Min, Mid, or Max is not positive.
+func (s *DivergingScale) At(v float64) Color {
+	if math.IsNaN(v) {
+		return s.NaNColor
+	}
+	sc := Scale{
+		Transform: s.Transform,
+		Constant:  s.Constant,
+		Exponent:  s.Exponent,
+	}
+	if v != s.Mid && (v < s.Mid) == (s.Min < s.Mid) {
+		sc.Gradient, sc.Min, sc.Max = s.Lower, s.Min, s.Mid
+	} else {
+		sc.Gradient, sc.Min, sc.Max = s.Upper, s.Mid, s.Max
+	}
+	return sc.At(v)
+}
+
+// ThresholdScale maps numeric values to discrete classes of colors by thresholds.
+type ThresholdScale struct {
+	// Thresholds is the boundaries of the classes, sorted in ascending order.
+	// A value v is in the class i where Thresholds[i-1] <= v < Thresholds[i].
+	Thresholds []float64
+
+	// Colors is the colors of the classes. The length must be len(Thresholds) + 1.
+	Colors []Color
+
+	// NaNColor is the color for NaN values. The default is the zero Color, a transparent black.
+	NaNColor Color
+}
+
+// NewThresholdScale creates a ThresholdScale with the thresholds and the colors.
+//
+// NewThresholdScale panics if len(colors) is not len(thresholds) + 1, or thresholds are not sorted.
+func NewThresholdScale(thresholds []float64, colors []Color) *ThresholdScale {
+	if len(colors) != len(thresholds)+1 {
+		panic(fmt.Sprintf("iro: the number of colors must be %d but %d", len(thresholds)+1, len(colors)))
+	}
+	if !sort.Float64sAreSorted(thresholds) {
+		panic("iro: thresholds must be sorted")
+	}
+	return &ThresholdScale{
+		Thresholds: slices.Clone(thresholds),
+		Colors:     slices.Clone(colors),
+	}
+}
+
+// NewQuantileScale creates a ThresholdScale whose classes have the same number of values of the sample.
+// The thresholds are the quantiles of the sample, linearly interpolated between the closest values.
+// NaN values in the sample are ignored.
+//
+// NewQuantileScale panics if colors is empty, or the sample has no values other than NaN.
+func NewQuantileScale(sample []float64, colors []Color) *ThresholdScale {
+	if len(colors) == 0 {
+		panic("iro: a quantile scale requires at least one color")
+	}
+	sorted := make([]float64, 0, len(sample))
+	for _, v := range sample {
+		if !math.IsNaN(v) {
+			sorted = append(sorted, v)
+		}
+	}
+	if len(sorted) == 0 {
+		panic("iro: a quantile scale requires at least one value in the sample")
+	}
+	sort.Float64s(sorted)
+
+	n := len(colors)
+	thresholds := make([]float64, n-1)
+	for i := range thresholds {
+		// This is the R-7 method, the default of R and NumPy.
+		p := float64(i+1) / float64(n) * float64(len(sorted)-1)
+		j := int(p)
+		thresholds[i] = sorted[j]
+		if j+1 < len(sorted) {
+			thresholds[i] = lerp(sorted[j], sorted[j+1], p-float64(j))
+		}
+	}
+	return &ThresholdScale{
+		Thresholds: thresholds,
+		Colors:     slices.Clone(colors),
+	}
+}
+
+// Class returns the index of the class of v, or -1 if v is NaN.
+func (s *ThresholdScale) Class(v float64) int {
+	if math.IsNaN(v) {
+		return -1
+	}
+	return sort.Search(len(s.Thresholds), func(i int) bool {
+		return s.Thresholds[i] > v
+	})
+}
+
+// At returns the color of the class of v.
+func (s *ThresholdScale) At(v float64) Color {
+	i := s.Class(v)
+	if i < 0 {
+		return s.NaNColor
+	}
+	return s.Colors[i]
+}
+
+// ScaleClass is a class of a ThresholdScale, for example to render a legend.
+type ScaleClass struct {
+	// Min and Max are the range [Min, Max) of the class.
+	// Min of the first class is -Inf, and Max of the last class is +Inf.
+	Min float64
+	Max float64
+
+	// Color is the color of the class.
+	Color Color
+}
+
+// Classes returns the classes of the scale.
+func (s *ThresholdScale) Classes() []ScaleClass {
+	classes := make([]ScaleClass, len(s.Colors))
+	for i, c := range s.Colors {
+		classes[i] = ScaleClass{
+			Min:   math.Inf(-1),
+			Max:   math.Inf(1),
+			Color: c,
+		}
+		if i > 0 {
+			classes[i].Min = s.Thresholds[i-1]
+		}
+		if i < len(s.Thresholds) {
+			classes[i].Max = s.Thresholds[i]
+		}
+	}
+	return classes
+}
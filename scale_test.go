@@ -0,0 +1,259 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestScale(t *testing.T) {
+	g := iro.NewGradient(iro.Black, iro.White)
+
+	testCases := []struct {
+		name    string
+		min     float64
+		max     float64
+		reverse bool
+		v       float64
+		want    float64
+	}{
+		{name: "min", min: 10, max: 20, v: 10, want: 0},
+		{name: "middle", min: 10, max: 20, v: 15, want: 0.5},
+		{name: "max", min: 10, max: 20, v: 20, want: 1},
+		{name: "below", min: 10, max: 20, v: -100, want: 0},
+		{name: "above", min: 10, max: 20, v: 100, want: 1},
+		{name: "quarter", min: 10, max: 20, v: 12.5, want: 0.25},
+		{name: "inverted domain", min: 20, max: 10, v: 12.5, want: 0.75},
+		{name: "reversed", min: 10, max: 20, reverse: true, v: 12.5, want: 0.75},
+		{name: "infinity", min: 10, max: 20, v: math.Inf(1), want: 1},
+		{name: "degenerate", min: 10, max: 10, v: 10, want: 0.5},
+	}
+	for _, tc := range testCases {
+		s := iro.NewScale(g, tc.min, tc.max)
+		s.Reverse = tc.reverse
+		l, _, _, _ := s.At(tc.v).OKLab()
+		if !checkTol(l, tc.want) {
+			t.Errorf("%s: At(%f): L: got %f, want %f", tc.name, tc.v, l, tc.want)
+		}
+	}
+}
+
+func TestScaleNaN(t *testing.T) {
+	s := iro.NewScale(iro.NewGradient(iro.Black, iro.White), 0, 1)
+	if got, want := s.At(math.NaN()), (iro.Color{}); got != want {
+		t.Errorf("At(NaN): got %v, want %v", got, want)
+	}
+	s.NaNColor = iro.Red
+	if got, want := s.At(math.NaN()), iro.Red; got != want {
+		t.Errorf("At(NaN): got %v, want %v", got, want)
+	}
+}
+
+func TestScaleTransform(t *testing.T) {
+	g := iro.NewGradient(iro.Black, iro.White)
+
+	testCases := []struct {
+		name      string
+		transform iro.ScaleTransform
+		min       float64
+		max       float64
+		constant  float64
+		exponent  float64
+		v         float64
+		want      float64
+	}{
+		{name: "log", transform: iro.ScaleTransformLog, min: 1, max: 1000, v: 10, want: 1.0 / 3},
+		{name: "log middle", transform: iro.ScaleTransformLog, min: 1, max: 10000, v: 100, want: 0.5},
+		{name: "log zero", transform: iro.ScaleTransformLog, min: 1, max: 1000, v: 0, want: 0},
+		{name: "log negative", transform: iro.ScaleTransformLog, min: 1, max: 1000, v: -5, want: 0},
+		{name: "log negative in an inverted domain", transform: iro.ScaleTransformLog, min: 1000, max: 1, v: -5, want: 0},
+		{name: "log infinity", transform: iro.ScaleTransformLog, min: 1, max: 1000, v: math.Inf(1), want: 1},
+		{name: "symlog zero", transform: iro.ScaleTransformSymlog, min: -100, max: 100, v: 0, want: 0.5},
+		{name: "symlog", transform: iro.ScaleTransformSymlog, min: 0, max: 99, v: 9, want: math.Log(10) / math.Log(100)},
+		{name: "symlog negative", transform: iro.ScaleTransformSymlog, min: -99, max: 0, v: -9, want: 1 - math.Log(10)/math.Log(100)},
+		{name: "symlog constant", transform: iro.ScaleTransformSymlog, min: 0, max: 990, constant: 10, v: 90, want: math.Log(10) / math.Log(100)},
+		{name: "pow", transform: iro.ScaleTransformPow, min: 0, max: 100, exponent: 0.5, v: 25, want: 0.5},
+		{name: "pow square", transform: iro.ScaleTransformPow, min: 0, max: 10, exponent: 2, v: 5, want: 0.25},
+		{name: "pow negative", transform: iro.ScaleTransformPow, min: -4, max: 4, exponent: 0.5, v: -1, want: 0.25},
+		{name: "pow default", transform: iro.ScaleTransformPow, min: 0, max: 10, v: 5, want: 0.5},
+	}
+	for _, tc := range testCases {
+		s := iro.NewScale(g, tc.min, tc.max)
+		s.Transform = tc.transform
+		s.Constant = tc.constant
+		s.Exponent = tc.exponent
+		l, _, _, _ := s.At(tc.v).OKLab()
+		if !checkTol(l, tc.want) {
+			t.Errorf("%s: At(%f): L: got %f, want %f", tc.name, tc.v, l, tc.want)
+		}
+	}
+}
+
+func TestScaleTransformLogPanics(t *testing.T) {
+	testCases := []struct {
+		name string
+		min  float64
+		max  float64
+	}{
+		{name: "zero min", min: 0, max: 100},
+		{name: "zero max", min: 1, max: 0},
+		{name: "negative min", min: -1, max: 100},
+		{name: "NaN min", min: math.NaN(), max: 100},
+	}
+	for _, tc := range testCases {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: At must panic", tc.name)
+				}
+			}()
+			s := iro.NewScale(iro.NewGradient(iro.Black, iro.White), tc.min, tc.max)
+			s.Transform = iro.ScaleTransformLog
+			s.At(10)
+		}()
+	}
+}
+
+func TestDivergingScale(t *testing.T) {
+	lower := iro.NewGradient(iro.Blue, iro.White)
+	upper := iro.NewGradient(iro.White, iro.Red)
+	s := iro.NewDivergingScale(lower, upper, -10, 0, 100)
+
+	testCases := []struct {
+		v    float64
+		want iro.Color
+	}{
+		{v: -20, want: iro.Blue},
+		{v: -10, want: iro.Blue},
+		{v: -5, want: iro.Mix(iro.Blue, iro.White, 0.5, iro.SpaceOKLab)},
+		{v: 0, want: iro.White},
+		{v: 25, want: iro.Mix(iro.White, iro.Red, 0.25, iro.SpaceOKLab)},
+		{v: 100, want: iro.Red},
+		{v: 1000, want: iro.Red},
+		{v: math.NaN(), want: iro.Color{}},
+	}
+	for _, tc := range testCases {
+		got := s.At(tc.v)
+		l0, a0, b0, _ := got.OKLab()
+		l1, a1, b1, _ := tc.want.OKLab()
+		if !checkTol(l0, l1) || !checkTol(a0, a1) || !checkTol(b0, b1) || got.Alpha() != tc.want.Alpha() {
+			t.Errorf("At(%f): got %v, want %v", tc.v, got, tc.want)
+		}
+	}
+}
+
+func TestDivergingScaleInverted(t *testing.T) {
+	// Min can be greater than Max.
+	lower := iro.NewGradient(iro.Blue, iro.White)
+	upper := iro.NewGradient(iro.White, iro.Red)
+	s := iro.NewDivergingScale(lower, upper, 1, 0, -1)
+	s.Transform = iro.ScaleTransformSymlog
+
+	if got, want := s.At(2), iro.Blue; got != want {
+		t.Errorf("At(2): got %v, want %v", got, want)
+	}
+	if got, want := s.At(-2), iro.Red; got != want {
+		t.Errorf("At(-2): got %v, want %v", got, want)
+	}
+	if got, want := s.At(0), iro.White; got != want {
+		t.Errorf("At(0): got %v, want %v", got, want)
+	}
+}
+
+func TestThresholdScale(t *testing.T) {
+	colors := []iro.Color{iro.Blue, iro.Green, iro.Red}
+	s := iro.NewThresholdScale([]float64{0, 10}, colors)
+
+	testCases := []struct {
+		v     float64
+		class int
+	}{
+		{v: -100, class: 0},
+		{v: -0.5, class: 0},
+		{v: 0, class: 1},
+		{v: 9.9, class: 1},
+		{v: 10, class: 2},
+		{v: math.Inf(1), class: 2},
+		{v: math.NaN(), class: -1},
+	}
+	for _, tc := range testCases {
+		if got := s.Class(tc.v); got != tc.class {
+			t.Errorf("Class(%f): got %d, want %d", tc.v, got, tc.class)
+		}
+		want := iro.Color{}
+		if tc.class >= 0 {
+			want = colors[tc.class]
+		}
+		if got := s.At(tc.v); got != want {
+			t.Errorf("At(%f): got %v, want %v", tc.v, got, want)
+		}
+	}
+
+	classes := s.Classes()
+	wantClasses := []iro.ScaleClass{
+		{Min: math.Inf(-1), Max: 0, Color: iro.Blue},
+		{Min: 0, Max: 10, Color: iro.Green},
+		{Min: 10, Max: math.Inf(1), Color: iro.Red},
+	}
+	if len(classes) != len(wantClasses) {
+		t.Fatalf("len(Classes()): got %d, want %d", len(classes), len(wantClasses))
+	}
+	for i := range classes {
+		if classes[i] != wantClasses[i] {
+			t.Errorf("Classes()[%d]: got %+v, want %+v", i, classes[i], wantClasses[i])
+		}
+	}
+}
+
+func TestNewThresholdScalePanics(t *testing.T) {
+	testCases := []struct {
+		name       string
+		thresholds []float64
+		colors     []iro.Color
+	}{
+		{name: "too few colors", thresholds: []float64{0, 1}, colors: []iro.Color{iro.Red, iro.Blue}},
+		{name: "unsorted", thresholds: []float64{1, 0}, colors: []iro.Color{iro.Red, iro.Green, iro.Blue}},
+	}
+	for _, tc := range testCases {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: NewThresholdScale must panic", tc.name)
+				}
+			}()
+			iro.NewThresholdScale(tc.thresholds, tc.colors)
+		}()
+	}
+}
+
+func TestQuantileScale(t *testing.T) {
+	sample := []float64{9, 1, 5, math.NaN(), 3, 7}
+	s := iro.NewQuantileScale(sample, []iro.Color{iro.Blue, iro.Green, iro.Red, iro.Yellow})
+
+	// The sorted sample is [1, 3, 5, 7, 9].
+	want := []float64{3, 5, 7}
+	if len(s.Thresholds) != len(want) {
+		t.Fatalf("len(Thresholds): got %d, want %d", len(s.Thresholds), len(want))
+	}
+	for i := range want {
+		if !checkTol(s.Thresholds[i], want[i]) {
+			t.Errorf("Thresholds[%d]: got %f, want %f", i, s.Thresholds[i], want[i])
+		}
+	}
+
+	// Interpolated quantiles.
+	s = iro.NewQuantileScale([]float64{0, 1, 2, 3}, []iro.Color{iro.Blue, iro.Red})
+	if got, want := s.Thresholds, []float64{1.5}; len(got) != 1 || !checkTol(got[0], want[0]) {
+		t.Errorf("Thresholds: got %v, want %v", got, want)
+	}
+	if got, want := s.At(1), iro.Blue; got != want {
+		t.Errorf("At(1): got %v, want %v", got, want)
+	}
+	if got, want := s.At(2), iro.Red; got != want {
+		t.Errorf("At(2): got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,152 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"math"
+)
+
+// CorePalette is the set of the key tonal palettes of a Material Design color scheme.
+type CorePalette struct {
+	Primary        TonalPalette
+	Secondary      TonalPalette
+	Tertiary       TonalPalette
+	Neutral        TonalPalette
+	NeutralVariant TonalPalette
+	Error          TonalPalette
+}
+
+// NewCorePalette creates a CorePalette from the seed color as Material Design does.
+//
+// The primary palette has the hue of the seed and the chroma of the seed, at least 48.
+// The secondary, the neutral, and the neutral variant palettes have the hue of the seed and the chroma 16, 4, and 8.
+// The tertiary palette has the hue rotated by 60° and the chroma 24.
+// The error palette has the hue 25° and the chroma 84, regardless of the seed.
+func NewCorePalette(seed Color) *CorePalette {
+	h, c, _, _ := seed.HCT()
+	deg := math.Pi / 180
+	return &CorePalette{
+		Primary:        TonalPalette{Hue: h, Chroma: max(c, 48)},
+		Secondary:      TonalPalette{Hue: h, Chroma: 16},
+		Tertiary:       TonalPalette{Hue: NormalizeHue(h + 60*deg), Chroma: 24},
+		Neutral:        TonalPalette{Hue: h, Chroma: 4},
+		NeutralVariant: TonalPalette{Hue: h, Chroma: 8},
+		Error:          TonalPalette{Hue: 25 * deg, Chroma: 84},
+	}
+}
+
+// Scheme is a Material Design color scheme, the colors of the roles in a user interface.
+type Scheme struct {
+	Primary            Color
+	OnPrimary          Color
+	PrimaryContainer   Color
+	OnPrimaryContainer Color
+
+	Secondary            Color
+	OnSecondary          Color
+	SecondaryContainer   Color
+	OnSecondaryContainer Color
+
+	Tertiary            Color
+	OnTertiary          Color
+	TertiaryContainer   Color
+	OnTertiaryContainer Color
+
+	Error            Color
+	OnError          Color
+	ErrorContainer   Color
+	OnErrorContainer Color
+
+	Background       Color
+	OnBackground     Color
+	Surface          Color
+	OnSurface        Color
+	SurfaceVariant   Color
+	OnSurfaceVariant Color
+	Outline          Color
+	OutlineVariant   Color
+	Shadow           Color
+	Scrim            Color
+	InverseSurface   Color
+	InverseOnSurface Color
+	InversePrimary   Color
+}
+
+// LightScheme returns the light color scheme of the palettes.
+func (p *CorePalette) LightScheme() *Scheme {
+	return &Scheme{
+		Primary:            p.Primary.Tone(40),
+		OnPrimary:          p.Primary.Tone(100),
+		PrimaryContainer:   p.Primary.Tone(90),
+		OnPrimaryContainer: p.Primary.Tone(10),
+
+		Secondary:            p.Secondary.Tone(40),
+		OnSecondary:          p.Secondary.Tone(100),
+		SecondaryContainer:   p.Secondary.Tone(90),
+		OnSecondaryContainer: p.Secondary.Tone(10),
+
+		Tertiary:            p.Tertiary.Tone(40),
+		OnTertiary:          p.Tertiary.Tone(100),
+		TertiaryContainer:   p.Tertiary.Tone(90),
+		OnTertiaryContainer: p.Tertiary.Tone(10),
+
+		Error:            p.Error.Tone(40),
+		OnError:          p.Error.Tone(100),
+		ErrorContainer:   p.Error.Tone(90),
+		OnErrorContainer: p.Error.Tone(10),
+
+		Background:       p.Neutral.Tone(99),
+		OnBackground:     p.Neutral.Tone(10),
+		Surface:          p.Neutral.Tone(99),
+		OnSurface:        p.Neutral.Tone(10),
+		SurfaceVariant:   p.NeutralVariant.Tone(90),
+		OnSurfaceVariant: p.NeutralVariant.Tone(30),
+		Outline:          p.NeutralVariant.Tone(50),
+		OutlineVariant:   p.NeutralVariant.Tone(80),
+		Shadow:           p.Neutral.Tone(0),
+		Scrim:            p.Neutral.Tone(0),
+		InverseSurface:   p.Neutral.Tone(20),
+		InverseOnSurface: p.Neutral.Tone(95),
+		InversePrimary:   p.Primary.Tone(80),
+	}
+}
+
+// DarkScheme returns the dark color scheme of the palettes.
+func (p *CorePalette) DarkScheme() *Scheme {
+	return &Scheme{
+		Primary:            p.Primary.Tone(80),
+		OnPrimary:          p.Primary.Tone(20),
+		PrimaryContainer:   p.Primary.Tone(30),
+		OnPrimaryContainer: p.Primary.Tone(90),
+
+		Secondary:            p.Secondary.Tone(80),
+		OnSecondary:          p.Secondary.Tone(20),
+		SecondaryContainer:   p.Secondary.Tone(30),
+		OnSecondaryContainer: p.Secondary.Tone(90),
+
+		Tertiary:            p.Tertiary.Tone(80),
+		OnTertiary:          p.Tertiary.Tone(20),
+		TertiaryContainer:   p.Tertiary.Tone(30),
+		OnTertiaryContainer: p.Tertiary.Tone(90),
+
+		Error:            p.Error.Tone(80),
+		OnError:          p.Error.Tone(20),
+		ErrorContainer:   p.Error.Tone(30),
+		OnErrorContainer: p.Error.Tone(90),
+
+		Background:       p.Neutral.Tone(10),
+		OnBackground:     p.Neutral.Tone(90),
+		Surface:          p.Neutral.Tone(10),
+		OnSurface:        p.Neutral.Tone(90),
+		SurfaceVariant:   p.NeutralVariant.Tone(30),
+		OnSurfaceVariant: p.NeutralVariant.Tone(80),
+		Outline:          p.NeutralVariant.Tone(60),
+		OutlineVariant:   p.NeutralVariant.Tone(30),
+		Shadow:           p.Neutral.Tone(0),
+		Scrim:            p.Neutral.Tone(0),
+		InverseSurface:   p.Neutral.Tone(90),
+		InverseOnSurface: p.Neutral.Tone(20),
+		InversePrimary:   p.Primary.Tone(40),
+	}
+}
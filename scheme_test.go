@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestScheme(t *testing.T) {
+	p := iro.NewCorePalette(iro.Blue)
+	light := p.LightScheme()
+	dark := p.DarkScheme()
+
+	// The expected values are from Material Color Utilities.
+	testCases := []struct {
+		name  string
+		color iro.Color
+		want  uint32
+	}{
+		{name: "light primary", color: light.Primary, want: 0x343dff},
+		{name: "light on primary", color: light.OnPrimary, want: 0xffffff},
+		{name: "light primary container", color: light.PrimaryContainer, want: 0xe0e0ff},
+		{name: "light on primary container", color: light.OnPrimaryContainer, want: 0x00006e},
+		{name: "light secondary", color: light.Secondary, want: 0x5c5d72},
+		{name: "light tertiary", color: light.Tertiary, want: 0x78536b},
+		{name: "light error", color: light.Error, want: 0xba1a1a},
+		{name: "light surface", color: light.Surface, want: 0xfffbff},
+		{name: "light on surface", color: light.OnSurface, want: 0x1b1b1f},
+		{name: "dark primary", color: dark.Primary, want: 0xbec2ff},
+		{name: "dark on primary", color: dark.OnPrimary, want: 0x0001ac},
+		{name: "dark primary container", color: dark.PrimaryContainer, want: 0x0000ef},
+		{name: "dark secondary", color: dark.Secondary, want: 0xc5c4dd},
+		{name: "dark tertiary", color: dark.Tertiary, want: 0xe8b9d5},
+		{name: "dark error", color: dark.Error, want: 0xffb4ab},
+		{name: "dark surface", color: dark.Surface, want: 0x1b1b1f},
+		{name: "dark on surface", color: dark.OnSurface, want: 0xe5e1e6},
+	}
+	for _, tc := range testCases {
+		c := tc.color.SRGBNRGBA()
+		// Allow a difference of 1 for the rounding errors.
+		for i, v := range []uint8{c.R, c.G, c.B} {
+			w := int(tc.want >> (16 - 8*i) & 0xff)
+			if d := int(v) - w; d < -1 || d > 1 {
+				t.Errorf("%s: got %s, want #%06x", tc.name, tc.color.Hex(), tc.want)
+				break
+			}
+		}
+	}
+}
+
+func TestCorePaletteMinimumChroma(t *testing.T) {
+	// The primary palette of a muted seed still has the chroma 48.
+	p := iro.NewCorePalette(iro.ColorFromSRGB(0.5, 0.45, 0.4, 1))
+	if got, want := p.Primary.Chroma, 48.0; got != want {
+		t.Errorf("primary chroma: got %f, want %f", got, want)
+	}
+	if got, want := p.Secondary.Hue, p.Primary.Hue; got != want {
+		t.Errorf("secondary hue: got %f, want %f", got, want)
+	}
+}
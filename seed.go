@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"hash/fnv"
+	"math"
+)
+
+// StringColorOptions represents options for [ColorFromString].
+type StringColorOptions struct {
+	// MinLightness and MaxLightness are the range of the OKLCh lightness.
+	// If both are 0, the range is [0.55, 0.75].
+	MinLightness float64
+	MaxLightness float64
+
+	// MinChroma and MaxChroma are the range of the OKLCh chroma.
+	// If both are 0, the range is [0.08, 0.16].
+	MinChroma float64
+	MaxChroma float64
+
+	// CompensateHK specifies whether the lightness is compensated for the Helmholtz–Kohlrausch effect.
+	// If true, the colors are darkened so that their [Color.HKLightness] is the one of the gray of the OKLCh lightness,
+	// and colors with the same lightness look equally bright regardless of the chroma and the hue.
+	CompensateHK bool
+}
+
+func (o *StringColorOptions) lightness() (float64, float64) {
+	if o == nil || o.MinLightness == 0 && o.MaxLightness == 0 {
+		return 0.55, 0.75
+	}
+	return o.MinLightness, o.MaxLightness
+}
+
+func (o *StringColorOptions) chroma() (float64, float64) {
+	if o == nil || o.MinChroma == 0 && o.MaxChroma == 0 {
+		return 0.08, 0.16
+	}
+	return o.MinChroma, o.MaxChroma
+}
+
+// ColorFromString returns an opaque color derived from the hash of seed.
+// The same seed always results in the same color, across processes and versions of Go.
+// This is useful to color user avatars, log streams, and so on.
+//
+// The color has a uniformly distributed OKLCh hue, and the lightness and the chroma in the ranges of opts.
+// If opts is nil, the default options are used.
+// The chroma is reduced if needed so that the color is inside the sRGB gamut.
+func ColorFromString(seed string, opts *StringColorOptions) Color {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(seed))
+	v := h.Sum64()
+	// Mix the bits so that similar seeds result in different colors.
+	v ^= v >> 33
+	v *= 0xff51afd7ed558ccd
+	v ^= v >> 33
+
+	// Take three fractions in [0, 1) from the hash.
+	hue := float64(v&0xffffff) / (1 << 24)
+	lt := float64(v>>24&0xffff) / (1 << 16)
+	ct := float64(v>>40&0xffff) / (1 << 16)
+
+	minL, maxL := opts.lightness()
+	minC, maxC := opts.chroma()
+	l := lerp(minL, maxL, lt)
+	ch := lerp(minC, maxC, ct)
+	hr := hue * 2 * math.Pi
+
+	if opts == nil || !opts.CompensateHK {
+		return colorFromOKLchInSRGB(l, ch, hr)
+	}
+
+	// The perceived lightness increases with the OKLCh lightness, so find the lightness by bisection.
+	target := lstarFromY(l * l * l)
+	lo, hi := 0.0, l
+	for i := 0; i < 32; i++ {
+		mid := (lo + hi) / 2
+		if colorFromOKLchInSRGB(mid, ch, hr).HKLightness() < target {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return colorFromOKLchInSRGB((lo+hi)/2, ch, hr)
+}
+
+// colorFromOKLchInSRGB returns the opaque color of the OKLCh components, whose chroma is reduced to fit the sRGB gamut.
+func colorFromOKLchInSRGB(l, ch, h float64) Color {
+	c := ColorFromOKLch(l, ch, h, 1)
+	if c.inGamut(SpaceSRGB) {
+		return c
+	}
+	return ColorFromOKLch(l, GamutMaxChroma(SpaceSRGB, l, h), h, 1)
+}
@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestColorFromString(t *testing.T) {
+	// The result must be stable.
+	if got, want := iro.ColorFromString("alice", nil), iro.ColorFromString("alice", nil); got != want {
+		t.Errorf("got %v and %v for the same seed", got, want)
+	}
+	if a, b := iro.ColorFromString("alice", nil), iro.ColorFromString("alicf", nil); a == b {
+		t.Errorf("got the same color %v for different seeds", a)
+	}
+
+	opts := []*iro.StringColorOptions{
+		nil,
+		{MinLightness: 0.3, MaxLightness: 0.4, MinChroma: 0.2, MaxChroma: 0.3},
+		{MinLightness: 0.9, MaxLightness: 0.9},
+	}
+	for i, opt := range opts {
+		minL, maxL, maxC := 0.55, 0.75, 0.16
+		if opt != nil {
+			if opt.MinLightness != 0 || opt.MaxLightness != 0 {
+				minL, maxL = opt.MinLightness, opt.MaxLightness
+			}
+			if opt.MinChroma != 0 || opt.MaxChroma != 0 {
+				maxC = opt.MaxChroma
+			}
+		}
+		var hues [4]int
+		for j := 0; j < 200; j++ {
+			c := iro.ColorFromString(fmt.Sprintf("user%d", j), opt)
+			l, ch, h, a := c.OKLchDeg()
+			if l < minL-1e-6 || l > maxL+1e-6 {
+				t.Errorf("opts #%d, user%d: lightness: got %f, want in [%f, %f]", i, j, l, minL, maxL)
+			}
+			if ch > maxC+1e-6 {
+				t.Errorf("opts #%d, user%d: chroma: got %f, want <= %f", i, j, ch, maxC)
+			}
+			if a != 1 {
+				t.Errorf("opts #%d, user%d: alpha: got %f, want 1", i, j, a)
+			}
+			r, g, b, _ := c.SRGB()
+			for _, v := range []float64{r, g, b} {
+				if v < -1e-6 || v > 1+1e-6 {
+					t.Errorf("opts #%d, user%d: got (%f, %f, %f), want inside the sRGB gamut", i, j, r, g, b)
+					break
+				}
+			}
+			hues[int(h/90)]++
+		}
+		// Hues should be spread.
+		for q, n := range hues {
+			if n < 20 {
+				t.Errorf("opts #%d: only %d of 200 colors have hues in [%d, %d)", i, n, q*90, (q+1)*90)
+			}
+		}
+	}
+}
+
+func TestColorFromStringCompensateHK(t *testing.T) {
+	opts := &iro.StringColorOptions{
+		MinLightness: 0.7,
+		MaxLightness: 0.7,
+		MinChroma:    0.15,
+		MaxChroma:    0.15,
+		CompensateHK: true,
+	}
+	// The perceived lightness is the one of the gray of the lightness.
+	want := iro.ColorFromOKLch(0.7, 0, 0, 1).LStar()
+	for j := 0; j < 50; j++ {
+		seed := fmt.Sprintf("user%d", j)
+		c := iro.ColorFromString(seed, opts)
+		if got := c.HKLightness(); math.Abs(got-want) > 1e-3 {
+			t.Errorf("%s: HKLightness: got %f, want %f", seed, got, want)
+		}
+		// The colors are darkened.
+		if l, _, _, _ := c.OKLch(); l > 0.7+1e-6 {
+			t.Errorf("%s: lightness: got %f, want <= 0.7", seed, l)
+		}
+		r, g, b, _ := c.SRGB()
+		for _, v := range []float64{r, g, b} {
+			if v < -1e-6 || v > 1+1e-6 {
+				t.Errorf("%s: got (%f, %f, %f), want inside the sRGB gamut", seed, r, g, b)
+				break
+			}
+		}
+	}
+}
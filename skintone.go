@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"math"
+)
+
+// skinToneHues is the range of the OKLCh hues of skin tones, from reddish brown to light beige.
+var skinToneHues = HueRange{
+	Center:  52 * math.Pi / 180,
+	Width:   60 * math.Pi / 180,
+	Feather: 15 * math.Pi / 180,
+}
+
+// SkinToneWeight returns how likely c is a skin tone in [0, 1].
+//
+// The skin tones are modeled as a region in OKLCh: the hues from about 22° to 82°,
+// moderate chroma (about 0.02 to 0.15), and the lightness excluding near black and near white.
+// The region has soft edges so that adjustments weighted by it don't make visible seams.
+// The region covers the skin tones of various ethnicities under neutral light,
+// but also some non-skin colors like wood and sand. The alpha value is ignored.
+//
+// SkinToneWeight is useful to protect faces from selective adjustments. See [ColorReplacement] and [HueRotation].
+func (c Color) SkinToneWeight() float64 {
+	l, ch, h, _ := c.OKLch()
+	return skinToneWeight(l, ch, h)
+}
+
+// IsSkinTone reports whether c is likely a skin tone, i.e. [Color.SkinToneWeight] is at least 0.5.
+func (c Color) IsSkinTone() bool {
+	return c.SkinToneWeight() >= 0.5
+}
+
+func skinToneWeight(l, ch, h float64) float64 {
+	w := smoothstep(0.01, 0.03, ch) * (1 - smoothstep(0.14, 0.18, ch))
+	if w == 0 {
+		return 0
+	}
+	w *= smoothstep(0.15, 0.25, l) * (1 - smoothstep(0.93, 0.97, l))
+	if w == 0 {
+		return 0
+	}
+	return w * skinToneHues.Weight(h)
+}
+
+// smoothstep returns 0 for x <= e0, 1 for x >= e1, and the Hermite interpolation between them.
+func smoothstep(e0, e1, x float64) float64 {
+	t := min(max((x-e0)/(e1-e0), 0), 1)
+	return t * t * (3 - 2*t)
+}
@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestIsSkinTone(t *testing.T) {
+	testCases := []struct {
+		hex  string
+		want bool
+	}{
+		// Skin tones.
+		{hex: "#8d5524", want: true},
+		{hex: "#c68642", want: true},
+		{hex: "#e0ac69", want: true},
+		{hex: "#f1c27d", want: true},
+		{hex: "#ffdbac", want: true},
+		{hex: "#3b2219", want: true},
+		{hex: "#c0785a", want: true},
+		{hex: "#e8b796", want: true},
+
+		// Non-skin tones.
+		{hex: "#ff0000", want: false},
+		{hex: "#00ff00", want: false},
+		{hex: "#0000ff", want: false},
+		{hex: "#ffa500", want: false},
+		{hex: "#808000", want: false},
+		{hex: "#808080", want: false},
+		{hex: "#000000", want: false},
+		{hex: "#ffffff", want: false},
+	}
+	for _, tc := range testCases {
+		c, err := iro.ParseCSS(tc.hex)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := c.IsSkinTone(); got != tc.want {
+			t.Errorf("%s: got %t (weight %f), want %t", tc.hex, got, c.SkinToneWeight(), tc.want)
+		}
+	}
+}
+
+func TestSkinToneWeightContinuity(t *testing.T) {
+	// The weight changes smoothly along the hue.
+	prev := iro.ColorFromOKLch(0.7, 0.1, 0, 1).SkinToneWeight()
+	for i := 1; i <= 360; i++ {
+		w := iro.ColorFromOKLch(0.7, 0.1, float64(i)*math.Pi/180, 1).SkinToneWeight()
+		if w < 0 || w > 1 {
+			t.Fatalf("hue %d°: weight %f out of range", i, w)
+		}
+		if d := w - prev; d > 0.1 || d < -0.1 {
+			t.Errorf("hue %d°: weight jumped from %f to %f", i, prev, w)
+		}
+		prev = w
+	}
+}
@@ -0,0 +1,192 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"fmt"
+)
+
+// Space represents a color space with three components.
+type Space int
+
+const (
+	// SpaceSRGB represents nonlinear sRGB.
+	SpaceSRGB Space = iota
+
+	// SpaceLinearSRGB represents linear sRGB.
+	SpaceLinearSRGB
+
+	// SpaceDisplayP3 represents nonlinear Display P3.
+	SpaceDisplayP3
+
+	// SpaceLinearDisplayP3 represents linear Display P3.
+	SpaceLinearDisplayP3
+
+	// SpaceOKLab represents OKLab.
+	SpaceOKLab
+
+	// SpaceOKLch represents OKLCh. The hue is in radians.
+	SpaceOKLch
+
+	// SpaceXYZ represents XYZ D65.
+	SpaceXYZ
+
+	// SpaceLab represents CIELAB with the D50 white point.
+	SpaceLab
+
+	// SpaceLch represents CIE LCh with the D50 white point. The hue is in radians.
+	SpaceLch
+
+	// SpaceRec2020 represents nonlinear Rec. 2020.
+	SpaceRec2020
+
+	// SpaceLinearRec2020 represents linear Rec. 2020.
+	SpaceLinearRec2020
+
+	// SpaceA98RGB represents nonlinear Adobe RGB (1998).
+	SpaceA98RGB
+
+	// SpaceLinearA98RGB represents linear Adobe RGB (1998).
+	SpaceLinearA98RGB
+
+	// SpaceProPhotoRGB represents nonlinear ProPhoto RGB.
+	SpaceProPhotoRGB
+
+	// SpaceLinearProPhotoRGB represents linear ProPhoto RGB.
+	SpaceLinearProPhotoRGB
+
+	// SpaceXYZD50 represents XYZ D50 adapted with the Bradford transform.
+	SpaceXYZD50
+
+	// SpaceSRGBGamma22 represents nonlinear sRGB with the pure power function of the gamma 2.2
+	// instead of the piecewise sRGB transfer function.
+	// The linear counterpart is [SpaceLinearSRGB].
+	SpaceSRGBGamma22
+)
+
+// String returns the name of the space.
+func (s Space) String() string {
+	switch s {
+	case SpaceSRGB:
+		return "sRGB"
+	case SpaceLinearSRGB:
+		return "linear sRGB"
+	case SpaceDisplayP3:
+		return "Display P3"
+	case SpaceLinearDisplayP3:
+		return "linear Display P3"
+	case SpaceOKLab:
+		return "OKLab"
+	case SpaceOKLch:
+		return "OKLCh"
+	case SpaceXYZ:
+		return "XYZ D65"
+	case SpaceLab:
+		return "CIELAB"
+	case SpaceLch:
+		return "CIE LCh"
+	case SpaceRec2020:
+		return "Rec. 2020"
+	case SpaceLinearRec2020:
+		return "linear Rec. 2020"
+	case SpaceA98RGB:
+		return "Adobe RGB (1998)"
+	case SpaceLinearA98RGB:
+		return "linear Adobe RGB (1998)"
+	case SpaceProPhotoRGB:
+		return "ProPhoto RGB"
+	case SpaceLinearProPhotoRGB:
+		return "linear ProPhoto RGB"
+	case SpaceXYZD50:
+		return "XYZ D50"
+	case SpaceSRGBGamma22:
+		return "sRGB (gamma 2.2)"
+	default:
+		return fmt.Sprintf("Space(%d)", s)
+	}
+}
+
+// ColorFromComponents builds a Color from the components in the given space and alpha.
+func ColorFromComponents(space Space, c0, c1, c2, alpha float64) Color {
+	switch space {
+	case SpaceSRGB:
+		return ColorFromSRGB(c0, c1, c2, alpha)
+	case SpaceLinearSRGB:
+		return ColorFromLinearSRGB(c0, c1, c2, alpha)
+	case SpaceDisplayP3:
+		return ColorFromDisplayP3(c0, c1, c2, alpha)
+	case SpaceLinearDisplayP3:
+		return ColorFromLinearDisplayP3(c0, c1, c2, alpha)
+	case SpaceOKLab:
+		return ColorFromOKLab(c0, c1, c2, alpha)
+	case SpaceOKLch:
+		return ColorFromOKLch(c0, c1, c2, alpha)
+	case SpaceXYZ:
+		return ColorFromXYZ(c0, c1, c2, alpha)
+	case SpaceLab:
+		return ColorFromLab(c0, c1, c2, alpha)
+	case SpaceLch:
+		return ColorFromLch(c0, c1, c2, alpha)
+	case SpaceRec2020:
+		return ColorFromRec2020(c0, c1, c2, alpha)
+	case SpaceLinearRec2020:
+		return ColorFromLinearRec2020(c0, c1, c2, alpha)
+	case SpaceA98RGB:
+		return ColorFromA98RGB(c0, c1, c2, alpha)
+	case SpaceLinearA98RGB:
+		return ColorFromLinearA98RGB(c0, c1, c2, alpha)
+	case SpaceProPhotoRGB:
+		return ColorFromProPhotoRGB(c0, c1, c2, alpha)
+	case SpaceLinearProPhotoRGB:
+		return ColorFromLinearProPhotoRGB(c0, c1, c2, alpha)
+	case SpaceXYZD50:
+		return ColorFromXYZD50(c0, c1, c2, alpha)
+	case SpaceSRGBGamma22:
+		return ColorFromSRGBGamma22(c0, c1, c2, alpha)
+	default:
+		panic(fmt.Sprintf("iro: invalid Space: %d", space))
+	}
+}
+
+// Components converts Color to the components in the given space and alpha.
+func (c Color) Components(space Space) (c0, c1, c2, alpha float64) {
+	switch space {
+	case SpaceSRGB:
+		return c.SRGB()
+	case SpaceLinearSRGB:
+		return c.LinearSRGB()
+	case SpaceDisplayP3:
+		return c.DisplayP3()
+	case SpaceLinearDisplayP3:
+		return c.LinearDisplayP3()
+	case SpaceOKLab:
+		return c.OKLab()
+	case SpaceOKLch:
+		return c.OKLch()
+	case SpaceXYZ:
+		return c.XYZ()
+	case SpaceLab:
+		return c.Lab()
+	case SpaceLch:
+		return c.Lch()
+	case SpaceRec2020:
+		return c.Rec2020()
+	case SpaceLinearRec2020:
+		return c.LinearRec2020()
+	case SpaceA98RGB:
+		return c.A98RGB()
+	case SpaceLinearA98RGB:
+		return c.LinearA98RGB()
+	case SpaceProPhotoRGB:
+		return c.ProPhotoRGB()
+	case SpaceLinearProPhotoRGB:
+		return c.LinearProPhotoRGB()
+	case SpaceXYZD50:
+		return c.XYZD50()
+	case SpaceSRGBGamma22:
+		return c.SRGBGamma22()
+	default:
+		panic(fmt.Sprintf("iro: invalid Space: %d", space))
+	}
+}
@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestComponentsRoundTrip(t *testing.T) {
+	c := iro.ColorFromSRGB(0.2, 0.4, 0.6, 0.8)
+	x0, y0, z0, a0 := c.XYZ()
+	for _, s := range []iro.Space{
+		iro.SpaceSRGB,
+		iro.SpaceLinearSRGB,
+		iro.SpaceDisplayP3,
+		iro.SpaceLinearDisplayP3,
+		iro.SpaceOKLab,
+		iro.SpaceOKLch,
+		iro.SpaceXYZ,
+		iro.SpaceLab,
+		iro.SpaceLch,
+		iro.SpaceRec2020,
+		iro.SpaceLinearRec2020,
+		iro.SpaceA98RGB,
+		iro.SpaceLinearA98RGB,
+		iro.SpaceProPhotoRGB,
+		iro.SpaceLinearProPhotoRGB,
+		iro.SpaceXYZD50,
+		iro.SpaceSRGBGamma22,
+	} {
+		t.Run(s.String(), func(t *testing.T) {
+			c0, c1, c2, alpha := c.Components(s)
+			x1, y1, z1, a1 := iro.ColorFromComponents(s, c0, c1, c2, alpha).XYZ()
+			if diff, ok := check(x1, x0); !ok {
+				t.Errorf("x: got %f, want %f (diff=%g)", x1, x0, diff)
+			}
+			if diff, ok := check(y1, y0); !ok {
+				t.Errorf("y: got %f, want %f (diff=%g)", y1, y0, diff)
+			}
+			if diff, ok := check(z1, z0); !ok {
+				t.Errorf("z: got %f, want %f (diff=%g)", z1, z0, diff)
+			}
+			if diff, ok := check(a1, a0); !ok {
+				t.Errorf("a: got %f, want %f (diff=%g)", a1, a0, diff)
+			}
+		})
+	}
+}
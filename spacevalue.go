@@ -0,0 +1,450 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+// SpaceValue is the components and alpha of a color in a space, like [SRGBValue] and [OKLchValue].
+//
+// SpaceValue enables generic code over spaces with [Convert].
+// SpaceValue cannot be implemented outside this package.
+type SpaceValue interface {
+	// Space returns the space of the value.
+	Space() Space
+
+	// Components returns the components and alpha of the value.
+	Components() (c0, c1, c2, alpha float64)
+
+	// Color returns the color of the value.
+	Color() Color
+
+	// withComponents returns the value of the same type with the components and alpha.
+	withComponents(c0, c1, c2, alpha float64) SpaceValue
+}
+
+// Convert converts c to the value of the space of T.
+//
+// For example, Convert[OKLchValue](c) returns the OKLCh components of c.
+func Convert[T SpaceValue](c Color) T {
+	var zero T
+	c0, c1, c2, alpha := c.Components(zero.Space())
+	return zero.withComponents(c0, c1, c2, alpha).(T)
+}
+
+// ValueFromComponents returns the value of T with the components and alpha.
+func ValueFromComponents[T SpaceValue](c0, c1, c2, alpha float64) T {
+	var zero T
+	return zero.withComponents(c0, c1, c2, alpha).(T)
+}
+
+// MixValues mixes a and b at t in [0, 1] in the space of T, in the same way as [Mix].
+func MixValues[T SpaceValue](a, b T, t float64) T {
+	return Convert[T](Mix(a.Color(), b.Color(), t, a.Space()))
+}
+
+// SRGBValue is the components of a color in nonlinear sRGB and alpha.
+type SRGBValue struct {
+	R, G, B, Alpha float64
+}
+
+// Space returns [SpaceSRGB].
+func (SRGBValue) Space() Space {
+	return SpaceSRGB
+}
+
+// Components returns the components and alpha of v.
+func (v SRGBValue) Components() (c0, c1, c2, alpha float64) {
+	return v.R, v.G, v.B, v.Alpha
+}
+
+// Color returns the color of v.
+func (v SRGBValue) Color() Color {
+	return ColorFromComponents(SpaceSRGB, v.R, v.G, v.B, v.Alpha)
+}
+
+func (SRGBValue) withComponents(c0, c1, c2, alpha float64) SpaceValue {
+	return SRGBValue{R: c0, G: c1, B: c2, Alpha: alpha}
+}
+
+// LinearSRGBValue is the components of a color in linear sRGB and alpha.
+type LinearSRGBValue struct {
+	R, G, B, Alpha float64
+}
+
+// Space returns [SpaceLinearSRGB].
+func (LinearSRGBValue) Space() Space {
+	return SpaceLinearSRGB
+}
+
+// Components returns the components and alpha of v.
+func (v LinearSRGBValue) Components() (c0, c1, c2, alpha float64) {
+	return v.R, v.G, v.B, v.Alpha
+}
+
+// Color returns the color of v.
+func (v LinearSRGBValue) Color() Color {
+	return ColorFromComponents(SpaceLinearSRGB, v.R, v.G, v.B, v.Alpha)
+}
+
+func (LinearSRGBValue) withComponents(c0, c1, c2, alpha float64) SpaceValue {
+	return LinearSRGBValue{R: c0, G: c1, B: c2, Alpha: alpha}
+}
+
+// SRGBGamma22Value is the components of a color in nonlinear sRGB with the gamma 2.2 and alpha.
+type SRGBGamma22Value struct {
+	R, G, B, Alpha float64
+}
+
+// Space returns [SpaceSRGBGamma22].
+func (SRGBGamma22Value) Space() Space {
+	return SpaceSRGBGamma22
+}
+
+// Components returns the components and alpha of v.
+func (v SRGBGamma22Value) Components() (c0, c1, c2, alpha float64) {
+	return v.R, v.G, v.B, v.Alpha
+}
+
+// Color returns the color of v.
+func (v SRGBGamma22Value) Color() Color {
+	return ColorFromComponents(SpaceSRGBGamma22, v.R, v.G, v.B, v.Alpha)
+}
+
+func (SRGBGamma22Value) withComponents(c0, c1, c2, alpha float64) SpaceValue {
+	return SRGBGamma22Value{R: c0, G: c1, B: c2, Alpha: alpha}
+}
+
+// DisplayP3Value is the components of a color in nonlinear Display P3 and alpha.
+type DisplayP3Value struct {
+	R, G, B, Alpha float64
+}
+
+// Space returns [SpaceDisplayP3].
+func (DisplayP3Value) Space() Space {
+	return SpaceDisplayP3
+}
+
+// Components returns the components and alpha of v.
+func (v DisplayP3Value) Components() (c0, c1, c2, alpha float64) {
+	return v.R, v.G, v.B, v.Alpha
+}
+
+// Color returns the color of v.
+func (v DisplayP3Value) Color() Color {
+	return ColorFromComponents(SpaceDisplayP3, v.R, v.G, v.B, v.Alpha)
+}
+
+func (DisplayP3Value) withComponents(c0, c1, c2, alpha float64) SpaceValue {
+	return DisplayP3Value{R: c0, G: c1, B: c2, Alpha: alpha}
+}
+
+// LinearDisplayP3Value is the components of a color in linear Display P3 and alpha.
+type LinearDisplayP3Value struct {
+	R, G, B, Alpha float64
+}
+
+// Space returns [SpaceLinearDisplayP3].
+func (LinearDisplayP3Value) Space() Space {
+	return SpaceLinearDisplayP3
+}
+
+// Components returns the components and alpha of v.
+func (v LinearDisplayP3Value) Components() (c0, c1, c2, alpha float64) {
+	return v.R, v.G, v.B, v.Alpha
+}
+
+// Color returns the color of v.
+func (v LinearDisplayP3Value) Color() Color {
+	return ColorFromComponents(SpaceLinearDisplayP3, v.R, v.G, v.B, v.Alpha)
+}
+
+func (LinearDisplayP3Value) withComponents(c0, c1, c2, alpha float64) SpaceValue {
+	return LinearDisplayP3Value{R: c0, G: c1, B: c2, Alpha: alpha}
+}
+
+// Rec2020Value is the components of a color in nonlinear Rec. 2020 and alpha.
+type Rec2020Value struct {
+	R, G, B, Alpha float64
+}
+
+// Space returns [SpaceRec2020].
+func (Rec2020Value) Space() Space {
+	return SpaceRec2020
+}
+
+// Components returns the components and alpha of v.
+func (v Rec2020Value) Components() (c0, c1, c2, alpha float64) {
+	return v.R, v.G, v.B, v.Alpha
+}
+
+// Color returns the color of v.
+func (v Rec2020Value) Color() Color {
+	return ColorFromComponents(SpaceRec2020, v.R, v.G, v.B, v.Alpha)
+}
+
+func (Rec2020Value) withComponents(c0, c1, c2, alpha float64) SpaceValue {
+	return Rec2020Value{R: c0, G: c1, B: c2, Alpha: alpha}
+}
+
+// LinearRec2020Value is the components of a color in linear Rec. 2020 and alpha.
+type LinearRec2020Value struct {
+	R, G, B, Alpha float64
+}
+
+// Space returns [SpaceLinearRec2020].
+func (LinearRec2020Value) Space() Space {
+	return SpaceLinearRec2020
+}
+
+// Components returns the components and alpha of v.
+func (v LinearRec2020Value) Components() (c0, c1, c2, alpha float64) {
+	return v.R, v.G, v.B, v.Alpha
+}
+
+// Color returns the color of v.
+func (v LinearRec2020Value) Color() Color {
+	return ColorFromComponents(SpaceLinearRec2020, v.R, v.G, v.B, v.Alpha)
+}
+
+func (LinearRec2020Value) withComponents(c0, c1, c2, alpha float64) SpaceValue {
+	return LinearRec2020Value{R: c0, G: c1, B: c2, Alpha: alpha}
+}
+
+// A98RGBValue is the components of a color in nonlinear Adobe RGB (1998) and alpha.
+type A98RGBValue struct {
+	R, G, B, Alpha float64
+}
+
+// Space returns [SpaceA98RGB].
+func (A98RGBValue) Space() Space {
+	return SpaceA98RGB
+}
+
+// Components returns the components and alpha of v.
+func (v A98RGBValue) Components() (c0, c1, c2, alpha float64) {
+	return v.R, v.G, v.B, v.Alpha
+}
+
+// Color returns the color of v.
+func (v A98RGBValue) Color() Color {
+	return ColorFromComponents(SpaceA98RGB, v.R, v.G, v.B, v.Alpha)
+}
+
+func (A98RGBValue) withComponents(c0, c1, c2, alpha float64) SpaceValue {
+	return A98RGBValue{R: c0, G: c1, B: c2, Alpha: alpha}
+}
+
+// LinearA98RGBValue is the components of a color in linear Adobe RGB (1998) and alpha.
+type LinearA98RGBValue struct {
+	R, G, B, Alpha float64
+}
+
+// Space returns [SpaceLinearA98RGB].
+func (LinearA98RGBValue) Space() Space {
+	return SpaceLinearA98RGB
+}
+
+// Components returns the components and alpha of v.
+func (v LinearA98RGBValue) Components() (c0, c1, c2, alpha float64) {
+	return v.R, v.G, v.B, v.Alpha
+}
+
+// Color returns the color of v.
+func (v LinearA98RGBValue) Color() Color {
+	return ColorFromComponents(SpaceLinearA98RGB, v.R, v.G, v.B, v.Alpha)
+}
+
+func (LinearA98RGBValue) withComponents(c0, c1, c2, alpha float64) SpaceValue {
+	return LinearA98RGBValue{R: c0, G: c1, B: c2, Alpha: alpha}
+}
+
+// ProPhotoRGBValue is the components of a color in nonlinear ProPhoto RGB and alpha.
+type ProPhotoRGBValue struct {
+	R, G, B, Alpha float64
+}
+
+// Space returns [SpaceProPhotoRGB].
+func (ProPhotoRGBValue) Space() Space {
+	return SpaceProPhotoRGB
+}
+
+// Components returns the components and alpha of v.
+func (v ProPhotoRGBValue) Components() (c0, c1, c2, alpha float64) {
+	return v.R, v.G, v.B, v.Alpha
+}
+
+// Color returns the color of v.
+func (v ProPhotoRGBValue) Color() Color {
+	return ColorFromComponents(SpaceProPhotoRGB, v.R, v.G, v.B, v.Alpha)
+}
+
+func (ProPhotoRGBValue) withComponents(c0, c1, c2, alpha float64) SpaceValue {
+	return ProPhotoRGBValue{R: c0, G: c1, B: c2, Alpha: alpha}
+}
+
+// LinearProPhotoRGBValue is the components of a color in linear ProPhoto RGB and alpha.
+type LinearProPhotoRGBValue struct {
+	R, G, B, Alpha float64
+}
+
+// Space returns [SpaceLinearProPhotoRGB].
+func (LinearProPhotoRGBValue) Space() Space {
+	return SpaceLinearProPhotoRGB
+}
+
+// Components returns the components and alpha of v.
+func (v LinearProPhotoRGBValue) Components() (c0, c1, c2, alpha float64) {
+	return v.R, v.G, v.B, v.Alpha
+}
+
+// Color returns the color of v.
+func (v LinearProPhotoRGBValue) Color() Color {
+	return ColorFromComponents(SpaceLinearProPhotoRGB, v.R, v.G, v.B, v.Alpha)
+}
+
+func (LinearProPhotoRGBValue) withComponents(c0, c1, c2, alpha float64) SpaceValue {
+	return LinearProPhotoRGBValue{R: c0, G: c1, B: c2, Alpha: alpha}
+}
+
+// OKLabValue is the components of a color in OKLab and alpha.
+type OKLabValue struct {
+	L, A, B, Alpha float64
+}
+
+// Space returns [SpaceOKLab].
+func (OKLabValue) Space() Space {
+	return SpaceOKLab
+}
+
+// Components returns the components and alpha of v.
+func (v OKLabValue) Components() (c0, c1, c2, alpha float64) {
+	return v.L, v.A, v.B, v.Alpha
+}
+
+// Color returns the color of v.
+func (v OKLabValue) Color() Color {
+	return ColorFromComponents(SpaceOKLab, v.L, v.A, v.B, v.Alpha)
+}
+
+func (OKLabValue) withComponents(c0, c1, c2, alpha float64) SpaceValue {
+	return OKLabValue{L: c0, A: c1, B: c2, Alpha: alpha}
+}
+
+// OKLchValue is the components of a color in OKLCh and alpha. The hue H is in radians.
+type OKLchValue struct {
+	L, C, H, Alpha float64
+}
+
+// Space returns [SpaceOKLch].
+func (OKLchValue) Space() Space {
+	return SpaceOKLch
+}
+
+// Components returns the components and alpha of v.
+func (v OKLchValue) Components() (c0, c1, c2, alpha float64) {
+	return v.L, v.C, v.H, v.Alpha
+}
+
+// Color returns the color of v.
+func (v OKLchValue) Color() Color {
+	return ColorFromComponents(SpaceOKLch, v.L, v.C, v.H, v.Alpha)
+}
+
+func (OKLchValue) withComponents(c0, c1, c2, alpha float64) SpaceValue {
+	return OKLchValue{L: c0, C: c1, H: c2, Alpha: alpha}
+}
+
+// XYZValue is the components of a color in XYZ D65 and alpha.
+type XYZValue struct {
+	X, Y, Z, Alpha float64
+}
+
+// Space returns [SpaceXYZ].
+func (XYZValue) Space() Space {
+	return SpaceXYZ
+}
+
+// Components returns the components and alpha of v.
+func (v XYZValue) Components() (c0, c1, c2, alpha float64) {
+	return v.X, v.Y, v.Z, v.Alpha
+}
+
+// Color returns the color of v.
+func (v XYZValue) Color() Color {
+	return ColorFromComponents(SpaceXYZ, v.X, v.Y, v.Z, v.Alpha)
+}
+
+func (XYZValue) withComponents(c0, c1, c2, alpha float64) SpaceValue {
+	return XYZValue{X: c0, Y: c1, Z: c2, Alpha: alpha}
+}
+
+// XYZD50Value is the components of a color in XYZ D50 and alpha.
+type XYZD50Value struct {
+	X, Y, Z, Alpha float64
+}
+
+// Space returns [SpaceXYZD50].
+func (XYZD50Value) Space() Space {
+	return SpaceXYZD50
+}
+
+// Components returns the components and alpha of v.
+func (v XYZD50Value) Components() (c0, c1, c2, alpha float64) {
+	return v.X, v.Y, v.Z, v.Alpha
+}
+
+// Color returns the color of v.
+func (v XYZD50Value) Color() Color {
+	return ColorFromComponents(SpaceXYZD50, v.X, v.Y, v.Z, v.Alpha)
+}
+
+func (XYZD50Value) withComponents(c0, c1, c2, alpha float64) SpaceValue {
+	return XYZD50Value{X: c0, Y: c1, Z: c2, Alpha: alpha}
+}
+
+// LabValue is the components of a color in CIELAB with the D50 white point and alpha.
+type LabValue struct {
+	L, A, B, Alpha float64
+}
+
+// Space returns [SpaceLab].
+func (LabValue) Space() Space {
+	return SpaceLab
+}
+
+// Components returns the components and alpha of v.
+func (v LabValue) Components() (c0, c1, c2, alpha float64) {
+	return v.L, v.A, v.B, v.Alpha
+}
+
+// Color returns the color of v.
+func (v LabValue) Color() Color {
+	return ColorFromComponents(SpaceLab, v.L, v.A, v.B, v.Alpha)
+}
+
+func (LabValue) withComponents(c0, c1, c2, alpha float64) SpaceValue {
+	return LabValue{L: c0, A: c1, B: c2, Alpha: alpha}
+}
+
+// LchValue is the components of a color in CIE LCh with the D50 white point and alpha. The hue H is in radians.
+type LchValue struct {
+	L, C, H, Alpha float64
+}
+
+// Space returns [SpaceLch].
+func (LchValue) Space() Space {
+	return SpaceLch
+}
+
+// Components returns the components and alpha of v.
+func (v LchValue) Components() (c0, c1, c2, alpha float64) {
+	return v.L, v.C, v.H, v.Alpha
+}
+
+// Color returns the color of v.
+func (v LchValue) Color() Color {
+	return ColorFromComponents(SpaceLch, v.L, v.C, v.H, v.Alpha)
+}
+
+func (LchValue) withComponents(c0, c1, c2, alpha float64) SpaceValue {
+	return LchValue{L: c0, C: c1, H: c2, Alpha: alpha}
+}
@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+// roundTrip converts c to T and back to a color, reporting an error if the components differ from Color.Components.
+func roundTrip[T iro.SpaceValue](t *testing.T, c iro.Color) {
+	t.Helper()
+	v := iro.Convert[T](c)
+	space := v.Space()
+
+	c0, c1, c2, alpha := v.Components()
+	w0, w1, w2, wantAlpha := c.Components(space)
+	if !checkTol(c0, w0) || !checkTol(c1, w1) || !checkTol(c2, w2) || alpha != wantAlpha {
+		t.Errorf("%s: got (%f, %f, %f, %f), want (%f, %f, %f, %f)", space, c0, c1, c2, alpha, w0, w1, w2, wantAlpha)
+	}
+	if got := iro.ValueFromComponents[T](c0, c1, c2, alpha); iro.SpaceValue(got) != iro.SpaceValue(v) {
+		t.Errorf("%s: ValueFromComponents: got %v, want %v", space, got, v)
+	}
+
+	x0, y0, z0, _ := c.XYZ()
+	x, y, z, _ := v.Color().XYZ()
+	if !checkTol(x, x0) || !checkTol(y, y0) || !checkTol(z, z0) {
+		t.Errorf("%s: Color: got XYZ (%f, %f, %f), want (%f, %f, %f)", space, x, y, z, x0, y0, z0)
+	}
+}
+
+func TestConvertGeneric(t *testing.T) {
+	c := iro.ColorFromSRGB(0.8, 0.4, 0.2, 0.5)
+	roundTrip[iro.SRGBValue](t, c)
+	roundTrip[iro.LinearSRGBValue](t, c)
+	roundTrip[iro.SRGBGamma22Value](t, c)
+	roundTrip[iro.DisplayP3Value](t, c)
+	roundTrip[iro.LinearDisplayP3Value](t, c)
+	roundTrip[iro.Rec2020Value](t, c)
+	roundTrip[iro.LinearRec2020Value](t, c)
+	roundTrip[iro.A98RGBValue](t, c)
+	roundTrip[iro.LinearA98RGBValue](t, c)
+	roundTrip[iro.ProPhotoRGBValue](t, c)
+	roundTrip[iro.LinearProPhotoRGBValue](t, c)
+	roundTrip[iro.OKLabValue](t, c)
+	roundTrip[iro.OKLchValue](t, c)
+	roundTrip[iro.XYZValue](t, c)
+	roundTrip[iro.XYZD50Value](t, c)
+	roundTrip[iro.LabValue](t, c)
+	roundTrip[iro.LchValue](t, c)
+
+	if got, want := iro.Convert[iro.SRGBValue](c), (iro.SRGBValue{R: 0.8, G: 0.4, B: 0.2, Alpha: 0.5}); !checkTol(got.R, want.R) || !checkTol(got.G, want.G) || !checkTol(got.B, want.B) || got.Alpha != want.Alpha {
+		t.Errorf("SRGBValue: got %v, want %v", got, want)
+	}
+}
+
+func TestMixValues(t *testing.T) {
+	a := iro.ColorFromSRGB(1, 0, 0, 1)
+	b := iro.ColorFromSRGB(0, 0, 1, 1)
+	for _, tc := range []struct {
+		got   iro.SpaceValue
+		space iro.Space
+	}{
+		{iro.MixValues(iro.Convert[iro.SRGBValue](a), iro.Convert[iro.SRGBValue](b), 0.25), iro.SpaceSRGB},
+		{iro.MixValues(iro.Convert[iro.OKLabValue](a), iro.Convert[iro.OKLabValue](b), 0.25), iro.SpaceOKLab},
+		{iro.MixValues(iro.Convert[iro.OKLchValue](a), iro.Convert[iro.OKLchValue](b), 0.25), iro.SpaceOKLch},
+	} {
+		c0, c1, c2, _ := tc.got.Components()
+		w0, w1, w2, _ := iro.Mix(a, b, 0.25, tc.space).Components(tc.space)
+		if !checkTol(c0, w0) || !checkTol(c1, w1) || !checkTol(c2, w2) {
+			t.Errorf("%s: got (%f, %f, %f), want (%f, %f, %f)", tc.space, c0, c1, c2, w0, w1, w2)
+		}
+	}
+}
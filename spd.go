@@ -0,0 +1,274 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"fmt"
+	"math"
+)
+
+// SPD represents a spectral distribution sampled at regular intervals of wavelengths,
+// like the spectral power distribution of a light source or the spectral reflectance of a surface.
+type SPD struct {
+	// Start is the wavelength of the first sample in nanometers.
+	Start float64
+
+	// Interval is the interval between the samples in nanometers. Interval must be positive.
+	Interval float64
+
+	// Values is the samples. Values must not be empty.
+	Values []float64
+}
+
+// At returns the value of the distribution at the wavelength in nanometers.
+//
+// Between the samples, the value is interpolated linearly.
+// Outside the samples, the value of the nearest sample is returned, as CIE 15 recommends for extrapolation.
+//
+// At panics if s is invalid.
+func (s *SPD) At(lambda float64) float64 {
+	if len(s.Values) == 0 {
+		panic("iro: an SPD requires at least one value")
+	}
+	if !(s.Interval > 0) {
+		panic(fmt.Sprintf("iro: the interval of an SPD must be positive but %f", s.Interval))
+	}
+	t := (lambda - s.Start) / s.Interval
+	if t <= 0 {
+		return s.Values[0]
+	}
+	n := len(s.Values)
+	if t >= float64(n-1) {
+		return s.Values[n-1]
+	}
+	i := int(t)
+	f := t - float64(i)
+	return s.Values[i]*(1-f) + s.Values[i+1]*f
+}
+
+const (
+	// spdMin, spdMax, and spdInterval are the range and the interval of wavelengths in nanometers for the integration of SPDs.
+	// CIE 15 recommends the summation at 5 nm intervals from 380 nm to 780 nm for practical purposes.
+	spdMin      = 380
+	spdMax      = 780
+	spdInterval = 5
+)
+
+// tristimulus returns the XYZ tristimulus values of the product of the distributions for the observer,
+// summed at the intervals of the tables of the color matching functions.
+// If b is nil, only a is integrated.
+func tristimulus(a, b *SPD, observer Observer) (x, y, z float64) {
+	for l := spdMin; l <= spdMax; l += spdInterval {
+		lambda := float64(l)
+		v := a.At(lambda)
+		if b != nil {
+			v *= b.At(lambda)
+		}
+		cx, cy, cz := observer.cmf(lambda)
+		x += v * cx
+		y += v * cy
+		z += v * cz
+	}
+	return
+}
+
+// Chromaticity returns the chromaticity of s as a light source for the CIE 1931 2° standard observer.
+//
+// The tristimulus values are summed at 5 nm intervals from 380 nm to 780 nm with the tabulated color matching functions of CIE 15,
+// where s is interpolated linearly as [SPD.At] does.
+func (s *SPD) Chromaticity() Chromaticity {
+	return s.ChromaticityWithObserver(Observer2Degree)
+}
+
+// ChromaticityWithObserver is like [SPD.Chromaticity] but uses the color matching functions of the observer.
+func (s *SPD) ChromaticityWithObserver(observer Observer) Chromaticity {
+	x, y, z := tristimulus(s, nil, observer)
+	sum := x + y + z
+	return Chromaticity{X: x / sum, Y: y / sum}
+}
+
+// ReflectanceXYZ returns the XYZ tristimulus values of s as the spectral reflectance of a surface under the illuminant.
+// The values are normalized so that Y of the perfect reflecting diffuser is 1.
+//
+// See [SPD.Chromaticity] for the summation.
+func (s *SPD) ReflectanceXYZ(illuminant *SPD) (x, y, z float64) {
+	return s.ReflectanceXYZWithObserver(illuminant, Observer2Degree)
+}
+
+// ReflectanceXYZWithObserver is like [SPD.ReflectanceXYZ] but uses the color matching functions of the observer.
+func (s *SPD) ReflectanceXYZWithObserver(illuminant *SPD, observer Observer) (x, y, z float64) {
+	x, y, z = tristimulus(s, illuminant, observer)
+	_, yn, _ := tristimulus(illuminant, nil, observer)
+	return x / yn, y / yn, z / yn
+}
+
+// ReflectanceLab returns the CIELAB components of s as the spectral reflectance of a surface under the illuminant for the observer.
+// Unlike [Color.Lab], the white point is the white of the illuminant for the observer, i.e. the perfect reflecting diffuser under the illuminant.
+// L is in [0, 100].
+func (s *SPD) ReflectanceLab(illuminant *SPD, observer Observer) (l, a, b float64) {
+	x, y, z := s.ReflectanceXYZWithObserver(illuminant, observer)
+	xn, yn, zn := perfectReflector.ReflectanceXYZWithObserver(illuminant, observer)
+	return xyzToLab(x, y, z, xn, yn, zn)
+}
+
+// CorrelatedColorTemperature returns the correlated color temperature of s as a light source in kelvins for the observer,
+// i.e. the temperature of the Planckian radiator nearest to s in the CIE 1960 UCS.
+// The result is in [1667, 25000].
+//
+// CIE defines the correlated color temperature with the 2° observer.
+func (s *SPD) CorrelatedColorTemperature(observer Observer) float64 {
+	return nearestTemperature(s.ChromaticityWithObserver(observer), func(t float64) Chromaticity {
+		return BlackbodySPD(t).ChromaticityWithObserver(observer)
+	})
+}
+
+// perfectReflector is the spectral reflectance of the perfect reflecting diffuser.
+var perfectReflector = &SPD{Start: spdMin, Interval: spdMax - spdMin, Values: []float64{1, 1}}
+
+// BlackbodySPD returns the spectral power distribution of a blackbody radiator at the temperature t in kelvins,
+// from 300 nm to 830 nm at 5 nm intervals, normalized to 100 at 560 nm.
+func BlackbodySPD(t float64) *SPD {
+	// c2 is the second radiation constant in nm·K.
+	const c2 = 1.4388e7
+	planck := func(lambda float64) float64 {
+		return 1 / (math.Pow(lambda, 5) * math.Expm1(c2/(lambda*t)))
+	}
+	n := planck(560)
+	s := &SPD{Start: 300, Interval: 5}
+	for l := 300; l <= 830; l += 5 {
+		s.Values = append(s.Values, 100*planck(float64(l))/n)
+	}
+	return s
+}
+
+// daylightBasis is the components S0, S1, and S2 of the CIE daylight illuminants from 300 nm to 780 nm at 10 nm intervals.
+//
+// The values are referenced from CIE 15:2004, Table T.2.
+var daylightBasis = [...][3]float64{
+	{0.04, 0.02, 0.00},
+	{6.00, 4.50, 2.00},
+	{29.60, 22.40, 4.00},
+	{55.30, 42.00, 8.50},
+	{57.30, 40.60, 7.80},
+	{61.80, 41.60, 6.70},
+	{61.50, 38.00, 5.30},
+	{68.80, 42.40, 6.10},
+	{63.40, 38.50, 3.00},
+	{65.80, 35.00, 1.20},
+	{94.80, 43.40, -1.10},
+	{104.80, 46.30, -0.50},
+	{105.90, 43.90, -0.70},
+	{96.80, 37.10, -1.20},
+	{113.90, 36.70, -2.60},
+	{125.60, 35.90, -2.90},
+	{125.50, 32.60, -2.80},
+	{121.30, 27.90, -2.60},
+	{121.30, 24.30, -2.60},
+	{113.50, 20.10, -1.80},
+	{113.10, 16.20, -1.50},
+	{110.80, 13.20, -1.30},
+	{106.50, 8.60, -1.20},
+	{108.80, 6.10, -1.00},
+	{105.30, 4.20, -0.50},
+	{104.40, 1.90, -0.30},
+	{100.00, 0.00, 0.00},
+	{96.00, -1.60, 0.20},
+	{95.10, -3.50, 0.50},
+	{89.10, -3.50, 2.10},
+	{90.50, -5.80, 3.20},
+	{90.30, -7.20, 4.10},
+	{88.40, -8.60, 4.70},
+	{84.00, -9.50, 5.10},
+	{85.10, -10.90, 6.70},
+	{81.90, -10.70, 7.30},
+	{82.60, -12.00, 8.60},
+	{84.90, -14.00, 9.80},
+	{81.30, -13.60, 10.20},
+	{71.90, -12.00, 8.30},
+	{74.30, -13.30, 9.60},
+	{76.40, -12.90, 8.50},
+	{63.30, -10.60, 7.00},
+	{71.70, -11.60, 7.60},
+	{77.00, -12.20, 8.00},
+	{65.20, -10.20, 6.70},
+	{47.70, -7.80, 5.20},
+	{68.60, -11.20, 7.40},
+	{65.00, -10.40, 6.80},
+}
+
+// DaylightSPD returns the spectral power distribution of the CIE daylight illuminant
+// at the correlated color temperature t in kelvins, from 300 nm to 780 nm at 10 nm intervals.
+// t is clamped to [4000, 25000], the range where the daylight illuminants are defined.
+//
+// As CIE 15 specifies, the coefficients M1 and M2 are rounded to three decimal places.
+// Note that the standard illuminant D65 is at 6504 K, not 6500 K, due to a revision of the radiation constant.
+func DaylightSPD(t float64) *SPD {
+	t = min(max(t, 4000), 25000)
+	t2 := t * t
+	t3 := t2 * t
+	var x float64
+	if t <= 7000 {
+		x = -4.6070e9/t3 + 2.9678e6/t2 + 0.09911e3/t + 0.244063
+	} else {
+		x = -2.0064e9/t3 + 1.9018e6/t2 + 0.24748e3/t + 0.237040
+	}
+	y := -3*x*x + 2.870*x - 0.275
+	m := 0.0241 + 0.2562*x - 0.7341*y
+	m1 := math.Round((-1.3515-1.7703*x+5.9114*y)/m*1000) / 1000
+	m2 := math.Round((0.0300-31.4424*x+30.0717*y)/m*1000) / 1000
+
+	s := &SPD{Start: 300, Interval: 10, Values: make([]float64, len(daylightBasis))}
+	for i, b := range daylightBasis {
+		s.Values[i] = b[0] + m1*b[1] + m2*b[2]
+	}
+	return s
+}
+
+// The CIE standard illuminants as spectral power distributions.
+var (
+	// IlluminantD65 is the CIE standard illuminant D65, representing average daylight, from 300 nm to 830 nm at 5 nm intervals.
+	// The values are the table of CIE 15, which are slightly different from DaylightSPD(6504).
+	IlluminantD65 = &SPD{Start: 300, Interval: 5, Values: illuminantD65[:]}
+
+	// IlluminantD50 is the CIE illuminant D50, used as the white point of CIELAB in this package.
+	IlluminantD50 = DaylightSPD(5003)
+
+	// IlluminantA is the CIE standard illuminant A, representing incandescent light.
+	IlluminantA = illuminantA()
+)
+
+// illuminantD65 is the CIE standard illuminant D65 from 300 nm to 830 nm at 5 nm intervals.
+//
+// The values are referenced from CIE 15:2004, Table T.1.
+var illuminantD65 = [...]float64{
+	0.0341, 1.6643, 3.2945, 11.7652, 20.236, 28.6447, 37.0535, 38.5011,
+	39.9488, 42.4302, 44.9117, 45.775, 46.6383, 49.3637, 52.0891, 51.0323,
+	49.9755, 52.3118, 54.6482, 68.7015, 82.7549, 87.1204, 91.486, 92.4589,
+	93.4318, 90.057, 86.6823, 95.7736, 104.865, 110.936, 117.008, 117.41,
+	117.812, 116.336, 114.861, 115.392, 115.923, 112.367, 108.811, 109.082,
+	109.354, 108.578, 107.802, 106.296, 104.79, 106.239, 107.689, 106.047,
+	104.405, 104.225, 104.046, 102.023, 100, 98.1671, 96.3342, 96.0611,
+	95.788, 92.2368, 88.6856, 89.3459, 90.0062, 89.8026, 89.5991, 88.6489,
+	87.6987, 85.4936, 83.2886, 83.4939, 83.6992, 81.863, 80.0268, 80.1207,
+	80.2146, 81.2462, 82.2778, 80.281, 78.2842, 74.0027, 69.7213, 70.6652,
+	71.6091, 72.979, 74.349, 67.9765, 61.604, 65.7448, 69.8856, 72.4863,
+	75.087, 69.3398, 63.5927, 55.0054, 46.4182, 56.6118, 66.8054, 65.0941,
+	63.3828, 63.8434, 64.304, 61.8779, 59.4519, 55.7054, 51.959, 54.6998,
+	57.4406, 58.8765, 60.2123,
+}
+
+// illuminantA returns the CIE standard illuminant A from 300 nm to 830 nm at 5 nm intervals.
+//
+// The formula defined in CIE 15 uses the radiation constant c2 = 1.435e7 nm·K at 2848 K,
+// which is slightly different from [BlackbodySPD] at 2856 K.
+func illuminantA() *SPD {
+	const c2 = 1.435e7
+	s := &SPD{Start: 300, Interval: 5}
+	for l := 300; l <= 830; l += 5 {
+		lambda := float64(l)
+		v := 100 * math.Pow(560/lambda, 5) * math.Expm1(c2/(2848*560)) / math.Expm1(c2/(2848*lambda))
+		s.Values = append(s.Values, v)
+	}
+	return s
+}
@@ -0,0 +1,144 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestSPDAt(t *testing.T) {
+	s := &iro.SPD{Start: 400, Interval: 10, Values: []float64{1, 3, 2}}
+	testCases := []struct {
+		lambda float64
+		want   float64
+	}{
+		{lambda: 380, want: 1},
+		{lambda: 400, want: 1},
+		{lambda: 405, want: 2},
+		{lambda: 410, want: 3},
+		{lambda: 417.5, want: 2.25},
+		{lambda: 420, want: 2},
+		{lambda: 500, want: 2},
+	}
+	for _, tc := range testCases {
+		if got := s.At(tc.lambda); !checkTol(got, tc.want) {
+			t.Errorf("At(%f): got %f, want %f", tc.lambda, got, tc.want)
+		}
+	}
+}
+
+func TestIlluminantChromaticity(t *testing.T) {
+	// The chromaticities are referenced from CIE 15:2004, Table T.3, computed at 5 nm intervals from 380 nm to 780 nm.
+	// Note that the values computed with the 1 nm tables, like (0.31271, 0.32902) for D65, are slightly different.
+	const tol = 1e-5
+	testCases := []struct {
+		name string
+		spd  *iro.SPD
+		want iro.Chromaticity
+	}{
+		{name: "D65", spd: iro.IlluminantD65, want: iro.Chromaticity{X: 0.31272, Y: 0.32903}},
+		{name: "D50", spd: iro.IlluminantD50, want: iro.Chromaticity{X: 0.34567, Y: 0.35851}},
+		{name: "A", spd: iro.IlluminantA, want: iro.Chromaticity{X: 0.44757, Y: 0.40745}},
+		{name: "Daylight(7504)", spd: iro.DaylightSPD(7504), want: iro.Chromaticity{X: 0.29903, Y: 0.31488}},
+	}
+	for _, tc := range testCases {
+		got := tc.spd.Chromaticity()
+		if math.Abs(got.X-tc.want.X) > tol || math.Abs(got.Y-tc.want.Y) > tol {
+			t.Errorf("%s: got %v, want %v", tc.name, got, tc.want)
+		}
+	}
+
+	// A Planckian radiator at 2856 K is close to the illuminant A.
+	if got, want := iro.BlackbodySPD(2856).Chromaticity(), (iro.Chromaticity{X: 0.44757, Y: 0.40745}); math.Abs(got.X-want.X) > 1e-4 || math.Abs(got.Y-want.Y) > 1e-4 {
+		t.Errorf("Blackbody(2856): got %v, want %v", got, want)
+	}
+}
+
+func TestSPDReflectanceXYZ(t *testing.T) {
+	white := &iro.SPD{Start: 380, Interval: 400, Values: []float64{1, 1}}
+
+	// The white points are referenced from CIE 15:2004, Table T.3.
+	testCases := []struct {
+		name       string
+		illuminant *iro.SPD
+		x, z       float64
+	}{
+		{name: "D65", illuminant: iro.IlluminantD65, x: 95.04, z: 108.88},
+		{name: "A", illuminant: iro.IlluminantA, x: 109.85, z: 35.58},
+	}
+	for _, tc := range testCases {
+		x, y, z := white.ReflectanceXYZ(tc.illuminant)
+		if !checkTol(y, 1) || math.Abs(x*100-tc.x) > 5e-3 || math.Abs(z*100-tc.z) > 5e-3 {
+			t.Errorf("%s: got (%f, %f, %f), want (%f, 100, %f)", tc.name, x*100, y*100, z*100, tc.x, tc.z)
+		}
+	}
+
+	// The tristimulus values are linear in the reflectance.
+	x, y, z := white.ReflectanceXYZ(iro.IlluminantD65)
+	gray := &iro.SPD{Start: 380, Interval: 400, Values: []float64{0.25, 0.25}}
+	gx, gy, gz := gray.ReflectanceXYZ(iro.IlluminantD65)
+	if !checkTol(gx, x/4) || !checkTol(gy, y/4) || !checkTol(gz, z/4) {
+		t.Errorf("gray: got (%f, %f, %f), want (%f, %f, %f)", gx, gy, gz, x/4, y/4, z/4)
+	}
+}
+
+func TestSPDChromaticityWithObserver(t *testing.T) {
+	// The chromaticities are referenced from CIE 15:2004, Table T.3.
+	// The one of D65 is computed with the 1 nm tables and is slightly different from the 5 nm summation.
+	testCases := []struct {
+		name string
+		spd  *iro.SPD
+		want iro.Chromaticity
+		tol  float64
+	}{
+		{name: "D65", spd: iro.IlluminantD65, want: iro.Chromaticity{X: 0.31382, Y: 0.33100}, tol: 3e-5},
+		{name: "D50", spd: iro.IlluminantD50, want: iro.Chromaticity{X: 0.34773, Y: 0.35952}, tol: 1e-5},
+		{name: "A", spd: iro.IlluminantA, want: iro.Chromaticity{X: 0.45117, Y: 0.40594}, tol: 1e-5},
+	}
+	for _, tc := range testCases {
+		got := tc.spd.ChromaticityWithObserver(iro.Observer10Degree)
+		if math.Abs(got.X-tc.want.X) > tc.tol || math.Abs(got.Y-tc.want.Y) > tc.tol {
+			t.Errorf("%s: got %v, want %v", tc.name, got, tc.want)
+		}
+	}
+
+	// The white point of D65 for the 10° observer is (94.81, 100, 107.32) in CIE 15:2004, Table T.3.
+	// ASTM E308 gives (94.811, 100, 107.304) with its own weighting tables.
+	white := &iro.SPD{Start: 380, Interval: 400, Values: []float64{1, 1}}
+	x, y, z := white.ReflectanceXYZWithObserver(iro.IlluminantD65, iro.Observer10Degree)
+	if math.Abs(x*100-94.81) > 5e-3 || !checkTol(y, 1) || math.Abs(z*100-107.32) > 5e-3 {
+		t.Errorf("D65 white: got (%f, %f, %f), want (94.81, 100, 107.32)", x*100, y*100, z*100)
+	}
+}
+
+func TestSPDReflectanceLab(t *testing.T) {
+	white := &iro.SPD{Start: 380, Interval: 400, Values: []float64{1, 1}}
+	gray := &iro.SPD{Start: 380, Interval: 400, Values: []float64{0.18, 0.18}}
+	for _, o := range []iro.Observer{iro.Observer2Degree, iro.Observer10Degree} {
+		for _, illuminant := range []*iro.SPD{iro.IlluminantD65, iro.IlluminantA} {
+			if l, a, b := white.ReflectanceLab(illuminant, o); !checkTol(l, 100) || !checkTol(a, 0) || !checkTol(b, 0) {
+				t.Errorf("white (observer=%d): got (%f, %f, %f), want (100, 0, 0)", o, l, a, b)
+			}
+			if l, a, b := gray.ReflectanceLab(illuminant, o); math.Abs(l-49.4961) > 1e-3 || !checkTol(a, 0) || !checkTol(b, 0) {
+				t.Errorf("gray (observer=%d): got (%f, %f, %f), want (49.4961, 0, 0)", o, l, a, b)
+			}
+		}
+	}
+}
+
+func TestSPDCorrelatedColorTemperature(t *testing.T) {
+	for _, o := range []iro.Observer{iro.Observer2Degree, iro.Observer10Degree} {
+		for _, temp := range []float64{2000, 3000, 6500, 10000} {
+			if got := iro.BlackbodySPD(temp).CorrelatedColorTemperature(o); math.Abs(got-temp) > temp*1e-4 {
+				t.Errorf("BlackbodySPD(%f).CorrelatedColorTemperature(%d): got %f, want %f", temp, o, got, temp)
+			}
+		}
+	}
+	if got := iro.IlluminantD65.CorrelatedColorTemperature(iro.Observer2Degree); math.Abs(got-6504) > 20 {
+		t.Errorf("IlluminantD65.CorrelatedColorTemperature(Observer2Degree): got %f, want 6504", got)
+	}
+}
@@ -0,0 +1,197 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+// PixelLayout specifies the order of the channels of raw interleaved pixels.
+type PixelLayout int
+
+const (
+	// PixelLayoutRGB is red, green, and blue in this order, without alpha.
+	PixelLayoutRGB PixelLayout = iota
+
+	// PixelLayoutRGBA is red, green, blue, and alpha in this order.
+	PixelLayoutRGBA
+
+	// PixelLayoutBGR is blue, green, and red in this order, without alpha.
+	PixelLayoutBGR
+
+	// PixelLayoutBGRA is blue, green, red, and alpha in this order.
+	PixelLayoutBGRA
+
+	// PixelLayoutARGB is alpha, red, green, and blue in this order.
+	PixelLayoutARGB
+
+	// PixelLayoutABGR is alpha, blue, green, and red in this order.
+	PixelLayoutABGR
+)
+
+// offsets returns the indices of the channels in a pixel and the number of the channels.
+// a is -1 if the layout has no alpha channel.
+func (l PixelLayout) offsets() (r, g, b, a, n int) {
+	switch l {
+	case PixelLayoutRGB:
+		return 0, 1, 2, -1, 3
+	case PixelLayoutRGBA:
+		return 0, 1, 2, 3, 4
+	case PixelLayoutBGR:
+		return 2, 1, 0, -1, 3
+	case PixelLayoutBGRA:
+		return 2, 1, 0, 3, 4
+	case PixelLayoutARGB:
+		return 1, 2, 3, 0, 4
+	case PixelLayoutABGR:
+		return 3, 2, 1, 0, 4
+	default:
+		panic(fmt.Sprintf("iro: invalid PixelLayout: %d", l))
+	}
+}
+
+// PixelFormat represents a format of raw interleaved pixels.
+// The channels are unsigned integers, where the maximum value represents 1.
+// Alpha is not premultiplied.
+type PixelFormat struct {
+	// Space is the color space of the pixels. Space must be an RGB space like [SpaceSRGB].
+	Space Space
+
+	// Layout is the order of the channels.
+	Layout PixelLayout
+
+	// BitDepth is the number of bits per channel, 8 or 16.
+	BitDepth int
+
+	// ByteOrder is the byte order of 16-bit channels. If ByteOrder is nil, big endian is used.
+	ByteOrder binary.ByteOrder
+}
+
+func (f *PixelFormat) check() {
+	if !f.Space.isRGB() {
+		panic(fmt.Sprintf("iro: the space of pixels must be an RGB space but %s", f.Space))
+	}
+	if f.BitDepth != 8 && f.BitDepth != 16 {
+		panic(fmt.Sprintf("iro: the bit depth must be 8 or 16 but %d", f.BitDepth))
+	}
+}
+
+func (f *PixelFormat) byteOrder() binary.ByteOrder {
+	if f.ByteOrder == nil {
+		return binary.BigEndian
+	}
+	return f.ByteOrder
+}
+
+// pixelSize returns the number of bytes per pixel.
+func (f *PixelFormat) pixelSize() int {
+	_, _, _, _, n := f.Layout.offsets()
+	return n * f.BitDepth / 8
+}
+
+// decode decodes the pixels in src into the triplets of components and the alpha values.
+func (f *PixelFormat) decode(components, alphas []float64, src []byte) {
+	r, g, b, a, n := f.Layout.offsets()
+	order := f.byteOrder()
+	size := f.pixelSize()
+	for i := range alphas {
+		p := src[i*size : (i+1)*size]
+		var ch [4]float64
+		for j := 0; j < n; j++ {
+			if f.BitDepth == 8 {
+				ch[j] = float64(p[j]) / 0xff
+			} else {
+				ch[j] = float64(order.Uint16(p[2*j:])) / 0xffff
+			}
+		}
+		components[3*i] = ch[r]
+		components[3*i+1] = ch[g]
+		components[3*i+2] = ch[b]
+		alphas[i] = 1
+		if a >= 0 {
+			alphas[i] = ch[a]
+		}
+	}
+}
+
+// encode encodes the triplets of components and the alpha values into dst. The values are clamped.
+func (f *PixelFormat) encode(dst []byte, components, alphas []float64) {
+	r, g, b, a, n := f.Layout.offsets()
+	order := f.byteOrder()
+	size := f.pixelSize()
+	for i, alpha := range alphas {
+		p := dst[i*size : (i+1)*size]
+		var ch [4]float64
+		ch[r] = components[3*i]
+		ch[g] = components[3*i+1]
+		ch[b] = components[3*i+2]
+		if a >= 0 {
+			ch[a] = alpha
+		}
+		for j := 0; j < n; j++ {
+			v := min(max(ch[j], 0), 1)
+			if f.BitDepth == 8 {
+				p[j] = uint8(math.Round(v * 0xff))
+			} else {
+				order.PutUint16(p[2*j:], uint16(math.Round(v*0xffff)))
+			}
+		}
+	}
+}
+
+// streamChunkPixels is the number of pixels converted at once by ConvertPixels.
+const streamChunkPixels = 4096
+
+// ConvertPixels reads raw pixels in the format srcFormat from src until EOF,
+// converts them into the format dstFormat, and writes them to dst.
+// The pixels are converted in chunks, so the whole pixels don't have to be in memory.
+//
+// ConvertPixels returns the number of bytes written and the first error encountered.
+// If src ends in the middle of a pixel, ConvertPixels returns [io.ErrUnexpectedEOF].
+// If the source format has no alpha channel, the pixels are opaque.
+//
+// ConvertPixels panics if the formats are invalid.
+func ConvertPixels(dst io.Writer, dstFormat PixelFormat, src io.Reader, srcFormat PixelFormat) (int64, error) {
+	srcFormat.check()
+	dstFormat.check()
+
+	srcSize := srcFormat.pixelSize()
+	dstSize := dstFormat.pixelSize()
+	srcBuf := make([]byte, streamChunkPixels*srcSize)
+	dstBuf := make([]byte, streamChunkPixels*dstSize)
+	components := make([]float64, 3*streamChunkPixels)
+	alphas := make([]float64, streamChunkPixels)
+
+	var written int64
+	for {
+		n, err := io.ReadFull(src, srcBuf)
+		eof := errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+		if err != nil && !eof {
+			return written, err
+		}
+
+		pixels := n / srcSize
+		if pixels > 0 {
+			srcFormat.decode(components[:3*pixels], alphas[:pixels], srcBuf[:pixels*srcSize])
+			ConvertRow(components[:3*pixels], components[:3*pixels], srcFormat.Space, dstFormat.Space)
+			dstFormat.encode(dstBuf[:pixels*dstSize], components[:3*pixels], alphas[:pixels])
+			m, err := dst.Write(dstBuf[:pixels*dstSize])
+			written += int64(m)
+			if err != nil {
+				return written, err
+			}
+		}
+
+		if eof {
+			if n%srcSize != 0 {
+				return written, io.ErrUnexpectedEOF
+			}
+			return written, nil
+		}
+	}
+}
@@ -0,0 +1,162 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+	"testing"
+	"testing/iotest"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestConvertPixels(t *testing.T) {
+	// More pixels than a chunk.
+	const n = 5000
+	src := make([]byte, 4*n)
+	for i := range src {
+		src[i] = byte(i * 7)
+	}
+
+	srcFormat := iro.PixelFormat{
+		Space:    iro.SpaceSRGB,
+		Layout:   iro.PixelLayoutRGBA,
+		BitDepth: 8,
+	}
+	dstFormat := iro.PixelFormat{
+		Space:     iro.SpaceLinearSRGB,
+		Layout:    iro.PixelLayoutABGR,
+		BitDepth:  16,
+		ByteOrder: binary.LittleEndian,
+	}
+
+	var dst bytes.Buffer
+	written, err := iro.ConvertPixels(&dst, dstFormat, iotest.OneByteReader(bytes.NewReader(src)), srcFormat)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := written, int64(8*n); got != want {
+		t.Fatalf("written: got %d, want %d", got, want)
+	}
+
+	out := dst.Bytes()
+	for i := 0; i < n; i++ {
+		p := src[4*i:]
+		c := iro.ColorFromSRGB(float64(p[0])/0xff, float64(p[1])/0xff, float64(p[2])/0xff, float64(p[3])/0xff)
+		r, g, b, a := c.LinearSRGB()
+		q := out[8*i:]
+		got := [4]uint16{
+			binary.LittleEndian.Uint16(q[6:]),
+			binary.LittleEndian.Uint16(q[4:]),
+			binary.LittleEndian.Uint16(q[2:]),
+			binary.LittleEndian.Uint16(q[0:]),
+		}
+		for j, v := range []float64{r, g, b, a} {
+			want := uint16(math.Round(v * 0xffff))
+			if d := int(got[j]) - int(want); d < -1 || d > 1 {
+				t.Errorf("pixel %d: got %v, want (%f, %f, %f, %f)", i, got, r, g, b, a)
+				break
+			}
+		}
+	}
+}
+
+func TestConvertPixelsLayouts(t *testing.T) {
+	// A pixel (R, G, B, A) = (0x10, 0x20, 0x30, 0x40) without color conversion.
+	testCases := []struct {
+		layout iro.PixelLayout
+		pixel  []byte
+	}{
+		{layout: iro.PixelLayoutRGB, pixel: []byte{0x10, 0x20, 0x30}},
+		{layout: iro.PixelLayoutRGBA, pixel: []byte{0x10, 0x20, 0x30, 0x40}},
+		{layout: iro.PixelLayoutBGR, pixel: []byte{0x30, 0x20, 0x10}},
+		{layout: iro.PixelLayoutBGRA, pixel: []byte{0x30, 0x20, 0x10, 0x40}},
+		{layout: iro.PixelLayoutARGB, pixel: []byte{0x40, 0x10, 0x20, 0x30}},
+		{layout: iro.PixelLayoutABGR, pixel: []byte{0x40, 0x30, 0x20, 0x10}},
+	}
+	for _, tc := range testCases {
+		format := iro.PixelFormat{
+			Space:    iro.SpaceSRGB,
+			Layout:   tc.layout,
+			BitDepth: 8,
+		}
+		rgba := iro.PixelFormat{
+			Space:    iro.SpaceSRGB,
+			Layout:   iro.PixelLayoutRGBA,
+			BitDepth: 8,
+		}
+		want := []byte{0x10, 0x20, 0x30, 0x40}
+		if len(tc.pixel) == 3 {
+			want[3] = 0xff
+		}
+
+		var dst bytes.Buffer
+		if _, err := iro.ConvertPixels(&dst, rgba, bytes.NewReader(tc.pixel), format); err != nil {
+			t.Fatal(err)
+		}
+		if got := dst.Bytes(); !bytes.Equal(got, want) {
+			t.Errorf("layout %d to RGBA: got %v, want %v", tc.layout, got, want)
+		}
+
+		dst.Reset()
+		if _, err := iro.ConvertPixels(&dst, format, bytes.NewReader(want), rgba); err != nil {
+			t.Fatal(err)
+		}
+		if got := dst.Bytes(); !bytes.Equal(got, tc.pixel) {
+			t.Errorf("RGBA to layout %d: got %v, want %v", tc.layout, got, tc.pixel)
+		}
+	}
+}
+
+func TestConvertPixelsErrors(t *testing.T) {
+	format := iro.PixelFormat{
+		Space:    iro.SpaceSRGB,
+		Layout:   iro.PixelLayoutRGB,
+		BitDepth: 8,
+	}
+
+	// A partial pixel.
+	var dst bytes.Buffer
+	n, err := iro.ConvertPixels(&dst, format, bytes.NewReader([]byte{1, 2, 3, 4}), format)
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("err: got %v, want %v", err, io.ErrUnexpectedEOF)
+	}
+	if n != 3 {
+		t.Errorf("n: got %d, want 3", n)
+	}
+
+	// A reader error.
+	errTest := errors.New("test")
+	if _, err := iro.ConvertPixels(&dst, format, iotest.ErrReader(errTest), format); !errors.Is(err, errTest) {
+		t.Errorf("err: got %v, want %v", err, errTest)
+	}
+
+	// Empty input.
+	dst.Reset()
+	if n, err := iro.ConvertPixels(&dst, format, bytes.NewReader(nil), format); n != 0 || err != nil {
+		t.Errorf("got (%d, %v), want (0, nil)", n, err)
+	}
+}
+
+func TestConvertPixelsPanics(t *testing.T) {
+	testCases := []iro.PixelFormat{
+		{Space: iro.SpaceOKLab, Layout: iro.PixelLayoutRGB, BitDepth: 8},
+		{Space: iro.SpaceSRGB, Layout: iro.PixelLayoutRGB, BitDepth: 12},
+		{Space: iro.SpaceSRGB, Layout: iro.PixelLayout(-1), BitDepth: 8},
+	}
+	for _, format := range testCases {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("ConvertPixels with %+v must panic", format)
+				}
+			}()
+			_, _ = iro.ConvertPixels(io.Discard, format, bytes.NewReader(nil), format)
+		}()
+	}
+}
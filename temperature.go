@@ -0,0 +1,150 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"math"
+)
+
+const (
+	// planckianMin and planckianMax are the range of temperatures in kelvins of [PlanckianLocus].
+	planckianMin = 1667
+	planckianMax = 25000
+)
+
+// PlanckianLocus returns the chromaticity of the Planckian locus at the color temperature t in kelvins.
+//
+// The coordinates are the cubic spline approximation by Kim et al., valid from 1667 K to 25000 K.
+// t is clamped to the range.
+// The spline has slight kinks at its joints at 2222 K and 4000 K,
+// so the isotherms near the joints are approximate.
+func PlanckianLocus(t float64) Chromaticity {
+	t = min(max(t, planckianMin), planckianMax)
+	var x, y float64
+	t2 := t * t
+	t3 := t2 * t
+	if t <= 4000 {
+		x = -0.2661239e9/t3 - 0.2343589e6/t2 + 0.8776956e3/t + 0.179910
+	} else {
+		x = -3.0258469e9/t3 + 2.1070379e6/t2 + 0.2226347e3/t + 0.240390
+	}
+	x2 := x * x
+	x3 := x2 * x
+	switch {
+	case t <= 2222:
+		y = -1.1063814*x3 - 1.34811020*x2 + 2.18555832*x - 0.20219683
+	case t <= 4000:
+		y = -0.9549476*x3 - 1.37418593*x2 + 2.09137015*x - 0.16748867
+	default:
+		y = 3.0817580*x3 - 5.87338670*x2 + 3.75112997*x - 0.37001483
+	}
+	return Chromaticity{X: x, Y: y}
+}
+
+// uv1960 returns the CIE 1960 UCS uv chromaticity coordinates of c.
+func (c Chromaticity) uv1960() (u, v float64) {
+	u, v = c.UV()
+	return u, v * 2 / 3
+}
+
+// chromaticityFromUV1960 returns the Chromaticity of the CIE 1960 UCS uv chromaticity coordinates.
+func chromaticityFromUV1960(u, v float64) Chromaticity {
+	return ChromaticityFromUV(u, v*3/2)
+}
+
+// planckianNormal returns the unit normal of the Planckian locus at the color temperature t in kelvins in the CIE 1960 UCS.
+// The normal points above the locus, i.e. toward green.
+func planckianNormal(t float64) (nu, nv float64) {
+	// Differentiate numerically in mireds, inside the valid range.
+	m := 1e6 / min(max(t, planckianMin), planckianMax)
+	const dm = 0.01
+	m0 := min(m+dm, 1e6/planckianMin)
+	m1 := max(m-dm, 1e6/planckianMax)
+	u0, v0 := PlanckianLocus(1e6 / m0).uv1960()
+	u1, v1 := PlanckianLocus(1e6 / m1).uv1960()
+	du, dv := u1-u0, v1-v0
+	d := math.Hypot(du, dv)
+	return dv / d, -du / d
+}
+
+// Isotherm returns the chromaticity on the isotherm of the color temperature t in kelvins,
+// at the signed distance duv from the Planckian locus in the CIE 1960 UCS.
+//
+// The isotherm is the line perpendicular to the Planckian locus ([PlanckianLocus]) in the CIE 1960 UCS,
+// along which all the chromaticities have the same correlated color temperature (see [Chromaticity.CorrelatedColorTemperature]).
+// A positive duv is above the locus (greenish), and a negative duv is below the locus (pinkish), as ANSI C78.377 defines Duv.
+func Isotherm(t, duv float64) Chromaticity {
+	u, v := PlanckianLocus(t).uv1960()
+	nu, nv := planckianNormal(t)
+	return chromaticityFromUV1960(u+duv*nu, v+duv*nv)
+}
+
+// CorrelatedColorTemperature returns the correlated color temperature t of c in kelvins,
+// i.e. the temperature of the nearest point on the Planckian locus in the CIE 1960 UCS,
+// and the signed distance duv from the locus. See [Isotherm] for the sign of duv.
+//
+// t is in the range of [PlanckianLocus], and duv is meaningful only if the range covers t.
+// The correlated color temperature is usually considered meaningful only for |duv| ≤ 0.05.
+func (c Chromaticity) CorrelatedColorTemperature() (t, duv float64) {
+	t = nearestTemperature(c, PlanckianLocus)
+	u, v := c.uv1960()
+	pu, pv := PlanckianLocus(t).uv1960()
+	nu, nv := planckianNormal(t)
+	return t, (u-pu)*nu + (v-pv)*nv
+}
+
+// nearestTemperature returns the temperature in the range of [PlanckianLocus] of the nearest point to c on the locus in the CIE 1960 UCS.
+func nearestTemperature(c Chromaticity, locus func(t float64) Chromaticity) float64 {
+	u, v := c.uv1960()
+	dist := func(mired float64) float64 {
+		pu, pv := locus(1e6 / mired).uv1960()
+		return (pu-u)*(pu-u) + (pv-v)*(pv-v)
+	}
+
+	// Search the nearest point by the golden-section search in mireds, where the locus is more uniform.
+	const phi = 0.6180339887498949
+	lo, hi := 1e6/planckianMax, 1e6/planckianMin
+	m0, m1 := hi-phi*(hi-lo), lo+phi*(hi-lo)
+	d0, d1 := dist(m0), dist(m1)
+	for hi-lo > 1e-6 {
+		if d0 < d1 {
+			hi, m1, d1 = m1, m0, d0
+			m0 = hi - phi*(hi-lo)
+			d0 = dist(m0)
+		} else {
+			lo, m0, d0 = m0, m1, d1
+			m1 = lo + phi*(hi-lo)
+			d1 = dist(m1)
+		}
+	}
+	return 1e6 / ((lo + hi) / 2)
+}
+
+// warmHue is the OKLCh hue in radians of the direction from the cool end to the warm end of the Planckian locus.
+var warmHue = func() float64 {
+	_, wa, wb, _ := PlanckianLocus(planckianMin).Color(1, 1).OKLab()
+	_, ca, cb, _ := PlanckianLocus(planckianMax).Color(1, 1).OKLab()
+	return math.Atan2(wb-cb, wa-ca)
+}()
+
+// warmCoolChroma is the OKLCh chroma at and above which the warm–cool score is not attenuated.
+const warmCoolChroma = 0.1
+
+// WarmCoolScore returns the warm–cool score of c in [-1, 1].
+// A positive score means a warm color, and a negative score means a cool color.
+// The alpha value is ignored.
+//
+// The score is the cosine of the angle between the hue of c and the direction along the Planckian locus
+// from high color temperatures (bluish) to low color temperatures (reddish orange) in OKLab,
+// attenuated linearly for chroma below 0.1. Thus oranges are the warmest, blues are the coolest,
+// and neutral colors score 0.
+func WarmCoolScore(c Color) float64 {
+	_, ch, h, _ := c.OKLch()
+	return math.Cos(h-warmHue) * min(ch/warmCoolChroma, 1)
+}
+
+// IsWarm reports whether c is a warm color, i.e. its [WarmCoolScore] is positive.
+func IsWarm(c Color) bool {
+	return WarmCoolScore(c) > 0
+}
@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestWarmCoolScore(t *testing.T) {
+	testCases := []struct {
+		name  string
+		color iro.Color
+		warm  bool
+		min   float64
+		max   float64
+	}{
+		{name: "red", color: iro.Red, warm: true, min: 0.8, max: 0.9},
+		{name: "orange", color: iro.ColorFromOKLchDeg(0.7, 0.15, 62, 1), warm: true, min: 0.99, max: 1},
+		{name: "yellow", color: iro.Yellow, warm: true, min: 0.6, max: 0.7},
+		{name: "blue", color: iro.Blue, warm: false, min: -0.95, max: -0.9},
+		{name: "cyan", color: iro.Cyan, warm: false, min: -0.7, max: -0.65},
+		{name: "muted blue", color: iro.ColorFromOKLchDeg(0.5, 0.05, 242, 1), warm: false, min: -0.51, max: -0.49},
+		{name: "gray", color: iro.Gray, warm: false, min: -1e-6, max: 1e-6},
+	}
+	for _, tc := range testCases {
+		got := iro.WarmCoolScore(tc.color)
+		if got < tc.min || got > tc.max {
+			t.Errorf("WarmCoolScore(%s): got %f, want in [%f, %f]", tc.name, got, tc.min, tc.max)
+		}
+		if got := iro.IsWarm(tc.color); got != tc.warm {
+			t.Errorf("IsWarm(%s): got %t, want %t", tc.name, got, tc.warm)
+		}
+	}
+}
+
+func TestPlanckianLocus(t *testing.T) {
+	testCases := []struct {
+		t    float64
+		want iro.Chromaticity
+	}{
+		{t: 2856, want: iro.Chromaticity{X: 0.44757, Y: 0.40745}},
+		{t: 5000, want: iro.Chromaticity{X: 0.34510, Y: 0.35161}},
+		{t: 10000, want: iro.Chromaticity{X: 0.27993, Y: 0.28824}},
+		// Out of the range, t is clamped.
+		{t: 100000, want: iro.PlanckianLocus(25000)},
+	}
+	for _, tc := range testCases {
+		got := iro.PlanckianLocus(tc.t)
+		if math.Abs(got.X-tc.want.X) > 1e-3 || math.Abs(got.Y-tc.want.Y) > 1e-3 {
+			t.Errorf("PlanckianLocus(%f): got %v, want %v", tc.t, got, tc.want)
+		}
+	}
+}
+
+func TestChromaticityCorrelatedColorTemperature(t *testing.T) {
+	testCases := []struct {
+		c   iro.Chromaticity
+		t   float64
+		duv float64
+	}{
+		// The approximation of the Planckian locus is accurate to about 10 K.
+		{c: iro.WhiteD65, t: 6504, duv: 0.0032},
+		{c: iro.WhiteD50, t: 5003, duv: 0.0033},
+		// Illuminant A.
+		{c: iro.Chromaticity{X: 0.44757, Y: 0.40745}, t: 2856, duv: 0},
+	}
+	for _, tc := range testCases {
+		temp, duv := tc.c.CorrelatedColorTemperature()
+		if math.Abs(temp-tc.t) > 10 || math.Abs(duv-tc.duv) > 2e-4 {
+			t.Errorf("%v.CorrelatedColorTemperature(): got (%f, %f), want (%f, %f)", tc.c, temp, duv, tc.t, tc.duv)
+		}
+	}
+}
+
+func TestIsotherm(t *testing.T) {
+	// Avoid the joints of the spline at 2222 K and 4000 K, where the isotherms are not exactly perpendicular to the locus.
+	for _, temp := range []float64{2000, 2700, 3000, 5000, 6500, 10000, 20000} {
+		for _, duv := range []float64{-0.02, -0.005, 0, 0.005, 0.02} {
+			c := iro.Isotherm(temp, duv)
+			gotT, gotDuv := c.CorrelatedColorTemperature()
+			if math.Abs(gotT-temp) > temp*1e-5 || math.Abs(gotDuv-duv) > 1e-6 {
+				t.Errorf("Isotherm(%f, %f).CorrelatedColorTemperature(): got (%f, %f), want (%f, %f)", temp, duv, gotT, gotDuv, temp, duv)
+			}
+		}
+	}
+
+	// A positive Duv is greenish.
+	_, a0, _, _ := iro.Isotherm(4000, 0.01).Color(1, 1).OKLab()
+	_, a1, _, _ := iro.Isotherm(4000, -0.01).Color(1, 1).OKLab()
+	if a0 >= a1 {
+		t.Errorf("OKLab a: got %f for Duv 0.01 and %f for Duv -0.01, want the former smaller", a0, a1)
+	}
+}
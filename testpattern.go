@@ -0,0 +1,150 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+)
+
+// PatternOptions is the options of test patterns.
+//
+// The pixels of test patterns are the nonlinear R'G'B' signal values of the transfer function stored as they are,
+// so they don't depend on the primaries of the color space.
+// Only with the sRGB transfer function, they are sRGB colors like the other images of this package.
+type PatternOptions struct {
+	// Transfer is the transfer function of the signals. The default is sRGB.
+	Transfer TransferFunction
+
+	// LinearLight reports whether the steps of step wedges and ramps are even in linear light instead of in the signal values.
+	LinearLight bool
+}
+
+func (o *PatternOptions) transfer() TransferFunction {
+	if o == nil {
+		return TransferSRGB
+	}
+	return o.Transfer
+}
+
+// signal returns the signal value at the position t in [0, 1] of step wedges and ramps.
+func (o *PatternOptions) signal(t float64) float64 {
+	if o != nil && o.LinearLight {
+		return o.Transfer.Encode(t)
+	}
+	return t
+}
+
+func patternColor(r, g, b float64) color.NRGBA64 {
+	return color.NRGBA64{R: toUint16(r), G: toUint16(g), B: toUint16(b), A: 0xffff}
+}
+
+// colorBarsLevel returns the signal value of the colors of the color bars.
+func colorBarsLevel(transfer TransferFunction) float64 {
+	if transfer == TransferPQ {
+		// BT.2111 uses the signal of 203 cd/m², the reference white of BT.2408, for PQ.
+		return TransferPQ.Encode(203.0 / 10000)
+	}
+	return 0.75
+}
+
+// ColorBars returns the SMPTE color bars (SMPTE EG 1) of the size.
+//
+// The top two thirds are the 75% bars of gray, yellow, cyan, green, magenta, red, and blue.
+// The next twelfth is the reversed blue bars, and the bottom quarter is -I, 100% white, +Q, black, and the PLUGE.
+// As the images cannot represent negative signal values, the -4% bar of the PLUGE is black.
+// For PQ, the 75% level is replaced with the signal of 203 cd/m² as BT.2111 does.
+// The option LinearLight is ignored.
+func ColorBars(width, height int, opts *PatternOptions) *image.NRGBA64 {
+	v := colorBarsLevel(opts.transfer())
+	gray := patternColor(v, v, v)
+	yellow := patternColor(v, v, 0)
+	cyan := patternColor(0, v, v)
+	green := patternColor(0, v, 0)
+	magenta := patternColor(v, 0, v)
+	red := patternColor(v, 0, 0)
+	blue := patternColor(0, 0, v)
+	black := patternColor(0, 0, 0)
+	white := patternColor(1, 1, 1)
+	// The approximate R'G'B' values of -I and +Q.
+	minusI := patternColor(0, 33.0/255, 76.0/255)
+	plusQ := patternColor(50.0/255, 0, 106.0/255)
+	plus4 := patternColor(0.04, 0.04, 0.04)
+
+	top := [...]color.NRGBA64{gray, yellow, cyan, green, magenta, red, blue}
+	middle := [...]color.NRGBA64{blue, black, magenta, black, cyan, black, gray}
+
+	img := image.NewNRGBA64(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			// u is the position in the units of the bar width.
+			u := float64(x) * 7 / float64(width)
+			var c color.NRGBA64
+			switch {
+			case y < height*2/3:
+				c = top[int(u)]
+			case y < height*3/4:
+				c = middle[int(u)]
+			case u < 5.0/4:
+				c = minusI
+			case u < 10.0/4:
+				c = white
+			case u < 15.0/4:
+				c = plusQ
+			case u < 5+2.0/3:
+				// The black, and the -4% and 0% bars of the PLUGE.
+				c = black
+			case u < 6:
+				c = plus4
+			default:
+				c = black
+			}
+			img.SetNRGBA64(x, y, c)
+		}
+	}
+	return img
+}
+
+// StepWedge returns a grayscale step wedge of the size with the number of steps from black to white, from left to right.
+//
+// StepWedge panics if steps is less than 2.
+func StepWedge(width, height, steps int, opts *PatternOptions) *image.NRGBA64 {
+	if steps < 2 {
+		panic(fmt.Sprintf("iro: the number of steps must be at least 2 but %d", steps))
+	}
+	img := image.NewNRGBA64(image.Rect(0, 0, width, height))
+	for x := 0; x < width; x++ {
+		s := x * steps / width
+		v := opts.signal(float64(s) / float64(steps-1))
+		c := patternColor(v, v, v)
+		for y := 0; y < height; y++ {
+			img.SetNRGBA64(x, y, c)
+		}
+	}
+	return img
+}
+
+// Ramp returns the gradient ramps of the size from black to white, red, green, and blue, from left to right.
+// The ramps are stacked from top to bottom in this order, each with a quarter of the height.
+func Ramp(width, height int, opts *PatternOptions) *image.NRGBA64 {
+	img := image.NewNRGBA64(image.Rect(0, 0, width, height))
+	for x := 0; x < width; x++ {
+		var t float64
+		if width > 1 {
+			t = float64(x) / float64(width-1)
+		}
+		v := opts.signal(t)
+		cs := [...]color.NRGBA64{
+			patternColor(v, v, v),
+			patternColor(v, 0, 0),
+			patternColor(0, v, 0),
+			patternColor(0, 0, v),
+		}
+		for y := 0; y < height; y++ {
+			img.SetNRGBA64(x, y, cs[min(y*4/height, 3)])
+		}
+	}
+	return img
+}
@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"image/color"
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestColorBars(t *testing.T) {
+	const w, h = 700, 120
+	img := iro.ColorBars(w, h, nil)
+	const v = 0xbfff // 75%
+	testCases := []struct {
+		x, y int
+		want color.NRGBA64
+	}{
+		{x: 50, y: 10, want: color.NRGBA64{v, v, v, 0xffff}},
+		{x: 150, y: 10, want: color.NRGBA64{v, v, 0, 0xffff}},
+		{x: 250, y: 10, want: color.NRGBA64{0, v, v, 0xffff}},
+		{x: 350, y: 10, want: color.NRGBA64{0, v, 0, 0xffff}},
+		{x: 450, y: 10, want: color.NRGBA64{v, 0, v, 0xffff}},
+		{x: 550, y: 10, want: color.NRGBA64{v, 0, 0, 0xffff}},
+		{x: 650, y: 10, want: color.NRGBA64{0, 0, v, 0xffff}},
+		{x: 50, y: 85, want: color.NRGBA64{0, 0, v, 0xffff}},
+		{x: 150, y: 85, want: color.NRGBA64{0, 0, 0, 0xffff}},
+		{x: 200, y: 110, want: color.NRGBA64{0xffff, 0xffff, 0xffff, 0xffff}},
+		{x: 450, y: 110, want: color.NRGBA64{0, 0, 0, 0xffff}},
+		{x: 580, y: 110, want: color.NRGBA64{0x0a3d, 0x0a3d, 0x0a3d, 0xffff}},
+		{x: 650, y: 110, want: color.NRGBA64{0, 0, 0, 0xffff}},
+	}
+	for _, tc := range testCases {
+		if got := img.NRGBA64At(tc.x, tc.y); got != tc.want {
+			t.Errorf("(%d, %d): got %v, want %v", tc.x, tc.y, got, tc.want)
+		}
+	}
+
+	// PQ bars are at 203 cd/m².
+	pq := iro.ColorBars(w, h, &iro.PatternOptions{Transfer: iro.TransferPQ})
+	if got, want := pq.NRGBA64At(50, 10).R, uint16(0x94a8); got < want-2 || got > want+2 {
+		t.Errorf("PQ: got %#x, want about %#x", got, want)
+	}
+}
+
+func TestStepWedge(t *testing.T) {
+	img := iro.StepWedge(110, 10, 11, nil)
+	for i := 0; i < 11; i++ {
+		want := uint16(math.Round(float64(i) / 10 * 0xffff))
+		if got := img.NRGBA64At(i*10+5, 5); got.R != want || got.G != want || got.B != want {
+			t.Errorf("step %d: got %v, want %#x", i, got, want)
+		}
+	}
+
+	// In linear light, the middle step is the encoded 50%.
+	img = iro.StepWedge(3, 1, 3, &iro.PatternOptions{Transfer: iro.TransferHLG, LinearLight: true})
+	if got, want := img.NRGBA64At(1, 0).R, uint16(math.Round(iro.TransferHLG.Encode(0.5)*0xffff)); got != want {
+		t.Errorf("HLG: got %#x, want %#x", got, want)
+	}
+}
+
+func TestRamp(t *testing.T) {
+	img := iro.Ramp(256, 8, nil)
+	testCases := []struct {
+		x, y int
+		want color.NRGBA64
+	}{
+		{x: 0, y: 0, want: color.NRGBA64{0, 0, 0, 0xffff}},
+		{x: 255, y: 0, want: color.NRGBA64{0xffff, 0xffff, 0xffff, 0xffff}},
+		{x: 255, y: 2, want: color.NRGBA64{0xffff, 0, 0, 0xffff}},
+		{x: 255, y: 4, want: color.NRGBA64{0, 0xffff, 0, 0xffff}},
+		{x: 255, y: 7, want: color.NRGBA64{0, 0, 0xffff, 0xffff}},
+		{x: 51, y: 0, want: color.NRGBA64{0x3333, 0x3333, 0x3333, 0xffff}},
+	}
+	for _, tc := range testCases {
+		if got := img.NRGBA64At(tc.x, tc.y); got != tc.want {
+			t.Errorf("(%d, %d): got %v, want %v", tc.x, tc.y, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,169 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"errors"
+	"image"
+	"math"
+)
+
+// ThemeOptions represents options for [ThemeFromImage].
+type ThemeOptions struct {
+	// Dark reports whether the theme has a dark background and light text.
+	Dark bool
+
+	// Colors is the number of the dominant colors extracted from the image.
+	// The default 0 means 8.
+	Colors int
+
+	// MinTextContrast is the minimum WCAG contrast ratio of the text against the background and the surface.
+	// The default 0 means [WCAGContrastAA].
+	MinTextContrast float64
+
+	// MinAccentContrast is the minimum WCAG contrast ratio of the accent against the background.
+	// The default 0 means [WCAGContrastAALarge], the ratio WCAG requires for user interface components.
+	MinAccentContrast float64
+}
+
+func (o *ThemeOptions) dark() bool {
+	return o != nil && o.Dark
+}
+
+func (o *ThemeOptions) colors() int {
+	if o == nil || o.Colors == 0 {
+		return 8
+	}
+	return o.Colors
+}
+
+func (o *ThemeOptions) minTextContrast() float64 {
+	if o == nil || o.MinTextContrast == 0 {
+		return WCAGContrastAA
+	}
+	return o.MinTextContrast
+}
+
+func (o *ThemeOptions) minAccentContrast() float64 {
+	if o == nil || o.MinAccentContrast == 0 {
+		return WCAGContrastAALarge
+	}
+	return o.MinAccentContrast
+}
+
+// Theme is the colors of the roles of a user interface. All the colors are opaque and inside the sRGB gamut.
+type Theme struct {
+	// Background is the color of the background.
+	Background Color
+
+	// Surface is the color of surfaces on the background like cards.
+	Surface Color
+
+	// Accent is the color to emphasize elements like buttons and links.
+	Accent Color
+
+	// OnAccent is the color of text on the accent.
+	OnAccent Color
+
+	// Text is the color of text on the background and the surface.
+	Text Color
+
+	// Palette is the dominant colors of the image, ordered by the populations from the largest. See [DominantColors].
+	Palette []Color
+}
+
+// The OKLCh lightness and the maximum chroma of the neutral roles of themes.
+const (
+	themeLightBackground = 0.98
+	themeLightSurface    = 0.94
+	themeLightText       = 0.25
+	themeDarkBackground  = 0.18
+	themeDarkSurface     = 0.24
+	themeDarkText        = 0.93
+	themeNeutralChroma   = 0.02
+)
+
+// themeAchromatic is the OKLCh chroma under which colors are treated as achromatic to choose the accent.
+const themeAchromatic = 0.03
+
+// ThemeFromImage returns a theme of the colors of img, for example to style a user interface around a photo.
+//
+// The dominant colors of img are extracted with [DominantColors].
+// The background and the surface are light (or dark with ThemeOptions.Dark) neutral colors tinted with the hue of the most populous color.
+// The accent is the most chromatic color weighted by the populations, whose lightness is adjusted
+// to satisfy ThemeOptions.MinAccentContrast against the background.
+// The text is a neutral color whose lightness is adjusted to satisfy ThemeOptions.MinTextContrast against the background and the surface.
+// The text on the accent is black or white, whichever has the higher contrast.
+// If a contrast cannot be satisfied, the color with the highest contrast is used.
+//
+// opts can be nil. ThemeFromImage returns an error if img has no opaque pixels.
+func ThemeFromImage(img image.Image, opts *ThemeOptions) (*Theme, error) {
+	palette := DominantColors(img, opts.colors())
+	if len(palette) == 0 {
+		return nil, errors.New("iro: an image for a theme must have opaque pixels")
+	}
+
+	// The neutral colors are tinted with the most populous color.
+	_, nc, nh, _ := palette[0].OKLch()
+	nc = min(nc, themeNeutralChroma)
+
+	// The accent is the most chromatic color, preferring populous ones.
+	accent := palette[0]
+	var best float64
+	for i, c := range palette {
+		_, ch, _, _ := c.OKLch()
+		if ch < themeAchromatic {
+			continue
+		}
+		if s := ch / math.Sqrt(float64(i+1)); s > best {
+			accent, best = c, s
+		}
+	}
+
+	bgL, surfaceL, textL := themeLightBackground, themeLightSurface, themeLightText
+	if opts.dark() {
+		bgL, surfaceL, textL = themeDarkBackground, themeDarkSurface, themeDarkText
+	}
+	t := &Theme{
+		Background: colorFromOKLchInSRGB(bgL, nc, nh),
+		Surface:    colorFromOKLchInSRGB(surfaceL, nc, nh),
+		Palette:    palette,
+	}
+
+	minText := opts.minTextContrast()
+	t.Text = ensureContrast(colorFromOKLchInSRGB(textL, nc, nh), func(c Color) float64 {
+		return min(WCAGContrast(c, t.Background), WCAGContrast(c, t.Surface))
+	}, minText)
+
+	l, ch, h, _ := accent.OKLch()
+	t.Accent = ensureContrast(colorFromOKLchInSRGB(l, ch, h), func(c Color) float64 {
+		return WCAGContrast(c, t.Background)
+	}, opts.minAccentContrast())
+
+	t.OnAccent = ColorFromSRGB(1, 1, 1, 1)
+	if black := ColorFromSRGB(0, 0, 0, 1); WCAGContrast(black, t.Accent) > WCAGContrast(t.OnAccent, t.Accent) {
+		t.OnAccent = black
+	}
+	return t, nil
+}
+
+// ensureContrast returns the color nearest to c in the OKLCh lightness whose contrast is at least minContrast.
+// If no colors satisfy it, ensureContrast returns the black or the white with the same hue, whichever has the higher contrast.
+func ensureContrast(c Color, contrast func(c Color) float64, minContrast float64) Color {
+	ok := func(c Color) bool {
+		return contrast(c) >= minContrast
+	}
+	if ok(c) {
+		return c
+	}
+	if s, ok := suggestContrastColor(c, ok); ok {
+		return s
+	}
+	_, ch, h, _ := c.OKLch()
+	black, white := colorFromOKLchInSRGB(0, ch, h), colorFromOKLchInSRGB(1, ch, h)
+	if contrast(black) > contrast(white) {
+		return black
+	}
+	return white
+}
@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+// themeImage returns an image mostly of a grayish blue with a small region of a vivid orange.
+func themeImage() *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, 32, 32))
+	for y := 0; y < 32; y++ {
+		for x := 0; x < 32; x++ {
+			c := color.NRGBA{R: 0x60, G: 0x70, B: 0x90, A: 0xff}
+			if x < 8 && y < 8 {
+				c = color.NRGBA{R: 0xff, G: 0x80, B: 0x00, A: 0xff}
+			}
+			img.SetNRGBA(x, y, c)
+		}
+	}
+	return img
+}
+
+func TestThemeFromImage(t *testing.T) {
+	testCases := []struct {
+		name string
+		opts *iro.ThemeOptions
+	}{
+		{name: "default", opts: nil},
+		{name: "dark", opts: &iro.ThemeOptions{Dark: true}},
+		{name: "AAA", opts: &iro.ThemeOptions{MinTextContrast: iro.WCAGContrastAAA, MinAccentContrast: iro.WCAGContrastAA}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			theme, err := iro.ThemeFromImage(themeImage(), tc.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var minText, minAccent float64 = iro.WCAGContrastAA, iro.WCAGContrastAALarge
+			if tc.opts != nil && tc.opts.MinTextContrast != 0 {
+				minText = tc.opts.MinTextContrast
+			}
+			if tc.opts != nil && tc.opts.MinAccentContrast != 0 {
+				minAccent = tc.opts.MinAccentContrast
+			}
+			for _, p := range []struct {
+				name   string
+				fg, bg iro.Color
+				min    float64
+			}{
+				{"text on background", theme.Text, theme.Background, minText},
+				{"text on surface", theme.Text, theme.Surface, minText},
+				{"accent on background", theme.Accent, theme.Background, minAccent},
+				{"on accent", theme.OnAccent, theme.Accent, iro.WCAGContrastAALarge},
+			} {
+				if got := iro.WCAGContrast(p.fg, p.bg); got < p.min {
+					t.Errorf("%s: contrast: got %f, want at least %f", p.name, got, p.min)
+				}
+			}
+
+			for _, c := range []iro.Color{theme.Background, theme.Surface, theme.Accent, theme.OnAccent, theme.Text} {
+				r, g, b, a := c.SRGB()
+				for _, v := range []float64{r, g, b} {
+					if v < -1e-6 || v > 1+1e-6 {
+						t.Errorf("%v: got sRGB (%f, %f, %f), want inside the gamut", c, r, g, b)
+					}
+				}
+				if a != 1 {
+					t.Errorf("%v: alpha: got %f, want 1", c, a)
+				}
+			}
+
+			bgL, _, _, _ := theme.Background.OKLch()
+			if dark := tc.opts != nil && tc.opts.Dark; dark && bgL > 0.3 || !dark && bgL < 0.9 {
+				t.Errorf("background lightness: got %f", bgL)
+			}
+
+			// The accent is the orange even though the region is small.
+			_, _, h, _ := theme.Accent.OKLch()
+			_, _, want, _ := iro.ColorFromSRGB(1, 0.5, 0, 1).OKLch()
+			if d := math.Abs(math.Remainder(h-want, 2*math.Pi)); d > 0.2 {
+				t.Errorf("accent hue: got %f, want %f", h, want)
+			}
+
+			if len(theme.Palette) != 2 {
+				t.Errorf("palette: got %d colors, want 2", len(theme.Palette))
+			}
+		})
+	}
+}
+
+func TestThemeFromImageTransparent(t *testing.T) {
+	if _, err := iro.ThemeFromImage(image.NewNRGBA(image.Rect(0, 0, 4, 4)), nil); err == nil {
+		t.Errorf("got no error for a transparent image")
+	}
+}
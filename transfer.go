@@ -0,0 +1,126 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"fmt"
+	"math"
+)
+
+// TransferFunction represents a transfer function between linear values and nonlinear signal values.
+type TransferFunction int
+
+const (
+	// TransferSRGB represents the sRGB transfer function.
+	TransferSRGB TransferFunction = iota
+
+	// TransferLinear represents the identity.
+	TransferLinear
+
+	// TransferBT709 represents the opto-electronic transfer function of BT.709, also used by BT.601 and BT.2020.
+	TransferBT709
+
+	// TransferPQ represents the perceptual quantizer of SMPTE ST 2084 and BT.2100.
+	// The linear value 1 is the luminance of 10000 cd/m².
+	TransferPQ
+
+	// TransferHLG represents the opto-electronic transfer function of the hybrid log-gamma of ARIB STD-B67 and BT.2100.
+	// The linear values are the scene light in [0, 1].
+	TransferHLG
+
+	// TransferGamma22 represents the pure power function of the gamma 2.2,
+	// which many displays and game engines assume instead of the sRGB transfer function.
+	TransferGamma22
+)
+
+// String returns the name of the transfer function.
+func (t TransferFunction) String() string {
+	switch t {
+	case TransferSRGB:
+		return "sRGB"
+	case TransferLinear:
+		return "linear"
+	case TransferBT709:
+		return "BT.709"
+	case TransferPQ:
+		return "PQ"
+	case TransferHLG:
+		return "HLG"
+	case TransferGamma22:
+		return "gamma 2.2"
+	default:
+		return fmt.Sprintf("TransferFunction(%d)", t)
+	}
+}
+
+// The constants of PQ in SMPTE ST 2084.
+const (
+	pqM1 = 2610.0 / 16384
+	pqM2 = 2523.0 / 4096 * 128
+	pqC1 = 3424.0 / 4096
+	pqC2 = 2413.0 / 4096 * 32
+	pqC3 = 2392.0 / 4096 * 32
+)
+
+// The constants of HLG in BT.2100.
+const (
+	hlgA = 0.17883277
+	hlgB = 1 - 4*hlgA
+)
+
+// hlgC is the constant c of HLG in BT.2100.
+var hlgC = 0.5 - hlgA*math.Log(4*hlgA)
+
+// Encode converts the linear value v to the signal value.
+//
+// The sRGB, BT.709, and gamma 2.2 functions are extended to negative values symmetrically.
+// The PQ and HLG functions clamp negative values to 0.
+func (t TransferFunction) Encode(v float64) float64 {
+	switch t {
+	case TransferSRGB:
+		return gamma(v)
+	case TransferLinear:
+		return v
+	case TransferBT709:
+		return bt709Gamma(v)
+	case TransferPQ:
+		p := math.Pow(max(v, 0), pqM1)
+		return math.Pow((pqC1+pqC2*p)/(1+pqC3*p), pqM2)
+	case TransferHLG:
+		v = max(v, 0)
+		if v <= 1.0/12 {
+			return math.Sqrt(3 * v)
+		}
+		return hlgA*math.Log(12*v-hlgB) + hlgC
+	case TransferGamma22:
+		return gamma22(v)
+	default:
+		panic(fmt.Sprintf("iro: invalid TransferFunction: %d", t))
+	}
+}
+
+// Decode converts the signal value v to the linear value. Decode is the inverse of [TransferFunction.Encode].
+func (t TransferFunction) Decode(v float64) float64 {
+	switch t {
+	case TransferSRGB:
+		return degamma(v)
+	case TransferLinear:
+		return v
+	case TransferBT709:
+		return bt709Degamma(v)
+	case TransferPQ:
+		p := math.Pow(max(v, 0), 1/pqM2)
+		return math.Pow(max(p-pqC1, 0)/(pqC2-pqC3*p), 1/pqM1)
+	case TransferHLG:
+		v = max(v, 0)
+		if v <= 0.5 {
+			return v * v / 3
+		}
+		return (math.Exp((v-hlgC)/hlgA) + hlgB) / 12
+	case TransferGamma22:
+		return degamma22(v)
+	default:
+		panic(fmt.Sprintf("iro: invalid TransferFunction: %d", t))
+	}
+}
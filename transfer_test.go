@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestTransferFunction(t *testing.T) {
+	testCases := []struct {
+		transfer iro.TransferFunction
+		linear   float64
+		signal   float64
+		tol      float64
+	}{
+		{transfer: iro.TransferSRGB, linear: 0.5, signal: 0.7353569830524495, tol: 1e-6},
+		{transfer: iro.TransferLinear, linear: 0.5, signal: 0.5, tol: 1e-12},
+		{transfer: iro.TransferBT709, linear: 0.01, signal: 0.045, tol: 1e-9},
+		{transfer: iro.TransferBT709, linear: 1, signal: 1, tol: 1e-9},
+		// 100 cd/m² and 203 cd/m² in PQ.
+		{transfer: iro.TransferPQ, linear: 0.01, signal: 0.5080784, tol: 1e-6},
+		{transfer: iro.TransferPQ, linear: 0.0203, signal: 0.5806888, tol: 1e-6},
+		{transfer: iro.TransferPQ, linear: 1, signal: 1, tol: 1e-9},
+		{transfer: iro.TransferHLG, linear: 1.0 / 12, signal: 0.5, tol: 1e-9},
+		{transfer: iro.TransferHLG, linear: 1, signal: 1, tol: 1e-7},
+		{transfer: iro.TransferGamma22, linear: 0.2176376, signal: 0.5, tol: 1e-6},
+		{transfer: iro.TransferGamma22, linear: -0.2176376, signal: -0.5, tol: 1e-6},
+	}
+	for _, tc := range testCases {
+		if got := tc.transfer.Encode(tc.linear); math.Abs(got-tc.signal) > tc.tol {
+			t.Errorf("%s: Encode(%f): got %.9f, want %.9f", tc.transfer, tc.linear, got, tc.signal)
+		}
+		if got := tc.transfer.Decode(tc.signal); math.Abs(got-tc.linear) > tc.tol {
+			t.Errorf("%s: Decode(%f): got %.9f, want %.9f", tc.transfer, tc.signal, got, tc.linear)
+		}
+	}
+}
+
+func TestTransferFunctionRoundTrip(t *testing.T) {
+	for _, tf := range []iro.TransferFunction{iro.TransferSRGB, iro.TransferLinear, iro.TransferBT709, iro.TransferPQ, iro.TransferHLG, iro.TransferGamma22} {
+		for i := 0; i <= 100; i++ {
+			v := float64(i) / 100
+			if got := tf.Decode(tf.Encode(v)); math.Abs(got-v) > 1e-6 {
+				t.Errorf("%s: Decode(Encode(%f)): got %f", tf, v, got)
+			}
+		}
+	}
+}
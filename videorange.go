@@ -0,0 +1,261 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"fmt"
+	"image"
+	"math"
+)
+
+// VideoSignal specifies the kind of the components of video code values.
+//
+// The zero value is invalid. Validations with an invalid VideoSignal panic.
+type VideoSignal int
+
+const (
+	// VideoSignalYCbCr represents Y', Cb, and Cr components.
+	VideoSignalYCbCr VideoSignal = iota + 1
+
+	// VideoSignalRGB represents R', G', and B' components.
+	VideoSignalRGB
+)
+
+// VideoLevels specifies the legal limits of narrow-range (limited-range) video code values.
+// The limits are the same among BT.601, BT.709, and BT.2020.
+//
+// The zero value is invalid. Validations with an invalid VideoLevels panic.
+type VideoLevels int
+
+const (
+	// VideoLevelsNominal represents the nominal range.
+	// Y', R', G', and B' are in [16, 235], and Cb and Cr are in [16, 240] in 8 bits.
+	VideoLevelsNominal VideoLevels = iota + 1
+
+	// VideoLevelsEBUR103 represents the preferred limits of EBU R 103.
+	// R', G', and B' are in [-5%, 105%] ([5, 246] in 8 bits), and Y' is in [-1%, 103%] ([14, 242] in 8 bits).
+	// Cb and Cr are not limited except for the reserved code values.
+	VideoLevelsEBUR103
+)
+
+// VideoRangeViolationKind is the kind of a violation of video code values.
+type VideoRangeViolationKind int
+
+const (
+	// VideoRangeBelow means a code value is below the minimum legal value.
+	VideoRangeBelow VideoRangeViolationKind = iota
+
+	// VideoRangeAbove means a code value is above the maximum legal value.
+	VideoRangeAbove
+
+	// VideoRangeReserved means a code value is one of the values reserved for timing references,
+	// i.e. 0 and 255 in 8 bits, or 0 to 3 and 1020 to 1023 in 10 bits.
+	VideoRangeReserved
+)
+
+// String returns the name of the kind.
+func (k VideoRangeViolationKind) String() string {
+	switch k {
+	case VideoRangeBelow:
+		return "below"
+	case VideoRangeAbove:
+		return "above"
+	case VideoRangeReserved:
+		return "reserved"
+	default:
+		return fmt.Sprintf("VideoRangeViolationKind(%d)", k)
+	}
+}
+
+// VideoRangeViolation is a code value outside the legal limits.
+type VideoRangeViolation struct {
+	// X and Y are the position of the sample.
+	X, Y int
+
+	// Channel is the index of the component: 0, 1, and 2 for Y', Cb, and Cr, or R', G', and B'.
+	Channel int
+
+	// Code is the code value.
+	Code int
+
+	// Kind is the kind of the violation.
+	Kind VideoRangeViolationKind
+}
+
+// VideoRangeReport is the result of a validation of video code values.
+type VideoRangeReport struct {
+	// Samples is the number of the validated samples of all the channels.
+	Samples int
+
+	// Total is the number of all the violations, including the ones not in Violations.
+	Total int
+
+	// Violations is the violations in the order of the samples, up to MaxViolations of the validator.
+	Violations []VideoRangeViolation
+}
+
+// OK reports whether no violations are found.
+func (r *VideoRangeReport) OK() bool {
+	return r.Total == 0
+}
+
+// VideoRangeValidator validates video code values against the legal limits of narrow-range video, for quality control.
+type VideoRangeValidator struct {
+	// Signal is the kind of the components.
+	Signal VideoSignal
+
+	// Levels is the legal limits.
+	Levels VideoLevels
+
+	// BitDepth is the bit depth of the code values in [8, 16]. 0 is treated as 8.
+	BitDepth int
+
+	// MaxViolations is the maximum number of the violations recorded in a report. 0 means no limit.
+	MaxViolations int
+}
+
+func (v *VideoRangeValidator) bitDepth() int {
+	if v.BitDepth == 0 {
+		return 8
+	}
+	if v.BitDepth < 8 || v.BitDepth > 16 {
+		panic(fmt.Sprintf("iro: the bit depth must be in [8, 16] but %d", v.BitDepth))
+	}
+	return v.BitDepth
+}
+
+// Limits returns the range of the legal code values of the channel, from 0 to 2.
+// The reserved code values are always outside the range.
+func (v *VideoRangeValidator) Limits(channel int) (min, max int) {
+	if channel < 0 || channel > 2 {
+		panic(fmt.Sprintf("iro: the channel must be 0, 1, or 2 but %d", channel))
+	}
+	var chroma bool
+	switch v.Signal {
+	case VideoSignalYCbCr:
+		chroma = channel > 0
+	case VideoSignalRGB:
+	default:
+		panic(fmt.Sprintf("iro: invalid VideoSignal: %d", v.Signal))
+	}
+
+	s := float64(int(1) << (v.bitDepth() - 8))
+	lo, hi := 16*s, 235*s
+	if chroma {
+		hi = 240 * s
+	}
+	switch v.Levels {
+	case VideoLevelsNominal:
+	case VideoLevelsEBUR103:
+		span := 219 * s
+		switch {
+		case chroma:
+			lo, hi = 0, math.Inf(1)
+		case v.Signal == VideoSignalRGB:
+			lo, hi = math.Round(lo-0.05*span), math.Round(hi+0.05*span)
+		default:
+			lo, hi = math.Round(lo-0.01*span), math.Round(hi+0.03*span)
+		}
+	default:
+		panic(fmt.Sprintf("iro: invalid VideoLevels: %d", v.Levels))
+	}
+	// Exclude the reserved code values.
+	return int(math.Max(lo, s)), int(math.Min(hi, 255*s-1))
+}
+
+// isReserved reports whether the code value is reserved for timing references.
+func (v *VideoRangeValidator) isReserved(code int) bool {
+	s := 1 << (v.bitDepth() - 8)
+	return code < s || code >= 255*s
+}
+
+type videoRangeChecker struct {
+	v      *VideoRangeValidator
+	limits [3][2]int
+	report *VideoRangeReport
+}
+
+func (v *VideoRangeValidator) checker() *videoRangeChecker {
+	c := &videoRangeChecker{
+		v:      v,
+		report: &VideoRangeReport{},
+	}
+	for i := range c.limits {
+		c.limits[i][0], c.limits[i][1] = v.Limits(i)
+	}
+	return c
+}
+
+func (c *videoRangeChecker) check(x, y, channel, code int) {
+	c.report.Samples++
+	var kind VideoRangeViolationKind
+	switch {
+	case c.v.isReserved(code):
+		kind = VideoRangeReserved
+	case code < c.limits[channel][0]:
+		kind = VideoRangeBelow
+	case code > c.limits[channel][1]:
+		kind = VideoRangeAbove
+	default:
+		return
+	}
+	c.report.Total++
+	if c.v.MaxViolations > 0 && len(c.report.Violations) >= c.v.MaxViolations {
+		return
+	}
+	c.report.Violations = append(c.report.Violations, VideoRangeViolation{
+		X:       x,
+		Y:       y,
+		Channel: channel,
+		Code:    code,
+		Kind:    kind,
+	})
+}
+
+// Validate validates the code values of the three channels interleaved in row-major order, without chroma subsampling.
+// The width is the number of the pixels in a row.
+//
+// Validate panics if the length of codes is not a multiple of 3*width.
+func (v *VideoRangeValidator) Validate(codes []uint16, width int) *VideoRangeReport {
+	if width <= 0 || len(codes)%(3*width) != 0 {
+		panic(fmt.Sprintf("iro: the length of codes %d doesn't match the width %d", len(codes), width))
+	}
+	c := v.checker()
+	for i := 0; i < len(codes); i += 3 {
+		p := i / 3
+		for j := 0; j < 3; j++ {
+			c.check(p%width, p/width, j, int(codes[i+j]))
+		}
+	}
+	return c.report
+}
+
+// ValidateYCbCrImage validates the 8-bit code values of img.
+// The positions of the chroma violations are the first pixels that the chroma samples cover.
+//
+// ValidateYCbCrImage panics if the signal is not [VideoSignalYCbCr] or the bit depth is not 8.
+func (v *VideoRangeValidator) ValidateYCbCrImage(img *image.YCbCr) *VideoRangeReport {
+	if v.Signal != VideoSignalYCbCr {
+		panic(fmt.Sprintf("iro: the signal must be VideoSignalYCbCr but %d", v.Signal))
+	}
+	if v.bitDepth() != 8 {
+		panic(fmt.Sprintf("iro: the bit depth must be 8 for an image.YCbCr but %d", v.bitDepth()))
+	}
+	c := v.checker()
+	visited := make([]bool, len(img.Cb))
+	b := img.Rect
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			c.check(x, y, 0, int(img.Y[img.YOffset(x, y)]))
+			i := img.COffset(x, y)
+			if visited[i] {
+				continue
+			}
+			visited[i] = true
+			c.check(x, y, 1, int(img.Cb[i]))
+			c.check(x, y, 2, int(img.Cr[i]))
+		}
+	}
+	return c.report
+}
@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"image"
+	"reflect"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestVideoRangeValidatorLimits(t *testing.T) {
+	testCases := []struct {
+		signal   iro.VideoSignal
+		levels   iro.VideoLevels
+		bitDepth int
+		want     [3][2]int
+	}{
+		{signal: iro.VideoSignalYCbCr, levels: iro.VideoLevelsNominal, want: [3][2]int{{16, 235}, {16, 240}, {16, 240}}},
+		{signal: iro.VideoSignalYCbCr, levels: iro.VideoLevelsNominal, bitDepth: 10, want: [3][2]int{{64, 940}, {64, 960}, {64, 960}}},
+		{signal: iro.VideoSignalRGB, levels: iro.VideoLevelsNominal, bitDepth: 10, want: [3][2]int{{64, 940}, {64, 940}, {64, 940}}},
+		{signal: iro.VideoSignalRGB, levels: iro.VideoLevelsEBUR103, want: [3][2]int{{5, 246}, {5, 246}, {5, 246}}},
+		{signal: iro.VideoSignalRGB, levels: iro.VideoLevelsEBUR103, bitDepth: 10, want: [3][2]int{{20, 984}, {20, 984}, {20, 984}}},
+		{signal: iro.VideoSignalYCbCr, levels: iro.VideoLevelsEBUR103, want: [3][2]int{{14, 242}, {1, 254}, {1, 254}}},
+		{signal: iro.VideoSignalYCbCr, levels: iro.VideoLevelsEBUR103, bitDepth: 10, want: [3][2]int{{55, 966}, {4, 1019}, {4, 1019}}},
+	}
+	for _, tc := range testCases {
+		v := &iro.VideoRangeValidator{Signal: tc.signal, Levels: tc.levels, BitDepth: tc.bitDepth}
+		var got [3][2]int
+		for i := range got {
+			got[i][0], got[i][1] = v.Limits(i)
+		}
+		if got != tc.want {
+			t.Errorf("%d, %d, %d bits: got %v, want %v", tc.signal, tc.levels, tc.bitDepth, got, tc.want)
+		}
+	}
+}
+
+func TestVideoRangeValidatorValidate(t *testing.T) {
+	v := &iro.VideoRangeValidator{Signal: iro.VideoSignalYCbCr, Levels: iro.VideoLevelsNominal, BitDepth: 10}
+	codes := []uint16{
+		64, 512, 512, 940, 960, 64,
+		63, 512, 1020, 941, 0, 512,
+	}
+	r := v.Validate(codes, 2)
+	want := &iro.VideoRangeReport{
+		Samples: 12,
+		Total:   4,
+		Violations: []iro.VideoRangeViolation{
+			{X: 0, Y: 1, Channel: 0, Code: 63, Kind: iro.VideoRangeBelow},
+			{X: 0, Y: 1, Channel: 2, Code: 1020, Kind: iro.VideoRangeReserved},
+			{X: 1, Y: 1, Channel: 0, Code: 941, Kind: iro.VideoRangeAbove},
+			{X: 1, Y: 1, Channel: 1, Code: 0, Kind: iro.VideoRangeReserved},
+		},
+	}
+	if !reflect.DeepEqual(r, want) {
+		t.Errorf("got %+v, want %+v", r, want)
+	}
+	if r.OK() {
+		t.Errorf("OK: got true, want false")
+	}
+
+	v.MaxViolations = 1
+	r = v.Validate(codes, 2)
+	if r.Total != 4 || len(r.Violations) != 1 {
+		t.Errorf("MaxViolations: got %d violations of %d, want 1 of 4", len(r.Violations), r.Total)
+	}
+
+	if r := v.Validate(codes[:6], 2); !r.OK() {
+		t.Errorf("legal codes: got %+v, want no violations", r)
+	}
+}
+
+func TestVideoRangeValidatorYCbCrImage(t *testing.T) {
+	img := image.NewYCbCr(image.Rect(0, 0, 4, 2), image.YCbCrSubsampleRatio420)
+	for i := range img.Y {
+		img.Y[i] = 128
+	}
+	for i := range img.Cb {
+		img.Cb[i] = 128
+		img.Cr[i] = 128
+	}
+	img.Y[img.YOffset(3, 1)] = 250
+	img.Cr[img.COffset(3, 1)] = 255
+
+	v := &iro.VideoRangeValidator{Signal: iro.VideoSignalYCbCr, Levels: iro.VideoLevelsNominal}
+	r := v.ValidateYCbCrImage(img)
+	want := &iro.VideoRangeReport{
+		Samples: 8 + 2*2,
+		Total:   2,
+		Violations: []iro.VideoRangeViolation{
+			{X: 2, Y: 0, Channel: 2, Code: 255, Kind: iro.VideoRangeReserved},
+			{X: 3, Y: 1, Channel: 0, Code: 250, Kind: iro.VideoRangeAbove},
+		},
+	}
+	if !reflect.DeepEqual(r, want) {
+		t.Errorf("got %+v, want %+v", r, want)
+	}
+}
@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package wairo
+
+// table is the traditional Japanese colors with the approximate sRGB values.
+var table = []struct {
+	kanji  string
+	kana   string
+	romaji string
+	rgb    uint32
+}{
+	{"撫子", "なでしこ", "nadeshiko", 0xdc9fb4},
+	{"紅梅", "こうばい", "kobai", 0xe16b8c},
+	{"蘇芳", "すおう", "suo", 0x8e354a},
+	{"桃", "もも", "momo", 0xf596aa},
+	{"桜", "さくら", "sakura", 0xfedfe1},
+	{"韓紅", "からくれない", "karakurenai", 0xd0104c},
+	{"臙脂", "えんじ", "enji", 0x9f353a},
+	{"紅", "くれない", "kurenai", 0xcb1b45},
+	{"茜", "あかね", "akane", 0xb7282e},
+	{"鴇", "とき", "toki", 0xeea9a9},
+	{"小豆", "あずき", "azuki", 0x954a45},
+	{"海老茶", "えびちゃ", "ebicha", 0x734338},
+	{"銀朱", "ぎんしゅ", "ginshu", 0xc73e3a},
+	{"曙", "あけぼの", "akebono", 0xf19483},
+	{"珊瑚朱", "さんごしゅ", "sangoshu", 0xf17c67},
+	{"猩猩緋", "しょうじょうひ", "shojohi", 0xe83015},
+	{"弁柄", "べんがら", "bengara", 0x9a5034},
+	{"琥珀", "こはく", "kohaku", 0xca7a2c},
+	{"金茶", "きんちゃ", "kincha", 0xc7802d},
+	{"山吹", "やまぶき", "yamabuki", 0xffb11b},
+	{"卵", "たまご", "tamago", 0xf9bf45},
+	{"鬱金", "うこん", "ukon", 0xefbb24},
+	{"菜の花", "なのはな", "nanohana", 0xf7d94c},
+	{"鶸", "ひわ", "hiwa", 0xbec23f},
+	{"萌黄", "もえぎ", "moegi", 0x7ba23f},
+	{"若竹", "わかたけ", "wakatake", 0x5dac81},
+	{"常磐", "ときわ", "tokiwa", 0x1b813e},
+	{"千歳緑", "ちとせみどり", "chitosemidori", 0x36563c},
+	{"青磁", "せいじ", "seiji", 0x69b0ac},
+	{"浅葱", "あさぎ", "asagi", 0x33a6b8},
+	{"水", "みず", "mizu", 0x81c7d4},
+	{"藍", "あい", "ai", 0x0d5661},
+	{"縹", "はなだ", "hanada", 0x006284},
+	{"露草", "つゆくさ", "tsuyukusa", 0x2ea9df},
+	{"群青", "ぐんじょう", "gunjo", 0x51a8dd},
+	{"勿忘草", "わすれなぐさ", "wasurenagusa", 0x7db9de},
+	{"瑠璃", "るり", "ruri", 0x005caf},
+	{"紺青", "こんじょう", "konjo", 0x113285},
+	{"紺", "こん", "kon", 0x0f2540},
+	{"藤", "ふじ", "fuji", 0x8b81c3},
+	{"藤紫", "ふじむらさき", "fujimurasaki", 0x8a6bbe},
+	{"桔梗", "ききょう", "kikyo", 0x6a4c9c},
+	{"菫", "すみれ", "sumire", 0x66327c},
+	{"紫", "むらさき", "murasaki", 0x77428d},
+	{"牡丹", "ぼたん", "botan", 0xc1328e},
+	{"躑躅", "つつじ", "tsutsuji", 0xe03c8a},
+	{"利休鼠", "りきゅうねずみ", "rikyunezumi", 0x707c74},
+	{"鈍", "にび", "nibi", 0x656765},
+	{"灰", "はい", "hai", 0x828282},
+	{"墨", "すみ", "sumi", 0x1c1c1c},
+	{"鳥の子", "とりのこ", "torinoko", 0xdac9a6},
+	{"白練", "しろねり", "shironeri", 0xfcfaf2},
+	{"胡粉", "ごふん", "gofun", 0xfffffb},
+}
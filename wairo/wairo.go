@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+// Package wairo provides traditional Japanese colors (和色, wa-iro).
+//
+// The colors are a selection of well-known traditional colors.
+// As the traditional colors are derived from dyes and pigments, their values vary among sources.
+// The values here are the sRGB values commonly published for screen display, and are approximations.
+package wairo
+
+import (
+	"math"
+	"strings"
+
+	"github.com/hajimehoshi/iro"
+)
+
+// Color is a traditional Japanese color.
+type Color struct {
+	// Kanji is the name in kanji like "茜".
+	Kanji string
+
+	// Kana is the reading in hiragana like "あかね".
+	Kana string
+
+	// Romaji is the reading in the Hepburn romanization without macrons like "akane".
+	Romaji string
+
+	// Color is the approximate color.
+	Color iro.Color
+}
+
+// String returns the name in kanji.
+func (c Color) String() string {
+	return c.Kanji
+}
+
+// colors is the colors built from the table.
+var colors = func() []Color {
+	cs := make([]Color, len(table))
+	for i, e := range table {
+		cs[i] = Color{
+			Kanji:  e.kanji,
+			Kana:   e.kana,
+			Romaji: e.romaji,
+			Color: iro.ColorFromSRGB(
+				float64(e.rgb>>16)/0xff,
+				float64((e.rgb>>8)&0xff)/0xff,
+				float64(e.rgb&0xff)/0xff,
+				1),
+		}
+	}
+	return cs
+}()
+
+// Colors returns all the colors.
+func Colors() []Color {
+	return append([]Color(nil), colors...)
+}
+
+// Lookup returns the color of the given name in kanji, hiragana, or romaji.
+// Romaji is case-insensitive.
+// A trailing 色 (iro) in kanji or いろ in hiragana is optional, as in "茜色".
+func Lookup(name string) (Color, bool) {
+	name = strings.TrimSpace(name)
+	for _, c := range colors {
+		if name == c.Kanji || name == c.Kanji+"色" || name == c.Kana || name == c.Kana+"いろ" || strings.EqualFold(name, c.Romaji) {
+			return c, true
+		}
+	}
+	return Color{}, false
+}
+
+// Nearest returns the traditional Japanese color nearest to c in OKLab.
+// The alpha value of c is ignored.
+func Nearest(c iro.Color) Color {
+	l0, a0, b0, _ := c.OKLab()
+	var nearest Color
+	minDist := math.Inf(1)
+	for _, wc := range colors {
+		l1, a1, b1, _ := wc.Color.OKLab()
+		dl, da, db := l1-l0, a1-a0, b1-b0
+		if d := dl*dl + da*da + db*db; d < minDist {
+			minDist = d
+			nearest = wc
+		}
+	}
+	return nearest
+}
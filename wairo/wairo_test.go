@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package wairo_test
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+	"github.com/hajimehoshi/iro/wairo"
+)
+
+func TestColors(t *testing.T) {
+	cs := wairo.Colors()
+	seen := map[string]bool{}
+	for _, c := range cs {
+		for _, n := range []string{c.Kanji, c.Kana, c.Romaji} {
+			if n == "" {
+				t.Errorf("%v: empty name", c)
+			}
+			if seen[n] {
+				t.Errorf("duplicated name: %q", n)
+			}
+			seen[n] = true
+		}
+	}
+}
+
+func TestLookup(t *testing.T) {
+	for _, name := range []string{"瑠璃", "瑠璃色", "るり", "るりいろ", "ruri", "Ruri", " RURI "} {
+		c, ok := wairo.Lookup(name)
+		if !ok {
+			t.Errorf("Lookup(%q): not found", name)
+			continue
+		}
+		if got, want := c.Kanji, "瑠璃"; got != want {
+			t.Errorf("Lookup(%q): got %q, want %q", name, got, want)
+		}
+		got := color.NRGBAModel.Convert(c.Color.SRGBColor()).(color.NRGBA)
+		if want := (color.NRGBA{0x00, 0x5c, 0xaf, 0xff}); got != want {
+			t.Errorf("Lookup(%q): color: got %v, want %v", name, got, want)
+		}
+	}
+	for _, name := range []string{"", "色", "foo"} {
+		if _, ok := wairo.Lookup(name); ok {
+			t.Errorf("Lookup(%q) must not be found", name)
+		}
+	}
+}
+
+func TestNearest(t *testing.T) {
+	for _, c := range wairo.Colors() {
+		if got := wairo.Nearest(c.Color); got.Kanji != c.Kanji {
+			t.Errorf("Nearest(%s): got %s", c, got)
+		}
+	}
+	if got, want := wairo.Nearest(iro.ColorFromSRGB(1, 1, 1, 1)).Kanji, "胡粉"; got != want {
+		t.Errorf("Nearest(white): got %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"fmt"
+	"image"
+)
+
+// WhiteBalanceMethod specifies a method to estimate the illuminant of an image.
+type WhiteBalanceMethod int
+
+const (
+	// WhiteBalanceGrayWorld assumes that the average color of the image is gray.
+	WhiteBalanceGrayWorld WhiteBalanceMethod = iota
+
+	// WhiteBalanceWhitePatch assumes that the maximum of each channel of the image is white,
+	// i.e. the brightest surfaces reflect the illuminant.
+	WhiteBalanceWhitePatch
+)
+
+// EstimateIlluminant returns the estimated chromaticity of the illuminant of img with the method.
+//
+// The pixels are interpreted as sRGB in the same way as [MapImage], and the channels are accumulated in linear sRGB.
+// Fully transparent pixels are ignored. If the image has no colors to estimate the illuminant, like a black image,
+// EstimateIlluminant returns [WhiteD65], the white point of sRGB.
+//
+// EstimateIlluminant panics if method is invalid.
+func EstimateIlluminant(img image.Image, method WhiteBalanceMethod) Chromaticity {
+	if method != WhiteBalanceGrayWorld && method != WhiteBalanceWhitePatch {
+		panic(fmt.Sprintf("iro: invalid WhiteBalanceMethod: %d", method))
+	}
+	b := img.Bounds()
+	var acc [3]float64
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			c := colorAt(img, x, y)
+			if c.alpha == 0 {
+				continue
+			}
+			var v [3]float64
+			v[0], v[1], v[2], _ = c.LinearSRGB()
+			for i := range v {
+				switch method {
+				case WhiteBalanceGrayWorld:
+					acc[i] += v[i]
+				case WhiteBalanceWhitePatch:
+					acc[i] = max(acc[i], v[i])
+				}
+			}
+		}
+	}
+	if acc[0] <= 0 && acc[1] <= 0 && acc[2] <= 0 {
+		return WhiteD65
+	}
+	x, y, z := MatrixLinearSRGBToXYZ.Apply(acc[0], acc[1], acc[2])
+	sum := x + y + z
+	if sum <= 0 {
+		return WhiteD65
+	}
+	return Chromaticity{X: x / sum, Y: y / sum}
+}
+
+// CorrectWhiteBalance returns a new image by adapting the colors of img under the illuminant to D65,
+// the white point of sRGB, with the Bradford transform. See [ChromaticAdaptationMatrix].
+// Use [EstimateIlluminant] to estimate the illuminant.
+//
+// See [MapImage] for the interpretation of the pixels.
+func CorrectWhiteBalance(img image.Image, illuminant Chromaticity) *image.NRGBA64 {
+	m := ChromaticAdaptationMatrix(illuminant, WhiteD65)
+	return MapImage(img, func(c Color) Color {
+		x, y, z := m.Apply(c.x, c.y, c.z)
+		return ColorFromXYZ(x, y, z, c.alpha)
+	})
+}
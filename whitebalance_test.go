@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"image"
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+// castImage returns an image whose pixels are the colors in linear sRGB under the illuminant instead of D65.
+func castImage(colors [][3]float64, illuminant iro.Chromaticity) *image.NRGBA64 {
+	m := iro.ChromaticAdaptationMatrix(iro.WhiteD65, illuminant)
+	img := image.NewNRGBA64(image.Rect(0, 0, len(colors), 1))
+	for i, c := range colors {
+		x, y, z := m.Apply(iro.MatrixLinearSRGBToXYZ.Apply(c[0], c[1], c[2]))
+		img.SetNRGBA64(i, 0, iro.ColorFromXYZ(x, y, z, 1).SRGBNRGBA64())
+	}
+	return img
+}
+
+func TestEstimateIlluminant(t *testing.T) {
+	warm := iro.Chromaticity{X: 0.35, Y: 0.36}
+	testCases := []struct {
+		name   string
+		method iro.WhiteBalanceMethod
+		colors [][3]float64
+	}{
+		{name: "gray world", method: iro.WhiteBalanceGrayWorld, colors: [][3]float64{{0.1, 0.1, 0.1}, {0.2, 0.3, 0.4}, {0.4, 0.3, 0.2}, {0.5, 0.5, 0.5}}},
+		{name: "white patch", method: iro.WhiteBalanceWhitePatch, colors: [][3]float64{{0.1, 0.2, 0.05}, {0.8, 0.8, 0.8}, {0.3, 0.1, 0.4}}},
+	}
+	for _, tc := range testCases {
+		img := castImage(tc.colors, warm)
+		got := iro.EstimateIlluminant(img, tc.method)
+		// The image is quantized to 16 bits.
+		if math.Abs(got.X-warm.X) > 1e-4 || math.Abs(got.Y-warm.Y) > 1e-4 {
+			t.Errorf("%s: got %v, want %v", tc.name, got, warm)
+		}
+
+		corrected := iro.CorrectWhiteBalance(img, got)
+		for i, c := range tc.colors {
+			r, g, b, _ := iro.ColorFromSRGBColor(corrected.At(i, 0)).LinearSRGB()
+			if math.Abs(r-c[0]) > 1e-3 || math.Abs(g-c[1]) > 1e-3 || math.Abs(b-c[2]) > 1e-3 {
+				t.Errorf("%s: pixel %d: got (%f, %f, %f), want %v", tc.name, i, r, g, b, c)
+			}
+		}
+	}
+}
+
+func TestEstimateIlluminantTransparent(t *testing.T) {
+	img := image.NewNRGBA64(image.Rect(0, 0, 2, 2))
+	if got, want := iro.EstimateIlluminant(img, iro.WhiteBalanceGrayWorld), iro.WhiteD65; got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,163 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"math"
+)
+
+// The matrices and the transfer functions are referenced from:
+// https://www.w3.org/TR/css-color-4/#color-conversion-code
+
+// ColorFromRec2020 builds a Color from nonlinear Rec. 2020 channels in [0,1] and alpha.
+func ColorFromRec2020(r, g, b, alpha float64) Color {
+	r = rec2020Degamma(r)
+	g = rec2020Degamma(g)
+	b = rec2020Degamma(b)
+
+	return ColorFromLinearRec2020(r, g, b, alpha)
+}
+
+// ColorFromLinearRec2020 builds a Color from linear Rec. 2020 channels in [0,1] and alpha.
+func ColorFromLinearRec2020(r, g, b, alpha float64) Color {
+	x, y, z := MatrixLinearRec2020ToXYZ.Apply(r, g, b)
+	return Color{
+		x:     x,
+		y:     y,
+		z:     z,
+		alpha: alpha,
+	}
+}
+
+// Rec2020 converts Color to nonlinear Rec. 2020 channels and alpha.
+func (c Color) Rec2020() (r, g, b, a float64) {
+	r, g, b, a = c.LinearRec2020()
+	r = rec2020Gamma(r)
+	g = rec2020Gamma(g)
+	b = rec2020Gamma(b)
+	return
+}
+
+// LinearRec2020 converts Color to linear Rec. 2020 channels and alpha.
+func (c Color) LinearRec2020() (r, g, b, a float64) {
+	r, g, b = MatrixXYZToLinearRec2020.Apply(c.x, c.y, c.z)
+	a = c.alpha
+	return
+}
+
+const (
+	rec2020Alpha = 1.09929682680944
+	rec2020Beta  = 0.018053968510807
+)
+
+func rec2020Degamma(x float64) float64 {
+	sign := math.Copysign(1, x)
+	abs := math.Abs(x)
+	if abs < rec2020Beta*4.5 {
+		return x / 4.5
+	}
+	return sign * math.Pow((abs+rec2020Alpha-1)/rec2020Alpha, 1/0.45)
+}
+
+func rec2020Gamma(x float64) float64 {
+	sign := math.Copysign(1, x)
+	abs := math.Abs(x)
+	if abs < rec2020Beta {
+		return 4.5 * x
+	}
+	return sign * (rec2020Alpha*math.Pow(abs, 0.45) - (rec2020Alpha - 1))
+}
+
+// ColorFromA98RGB builds a Color from nonlinear Adobe RGB (1998) channels in [0,1] and alpha.
+func ColorFromA98RGB(r, g, b, alpha float64) Color {
+	r = a98Degamma(r)
+	g = a98Degamma(g)
+	b = a98Degamma(b)
+
+	return ColorFromLinearA98RGB(r, g, b, alpha)
+}
+
+// ColorFromLinearA98RGB builds a Color from linear Adobe RGB (1998) channels in [0,1] and alpha.
+func ColorFromLinearA98RGB(r, g, b, alpha float64) Color {
+	x, y, z := MatrixLinearA98RGBToXYZ.Apply(r, g, b)
+	return Color{
+		x:     x,
+		y:     y,
+		z:     z,
+		alpha: alpha,
+	}
+}
+
+// A98RGB converts Color to nonlinear Adobe RGB (1998) channels and alpha.
+func (c Color) A98RGB() (r, g, b, a float64) {
+	r, g, b, a = c.LinearA98RGB()
+	r = a98Gamma(r)
+	g = a98Gamma(g)
+	b = a98Gamma(b)
+	return
+}
+
+// LinearA98RGB converts Color to linear Adobe RGB (1998) channels and alpha.
+func (c Color) LinearA98RGB() (r, g, b, a float64) {
+	r, g, b = MatrixXYZToLinearA98RGB.Apply(c.x, c.y, c.z)
+	a = c.alpha
+	return
+}
+
+func a98Degamma(x float64) float64 {
+	return math.Copysign(math.Pow(math.Abs(x), 563.0/256), x)
+}
+
+func a98Gamma(x float64) float64 {
+	return math.Copysign(math.Pow(math.Abs(x), 256.0/563), x)
+}
+
+// ColorFromProPhotoRGB builds a Color from nonlinear ProPhoto RGB channels in [0,1] and alpha.
+func ColorFromProPhotoRGB(r, g, b, alpha float64) Color {
+	r = prophotoDegamma(r)
+	g = prophotoDegamma(g)
+	b = prophotoDegamma(b)
+
+	return ColorFromLinearProPhotoRGB(r, g, b, alpha)
+}
+
+// ColorFromLinearProPhotoRGB builds a Color from linear ProPhoto RGB channels in [0,1] and alpha.
+// The white point of ProPhoto RGB is D50, and the color is adapted to D65 with the Bradford transform.
+func ColorFromLinearProPhotoRGB(r, g, b, alpha float64) Color {
+	x, y, z := MatrixLinearProPhotoRGBToXYZD50.Apply(r, g, b)
+	return ColorFromXYZD50(x, y, z, alpha)
+}
+
+// ProPhotoRGB converts Color to nonlinear ProPhoto RGB channels and alpha.
+func (c Color) ProPhotoRGB() (r, g, b, a float64) {
+	r, g, b, a = c.LinearProPhotoRGB()
+	r = prophotoGamma(r)
+	g = prophotoGamma(g)
+	b = prophotoGamma(b)
+	return
+}
+
+// LinearProPhotoRGB converts Color to linear ProPhoto RGB channels and alpha.
+// The white point of ProPhoto RGB is D50, and the color is adapted from D65 with the Bradford transform.
+func (c Color) LinearProPhotoRGB() (r, g, b, a float64) {
+	x, y, z, a := c.XYZD50()
+	r, g, b = MatrixXYZD50ToLinearProPhotoRGB.Apply(x, y, z)
+	return
+}
+
+func prophotoDegamma(x float64) float64 {
+	abs := math.Abs(x)
+	if abs <= 16.0/512 {
+		return x / 16
+	}
+	return math.Copysign(math.Pow(abs, 1.8), x)
+}
+
+func prophotoGamma(x float64) float64 {
+	abs := math.Abs(x)
+	if abs >= 1.0/512 {
+		return math.Copysign(math.Pow(abs, 1/1.8), x)
+	}
+	return 16 * x
+}
@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestWideGamutWhite(t *testing.T) {
+	white := iro.ColorFromSRGB(1, 1, 1, 1)
+	wx, wy, wz, _ := white.XYZ()
+	for _, s := range []iro.Space{
+		iro.SpaceRec2020,
+		iro.SpaceLinearRec2020,
+		iro.SpaceA98RGB,
+		iro.SpaceLinearA98RGB,
+		iro.SpaceProPhotoRGB,
+		iro.SpaceLinearProPhotoRGB,
+	} {
+		t.Run(s.String(), func(t *testing.T) {
+			// The white of each space is D65 white after the adaptation.
+			x, y, z, _ := iro.ColorFromComponents(s, 1, 1, 1, 1).XYZ()
+			if math.Abs(x-wx) > 1e-4 || math.Abs(y-wy) > 1e-4 || math.Abs(z-wz) > 1e-4 {
+				t.Errorf("got (%f, %f, %f), want (%f, %f, %f)", x, y, z, wx, wy, wz)
+			}
+		})
+	}
+}
+
+func TestWideGamut(t *testing.T) {
+	// The values of sRGB red in each space.
+	red := iro.ColorFromSRGB(1, 0, 0, 1)
+	testCases := []struct {
+		space iro.Space
+		want  [3]float64
+	}{
+		{space: iro.SpaceLinearRec2020, want: [3]float64{0.627404, 0.069097, 0.016391}},
+		{space: iro.SpaceRec2020, want: [3]float64{0.791977, 0.230976, 0.073761}},
+		{space: iro.SpaceA98RGB, want: [3]float64{0.858592, 0, 0}},
+		{space: iro.SpaceProPhotoRGB, want: [3]float64{0.702238, 0.275749, 0.103574}},
+	}
+	for _, tc := range testCases {
+		c0, c1, c2, _ := red.Components(tc.space)
+		if math.Abs(c0-tc.want[0]) > 1e-4 || math.Abs(c1-tc.want[1]) > 1e-4 || math.Abs(c2-tc.want[2]) > 1e-4 {
+			t.Errorf("%s: got (%f, %f, %f), want %v", tc.space, c0, c1, c2, tc.want)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+// ColorFromXyY builds a Color from CIE xyY coordinates and alpha.
+// x and y are the chromaticity coordinates and yy is the luminance Y.
+//
+// If y is 0, ColorFromXyY returns black with the alpha.
+func ColorFromXyY(x, y, yy, alpha float64) Color {
+	x, y, z := Chromaticity{X: x, Y: y}.XYZ(yy)
+	return Color{
+		x:     x,
+		y:     y,
+		z:     z,
+		alpha: alpha,
+	}
+}
+
+// XyY converts Color to CIE xyY coordinates and alpha.
+// x and y are the chromaticity coordinates and yy is the luminance Y.
+//
+// For black, whose chromaticity is undefined, XyY returns the chromaticity of [WhiteD65].
+func (c Color) XyY() (x, y, yy, alpha float64) {
+	sum := c.x + c.y + c.z
+	if sum == 0 {
+		return WhiteD65.X, WhiteD65.Y, 0, c.alpha
+	}
+	return c.x / sum, c.y / sum, c.y, c.alpha
+}
+
+// ColorFromUVPrime builds a Color from CIE 1976 u'v'Y coordinates and alpha.
+// u and v are the u'v' chromaticity coordinates of the uniform chromaticity scale and yy is the luminance Y.
+//
+// If v is 0, ColorFromUVPrime returns black with the alpha.
+func ColorFromUVPrime(u, v, yy, alpha float64) Color {
+	if v == 0 {
+		return Color{alpha: alpha}
+	}
+	x := yy * 9 * u / (4 * v)
+	z := yy * (12 - 3*u - 20*v) / (4 * v)
+	return Color{
+		x:     x,
+		y:     yy,
+		z:     z,
+		alpha: alpha,
+	}
+}
+
+// UVPrime converts Color to CIE 1976 u'v'Y coordinates and alpha.
+// u and v are the u'v' chromaticity coordinates of the uniform chromaticity scale and yy is the luminance Y.
+//
+// For black, whose chromaticity is undefined, UVPrime returns the chromaticity of [WhiteD65].
+func (c Color) UVPrime() (u, v, yy, alpha float64) {
+	d := c.x + 15*c.y + 3*c.z
+	if d == 0 {
+		u, v = WhiteD65.UV()
+		return u, v, 0, c.alpha
+	}
+	return 4 * c.x / d, 9 * c.y / d, c.y, c.alpha
+}
@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestXyYRoundTrip(t *testing.T) {
+	c := iro.ColorFromSRGB(0.2, 0.4, 0.6, 0.8)
+	x0, y0, z0, a0 := c.XYZ()
+	x, y, yy, alpha := c.XyY()
+	x1, y1, z1, a1 := iro.ColorFromXyY(x, y, yy, alpha).XYZ()
+	if !checkTol(x1, x0) || !checkTol(y1, y0) || !checkTol(z1, z0) || !checkTol(a1, a0) {
+		t.Errorf("got (%f, %f, %f, %f), want (%f, %f, %f, %f)", x1, y1, z1, a1, x0, y0, z0, a0)
+	}
+}
+
+func TestXyYWhite(t *testing.T) {
+	// The white point of sRGB is D65.
+	x, y, yy, _ := iro.ColorFromSRGB(1, 1, 1, 1).XyY()
+	if !checkTol(x, 0.3127) || !checkTol(y, 0.3290) || !checkTol(yy, 1) {
+		t.Errorf("got (%f, %f, %f), want (0.3127, 0.3290, 1)", x, y, yy)
+	}
+
+	// Black has the same chromaticity as white.
+	x, y, yy, _ = iro.ColorFromSRGB(0, 0, 0, 1).XyY()
+	if !checkTol(x, 0.3127) || !checkTol(y, 0.3290) || !checkTol(yy, 0) {
+		t.Errorf("got (%f, %f, %f), want (0.3127, 0.3290, 0)", x, y, yy)
+	}
+}
+
+func TestUVPrimeRoundTrip(t *testing.T) {
+	c := iro.ColorFromSRGB(0.2, 0.4, 0.6, 0.8)
+	x0, y0, z0, a0 := c.XYZ()
+	u, v, yy, alpha := c.UVPrime()
+	x1, y1, z1, a1 := iro.ColorFromUVPrime(u, v, yy, alpha).XYZ()
+	if !checkTol(x1, x0) || !checkTol(y1, y0) || !checkTol(z1, z0) || !checkTol(a1, a0) {
+		t.Errorf("got (%f, %f, %f, %f), want (%f, %f, %f, %f)", x1, y1, z1, a1, x0, y0, z0, a0)
+	}
+}
+
+func TestUVPrime(t *testing.T) {
+	testCases := []struct {
+		name  string
+		color iro.Color
+		want  [3]float64
+	}{
+		{
+			// The u'v' of D65 is (0.1978, 0.4683).
+			name:  "White",
+			color: iro.ColorFromSRGB(1, 1, 1, 1),
+			want:  [3]float64{0.197830, 0.468320, 1},
+		},
+		{
+			// Black has the same chromaticity as white.
+			name:  "Black",
+			color: iro.ColorFromSRGB(0, 0, 0, 1),
+			want:  [3]float64{0.197830, 0.468320, 0},
+		},
+		{
+			// The red primary of sRGB at (0.64, 0.33) in xy.
+			name:  "Red",
+			color: iro.ColorFromSRGB(1, 0, 0, 1),
+			want:  [3]float64{0.450704, 0.522887, 0.212639},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			u, v, yy, _ := tc.color.UVPrime()
+			const tol = 1e-5
+			if d := u - tc.want[0]; d > tol || d < -tol {
+				t.Errorf("u: got %f, want %f", u, tc.want[0])
+			}
+			if d := v - tc.want[1]; d > tol || d < -tol {
+				t.Errorf("v: got %f, want %f", v, tc.want[1])
+			}
+			if d := yy - tc.want[2]; d > tol || d < -tol {
+				t.Errorf("yy: got %f, want %f", yy, tc.want[2])
+			}
+
+			// The u'v' chromaticity is consistent with the xy chromaticity.
+			if tc.name != "Black" {
+				wu, wv := tc.color.Chromaticity().UV()
+				if !checkTol(u, wu) || !checkTol(v, wv) {
+					t.Errorf("got (%f, %f), want (%f, %f)", u, v, wu, wv)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"fmt"
+	"math"
+)
+
+// YCbCrMatrix specifies the luma coefficients to convert between nonlinear sRGB and Y'CbCr.
+//
+// The zero value is invalid. Conversions with an invalid YCbCrMatrix panic.
+type YCbCrMatrix int
+
+const (
+	// YCbCrMatrixBT601 represents the ITU-R BT.601 coefficients, used by JPEG and SD video.
+	YCbCrMatrixBT601 YCbCrMatrix = iota + 1
+
+	// YCbCrMatrixBT709 represents the ITU-R BT.709 coefficients, used by HD video.
+	YCbCrMatrixBT709
+)
+
+func (m YCbCrMatrix) coefficients() (kr, kb float64) {
+	switch m {
+	case YCbCrMatrixBT601:
+		return 0.299, 0.114
+	case YCbCrMatrixBT709:
+		return 0.2126, 0.0722
+	default:
+		panic(fmt.Sprintf("iro: invalid YCbCrMatrix: %d", m))
+	}
+}
+
+// YCbCrRange specifies the quantization range of Y'CbCr values.
+//
+// Y'CbCr values are expressed as 8-bit code values divided by 255.
+//
+// The zero value is invalid. Conversions with an invalid YCbCrRange panic.
+// This is intentional: misinterpreting the range is one of the most common video color bugs,
+// and there is no range that is correct by default.
+type YCbCrRange int
+
+const (
+	// YCbCrRangeFull represents the full range, used by JPEG.
+	// Y' is in [0, 1], and Cb and Cr are in [0, 1] centered at 128/255.
+	YCbCrRangeFull YCbCrRange = iota + 1
+
+	// YCbCrRangeLimited represents the limited (studio) range, used by most video.
+	// Y' is in [16/255, 235/255], and Cb and Cr are in [16/255, 240/255] centered at 128/255.
+	YCbCrRangeLimited
+)
+
+// scales returns the scales and the offsets of Y' and Cb/Cr in code values.
+func (r YCbCrRange) scales() (yScale, yOffset, cScale, cOffset float64) {
+	switch r {
+	case YCbCrRangeFull:
+		return 255, 0, 255, 128
+	case YCbCrRangeLimited:
+		return 219, 16, 224, 128
+	default:
+		panic(fmt.Sprintf("iro: invalid YCbCrRange: %d", r))
+	}
+}
+
+// ColorFromYCbCr builds a Color from Y'CbCr values and alpha.
+// The Y'CbCr values are derived from nonlinear sRGB with the given matrix and range.
+func ColorFromYCbCr(y, cb, cr, alpha float64, matrix YCbCrMatrix, rng YCbCrRange) Color {
+	r, g, b := ycbcrToRGB(y, cb, cr, matrix, rng)
+	return ColorFromSRGB(r, g, b, alpha)
+}
+
+// YCbCr converts Color to Y'CbCr values and alpha.
+// The Y'CbCr values are derived from nonlinear sRGB with the given matrix and range.
+func (c Color) YCbCr(matrix YCbCrMatrix, rng YCbCrRange) (y, cb, cr, alpha float64) {
+	r, g, b, alpha := c.SRGB()
+	y, cb, cr = rgbToYCbCr(r, g, b, matrix, rng)
+	return
+}
+
+// ColorFromXvYCC builds a Color from xvYCC values and alpha.
+//
+// xvYCC (IEC 61966-2-4) is an extended-gamut Y'CbCr encoding with the BT.709 primaries and the limited range.
+// Unlike [ColorFromYCbCr], the nonlinear RGB values are derived with the BT.709 transfer function extended to negative values,
+// so code values outside the nominal range represent colors outside the sRGB gamut.
+func ColorFromXvYCC(y, cb, cr, alpha float64, matrix YCbCrMatrix) Color {
+	r, g, b := ycbcrToRGB(y, cb, cr, matrix, YCbCrRangeLimited)
+	return ColorFromLinearSRGB(bt709Degamma(r), bt709Degamma(g), bt709Degamma(b), alpha)
+}
+
+// XvYCC converts Color to xvYCC values and alpha.
+// The values are not clamped. See [ColorFromXvYCC] for details.
+func (c Color) XvYCC(matrix YCbCrMatrix) (y, cb, cr, alpha float64) {
+	r, g, b, alpha := c.LinearSRGB()
+	y, cb, cr = rgbToYCbCr(bt709Gamma(r), bt709Gamma(g), bt709Gamma(b), matrix, YCbCrRangeLimited)
+	return
+}
+
+func ycbcrToRGB(y, cb, cr float64, matrix YCbCrMatrix, rng YCbCrRange) (r, g, b float64) {
+	kr, kb := matrix.coefficients()
+	yScale, yOffset, cScale, cOffset := rng.scales()
+
+	y = (y*255 - yOffset) / yScale
+	cb = (cb*255 - cOffset) / cScale
+	cr = (cr*255 - cOffset) / cScale
+
+	r = y + 2*(1-kr)*cr
+	b = y + 2*(1-kb)*cb
+	g = (y - kr*r - kb*b) / (1 - kr - kb)
+	return
+}
+
+func rgbToYCbCr(r, g, b float64, matrix YCbCrMatrix, rng YCbCrRange) (y, cb, cr float64) {
+	kr, kb := matrix.coefficients()
+	yScale, yOffset, cScale, cOffset := rng.scales()
+
+	y = kr*r + (1-kr-kb)*g + kb*b
+	cb = (b - y) / (2 * (1 - kb))
+	cr = (r - y) / (2 * (1 - kr))
+
+	y = (y*yScale + yOffset) / 255
+	cb = (cb*cScale + cOffset) / 255
+	cr = (cr*cScale + cOffset) / 255
+	return
+}
+
+func bt709Degamma(x float64) float64 {
+	// https://www.itu.int/rec/R-REC-BT.709
+	// The curve is extended to negative values symmetrically as IEC 61966-2-4 does.
+	sign := math.Copysign(1, x)
+	abs := math.Abs(x)
+	if abs < 4.5*0.018 {
+		return x / 4.5
+	}
+	return sign * math.Pow((abs+0.099)/1.099, 1/0.45)
+}
+
+func bt709Gamma(x float64) float64 {
+	// https://www.itu.int/rec/R-REC-BT.709
+	// The curve is extended to negative values symmetrically as IEC 61966-2-4 does.
+	sign := math.Copysign(1, x)
+	abs := math.Abs(x)
+	if abs < 0.018 {
+		return 4.5 * x
+	}
+	return sign * (1.099*math.Pow(abs, 0.45) - 0.099)
+}
@@ -0,0 +1,165 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+func TestYCbCrRoundTrip(t *testing.T) {
+	for _, m := range []iro.YCbCrMatrix{iro.YCbCrMatrixBT601, iro.YCbCrMatrixBT709} {
+		for _, rng := range []iro.YCbCrRange{iro.YCbCrRangeFull, iro.YCbCrRangeLimited} {
+			t.Run(fmt.Sprintf("matrix=%d,range=%d", m, rng), func(t *testing.T) {
+				r0, g0, b0, a0 := 0.2, 0.4, 0.6, 0.8
+				c := iro.ColorFromSRGB(r0, g0, b0, a0)
+				y, cb, cr, alpha := c.YCbCr(m, rng)
+				r1, g1, b1, a1 := iro.ColorFromYCbCr(y, cb, cr, alpha, m, rng).SRGB()
+
+				if diff, ok := check(r1, r0); !ok {
+					t.Errorf("r: got %f, want %f (diff=%g)", r1, r0, diff)
+				}
+				if diff, ok := check(g1, g0); !ok {
+					t.Errorf("g: got %f, want %f (diff=%g)", g1, g0, diff)
+				}
+				if diff, ok := check(b1, b0); !ok {
+					t.Errorf("b: got %f, want %f (diff=%g)", b1, b0, diff)
+				}
+				if diff, ok := check(a1, a0); !ok {
+					t.Errorf("a: got %f, want %f (diff=%g)", a1, a0, diff)
+				}
+			})
+		}
+	}
+}
+
+func TestYCbCrRange(t *testing.T) {
+	testCases := []struct {
+		name   string
+		rng    iro.YCbCrRange
+		color  iro.Color
+		wantY  float64
+		wantCb float64
+		wantCr float64
+	}{
+		{
+			name:   "Full/White",
+			rng:    iro.YCbCrRangeFull,
+			color:  iro.ColorFromSRGB(1, 1, 1, 1),
+			wantY:  1,
+			wantCb: 128.0 / 255,
+			wantCr: 128.0 / 255,
+		},
+		{
+			name:   "Full/Black",
+			rng:    iro.YCbCrRangeFull,
+			color:  iro.ColorFromSRGB(0, 0, 0, 1),
+			wantY:  0,
+			wantCb: 128.0 / 255,
+			wantCr: 128.0 / 255,
+		},
+		{
+			name:   "Limited/White",
+			rng:    iro.YCbCrRangeLimited,
+			color:  iro.ColorFromSRGB(1, 1, 1, 1),
+			wantY:  235.0 / 255,
+			wantCb: 128.0 / 255,
+			wantCr: 128.0 / 255,
+		},
+		{
+			name:   "Limited/Black",
+			rng:    iro.YCbCrRangeLimited,
+			color:  iro.ColorFromSRGB(0, 0, 0, 1),
+			wantY:  16.0 / 255,
+			wantCb: 128.0 / 255,
+			wantCr: 128.0 / 255,
+		},
+		{
+			name:   "Limited/Blue",
+			rng:    iro.YCbCrRangeLimited,
+			color:  iro.ColorFromSRGB(0, 0, 1, 1),
+			wantY:  (16 + 219*0.114) / 255,
+			wantCb: 240.0 / 255,
+			wantCr: (128 - 224*0.114/(2*(1-0.299))) / 255,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			y, cb, cr, _ := tc.color.YCbCr(iro.YCbCrMatrixBT601, tc.rng)
+			if diff, ok := check(y, tc.wantY); !ok {
+				t.Errorf("y: got %f, want %f (diff=%g)", y, tc.wantY, diff)
+			}
+			if diff, ok := check(cb, tc.wantCb); !ok {
+				t.Errorf("cb: got %f, want %f (diff=%g)", cb, tc.wantCb, diff)
+			}
+			if diff, ok := check(cr, tc.wantCr); !ok {
+				t.Errorf("cr: got %f, want %f (diff=%g)", cr, tc.wantCr, diff)
+			}
+		})
+	}
+}
+
+func TestYCbCrInvalidRange(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("YCbCr with the zero YCbCrRange must panic")
+		}
+	}()
+	iro.ColorFromSRGB(1, 1, 1, 1).YCbCr(iro.YCbCrMatrixBT601, 0)
+}
+
+func TestXvYCCRoundTrip(t *testing.T) {
+	testCases := []struct {
+		name  string
+		color iro.Color
+	}{
+		{
+			name:  "InGamut",
+			color: iro.ColorFromSRGB(0.2, 0.4, 0.6, 0.8),
+		},
+		{
+			// A saturated Display P3 green is outside the sRGB gamut.
+			name:  "OutOfGamut",
+			color: iro.ColorFromDisplayP3(0, 1, 0, 1),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			x0, y0, z0, a0 := tc.color.XYZ()
+			y, cb, cr, alpha := tc.color.XvYCC(iro.YCbCrMatrixBT709)
+			x1, y1, z1, a1 := iro.ColorFromXvYCC(y, cb, cr, alpha, iro.YCbCrMatrixBT709).XYZ()
+
+			if diff, ok := check(x1, x0); !ok {
+				t.Errorf("x: got %f, want %f (diff=%g)", x1, x0, diff)
+			}
+			if diff, ok := check(y1, y0); !ok {
+				t.Errorf("y: got %f, want %f (diff=%g)", y1, y0, diff)
+			}
+			if diff, ok := check(z1, z0); !ok {
+				t.Errorf("z: got %f, want %f (diff=%g)", z1, z0, diff)
+			}
+			if diff, ok := check(a1, a0); !ok {
+				t.Errorf("a: got %f, want %f (diff=%g)", a1, a0, diff)
+			}
+		})
+	}
+}
+
+func TestXvYCCExtendedGamut(t *testing.T) {
+	// Moderately out-of-sRGB-gamut colors are still representable in the xvYCC code value range [1/255, 254/255].
+	c := iro.ColorFromDisplayP3(0.2, 0.8, 0.2, 1)
+	y, cb, cr, _ := c.XvYCC(iro.YCbCrMatrixBT709)
+	if r, g, b, _ := c.SRGB(); r >= 0 && g >= 0 && b >= 0 && r <= 1 && g <= 1 && b <= 1 {
+		t.Fatalf("the color must be outside the sRGB gamut: (%f, %f, %f)", r, g, b)
+	}
+	for _, v := range []float64{y, cb, cr} {
+		if v < 1.0/255 || v > 254.0/255 {
+			t.Errorf("%f is outside the xvYCC code value range", v*255)
+		}
+	}
+}
@@ -0,0 +1,273 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro
+
+import (
+	"fmt"
+	"image"
+	"math"
+	"sync"
+)
+
+// subsampleFactors returns the horizontal and vertical chroma subsampling factors.
+func subsampleFactors(ratio image.YCbCrSubsampleRatio) (sx, sy int) {
+	switch ratio {
+	case image.YCbCrSubsampleRatio444:
+		return 1, 1
+	case image.YCbCrSubsampleRatio422:
+		return 2, 1
+	case image.YCbCrSubsampleRatio420:
+		return 2, 2
+	case image.YCbCrSubsampleRatio440:
+		return 1, 2
+	case image.YCbCrSubsampleRatio411:
+		return 4, 1
+	case image.YCbCrSubsampleRatio410:
+		return 4, 2
+	default:
+		panic(fmt.Sprintf("iro: invalid image.YCbCrSubsampleRatio: %d", ratio))
+	}
+}
+
+// chromaSample represents the two chroma samples and the weight of the latter to interpolate one coordinate.
+type chromaSample struct {
+	i0 int
+	i1 int
+	t  float64
+}
+
+// chromaSamples returns the chroma samples for each luma coordinate in [min, max).
+// Chroma samples are assumed to be centered between the luma samples they cover, as JPEG does.
+func chromaSamples(min, max, factor int) []chromaSample {
+	first := min / factor
+	last := (max - 1) / factor
+	samples := make([]chromaSample, max-min)
+	for i := range samples {
+		pos := (float64(min+i)+0.5)/float64(factor) - 0.5
+		j := math.Floor(pos)
+		j0 := int(j)
+		j1 := j0 + 1
+		samples[i] = chromaSample{
+			i0: clampInt(j0, first, last) - first,
+			i1: clampInt(j1, first, last) - first,
+			t:  pos - j,
+		}
+	}
+	return samples
+}
+
+func clampInt(v, min, max int) int {
+	if v < min {
+		return min
+	}
+	if v > max {
+		return max
+	}
+	return v
+}
+
+// ColorsFromYCbCrImage converts img to Colors in row-major order.
+// The Y'CbCr values of img are interpreted with the given matrix and range.
+//
+// Chroma samples are upsampled with bilinear interpolation for any subsampling ratio.
+func ColorsFromYCbCrImage(img *image.YCbCr, matrix YCbCrMatrix, rng YCbCrRange) []Color {
+	b := img.Rect
+	if b.Empty() {
+		return nil
+	}
+	sx, sy := subsampleFactors(img.SubsampleRatio)
+	xs := chromaSamples(b.Min.X, b.Max.X, sx)
+	ys := chromaSamples(b.Min.Y, b.Max.Y, sy)
+
+	colors := make([]Color, 0, b.Dx()*b.Dy())
+	for j := 0; j < b.Dy(); j++ {
+		cy := ys[j]
+		r0 := cy.i0 * img.CStride
+		r1 := cy.i1 * img.CStride
+		for i := 0; i < b.Dx(); i++ {
+			cx := xs[i]
+			y := float64(img.Y[j*img.YStride+i]) / 0xff
+			cb := bilinear(img.Cb, r0, r1, cx, cy.t) / 0xff
+			cr := bilinear(img.Cr, r0, r1, cx, cy.t) / 0xff
+			colors = append(colors, ColorFromYCbCr(y, cb, cr, 1, matrix, rng))
+		}
+	}
+	return colors
+}
+
+func bilinear(plane []uint8, r0, r1 int, cx chromaSample, ty float64) float64 {
+	v00 := float64(plane[r0+cx.i0])
+	v01 := float64(plane[r0+cx.i1])
+	v10 := float64(plane[r1+cx.i0])
+	v11 := float64(plane[r1+cx.i1])
+	v0 := v00 + (v01-v00)*cx.t
+	v1 := v10 + (v11-v10)*cx.t
+	return v0 + (v1-v0)*ty
+}
+
+// YCbCrImageFromColors builds an [image.YCbCr] from Colors in row-major order.
+// The Y'CbCr values of the result are encoded with the given matrix and range.
+//
+// Chroma samples are downsampled by averaging the luma samples they cover.
+//
+// YCbCrImageFromColors panics if the length of colors doesn't match the size of rect.
+func YCbCrImageFromColors(colors []Color, rect image.Rectangle, ratio image.YCbCrSubsampleRatio, matrix YCbCrMatrix, rng YCbCrRange) *image.YCbCr {
+	if len(colors) != rect.Dx()*rect.Dy() {
+		panic(fmt.Sprintf("iro: len(colors) (%d) must match the rectangle size (%d)", len(colors), rect.Dx()*rect.Dy()))
+	}
+	img := image.NewYCbCr(rect, ratio)
+	if rect.Empty() {
+		return img
+	}
+	sx, sy := subsampleFactors(ratio)
+	cw := (rect.Max.X-1)/sx - rect.Min.X/sx + 1
+	ch := (rect.Max.Y-1)/sy - rect.Min.Y/sy + 1
+	cbs := make([]float64, cw*ch)
+	crs := make([]float64, cw*ch)
+	counts := make([]int, cw*ch)
+
+	for j := 0; j < rect.Dy(); j++ {
+		cj := (rect.Min.Y+j)/sy - rect.Min.Y/sy
+		for i := 0; i < rect.Dx(); i++ {
+			ci := (rect.Min.X+i)/sx - rect.Min.X/sx
+			y, cb, cr, _ := colors[j*rect.Dx()+i].YCbCr(matrix, rng)
+			img.Y[j*img.YStride+i] = toUint8(y)
+			cbs[cj*cw+ci] += cb
+			crs[cj*cw+ci] += cr
+			counts[cj*cw+ci]++
+		}
+	}
+
+	for j := 0; j < ch; j++ {
+		for i := 0; i < cw; i++ {
+			n := float64(counts[j*cw+i])
+			img.Cb[j*img.CStride+i] = toUint8(cbs[j*cw+i] / n)
+			img.Cr[j*img.CStride+i] = toUint8(crs[j*cw+i] / n)
+		}
+	}
+	return img
+}
+
+func toUint8(v float64) uint8 {
+	return uint8(min(max(math.Round(v*0xff), 0), 0xff))
+}
+
+const (
+	degammaLUTSize = 16384
+	degammaLUTMin  = -1.0
+	degammaLUTMax  = 2.0
+)
+
+// degammaLUT is a lookup table of degamma in [degammaLUTMin, degammaLUTMax].
+// With linear interpolation, the error is less than 1e-7.
+var degammaLUT = sync.OnceValue(func() []float64 {
+	lut := make([]float64, degammaLUTSize+1)
+	for i := range lut {
+		lut[i] = degamma(degammaLUTMin + (degammaLUTMax-degammaLUTMin)*float64(i)/degammaLUTSize)
+	}
+	return lut
+})
+
+func degammaWithLUT(lut []float64, x float64) float64 {
+	if x < degammaLUTMin || x >= degammaLUTMax {
+		return degamma(x)
+	}
+	pos := (x - degammaLUTMin) * (degammaLUTSize / (degammaLUTMax - degammaLUTMin))
+	i := int(pos)
+	t := pos - float64(i)
+	return lut[i] + (lut[i+1]-lut[i])*t
+}
+
+// YCbCrImageToLinearSRGB converts img to linear sRGB channels in row-major order,
+// and stores them in dst as interleaved R, G, and B values.
+// The Y'CbCr values of img are interpreted with the given matrix and range.
+//
+// dst is reused if it has enough capacity. The returned slice has the length of 3 * the number of pixels.
+//
+// YCbCrImageToLinearSRGB is a fast path of [ColorsFromYCbCrImage] using lookup tables.
+// The maximum error against [ColorsFromYCbCrImage] is 1e-6.
+func YCbCrImageToLinearSRGB(dst []float64, img *image.YCbCr, matrix YCbCrMatrix, rng YCbCrRange) []float64 {
+	return convertYCbCrImage(dst, img, matrix, rng, func(dst []float64, r, g, b float64) {
+		dst[0] = r
+		dst[1] = g
+		dst[2] = b
+	})
+}
+
+// YCbCrImageToOKLab converts img to OKLab components in row-major order,
+// and stores them in dst as interleaved L, a, and b values.
+// The Y'CbCr values of img are interpreted with the given matrix and range.
+//
+// dst is reused if it has enough capacity. The returned slice has the length of 3 * the number of pixels.
+//
+// YCbCrImageToOKLab is a fast path of [ColorsFromYCbCrImage] using lookup tables.
+// The maximum error against [ColorsFromYCbCrImage] is 1e-5, as the cube roots of OKLab amplify the errors of dark colors.
+func YCbCrImageToOKLab(dst []float64, img *image.YCbCr, matrix YCbCrMatrix, rng YCbCrRange) []float64 {
+	dst = YCbCrImageToLinearSRGB(dst, img, matrix, rng)
+	return ConvertRow(dst, dst, SpaceLinearSRGB, SpaceOKLab)
+}
+
+func convertYCbCrImage(dst []float64, img *image.YCbCr, matrix YCbCrMatrix, rng YCbCrRange, store func(dst []float64, r, g, b float64)) []float64 {
+	b := img.Rect
+	n := 3 * b.Dx() * b.Dy()
+	if cap(dst) < n {
+		dst = make([]float64, n)
+	}
+	dst = dst[:n]
+	if b.Empty() {
+		return dst
+	}
+
+	kr, kb := matrix.coefficients()
+	kg := 1 - kr - kb
+	yScale, yOffset, cScale, cOffset := rng.scales()
+
+	// Y' and the chroma contributions to R'G'B' per code value.
+	var ys, crR, cbG, crG, cbB [256]float64
+	for i := 0; i < 256; i++ {
+		y := (float64(i) - yOffset) / yScale
+		c := (float64(i) - cOffset) / cScale
+		ys[i] = y
+		crR[i] = 2 * (1 - kr) * c
+		cbB[i] = 2 * (1 - kb) * c
+		cbG[i] = -kb * 2 * (1 - kb) * c / kg
+		crG[i] = -kr * 2 * (1 - kr) * c / kg
+	}
+	lut := degammaLUT()
+
+	sx, sy := subsampleFactors(img.SubsampleRatio)
+	xs := chromaSamples(b.Min.X, b.Max.X, sx)
+	cys := chromaSamples(b.Min.Y, b.Max.Y, sy)
+	fullRes := sx == 1 && sy == 1
+
+	for j := 0; j < b.Dy(); j++ {
+		cy := cys[j]
+		r0 := cy.i0 * img.CStride
+		r1 := cy.i1 * img.CStride
+		for i := 0; i < b.Dx(); i++ {
+			y := ys[img.Y[j*img.YStride+i]]
+			var rr, gg, bb float64
+			if fullRes {
+				cb := img.Cb[j*img.CStride+i]
+				cr := img.Cr[j*img.CStride+i]
+				rr = y + crR[cr]
+				gg = y + cbG[cb] + crG[cr]
+				bb = y + cbB[cb]
+			} else {
+				// The contributions are linear in code values, so interpolating code values is equivalent.
+				cx := xs[i]
+				cb := bilinear(img.Cb, r0, r1, cx, cy.t)
+				cr := bilinear(img.Cr, r0, r1, cx, cy.t)
+				c := (cb - cOffset) / cScale
+				d := (cr - cOffset) / cScale
+				rr = y + 2*(1-kr)*d
+				bb = y + 2*(1-kb)*c
+				gg = (y - kr*rr - kb*bb) / kg
+			}
+			k := 3 * (j*b.Dx() + i)
+			store(dst[k:k+3], degammaWithLUT(lut, rr), degammaWithLUT(lut, gg), degammaWithLUT(lut, bb))
+		}
+	}
+	return dst
+}
@@ -0,0 +1,150 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Hajime Hoshi
+
+package iro_test
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/iro"
+)
+
+var subsampleRatios = []image.YCbCrSubsampleRatio{
+	image.YCbCrSubsampleRatio444,
+	image.YCbCrSubsampleRatio422,
+	image.YCbCrSubsampleRatio420,
+	image.YCbCrSubsampleRatio440,
+	image.YCbCrSubsampleRatio411,
+	image.YCbCrSubsampleRatio410,
+}
+
+func TestColorsFromYCbCrImageMatchesStandardLibrary(t *testing.T) {
+	rect := image.Rect(0, 0, 4, 3)
+	img := image.NewYCbCr(rect, image.YCbCrSubsampleRatio444)
+	for i := range img.Y {
+		img.Y[i] = uint8(i * 20)
+		img.Cb[i] = uint8(255 - i*20)
+		img.Cr[i] = uint8(64 + i*10)
+	}
+
+	colors := iro.ColorsFromYCbCrImage(img, iro.YCbCrMatrixBT601, iro.YCbCrRangeFull)
+	for j := 0; j < rect.Dy(); j++ {
+		for i := 0; i < rect.Dx(); i++ {
+			// color.YCbCr uses the JFIF conversion, i.e, BT.601 in the full range.
+			want := color.NRGBAModel.Convert(img.At(i, j)).(color.NRGBA)
+			r, g, b, _ := colors[j*rect.Dx()+i].SRGB()
+			// The standard library uses fixed-point arithmetic and clamps the results.
+			if d := math.Abs(min(max(r, 0), 1)*0xff - float64(want.R)); d > 1 {
+				t.Errorf("(%d, %d): r: got %f, want %d", i, j, r*0xff, want.R)
+			}
+			if d := math.Abs(min(max(g, 0), 1)*0xff - float64(want.G)); d > 1 {
+				t.Errorf("(%d, %d): g: got %f, want %d", i, j, g*0xff, want.G)
+			}
+			if d := math.Abs(min(max(b, 0), 1)*0xff - float64(want.B)); d > 1 {
+				t.Errorf("(%d, %d): b: got %f, want %d", i, j, b*0xff, want.B)
+			}
+		}
+	}
+}
+
+func TestYCbCrImageRoundTrip(t *testing.T) {
+	for _, ratio := range subsampleRatios {
+		t.Run(fmt.Sprintf("ratio=%s", ratio), func(t *testing.T) {
+			// Use an odd-sized rectangle at an odd origin to exercise partial chroma blocks.
+			rect := image.Rect(1, 3, 10, 8)
+			c := iro.ColorFromSRGB(0.2, 0.5, 0.7, 1)
+			colors := make([]iro.Color, rect.Dx()*rect.Dy())
+			for i := range colors {
+				colors[i] = c
+			}
+
+			img := iro.YCbCrImageFromColors(colors, rect, ratio, iro.YCbCrMatrixBT709, iro.YCbCrRangeLimited)
+			got := iro.ColorsFromYCbCrImage(img, iro.YCbCrMatrixBT709, iro.YCbCrRangeLimited)
+			if len(got) != len(colors) {
+				t.Fatalf("len(got): got %d, want %d", len(got), len(colors))
+			}
+			r0, g0, b0, _ := c.SRGB()
+			for i, c := range got {
+				r1, g1, b1, _ := c.SRGB()
+				// 8-bit quantization of Y'CbCr can shift RGB values by a few code values.
+				const tol = 3.0 / 255
+				if math.Abs(r1-r0) > tol || math.Abs(g1-g0) > tol || math.Abs(b1-b0) > tol {
+					t.Errorf("colors[%d]: got (%f, %f, %f), want (%f, %f, %f)", i, r1, g1, b1, r0, g0, b0)
+				}
+			}
+		})
+	}
+}
+
+func TestColorsFromYCbCrImageUpsampling(t *testing.T) {
+	// A 4:2:0 image with two chroma columns: the chroma should be interpolated between them.
+	rect := image.Rect(0, 0, 4, 2)
+	img := image.NewYCbCr(rect, image.YCbCrSubsampleRatio420)
+	for i := range img.Y {
+		img.Y[i] = 128
+	}
+	img.Cb[0], img.Cb[1] = 128, 128
+	img.Cr[0], img.Cr[1] = 0, 255
+
+	colors := iro.ColorsFromYCbCrImage(img, iro.YCbCrMatrixBT601, iro.YCbCrRangeFull)
+	var prev float64
+	for i := 0; i < rect.Dx(); i++ {
+		_, _, cr, _ := colors[i].YCbCr(iro.YCbCrMatrixBT601, iro.YCbCrRangeFull)
+		if i > 0 && cr <= prev {
+			t.Errorf("cr at x=%d: got %f, want greater than %f", i, cr, prev)
+		}
+		prev = cr
+	}
+}
+
+func TestYCbCrImageFastPaths(t *testing.T) {
+	for _, ratio := range subsampleRatios {
+		t.Run(fmt.Sprintf("ratio=%s", ratio), func(t *testing.T) {
+			rect := image.Rect(0, 0, 7, 5)
+			img := image.NewYCbCr(rect, ratio)
+			for i := range img.Y {
+				img.Y[i] = uint8(i * 37)
+			}
+			for i := range img.Cb {
+				img.Cb[i] = uint8(i * 53)
+				img.Cr[i] = uint8(255 - i*29)
+			}
+
+			colors := iro.ColorsFromYCbCrImage(img, iro.YCbCrMatrixBT709, iro.YCbCrRangeLimited)
+			linear := iro.YCbCrImageToLinearSRGB(nil, img, iro.YCbCrMatrixBT709, iro.YCbCrRangeLimited)
+			oklab := iro.YCbCrImageToOKLab(nil, img, iro.YCbCrMatrixBT709, iro.YCbCrRangeLimited)
+			if len(linear) != 3*len(colors) {
+				t.Fatalf("len(linear): got %d, want %d", len(linear), 3*len(colors))
+			}
+			if len(oklab) != 3*len(colors) {
+				t.Fatalf("len(oklab): got %d, want %d", len(oklab), 3*len(colors))
+			}
+			for i, c := range colors {
+				r, g, b, _ := c.LinearSRGB()
+				for j, want := range []float64{r, g, b} {
+					if diff, ok := check(linear[3*i+j], want); !ok {
+						t.Errorf("linear[%d]: got %f, want %f (diff=%g)", 3*i+j, linear[3*i+j], want, diff)
+					}
+				}
+				l, a, b, _ := c.OKLab()
+				for j, want := range []float64{l, a, b} {
+					if diff := math.Abs(oklab[3*i+j] - want); diff > 1e-5 {
+						t.Errorf("oklab[%d]: got %f, want %f (diff=%g)", 3*i+j, oklab[3*i+j], want, diff)
+					}
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkYCbCrImageToOKLab(b *testing.B) {
+	img := image.NewYCbCr(image.Rect(0, 0, 256, 256), image.YCbCrSubsampleRatio420)
+	var dst []float64
+	for i := 0; i < b.N; i++ {
+		dst = iro.YCbCrImageToOKLab(dst, img, iro.YCbCrMatrixBT601, iro.YCbCrRangeFull)
+	}
+}